@@ -0,0 +1,122 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/ntchjb/gohid/hid"
+	"gopkg.in/yaml.v3"
+
+	hidreport "github.com/ntchjb/usbip-virtual-device/usb/protocol/hid/report"
+)
+
+var (
+	ErrUnknownFormat           = errors.New("unknown format")
+	ErrInvalidReportDescriptor = errors.New("invalid report descriptor")
+)
+
+const (
+	FORMAT_HEX  = "hex"
+	FORMAT_TEXT = "text"
+	FORMAT_JSON = "json"
+	FORMAT_YAML = "yaml"
+)
+
+// formatHex formats bytes as hex, 16 bytes per line
+func formatHex(data []byte) string {
+	var builder strings.Builder
+	for i, b := range data {
+		switch {
+		case i == 0:
+		case i%16 == 0:
+			builder.WriteByte('\n')
+		default:
+			builder.WriteByte(' ')
+		}
+		fmt.Fprintf(&builder, "%02x", b)
+	}
+
+	return builder.String()
+}
+
+func writeReportDescriptor(w io.Writer, desc hidreport.HIDReportDescriptor, format string) error {
+	switch format {
+	case FORMAT_HEX:
+		_, err := fmt.Fprintln(w, formatHex(desc))
+		return err
+	case FORMAT_TEXT:
+		text, err := hid.FormatReportDescriptor(desc)
+		if err != nil {
+			return err
+		}
+		_, err = io.WriteString(w, text)
+		return err
+	case FORMAT_JSON, FORMAT_YAML:
+		items, err := hid.DecompileReportDescriptor(desc)
+		if err != nil {
+			return err
+		}
+		if format == FORMAT_JSON {
+			return writeJSON(w, items)
+		}
+		encoder := yaml.NewEncoder(w)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(items); err != nil {
+			return err
+		}
+		return encoder.Close()
+	}
+
+	return fmt.Errorf("%q: %w", format, ErrUnknownFormat)
+}
+
+func (a *app) descriptor(args []string) error {
+	var flags deviceFlags
+	fs := a.flagSet("descriptor")
+	flags.register(fs)
+	format := fs.String("format", FORMAT_TEXT, "output format: hex, text, json or yaml")
+	file := fs.String("file", "", "read binary report descriptor from a file instead of a device, e.g. report_descriptor of hidraw in sysfs")
+	validate := fs.Bool("validate", false, "validate report descriptor and print diagnostics")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	var desc hidreport.HIDReportDescriptor
+	var options hid.ValidationOptions
+	if *file != "" {
+		data, err := os.ReadFile(*file)
+		if err != nil {
+			return fmt.Errorf("unable to read report descriptor file: %w", err)
+		}
+		desc = data
+	} else {
+		s, err := a.open(&flags)
+		if err != nil {
+			return err
+		}
+		defer s.Close()
+
+		if desc, err = s.device.GetReportDescriptor(); err != nil {
+			return err
+		}
+		options = s.info.GetValidationOptions()
+	}
+
+	if err := writeReportDescriptor(a.stdout, desc, *format); err != nil {
+		return err
+	}
+	if *validate {
+		diagnostics := hid.ValidateReportDescriptor(desc, options)
+		for _, diagnostic := range diagnostics {
+			fmt.Fprintln(a.stdout, diagnostic.String())
+		}
+		if diagnostics.HasErrors() {
+			return fmt.Errorf("report descriptor has %d diagnostics: %w", len(diagnostics), ErrInvalidReportDescriptor)
+		}
+	}
+
+	return nil
+}
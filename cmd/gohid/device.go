@@ -0,0 +1,195 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/google/gousb"
+	"github.com/ntchjb/gohid/hid"
+	"github.com/ntchjb/gohid/manager"
+	"github.com/ntchjb/gohid/usb/capture"
+	"github.com/ntchjb/gohid/usb/record"
+)
+
+var (
+	ErrDeviceNotFound = errors.New("HID device not found")
+)
+
+// idFlag is a vendor or product ID given in hex, with or without 0x prefix
+type idFlag gousb.ID
+
+func (i *idFlag) String() string {
+	return fmt.Sprintf("%04x", uint16(*i))
+}
+
+func (i *idFlag) Set(value string) error {
+	id, err := parseID(value)
+	if err != nil {
+		return err
+	}
+	*i = idFlag(id)
+
+	return nil
+}
+
+func parseID(value string) (gousb.ID, error) {
+	id, err := strconv.ParseUint(value, 16, 16)
+	if err != nil && len(value) > 2 && (value[:2] == "0x" || value[:2] == "0X") {
+		id, err = strconv.ParseUint(value[2:], 16, 16)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("invalid ID %q: %w", value, err)
+	}
+
+	return gousb.ID(id), nil
+}
+
+// filterFlags selects HID interfaces of connected devices
+type filterFlags struct {
+	vendorID  idFlag
+	productID idFlag
+	// Interface number, or -1 for any interface
+	interfaceNumber int
+}
+
+func (f *filterFlags) register(fs *flag.FlagSet) {
+	fs.Var(&f.vendorID, "vid", "vendor ID in hex, e.g. 046d")
+	fs.Var(&f.productID, "pid", "product ID in hex, e.g. c52b")
+	fs.IntVar(&f.interfaceNumber, "interface", -1, "interface number, or -1 for any HID interface")
+}
+
+func (f *filterFlags) find(man manager.DeviceManager) (hid.DeviceInfos, error) {
+	deviceInfos, err := man.Enumerate(gousb.ID(f.vendorID), gousb.ID(f.productID))
+	if err != nil {
+		return nil, err
+	}
+	if f.interfaceNumber < 0 {
+		return deviceInfos, nil
+	}
+
+	var res hid.DeviceInfos
+	for _, info := range deviceInfos {
+		if info.GetInterfaceNumber() == f.interfaceNumber {
+			res = append(res, info)
+		}
+	}
+
+	return res, nil
+}
+
+// deviceFlags selects a HID interface to be opened
+type deviceFlags struct {
+	filterFlags
+	quirks  string
+	capture string
+	record  string
+}
+
+func (f *deviceFlags) register(fs *flag.FlagSet) {
+	f.filterFlags.register(fs)
+	fs.StringVar(&f.quirks, "quirks", "", "path to JSON file of device quirks")
+	fs.StringVar(&f.capture, "capture", "", "write USB traffic of the device to a pcapng file, which can be opened by Wireshark")
+	fs.StringVar(&f.record, "record", "", "write the device session to a JSON lines file, which can be replayed in tests")
+}
+
+// session is an opened HID interface and the device manager it is opened from
+type session struct {
+	manager manager.DeviceManager
+	device  hid.Device
+	info    hid.DeviceInfo
+	// Capture and record files
+	files []io.Closer
+}
+
+func (s *session) closeFiles() error {
+	var err error
+	for _, file := range s.files {
+		err = errors.Join(err, file.Close())
+	}
+
+	return err
+}
+
+func (s *session) Close() error {
+	return errors.Join(s.device.Close(), s.manager.Close(), s.closeFiles())
+}
+
+// open opens the first HID interface matching device flags, and targets the device to it
+func (a *app) open(f *deviceFlags) (*session, error) {
+	config := hid.DeviceConfig{
+		StreamLaneCount: hid.DEFAULT_ENDPOINT_STREAM_COUNT,
+	}
+	if f.quirks != "" {
+		quirks, err := hid.LoadQuirksFile(f.quirks)
+		if err != nil {
+			return nil, err
+		}
+		config.Quirks = quirks
+	}
+
+	s := &session{}
+	usbCtx := a.newContext()
+	if f.capture != "" {
+		file, err := os.Create(f.capture)
+		if err != nil {
+			usbCtx.Close()
+			return nil, fmt.Errorf("unable to create capture file: %w", err)
+		}
+		writer, err := capture.NewPcapngWriter(file, "gohid", 0)
+		if err != nil {
+			usbCtx.Close()
+			file.Close()
+			return nil, err
+		}
+		s.files = append(s.files, file)
+		usbCtx = capture.NewContext(usbCtx, writer, a.logger)
+	}
+	if f.record != "" {
+		file, err := os.Create(f.record)
+		if err != nil {
+			usbCtx.Close()
+			s.closeFiles()
+			return nil, fmt.Errorf("unable to create record file: %w", err)
+		}
+		s.files = append(s.files, file)
+		usbCtx = record.NewRecorder(usbCtx, file, a.logger)
+	}
+	// Closes resources opened so far if the device cannot be opened
+	closeAll := func() {
+		s.manager.Close()
+		s.closeFiles()
+	}
+
+	s.manager = manager.NewDeviceManager(usbCtx, a.logger)
+	deviceInfos, err := f.find(s.manager)
+	if err != nil {
+		closeAll()
+		return nil, err
+	}
+	if len(deviceInfos) == 0 {
+		closeAll()
+		return nil, fmt.Errorf("vid %s, pid %s, interface %d: %w", &f.vendorID, &f.productID, f.interfaceNumber, ErrDeviceNotFound)
+	}
+	s.info = deviceInfos[0]
+
+	device, err := s.manager.Open(s.info.DeviceDesc.Vendor, s.info.DeviceDesc.Product, config)
+	if err != nil {
+		closeAll()
+		return nil, err
+	}
+	s.device = device
+	if err := device.SetAutoDetach(true); err != nil {
+		s.Close()
+		return nil, err
+	}
+	if err := device.SetTarget(s.info.GetConfigNumber(), s.info.GetInterfaceNumber(), s.info.GetAltSettingNumber()); err != nil {
+		s.Close()
+		return nil, err
+	}
+
+	return s, nil
+}
@@ -0,0 +1,92 @@
+package main
+
+import "fmt"
+
+type hidDescriptorEntry struct {
+	HIDVersion  string `json:"hidVersion"`
+	CountryCode uint8  `json:"countryCode"`
+	// Length of report descriptor in bytes
+	ReportDescriptorLength uint16 `json:"reportDescriptorLength"`
+}
+
+type infoEntry struct {
+	deviceEntry
+	Manufacturer  string             `json:"manufacturer"`
+	Product       string             `json:"product"`
+	SerialNumber  string             `json:"serialNumber"`
+	HIDDescriptor hidDescriptorEntry `json:"hidDescriptor"`
+}
+
+func (a *app) info(args []string) error {
+	var flags deviceFlags
+	fs := a.flagSet("info")
+	flags.register(fs)
+	asJSON := fs.Bool("json", false, "print device info as JSON")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	s, err := a.open(&flags)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	entry := infoEntry{deviceEntry: newDeviceEntry(s.info)}
+	// Devices may not have some of the strings, which is not an error
+	deviceStrings := []struct {
+		name  string
+		value *string
+		get   func() (string, error)
+	}{
+		{name: "manufacturer", value: &entry.Manufacturer, get: s.device.GetManufacturer},
+		{name: "product", value: &entry.Product, get: s.device.GetProduct},
+		{name: "serial number", value: &entry.SerialNumber, get: s.device.GetSerialNumber},
+	}
+	for _, str := range deviceStrings {
+		value, err := str.get()
+		if err != nil {
+			a.logger.Warn("unable to get string of device", "name", str.name, "err", err)
+			continue
+		}
+		*str.value = value
+	}
+
+	hidDesc, err := s.device.GetHIDDescriptor()
+	if err != nil {
+		return err
+	}
+	entry.HIDDescriptor = newHIDDescriptorEntry(hidDesc.BCDHID, hidDesc.BCountryCode, hidDesc.WDescriptorLength)
+
+	if *asJSON {
+		return writeJSON(a.stdout, entry)
+	}
+	a.writeInfo(entry)
+
+	return nil
+}
+
+func newHIDDescriptorEntry(bcdHID uint16, countryCode uint8, reportDescriptorLength uint16) hidDescriptorEntry {
+	return hidDescriptorEntry{
+		HIDVersion:             fmt.Sprintf("%x.%02x", bcdHID>>8, bcdHID&0xFF),
+		CountryCode:            countryCode,
+		ReportDescriptorLength: reportDescriptorLength,
+	}
+}
+
+func (a *app) writeInfo(entry infoEntry) {
+	fmt.Fprintf(a.stdout, "Device:            %04x:%04x (bus %d, address %d, %s)\n", entry.VendorID, entry.ProductID, entry.Bus, entry.Address, entry.Speed)
+	fmt.Fprintf(a.stdout, "Manufacturer:      %s\n", entry.Manufacturer)
+	fmt.Fprintf(a.stdout, "Product:           %s\n", entry.Product)
+	fmt.Fprintf(a.stdout, "Serial number:     %s\n", entry.SerialNumber)
+	fmt.Fprintf(a.stdout, "Interface:         config %d, interface %d, alt setting %d\n", entry.Config, entry.Interface, entry.AltSetting)
+	fmt.Fprintf(a.stdout, "Subclass/protocol: %d/%d\n", entry.SubClass, entry.Protocol)
+	fmt.Fprintf(a.stdout, "HID version:       %s\n", entry.HIDDescriptor.HIDVersion)
+	fmt.Fprintf(a.stdout, "Country code:      %d\n", entry.HIDDescriptor.CountryCode)
+	fmt.Fprintf(a.stdout, "Report descriptor: %d bytes\n", entry.HIDDescriptor.ReportDescriptorLength)
+	fmt.Fprintf(a.stdout, "Endpoints:\n")
+	for _, endpoint := range entry.Endpoints {
+		fmt.Fprintf(a.stdout, "  0x%02x %-3s %s, max packet size %d, interval %dus\n",
+			endpoint.Address, endpoint.Direction, endpoint.TransferType, endpoint.MaxPacketSize, endpoint.PollInterval)
+	}
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/google/gousb"
+	"github.com/ntchjb/gohid/hid"
+	"github.com/ntchjb/gohid/manager"
+)
+
+type endpointEntry struct {
+	Address       uint8  `json:"address"`
+	Direction     string `json:"direction"`
+	TransferType  string `json:"transferType"`
+	MaxPacketSize int    `json:"maxPacketSize"`
+	// Polling interval in microseconds
+	PollInterval int64 `json:"pollInterval"`
+}
+
+// deviceEntry is a HID interface of a connected device, as shown by list and info commands
+type deviceEntry struct {
+	VendorID   uint16          `json:"vendorId"`
+	ProductID  uint16          `json:"productId"`
+	Bus        int             `json:"bus"`
+	Address    int             `json:"address"`
+	Speed      string          `json:"speed"`
+	Config     int             `json:"config"`
+	Interface  int             `json:"interface"`
+	AltSetting int             `json:"altSetting"`
+	SubClass   uint8           `json:"subClass"`
+	Protocol   uint8           `json:"protocol"`
+	Endpoints  []endpointEntry `json:"endpoints"`
+}
+
+func newDeviceEntry(info hid.DeviceInfo) deviceEntry {
+	desc := info.DeviceDesc
+	entry := deviceEntry{
+		VendorID:   uint16(desc.Vendor),
+		ProductID:  uint16(desc.Product),
+		Bus:        desc.Bus,
+		Address:    desc.Address,
+		Speed:      desc.Speed.String(),
+		Config:     info.GetConfigNumber(),
+		Interface:  info.GetInterfaceNumber(),
+		AltSetting: info.GetAltSettingNumber(),
+		Endpoints:  []endpointEntry{},
+	}
+	for _, inf := range desc.Configs[entry.Config].Interfaces {
+		for _, setting := range inf.AltSettings {
+			if inf.Number == entry.Interface && setting.Alternate == entry.AltSetting {
+				entry.SubClass = uint8(setting.SubClass)
+				entry.Protocol = uint8(setting.Protocol)
+			}
+		}
+	}
+	for _, endpoint := range info.GetEndpoints() {
+		direction := "out"
+		if endpoint.Direction == gousb.EndpointDirectionIn {
+			direction = "in"
+		}
+		entry.Endpoints = append(entry.Endpoints, endpointEntry{
+			Address:       uint8(endpoint.Address),
+			Direction:     direction,
+			TransferType:  endpoint.TransferType.String(),
+			MaxPacketSize: endpoint.MaxPacketSize,
+			PollInterval:  endpoint.PollInterval.Microseconds(),
+		})
+	}
+	sort.Slice(entry.Endpoints, func(i, j int) bool {
+		return entry.Endpoints[i].Address < entry.Endpoints[j].Address
+	})
+
+	return entry
+}
+
+func writeJSON(w io.Writer, v any) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(v)
+}
+
+func (a *app) list(args []string) error {
+	var filter filterFlags
+	fs := a.flagSet("list")
+	filter.register(fs)
+	asJSON := fs.Bool("json", false, "print devices as JSON")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	man := manager.NewDeviceManager(a.newContext(), a.logger)
+	defer man.Close()
+
+	deviceInfos, err := filter.find(man)
+	if err != nil {
+		return err
+	}
+	if *asJSON {
+		entries := []deviceEntry{}
+		for _, info := range deviceInfos {
+			entries = append(entries, newDeviceEntry(info))
+		}
+		return writeJSON(a.stdout, entries)
+	}
+	for _, info := range deviceInfos {
+		fmt.Fprintln(a.stdout, info.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+// Command gohid enumerates HID devices and reads or writes their reports, e.g.
+//
+//	gohid list -vid 046d
+//	gohid info -vid 046d -pid c52b -interface 2
+//	gohid descriptor -vid 046d -pid c52b -interface 2 -format text
+//	gohid read -vid 046d -pid c52b -interface 2 -decode
+//	gohid monitor -vid 046d -pid c52b -interface 2
+//	gohid set-feature -vid 046d -pid c52b -interface 2 10 ff 00 00
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	"github.com/ntchjb/gohid/usb"
+)
+
+var (
+	ErrUnknownCommand = errors.New("unknown command")
+)
+
+type command struct {
+	name string
+	// Arguments following flags of the command, shown in usage
+	args    string
+	summary string
+	run     func(a *app, args []string) error
+}
+
+var commands []command
+
+func init() {
+	commands = []command{
+		{name: "list", summary: "list HID interfaces of connected USB devices", run: (*app).list},
+		{name: "info", summary: "show strings, HID descriptor and endpoints of a device", run: (*app).info},
+		{name: "descriptor", summary: "dump or decode report descriptor of a device or a file", run: (*app).descriptor},
+		{name: "read", summary: "stream input reports as hex or decoded values", run: (*app).read},
+		{name: "monitor", summary: "show a live table of decoded input report values", run: (*app).monitor},
+		{name: "write", args: "<hex bytes>", summary: "write an output report, starting with report ID", run: (*app).write},
+		{name: "get-feature", summary: "get a feature report", run: (*app).getFeature},
+		{name: "set-feature", args: "<hex bytes>", summary: "set a feature report, starting with report ID", run: (*app).setFeature},
+	}
+}
+
+// app holds dependencies shared by all commands
+type app struct {
+	stdout io.Writer
+	stderr io.Writer
+	logger *slog.Logger
+	// Creates USB context for accessing devices
+	newContext func() usb.Context
+}
+
+func (a *app) usage() {
+	fmt.Fprintf(a.stderr, "Usage: gohid <command> [flags] [args]\n\nCommands:\n")
+	for _, cmd := range commands {
+		fmt.Fprintf(a.stderr, "  %-12s %s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprintf(a.stderr, "\nRun 'gohid <command> -h' for flags of a command.\n")
+}
+
+// flagSet creates flag set of a command, which prints usage of the command on error
+func (a *app) flagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(a.stderr)
+	fs.Usage = func() {
+		for _, cmd := range commands {
+			if cmd.name == name {
+				fmt.Fprintf(a.stderr, "Usage: gohid %s [flags] %s\n\n%s\n\nFlags:\n", cmd.name, cmd.args, cmd.summary)
+			}
+		}
+		fs.PrintDefaults()
+	}
+
+	return fs
+}
+
+func (a *app) run(args []string) error {
+	if len(args) == 0 || args[0] == "-h" || args[0] == "help" {
+		a.usage()
+		return nil
+	}
+	for _, cmd := range commands {
+		if cmd.name == args[0] {
+			return cmd.run(a, args[1:])
+		}
+	}
+	a.usage()
+
+	return fmt.Errorf("%q: %w", args[0], ErrUnknownCommand)
+}
+
+func main() {
+	a := &app{
+		stdout: os.Stdout,
+		stderr: os.Stderr,
+		logger: slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelWarn})),
+		newContext: func() usb.Context {
+			return usb.NewGOUSBContext()
+		},
+	}
+	if err := a.run(os.Args[1:]); err != nil && !errors.Is(err, flag.ErrHelp) {
+		fmt.Fprintf(os.Stderr, "gohid: %v\n", err)
+		os.Exit(1)
+	}
+}
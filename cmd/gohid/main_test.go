@@ -0,0 +1,248 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/gousb"
+	"github.com/ntchjb/gohid/hid"
+	"github.com/ntchjb/gohid/usb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	hidreport "github.com/ntchjb/usbip-virtual-device/usb/protocol/hid/report"
+)
+
+var deviceDesc = &gousb.DeviceDesc{
+	Bus:     1,
+	Address: 21,
+	Speed:   gousb.SpeedFull,
+	Vendor:  0xFF01,
+	Product: 0x0001,
+	Configs: map[int]gousb.ConfigDesc{
+		1: {
+			Number: 1,
+			Interfaces: []gousb.InterfaceDesc{
+				{
+					Number: 0,
+					AltSettings: []gousb.InterfaceSetting{
+						{Number: 0, Alternate: 0, Class: gousb.ClassVendorSpec},
+					},
+				},
+				{
+					Number: 1,
+					AltSettings: []gousb.InterfaceSetting{
+						{
+							Number:    1,
+							Alternate: 0,
+							Class:     gousb.ClassHID,
+							SubClass:  gousb.Class(hid.HID_SUBCLASS_BOOT_INTERFACE),
+							Protocol:  gousb.Protocol(hid.HID_PROTOCOL_MOUSE),
+							Endpoints: map[gousb.EndpointAddress]gousb.EndpointDesc{
+								0x81: {
+									Address:       0x81,
+									Number:        1,
+									Direction:     gousb.EndpointDirectionIn,
+									MaxPacketSize: 8,
+									TransferType:  gousb.TransferTypeInterrupt,
+									PollInterval:  time.Millisecond,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func newTestApp(t *testing.T) (*app, *bytes.Buffer) {
+	ctrl := gomock.NewController(t)
+	usbCtx := usb.NewMockContext(ctrl)
+	usbCtx.EXPECT().IterateDevices(gomock.Any()).DoAndReturn(func(reader func(desc *gousb.DeviceDesc)) error {
+		reader(deviceDesc)
+		return nil
+	}).AnyTimes()
+	usbCtx.EXPECT().Close().Return(nil).AnyTimes()
+
+	var stdout bytes.Buffer
+	return &app{
+		stdout: &stdout,
+		stderr: &bytes.Buffer{},
+		logger: slog.Default(),
+		newContext: func() usb.Context {
+			return usbCtx
+		},
+	}, &stdout
+}
+
+func TestParseID(t *testing.T) {
+	tests := []struct {
+		value string
+		id    gousb.ID
+		isErr bool
+	}{
+		{value: "046d", id: 0x046D},
+		{value: "0xC52B", id: 0xC52B},
+		{value: "1", id: 0x0001},
+		{value: "10000", isErr: true},
+		{value: "xyz", isErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			id, err := parseID(test.value)
+			if test.isErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.id, id)
+		})
+	}
+}
+
+func TestParseHexBytes(t *testing.T) {
+	data, err := parseHexBytes([]string{"01", "ff:00", "0A0b"})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x01, 0xFF, 0x00, 0x0A, 0x0B}, data)
+
+	_, err = parseHexBytes(nil)
+	assert.ErrorIs(t, err, ErrMissingData)
+
+	_, err = parseHexBytes([]string{"0"})
+	assert.Error(t, err)
+}
+
+// newTestSchema creates schema of a report with 2 buttons, X, Y and 2 keys
+func newTestSchema(t *testing.T) *hid.ReportSchema {
+	desc, err := hid.NewReportDescriptorBuilder().
+		UsagePage(0x01).Usage(0x02).Collection(hidreport.HID_REPORT_COLLECTION_APPLICATION).
+		ReportID(1).
+		UsagePage(0x09).UsageMinimum(1).UsageMaximum(2).
+		LogicalMinimum(0).LogicalMaximum(1).ReportCount(2).ReportSize(1).
+		Input(hid.REPORT_FLAG_DATA | hid.REPORT_FLAG_VARIABLE).
+		ReportCount(1).ReportSize(6).
+		Input(hid.REPORT_FLAG_CONSTANT).
+		UsagePage(0x01).Usage(0x30).Usage(0x31).
+		LogicalMinimum(-127).LogicalMaximum(127).ReportSize(8).ReportCount(2).
+		Input(hid.REPORT_FLAG_DATA | hid.REPORT_FLAG_VARIABLE | hid.REPORT_FLAG_RELATIVE).
+		UsagePage(0x07).UsageMinimum(0).UsageMaximum(0x65).
+		LogicalMinimum(0).LogicalMaximum(0x65).ReportSize(8).ReportCount(2).
+		Input(hid.REPORT_FLAG_DATA | hid.REPORT_FLAG_ARRAY).
+		EndCollection().
+		Build()
+	require.NoError(t, err)
+	schema, err := hid.ParseReportDescriptor(desc)
+	require.NoError(t, err)
+
+	return schema
+}
+
+func TestDecodeReport(t *testing.T) {
+	schema := newTestSchema(t)
+
+	layout, payload, err := schema.SplitInputReport([]byte{0x01, 0b10, 0x05, 0xFD, 0x04, 0x00})
+	require.NoError(t, err)
+	values, err := decodeReport(layout, payload)
+	require.NoError(t, err)
+	assert.Equal(t, "report 1: Button 1=0 Button 2=1 X=5 Y=-3 Keyboard A=1", formatReport(layout, values))
+
+	var stdout bytes.Buffer
+	a := &app{stdout: &stdout}
+	a.writeReport(schema, []byte{0x02, 0x00})
+	a.writeReport(nil, []byte{0x01, 0xAB})
+	assert.Equal(t, "02 00 (report type 1, ID 2: report layout not found)\n01 ab\n", stdout.String())
+}
+
+func TestInputReportSize(t *testing.T) {
+	schema := newTestSchema(t)
+
+	assert.Equal(t, 6, inputReportSize(schema, 4))
+	assert.Equal(t, 64, inputReportSize(schema, 64))
+	assert.Equal(t, 8, inputReportSize(nil, 8))
+}
+
+func TestApp_List(t *testing.T) {
+	a, stdout := newTestApp(t)
+
+	err := a.run([]string{"list", "-vid", "ff01", "-json"})
+	require.NoError(t, err)
+
+	var entries []deviceEntry
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &entries))
+	assert.Equal(t, []deviceEntry{
+		{
+			VendorID:   0xFF01,
+			ProductID:  0x0001,
+			Bus:        1,
+			Address:    21,
+			Speed:      gousb.SpeedFull.String(),
+			Config:     1,
+			Interface:  1,
+			AltSetting: 0,
+			SubClass:   uint8(hid.HID_SUBCLASS_BOOT_INTERFACE),
+			Protocol:   uint8(hid.HID_PROTOCOL_MOUSE),
+			Endpoints: []endpointEntry{
+				{Address: 0x81, Direction: "in", TransferType: gousb.TransferTypeInterrupt.String(), MaxPacketSize: 8, PollInterval: 1000},
+			},
+		},
+	}, entries)
+
+	stdout.Reset()
+	err = a.run([]string{"list", "-interface", "2"})
+	require.NoError(t, err)
+	assert.Empty(t, stdout.String())
+}
+
+func TestApp_Descriptor(t *testing.T) {
+	a, stdout := newTestApp(t)
+	path := filepath.Join(t.TempDir(), "report_descriptor")
+	require.NoError(t, os.WriteFile(path, []byte{0x05, 0x01, 0x09, 0x02, 0xA1, 0x01, 0xC0}, 0o644))
+
+	tests := []struct {
+		format string
+		output string
+	}{
+		{format: FORMAT_HEX, output: "05 01 09 02 a1 01 c0\n"},
+		{format: FORMAT_TEXT, output: "0x05, 0x01, // Usage Page (Generic Desktop)\n0x09, 0x02, // Usage (Mouse)\n0xA1, 0x01, // Collection (Application)\n0xC0,       // End Collection\n"},
+		{format: FORMAT_YAML, output: "- item: Usage Page\n  value: 1\n  description: Generic Desktop\n- item: Usage\n  value: 2\n  description: Mouse\n- item: Collection\n  value: 1\n  description: Application\n"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.format, func(t *testing.T) {
+			stdout.Reset()
+			err := a.run([]string{"descriptor", "-file", path, "-format", test.format})
+			require.NoError(t, err)
+			assert.Equal(t, test.output, stdout.String())
+		})
+	}
+
+	err := a.run([]string{"descriptor", "-file", path, "-format", "xml"})
+	assert.ErrorIs(t, err, ErrUnknownFormat)
+}
+
+func TestApp_Run_Error(t *testing.T) {
+	a, _ := newTestApp(t)
+
+	err := a.run([]string{"unknown"})
+	assert.ErrorIs(t, err, ErrUnknownCommand)
+
+	err = a.run([]string{"info", "-vid", "1234"})
+	assert.ErrorIs(t, err, ErrDeviceNotFound)
+
+	err = a.run([]string{"set-feature", "-vid", "ff01"})
+	assert.ErrorIs(t, err, ErrMissingData)
+
+	err = a.run([]string{"get-feature", "-vid", "ff01", "-length", "-1"})
+	assert.ErrorIs(t, err, ErrInvalidLength)
+
+	assert.True(t, strings.HasPrefix(a.stderr.(*bytes.Buffer).String(), "Usage: gohid"))
+}
@@ -0,0 +1,237 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ntchjb/gohid/hid"
+	"github.com/ntchjb/gohid/usages"
+)
+
+const (
+	ANSI_CLEAR_SCREEN = "\x1b[H\x1b[2J"
+	ANSI_REVERSE      = "\x1b[7m"
+	ANSI_RESET        = "\x1b[0m"
+)
+
+// monitorRow is a value of a variable field, or selected usages of an array field
+type monitorRow struct {
+	reportID uint8
+	name     string
+	value    string
+	// Time when value of this row is changed
+	changedAt time.Time
+}
+
+// monitorTable keeps the latest values of all usages of input reports, in order of their first appearance
+type monitorTable struct {
+	rows []*monitorRow
+	// Index of rows, keyed by report ID, field index and value index
+	rowIndex map[[3]int]*monitorRow
+	// Number of reports received, keyed by report ID
+	reportCounts map[uint8]int
+	// Number of reports which cannot be decoded
+	errorCount int
+	lastError  error
+}
+
+func newMonitorTable() *monitorTable {
+	return &monitorTable{
+		rowIndex:     make(map[[3]int]*monitorRow),
+		reportCounts: make(map[uint8]int),
+	}
+}
+
+func formatFieldValue(field *hid.ReportField, value int32) string {
+	str := strconv.Itoa(int(value))
+	if field.Unit != 0 {
+		str += fmt.Sprintf(" (%.4g)", field.PhysicalValue(value))
+	}
+
+	return str
+}
+
+func (m *monitorTable) set(key [3]int, reportID uint8, name, value string, now time.Time) {
+	row, ok := m.rowIndex[key]
+	if !ok {
+		row = &monitorRow{reportID: reportID, name: name}
+		m.rowIndex[key] = row
+		m.rows = append(m.rows, row)
+	}
+	if !ok || row.value != value {
+		row.value = value
+		row.changedAt = now
+	}
+}
+
+// update decodes an input report and updates values of its usages
+func (m *monitorTable) update(schema *hid.ReportSchema, data []byte, now time.Time) {
+	layout, payload, err := schema.SplitInputReport(data)
+	if err != nil {
+		m.errorCount++
+		m.lastError = err
+		return
+	}
+	m.reportCounts[layout.ID]++
+
+	for fieldIndex, field := range layout.Fields {
+		if field.IsConstant() {
+			continue
+		}
+		if field.IsVariable() {
+			for i := 0; i < int(field.ReportCount); i++ {
+				value, err := field.Value(payload, i)
+				if err != nil {
+					m.errorCount++
+					m.lastError = err
+					return
+				}
+				m.set([3]int{int(layout.ID), fieldIndex, i}, layout.ID, usageName(field.Usage(i)), formatFieldValue(field, value), now)
+			}
+			continue
+		}
+
+		var selected []string
+		for i := 0; i < int(field.ReportCount); i++ {
+			value, err := field.Value(payload, i)
+			if err != nil {
+				m.errorCount++
+				m.lastError = err
+				return
+			}
+			// Usage ID 0 of array fields means no usage is selected
+			if usage, ok := field.ArrayUsage(value); ok && usage.ID() != 0 {
+				selected = append(selected, usageName(usage))
+			}
+		}
+		name := usages.PageName(field.Usage(0).Page()) + " array"
+		m.set([3]int{int(layout.ID), fieldIndex, 0}, layout.ID, name, strings.Join(selected, ", "), now)
+	}
+}
+
+// render writes the table to a terminal. Rows changed within highlight duration are shown in reverse video.
+func (m *monitorTable) render(w io.Writer, title string, now time.Time, highlight time.Duration) {
+	var builder strings.Builder
+	builder.WriteString(ANSI_CLEAR_SCREEN)
+	builder.WriteString(title + "\n")
+	reportIDs := make([]int, 0, len(m.reportCounts))
+	for reportID := range m.reportCounts {
+		reportIDs = append(reportIDs, int(reportID))
+	}
+	sort.Ints(reportIDs)
+	for _, reportID := range reportIDs {
+		fmt.Fprintf(&builder, "report %d: %d received  ", reportID, m.reportCounts[uint8(reportID)])
+	}
+	if m.errorCount > 0 {
+		fmt.Fprintf(&builder, "errors: %d (%v)", m.errorCount, m.lastError)
+	}
+	builder.WriteString("\n\n")
+
+	nameWidth := len("Usage")
+	for _, row := range m.rows {
+		nameWidth = max(nameWidth, len(row.name))
+	}
+	fmt.Fprintf(&builder, "%-4s %-*s %s\n", "ID", nameWidth, "Usage", "Value")
+	for _, row := range m.rows {
+		line := fmt.Sprintf("%-4d %-*s %s", row.reportID, nameWidth, row.name, row.value)
+		if now.Sub(row.changedAt) < highlight {
+			line = ANSI_REVERSE + line + ANSI_RESET
+		}
+		builder.WriteString(line + "\n")
+	}
+
+	io.WriteString(w, builder.String())
+}
+
+func (a *app) monitor(args []string) error {
+	var flags deviceFlags
+	fs := a.flagSet("monitor")
+	flags.register(fs)
+	interval := fs.Duration("interval", 100*time.Millisecond, "refresh interval of the table")
+	highlight := fs.Duration("highlight", time.Second, "duration for which changed values are highlighted")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	s, err := a.open(&flags)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	schema, err := s.reportSchema()
+	if err != nil {
+		return err
+	}
+	title := fmt.Sprintf("gohid monitor %s (press Ctrl+C to exit)", s.info.String())
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	// Reading is cancelled and waited for before the device is closed, as its stream must not be closed while it is read
+	readCtx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	defer cancel()
+
+	reports := make(chan []byte)
+	// The only buffer is handed back once its report is decoded, so it is reused for all reports
+	free := make(chan []byte, 1)
+	free <- make([]byte, inputReportSize(schema, s.inputPacketSize()))
+	readErr := make(chan error, 1)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			var buf []byte
+			select {
+			case buf = <-free:
+			case <-readCtx.Done():
+				return
+			}
+			n, err := s.device.ReadInput(readCtx, buf)
+			if err != nil {
+				readErr <- err
+				return
+			}
+			if n == 0 {
+				free <- buf
+				continue
+			}
+			select {
+			case reports <- buf[:n]:
+			case <-readCtx.Done():
+				return
+			}
+		}
+	}()
+
+	table := newMonitorTable()
+	ticker := time.NewTicker(*interval)
+	defer ticker.Stop()
+	table.render(a.stdout, title, time.Now(), *highlight)
+	for {
+		select {
+		case data := <-reports:
+			table.update(schema, data, time.Now())
+			free <- data[:cap(data)]
+		case <-ticker.C:
+			table.render(a.stdout, title, time.Now(), *highlight)
+		case err := <-readErr:
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMonitorTable(t *testing.T) {
+	schema := newTestSchema(t)
+	table := newMonitorTable()
+	start := time.Unix(1000, 0)
+
+	table.update(schema, []byte{0x01, 0b01, 0x05, 0xFD, 0x04, 0x05}, start)
+	table.update(schema, []byte{0x01, 0b01, 0x06, 0xFD, 0x00, 0x00}, start.Add(time.Second))
+	table.update(schema, []byte{0x02}, start.Add(time.Second))
+
+	var buf bytes.Buffer
+	table.render(&buf, "title", start.Add(1500*time.Millisecond), time.Second)
+	assert.Equal(t, ANSI_CLEAR_SCREEN+"title\n"+
+		"report 1: 2 received  errors: 1 (report type 1, ID 2: report layout not found)\n\n"+
+		"ID   Usage                 Value\n"+
+		"1    Button 1              1\n"+
+		"1    Button 2              0\n"+
+		ANSI_REVERSE+"1    X                     6"+ANSI_RESET+"\n"+
+		"1    Y                     -3\n"+
+		ANSI_REVERSE+"1    Keyboard/Keypad array "+ANSI_RESET+"\n",
+		buf.String())
+}
@@ -0,0 +1,328 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/google/gousb"
+	"github.com/ntchjb/gohid/hid"
+	"github.com/ntchjb/gohid/usages"
+)
+
+var (
+	ErrMissingData   = errors.New("missing report data")
+	ErrInvalidLength = errors.New("invalid report length")
+	ErrEmptyReport   = errors.New("empty report")
+)
+
+const (
+	DEFAULT_REPORT_BUFFER_SIZE = 64
+)
+
+// parseHexBytes parses report data given as hex arguments, e.g. "01 ff 00" or "01ff00".
+// Colons between bytes are ignored.
+func parseHexBytes(args []string) ([]byte, error) {
+	str := strings.ReplaceAll(strings.Join(args, ""), ":", "")
+	str = strings.TrimPrefix(strings.TrimPrefix(str, "0x"), "0X")
+	if str == "" {
+		return nil, ErrMissingData
+	}
+	data, err := hex.DecodeString(str)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode hex bytes: %w", err)
+	}
+
+	return data, nil
+}
+
+// fieldValue is a value of a usage in a report
+type fieldValue struct {
+	Usage hid.Usage
+	Value int32
+}
+
+// usageName returns name of a usage, or its page and ID if it is not defined in HID Usage Tables
+func usageName(usage hid.Usage) string {
+	if u, ok := usages.Lookup(usage.Page(), usage.ID()); ok {
+		return u.Name
+	}
+
+	return usage.String()
+}
+
+// decodeReport returns values of non-constant fields of report data, excluding report ID.
+// Array fields are decoded into their selected usages with value 1.
+func decodeReport(layout *hid.ReportLayout, payload []byte) ([]fieldValue, error) {
+	var values []fieldValue
+	for _, field := range layout.Fields {
+		if field.IsConstant() {
+			continue
+		}
+		for i := 0; i < int(field.ReportCount); i++ {
+			value, err := field.Value(payload, i)
+			if err != nil {
+				return nil, err
+			}
+			if field.IsVariable() {
+				values = append(values, fieldValue{Usage: field.Usage(i), Value: value})
+				continue
+			}
+			// Usage ID 0 of array fields means no usage is selected
+			if usage, ok := field.ArrayUsage(value); ok && usage.ID() != 0 {
+				values = append(values, fieldValue{Usage: usage, Value: 1})
+			}
+		}
+	}
+
+	return values, nil
+}
+
+func formatReport(layout *hid.ReportLayout, values []fieldValue) string {
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "report %d:", layout.ID)
+	for _, value := range values {
+		fmt.Fprintf(&builder, " %s=%d", usageName(value.Usage), value.Value)
+	}
+
+	return builder.String()
+}
+
+// reportSchema gets and parses report descriptor of an opened device
+func (s *session) reportSchema() (*hid.ReportSchema, error) {
+	desc, err := s.device.GetReportDescriptor()
+	if err != nil {
+		return nil, err
+	}
+
+	return hid.ParseReportDescriptor(desc)
+}
+
+// inputPacketSize returns max packet size of interrupt IN endpoint of an opened device
+func (s *session) inputPacketSize() int {
+	for _, endpoint := range s.info.GetEndpoints() {
+		if endpoint.Direction == gousb.EndpointDirectionIn && endpoint.TransferType == gousb.TransferTypeInterrupt {
+			return endpoint.MaxPacketSize
+		}
+	}
+
+	return DEFAULT_REPORT_BUFFER_SIZE
+}
+
+// inputReportSize returns size of a buffer holding the largest Input report of schema with its report ID,
+// which is at least max packet size of interrupt IN endpoint so that a packet does not overflow the buffer
+func inputReportSize(schema *hid.ReportSchema, packetSize int) int {
+	size := packetSize
+	if schema == nil {
+		return size
+	}
+	for _, layout := range schema.Layouts {
+		if layout.Type == hid.REPORT_TYPE_INPUT {
+			size = max(size, 1+layout.ByteSize())
+		}
+	}
+
+	return size
+}
+
+func (a *app) read(args []string) error {
+	var flags deviceFlags
+	fs := a.flagSet("read")
+	flags.register(fs)
+	count := fs.Int("count", 0, "number of reports to read, or 0 to read until interrupted")
+	timeout := fs.Duration("timeout", 0, "stop reading after this duration, or 0 to read until interrupted")
+	decode := fs.Bool("decode", false, "decode reports using report descriptor")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	s, err := a.open(&flags)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	schema, err := s.reportSchema()
+	if err != nil {
+		if *decode {
+			return err
+		}
+		a.logger.Warn("unable to get report descriptor, which sizes report buffer", "err", err)
+	}
+	buf := make([]byte, inputReportSize(schema, s.inputPacketSize()))
+	if !*decode {
+		schema = nil
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	if *timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
+	for i := 0; *count == 0 || i < *count; {
+		n, err := s.device.ReadInput(ctx, buf)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			continue
+		}
+		i++
+		a.writeReport(schema, buf[:n])
+	}
+
+	return nil
+}
+
+// writeReport prints an input report as hex, or decoded if schema is given
+func (a *app) writeReport(schema *hid.ReportSchema, data []byte) {
+	if schema == nil {
+		fmt.Fprintln(a.stdout, formatHex(data))
+		return
+	}
+	layout, payload, err := schema.SplitInputReport(data)
+	if err == nil {
+		var values []fieldValue
+		if values, err = decodeReport(layout, payload); err == nil {
+			fmt.Fprintln(a.stdout, formatReport(layout, values))
+			return
+		}
+	}
+	fmt.Fprintf(a.stdout, "%s (%v)\n", formatHex(data), err)
+}
+
+func (a *app) write(args []string) error {
+	var flags deviceFlags
+	fs := a.flagSet("write")
+	flags.register(fs)
+	control := fs.Bool("control", false, "send output report via control endpoint instead of interrupt OUT endpoint")
+	timeout := fs.Duration("timeout", 5*time.Second, "timeout of writing to interrupt OUT endpoint")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	data, err := parseHexBytes(fs.Args())
+	if err != nil {
+		return err
+	}
+
+	s, err := a.open(&flags)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	var n int
+	if *control {
+		n, err = s.device.SendOutputReport(data)
+	} else {
+		ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+		defer cancel()
+		n, err = s.device.WriteOutput(ctx, data)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(a.stdout, "%d bytes written\n", n)
+
+	return nil
+}
+
+func (a *app) getFeature(args []string) error {
+	var flags deviceFlags
+	fs := a.flagSet("get-feature")
+	flags.register(fs)
+	reportID := fs.Uint("id", 0, "report ID, or 0 if the device does not use report IDs")
+	length := fs.Int("length", 0, "length of the report including report ID, or 0 to get it from report descriptor")
+	decode := fs.Bool("decode", false, "decode report using report descriptor")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *reportID > 0xFF {
+		return fmt.Errorf("report ID %d: %w", *reportID, hid.ErrReportItemOutOfRange)
+	}
+	// Report includes its report ID byte, which is zero if the device does not use report IDs
+	if *length < 0 || *length > int(hid.HID_MAX_REPORT_SIZE) {
+		fs.Usage()
+		return fmt.Errorf("-length %d: %w", *length, ErrInvalidLength)
+	}
+
+	s, err := a.open(&flags)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	var layout *hid.ReportLayout
+	if *decode || *length == 0 {
+		schema, err := s.reportSchema()
+		if err != nil {
+			return err
+		}
+		if layout, err = schema.Layout(hid.REPORT_TYPE_FEATURE, uint8(*reportID)); err != nil {
+			return err
+		}
+	}
+	buf := make([]byte, *length)
+	if *length == 0 {
+		buf = layout.NewBuffer()
+	}
+	buf[0] = uint8(*reportID)
+
+	n, err := s.device.GetFeatureReport(buf)
+	if err != nil {
+		return err
+	}
+	buf = buf[:n]
+	if !*decode {
+		fmt.Fprintln(a.stdout, formatHex(buf))
+		return nil
+	}
+	if n < 1 {
+		return fmt.Errorf("feature report %d: %w", *reportID, ErrEmptyReport)
+	}
+	values, err := decodeReport(layout, buf[1:])
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(a.stdout, formatReport(layout, values))
+
+	return nil
+}
+
+func (a *app) setFeature(args []string) error {
+	var flags deviceFlags
+	fs := a.flagSet("set-feature")
+	flags.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	data, err := parseHexBytes(fs.Args())
+	if err != nil {
+		return err
+	}
+
+	s, err := a.open(&flags)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	n, err := s.device.SendFeatureReport(data)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(a.stdout, "%d bytes written\n", n)
+
+	return nil
+}
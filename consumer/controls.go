@@ -0,0 +1,103 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/ntchjb/gohid/hid"
+)
+
+var (
+	ErrNoControlFound = errors.New("no consumer or telephony control found")
+	ErrNoNullValue    = errors.New("array field has no value selecting no control")
+)
+
+// Controls provides consumer and telephony controls of a HID device, e.g. a headset or a media remote
+type Controls interface {
+	// Read Input reports until at least one event of consumer or telephony controls is decoded
+	Read(ctx context.Context) ([]Event, error)
+	// Turn on or off an output control, e.g. LED_RING or LED_OFF_HOOK, via an Output report
+	SetLED(ctx context.Context, led hid.Usage, on bool) error
+}
+
+type controlsImpl struct {
+	device  hid.Device
+	decoder *Decoder
+	encoder *Encoder
+
+	// Size of buffer that fits all Input reports
+	inputSize int
+	logger    *slog.Logger
+}
+
+// NewControls gets report descriptor from an opened HID device, and prepares decoder and encoder from it
+func NewControls(device hid.Device, logger *slog.Logger) (Controls, error) {
+	if device == nil {
+		return nil, hid.ErrDeviceIsNil
+	}
+	desc, err := device.GetReportDescriptor()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get report descriptor: %w", err)
+	}
+	schema, err := hid.ParseReportDescriptor(desc)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse report descriptor: %w", err)
+	}
+	decoder := NewDecoder(schema)
+	if !decoder.HasControls() {
+		return nil, ErrNoControlFound
+	}
+
+	controls := &controlsImpl{
+		device:  device,
+		decoder: decoder,
+		encoder: NewEncoder(schema),
+		logger:  logger,
+	}
+	for _, layout := range schema.Layouts {
+		if layout.Type == hid.REPORT_TYPE_INPUT && layout.ByteSize()+1 > controls.inputSize {
+			controls.inputSize = layout.ByteSize() + 1
+		}
+	}
+
+	return controls, nil
+}
+
+func (c *controlsImpl) Read(ctx context.Context) ([]Event, error) {
+	buf := make([]byte, c.inputSize)
+	for {
+		n, err := c.device.ReadInput(ctx, buf)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read input report: %w", err)
+		}
+		if n == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		events, err := c.decoder.Decode(buf[:n])
+		if err != nil {
+			c.logger.Debug("skip unknown input report", "err", err)
+			continue
+		}
+		if len(events) > 0 {
+			return events, nil
+		}
+	}
+}
+
+func (c *controlsImpl) SetLED(ctx context.Context, led hid.Usage, on bool) error {
+	data, err := c.encoder.Set(led, on)
+	if err != nil {
+		return err
+	}
+	if _, err := c.device.WriteOutput(ctx, data); err != nil {
+		return fmt.Errorf("unable to write output report #%d: %w", data[0], err)
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package consumer_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/ntchjb/gohid/consumer"
+	"github.com/ntchjb/gohid/hid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	hidreport "github.com/ntchjb/usbip-virtual-device/usb/protocol/hid/report"
+)
+
+func TestNewControls(t *testing.T) {
+	errControl := errors.New("control transfer error")
+
+	tests := []struct {
+		name    string
+		desc    hidreport.HIDReportDescriptor
+		descErr error
+		err     error
+	}{
+		{
+			name: "Success",
+			desc: headsetReportDescriptor,
+		},
+		{
+			name:    "Error_GetReportDescriptor",
+			descErr: errControl,
+			err:     errControl,
+		},
+		{
+			name: "Error_NoControlFound",
+			desc: hidreport.HIDReportDescriptor{0x05, 0x01, 0x09, 0x02, 0xA1, 0x01, 0xC0},
+			err:  consumer.ErrNoControlFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			device := hid.NewMockDevice(ctrl)
+			device.EXPECT().GetReportDescriptor().Return(test.desc, test.descErr)
+
+			_, err := consumer.NewControls(device, slog.Default())
+			assert.ErrorIs(t, err, test.err)
+		})
+	}
+}
+
+func TestControls_Read(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	device := hid.NewMockDevice(ctrl)
+	device.EXPECT().GetReportDescriptor().Return(headsetReportDescriptor, nil)
+	gomock.InOrder(
+		device.EXPECT().ReadInput(ctx, gomock.Len(6)).Return(0, nil),
+		// Unknown report ID is skipped
+		device.EXPECT().ReadInput(ctx, gomock.Len(6)).DoAndReturn(func(ctx context.Context, data []byte) (int, error) {
+			return copy(data, []byte{0x09, 0x00}), nil
+		}),
+		// Report without any change is skipped
+		device.EXPECT().ReadInput(ctx, gomock.Len(6)).DoAndReturn(func(ctx context.Context, data []byte) (int, error) {
+			return copy(data, []byte{0x01, 0x00}), nil
+		}),
+		device.EXPECT().ReadInput(ctx, gomock.Len(6)).DoAndReturn(func(ctx context.Context, data []byte) (int, error) {
+			return copy(data, []byte{0x01, 0x01}), nil
+		}),
+	)
+
+	controls, err := consumer.NewControls(device, slog.Default())
+	assert.NoError(t, err)
+
+	events, err := controls.Read(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []consumer.Event{{Usage: consumer.CONTROL_HOOK_SWITCH, Active: true, Value: 1}}, events)
+}
+
+func TestControls_Read_ContextDone(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	device := hid.NewMockDevice(ctrl)
+	device.EXPECT().GetReportDescriptor().Return(headsetReportDescriptor, nil)
+	device.EXPECT().ReadInput(ctx, gomock.Len(6)).Return(0, nil)
+
+	controls, err := consumer.NewControls(device, slog.Default())
+	assert.NoError(t, err)
+
+	_, err = controls.Read(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestControls_SetLED(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	device := hid.NewMockDevice(ctrl)
+	device.EXPECT().GetReportDescriptor().Return(headsetReportDescriptor, nil)
+	gomock.InOrder(
+		device.EXPECT().WriteOutput(ctx, []byte{0x02, 0b001}).Return(2, nil),
+		device.EXPECT().WriteOutput(ctx, []byte{0x02, 0b101}).Return(2, nil),
+	)
+
+	controls, err := consumer.NewControls(device, slog.Default())
+	assert.NoError(t, err)
+
+	assert.NoError(t, controls.SetLED(ctx, consumer.LED_RING, true))
+	assert.NoError(t, controls.SetLED(ctx, consumer.LED_MUTE, true))
+	assert.ErrorIs(t, controls.SetLED(ctx, consumer.LED_HOLD, true), hid.ErrReportFieldNotFound)
+}
@@ -0,0 +1,157 @@
+package consumer
+
+import (
+	"fmt"
+
+	"github.com/ntchjb/gohid/hid"
+)
+
+// Event is a change of a consumer or telephony control
+type Event struct {
+	Usage hid.Usage
+	// Whether the control is active, e.g. a button is pressed or a hook switch is off-hook
+	Active bool
+	// Value of the control. It is 1 or 0 for buttons, and a signed delta for relative controls such as a volume knob.
+	Value int32
+}
+
+// Name returns a human-readable name of the control
+func (e Event) Name() string {
+	return Name(e.Usage)
+}
+
+// controlValue is a value of a control in a report
+type controlValue struct {
+	usage hid.Usage
+	value int32
+	// Whether every non-zero value is an event, e.g. a relative multi-bit control
+	isDelta bool
+}
+
+// Decoder decodes Input reports into events of consumer and telephony controls.
+// It keeps the last values of controls, so that only changes are reported.
+type Decoder struct {
+	schema   *hid.ReportSchema
+	previous map[*hid.ReportLayout][]controlValue
+}
+
+func NewDecoder(schema *hid.ReportSchema) *Decoder {
+	return &Decoder{
+		schema:   schema,
+		previous: make(map[*hid.ReportLayout][]controlValue),
+	}
+}
+
+// HasControls reports whether the report descriptor declares any consumer or telephony control in Input reports
+func (d *Decoder) HasControls() bool {
+	for _, field := range d.schema.Fields() {
+		if field.ReportType != hid.REPORT_TYPE_INPUT || field.IsConstant() {
+			continue
+		}
+		for i := 0; i < int(field.ReportCount); i++ {
+			if isControl(field.Usage(i)) {
+				return true
+			}
+		}
+		if field.IsArray() {
+			if usage, ok := field.ArrayUsage(field.LogicalMinimum); ok && isControl(usage) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (d *Decoder) values(layout *hid.ReportLayout, payload []byte) ([]controlValue, error) {
+	var values []controlValue
+	for _, field := range layout.Fields {
+		if field.IsConstant() {
+			continue
+		}
+		for i := 0; i < int(field.ReportCount); i++ {
+			value, err := field.Value(payload, i)
+			if err != nil {
+				return nil, err
+			}
+			if field.IsArray() {
+				// Array fields list controls being active, e.g. buttons being pressed
+				usage, ok := field.ArrayUsage(value)
+				if ok && usage.ID() != 0 && isControl(usage) {
+					values = append(values, controlValue{usage: usage, value: 1})
+				}
+				continue
+			}
+			if usage := field.Usage(i); isControl(usage) {
+				values = append(values, controlValue{
+					usage:   usage,
+					value:   value,
+					isDelta: field.IsRelative() && field.ReportSize > 1,
+				})
+			}
+		}
+	}
+
+	return values, nil
+}
+
+// Decode decodes an Input report, in which the first byte is report ID if the device uses report IDs.
+// It returns events of controls whose values are changed since the previous report of the same report ID.
+func (d *Decoder) Decode(data []byte) ([]Event, error) {
+	layout, payload, err := d.schema.SplitInputReport(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find layout of input report: %w", err)
+	}
+	current, err := d.values(layout, payload)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode input report #%d: %w", layout.ID, err)
+	}
+
+	previous := make(map[hid.Usage]int32)
+	for _, control := range d.previous[layout] {
+		previous[control.usage] = control.value
+	}
+	active := make(map[hid.Usage]bool)
+	var events []Event
+	for _, control := range current {
+		if active[control.usage] {
+			continue
+		}
+		if control.value != 0 {
+			active[control.usage] = true
+		}
+		if control.isDelta {
+			// Relative controls report changes, so zero means no change
+			if control.value == 0 {
+				continue
+			}
+		} else if control.value == previous[control.usage] {
+			continue
+		}
+		events = append(events, Event{
+			Usage:  control.usage,
+			Active: control.value != 0,
+			Value:  control.value,
+		})
+	}
+	// Controls no longer listed in array fields are released
+	for _, control := range d.previous[layout] {
+		if control.value == 0 || active[control.usage] || containsUsage(current, control.usage) {
+			continue
+		}
+		events = append(events, Event{Usage: control.usage})
+	}
+	d.previous[layout] = current
+
+	return events, nil
+}
+
+func containsUsage(values []controlValue, usage hid.Usage) bool {
+	for _, value := range values {
+		if value.usage == usage {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,215 @@
+package consumer_test
+
+import (
+	"testing"
+
+	"github.com/ntchjb/gohid/consumer"
+	"github.com/ntchjb/gohid/hid"
+	"github.com/stretchr/testify/assert"
+
+	hidreport "github.com/ntchjb/usbip-virtual-device/usb/protocol/hid/report"
+)
+
+var (
+	// Headset with hook switch, phone mute and telephony LEDs in report ID 1 and 2,
+	// and consumer controls with volume buttons, a volume knob and 2 array slots in report ID 3
+	headsetReportDescriptor = hidreport.HIDReportDescriptor{
+		0x05, 0x0B, // Usage Page (Telephony)
+		0x09, 0x05, // Usage (Headset)
+		0xA1, 0x01, // Collection (Application)
+		0x85, 0x01, //   Report ID (1)
+		0x15, 0x00, //   Logical Minimum (0)
+		0x25, 0x01, //   Logical Maximum (1)
+		0x75, 0x01, //   Report Size (1)
+		0x95, 0x01, //   Report Count (1)
+		0x09, 0x20, //   Usage (Hook Switch)
+		0x81, 0x22, //   Input (Data,Var,Abs,NoPref)
+		0x09, 0x2F, //   Usage (Phone Mute)
+		0x81, 0x06, //   Input (Data,Var,Rel)
+		0x95, 0x06, //   Report Count (6)
+		0x81, 0x03, //   Input (Const,Var,Abs)
+		0x85, 0x02, //   Report ID (2)
+		0x05, 0x08, //   Usage Page (LED)
+		0x09, 0x18, //   Usage (Ring)
+		0x09, 0x17, //   Usage (Off-Hook)
+		0x09, 0x09, //   Usage (Mute)
+		0x95, 0x03, //   Report Count (3)
+		0x91, 0x22, //   Output (Data,Var,Abs,NoPref)
+		0x95, 0x05, //   Report Count (5)
+		0x91, 0x03, //   Output (Const,Var,Abs)
+		0xC0,       //       End Collection
+		0x05, 0x0C, // Usage Page (Consumer)
+		0x09, 0x01, // Usage (Consumer Control)
+		0xA1, 0x01, // Collection (Application)
+		0x85, 0x03, //   Report ID (3)
+		0x09, 0xE9, //   Usage (Volume Increment)
+		0x09, 0xEA, //   Usage (Volume Decrement)
+		0x95, 0x02, //   Report Count (2)
+		0x81, 0x02, //   Input (Data,Var,Abs)
+		0x09, 0xE0, //   Usage (Volume)
+		0x15, 0xFF, //   Logical Minimum (-1)
+		0x75, 0x02, //   Report Size (2)
+		0x95, 0x01, //   Report Count (1)
+		0x81, 0x06, //   Input (Data,Var,Rel)
+		0x75, 0x04, //   Report Size (4)
+		0x81, 0x03, //   Input (Const,Var,Abs)
+		0x19, 0x00, //   Usage Minimum (0)
+		0x2A, 0x3C, 0x02, // Usage Maximum (AC Format)
+		0x15, 0x00, //   Logical Minimum (0)
+		0x26, 0x3C, 0x02, // Logical Maximum (572)
+		0x75, 0x10, //   Report Size (16)
+		0x95, 0x02, //   Report Count (2)
+		0x81, 0x00, //   Input (Data,Arr,Abs)
+		0xC0, //       End Collection
+	}
+)
+
+func TestDecoder_Decode(t *testing.T) {
+	schema, err := hid.ParseReportDescriptor(headsetReportDescriptor)
+	assert.NoError(t, err)
+	decoder := consumer.NewDecoder(schema)
+	assert.True(t, decoder.HasControls())
+
+	steps := []struct {
+		name   string
+		data   []byte
+		events []consumer.Event
+		err    error
+	}{
+		{
+			name:   "OffHook",
+			data:   []byte{0x01, 0b01},
+			events: []consumer.Event{{Usage: consumer.CONTROL_HOOK_SWITCH, Active: true, Value: 1}},
+		},
+		{
+			name:   "PhoneMutePressed",
+			data:   []byte{0x01, 0b11},
+			events: []consumer.Event{{Usage: consumer.CONTROL_PHONE_MUTE, Active: true, Value: 1}},
+		},
+		{
+			name:   "PhoneMuteReleased",
+			data:   []byte{0x01, 0b01},
+			events: []consumer.Event{{Usage: consumer.CONTROL_PHONE_MUTE}},
+		},
+		{
+			name: "VolumeUpAndPlayPausePressed",
+			data: []byte{0x03, 0b01, 0xCD, 0x00, 0x00, 0x00},
+			events: []consumer.Event{
+				{Usage: consumer.CONTROL_VOLUME_INCREMENT, Active: true, Value: 1},
+				{Usage: consumer.CONTROL_PLAY_PAUSE, Active: true, Value: 1},
+			},
+		},
+		{
+			name: "MutePressed",
+			data: []byte{0x03, 0b00, 0xE2, 0x00, 0xCD, 0x00},
+			events: []consumer.Event{
+				{Usage: consumer.CONTROL_VOLUME_INCREMENT},
+				{Usage: consumer.CONTROL_MUTE, Active: true, Value: 1},
+			},
+		},
+		{
+			name: "AllReleased",
+			data: []byte{0x03, 0b00, 0x00, 0x00, 0x00, 0x00},
+			events: []consumer.Event{
+				{Usage: consumer.CONTROL_MUTE},
+				{Usage: consumer.CONTROL_PLAY_PAUSE},
+			},
+		},
+		{
+			name:   "VolumeKnobUp",
+			data:   []byte{0x03, 0b0100, 0x00, 0x00, 0x00, 0x00},
+			events: []consumer.Event{{Usage: consumer.CONTROL_VOLUME, Active: true, Value: 1}},
+		},
+		{
+			name:   "VolumeKnobUpAgain",
+			data:   []byte{0x03, 0b0100, 0x00, 0x00, 0x00, 0x00},
+			events: []consumer.Event{{Usage: consumer.CONTROL_VOLUME, Active: true, Value: 1}},
+		},
+		{
+			name:   "VolumeKnobDown",
+			data:   []byte{0x03, 0b1100, 0x00, 0x00, 0x00, 0x00},
+			events: []consumer.Event{{Usage: consumer.CONTROL_VOLUME, Active: true, Value: -1}},
+		},
+		{
+			name: "VolumeKnobStopped",
+			data: []byte{0x03, 0b0000, 0x00, 0x00, 0x00, 0x00},
+		},
+		{
+			name: "Error_UnknownReportID",
+			data: []byte{0x09, 0x00},
+			err:  hid.ErrReportLayoutNotFound,
+		},
+		{
+			name: "Error_PayloadTooShort",
+			data: []byte{0x03, 0x00},
+			err:  hid.ErrReportPayloadIsTooShort,
+		},
+	}
+
+	// Steps are run in order, as events depend on previous reports
+	for _, step := range steps {
+		events, err := decoder.Decode(step.data)
+		assert.ErrorIs(t, err, step.err, step.name)
+		assert.Equal(t, step.events, events, step.name)
+	}
+}
+
+func TestName(t *testing.T) {
+	assert.Equal(t, "Play/Pause", consumer.Event{Usage: consumer.CONTROL_PLAY_PAUSE}.Name())
+	assert.Equal(t, "Volume Up", consumer.Name(consumer.CONTROL_VOLUME_INCREMENT))
+	assert.Equal(t, "Hook Switch", consumer.Name(consumer.CONTROL_HOOK_SWITCH))
+	assert.Equal(t, "Stop", consumer.Name(consumer.CONTROL_STOP))
+	assert.Equal(t, "Off-Hook", consumer.Name(consumer.LED_OFF_HOOK))
+}
+
+func TestEncoder_Set(t *testing.T) {
+	schema, err := hid.ParseReportDescriptor(headsetReportDescriptor)
+	assert.NoError(t, err)
+	encoder := consumer.NewEncoder(schema)
+	assert.True(t, encoder.HasOutput(consumer.LED_RING))
+	assert.False(t, encoder.HasOutput(consumer.LED_HOLD))
+
+	data, err := encoder.Set(consumer.LED_RING, true)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x02, 0b001}, data)
+
+	data, err = encoder.Set(consumer.LED_OFF_HOOK, true)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x02, 0b011}, data)
+
+	data, err = encoder.Set(consumer.LED_RING, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x02, 0b010}, data)
+
+	_, err = encoder.Set(consumer.LED_HOLD, true)
+	assert.ErrorIs(t, err, hid.ErrReportFieldNotFound)
+}
+
+func TestEncoder_Set_Array(t *testing.T) {
+	// LED array of Off-Hook and Ring, whose values are 1 and 2
+	arrayDescriptor := []byte{
+		0x05, 0x0B, 0x09, 0x05, 0xA1, 0x01,
+		0x85, 0x03, 0x05, 0x08, 0x19, 0x17, 0x29, 0x18,
+		0x15, 0x01, 0x25, 0x02, 0x75, 0x02, 0x95, 0x01, 0x91, 0x00,
+		0x75, 0x06, 0x95, 0x01, 0x91, 0x01,
+		0xC0,
+	}
+	schema, err := hid.ParseReportDescriptor(arrayDescriptor)
+	assert.NoError(t, err)
+	encoder := consumer.NewEncoder(schema)
+
+	data, err := encoder.Set(consumer.LED_RING, true)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x03, 0x02}, data)
+	data, err = encoder.Set(consumer.LED_RING, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x03, 0x00}, data)
+
+	// Every value of 2 bits selects a LED, so no LED can be turned off
+	arrayDescriptor[15], arrayDescriptor[17] = 0x00, 0x03
+	schema, err = hid.ParseReportDescriptor(arrayDescriptor)
+	assert.NoError(t, err)
+	encoder = consumer.NewEncoder(schema)
+	_, err = encoder.Set(consumer.LED_RING, false)
+	assert.ErrorIs(t, err, consumer.ErrNoNullValue)
+}
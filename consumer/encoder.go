@@ -0,0 +1,95 @@
+package consumer
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/ntchjb/gohid/hid"
+)
+
+// Encoder encodes states of output controls, e.g. telephony LEDs, into Output reports.
+// It keeps the last report data of each report ID, so that other controls in the same report keep their states.
+type Encoder struct {
+	schema  *hid.ReportSchema
+	reports map[*hid.ReportLayout][]byte
+}
+
+func NewEncoder(schema *hid.ReportSchema) *Encoder {
+	return &Encoder{
+		schema:  schema,
+		reports: make(map[*hid.ReportLayout][]byte),
+	}
+}
+
+func (e *Encoder) findField(usage hid.Usage) (*hid.ReportLayout, *hid.ReportField, int, error) {
+	for _, layout := range e.schema.Layouts {
+		if layout.Type != hid.REPORT_TYPE_OUTPUT {
+			continue
+		}
+		if field, idx, err := layout.FindField(usage); err == nil {
+			return layout, field, idx, nil
+		}
+	}
+
+	return nil, nil, 0, fmt.Errorf("output usage %v: %w", usage, hid.ErrReportFieldNotFound)
+}
+
+// HasOutput reports whether the usage is declared in any Output report
+func (e *Encoder) HasOutput(usage hid.Usage) bool {
+	_, _, _, err := e.findField(usage)
+	return err == nil
+}
+
+// Set sets an on/off control, e.g. LED_RING, and returns the Output report containing it,
+// in which the first byte is report ID. The returned buffer is owned by the caller.
+// A control of an array field is turned off by a value outside logical range, which fails with ErrNoNullValue
+// if all values of the field select a control.
+func (e *Encoder) Set(usage hid.Usage, on bool) ([]byte, error) {
+	layout, field, idx, err := e.findField(usage)
+	if err != nil {
+		return nil, err
+	}
+	buf, ok := e.reports[layout]
+	if !ok {
+		buf = layout.NewBuffer()
+		e.reports[layout] = buf
+	}
+
+	var value int32
+	if field.IsArray() {
+		// Array fields select a single control at a time, so turning off writes a value selecting nothing
+		if on {
+			value, _ = field.ArrayValue(usage)
+		} else if value, err = nullValue(field); err != nil {
+			return nil, fmt.Errorf("unable to turn off output usage %v: %w", usage, err)
+		}
+	} else if on {
+		value = 1
+	}
+	if err := field.SetValue(buf[1:], idx, value); err != nil {
+		return nil, fmt.Errorf("unable to set output usage %v: %w", usage, err)
+	}
+
+	return append([]byte{}, buf...), nil
+}
+
+// nullValue returns a value of an array field which selects no control. It is a value outside logical range
+// of the field, which fits in its report size.
+func nullValue(field *hid.ReportField) (int32, error) {
+	lowest, highest := int64(math.MinInt32), int64(math.MaxInt32)
+	if size := field.ReportSize; size < 32 {
+		if field.LogicalMinimum < 0 {
+			lowest, highest = -(1 << (size - 1)), 1<<(size-1)-1
+		} else {
+			lowest, highest = 0, 1<<size-1
+		}
+	}
+	if value := int64(field.LogicalMinimum) - 1; value >= lowest {
+		return int32(value), nil
+	}
+	if value := int64(field.LogicalMaximum) + 1; value <= highest {
+		return int32(value), nil
+	}
+
+	return 0, fmt.Errorf("logical range %d to %d of %d bits: %w", field.LogicalMinimum, field.LogicalMaximum, field.ReportSize, ErrNoNullValue)
+}
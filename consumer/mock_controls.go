@@ -0,0 +1,70 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./consumer/controls.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./consumer/controls.go -destination=./consumer/mock_controls.go -package=consumer
+//
+
+// Package consumer is a generated GoMock package.
+package consumer
+
+import (
+	context "context"
+	reflect "reflect"
+
+	hid "github.com/ntchjb/gohid/hid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockControls is a mock of Controls interface.
+type MockControls struct {
+	ctrl     *gomock.Controller
+	recorder *MockControlsMockRecorder
+}
+
+// MockControlsMockRecorder is the mock recorder for MockControls.
+type MockControlsMockRecorder struct {
+	mock *MockControls
+}
+
+// NewMockControls creates a new mock instance.
+func NewMockControls(ctrl *gomock.Controller) *MockControls {
+	mock := &MockControls{ctrl: ctrl}
+	mock.recorder = &MockControlsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockControls) EXPECT() *MockControlsMockRecorder {
+	return m.recorder
+}
+
+// Read mocks base method.
+func (m *MockControls) Read(ctx context.Context) ([]Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", ctx)
+	ret0, _ := ret[0].([]Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockControlsMockRecorder) Read(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockControls)(nil).Read), ctx)
+}
+
+// SetLED mocks base method.
+func (m *MockControls) SetLED(ctx context.Context, led hid.Usage, on bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLED", ctx, led, on)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLED indicates an expected call of SetLED.
+func (mr *MockControlsMockRecorder) SetLED(ctx, led, on any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLED", reflect.TypeOf((*MockControls)(nil).SetLED), ctx, led, on)
+}
@@ -0,0 +1,93 @@
+package consumer
+
+import (
+	"github.com/ntchjb/gohid/hid"
+	"github.com/ntchjb/gohid/usages"
+)
+
+const (
+	USAGE_PAGE_LED       uint16 = 0x08
+	USAGE_PAGE_TELEPHONY uint16 = 0x0B
+	USAGE_PAGE_CONSUMER  uint16 = 0x0C
+)
+
+// Controls on Consumer usage page, as extended usages
+const (
+	CONTROL_POWER               hid.Usage = 0x000C_0030
+	CONTROL_MENU                hid.Usage = 0x000C_0040
+	CONTROL_PLAY                hid.Usage = 0x000C_00B0
+	CONTROL_PAUSE               hid.Usage = 0x000C_00B1
+	CONTROL_RECORD              hid.Usage = 0x000C_00B2
+	CONTROL_FAST_FORWARD        hid.Usage = 0x000C_00B3
+	CONTROL_REWIND              hid.Usage = 0x000C_00B4
+	CONTROL_SCAN_NEXT_TRACK     hid.Usage = 0x000C_00B5
+	CONTROL_SCAN_PREVIOUS_TRACK hid.Usage = 0x000C_00B6
+	CONTROL_STOP                hid.Usage = 0x000C_00B7
+	CONTROL_EJECT               hid.Usage = 0x000C_00B8
+	CONTROL_PLAY_PAUSE          hid.Usage = 0x000C_00CD
+	CONTROL_VOICE_COMMAND       hid.Usage = 0x000C_00CF
+	CONTROL_VOLUME              hid.Usage = 0x000C_00E0
+	CONTROL_MUTE                hid.Usage = 0x000C_00E2
+	CONTROL_BASS_BOOST          hid.Usage = 0x000C_00E5
+	CONTROL_VOLUME_INCREMENT    hid.Usage = 0x000C_00E9
+	CONTROL_VOLUME_DECREMENT    hid.Usage = 0x000C_00EA
+)
+
+// Controls on Telephony usage page, as extended usages
+const (
+	CONTROL_HOOK_SWITCH   hid.Usage = 0x000B_0020
+	CONTROL_FLASH         hid.Usage = 0x000B_0021
+	CONTROL_HOLD          hid.Usage = 0x000B_0023
+	CONTROL_REDIAL        hid.Usage = 0x000B_0024
+	CONTROL_TRANSFER      hid.Usage = 0x000B_0025
+	CONTROL_DROP          hid.Usage = 0x000B_0026
+	CONTROL_LINE          hid.Usage = 0x000B_002A
+	CONTROL_SPEAKER_PHONE hid.Usage = 0x000B_002B
+	CONTROL_CONFERENCE    hid.Usage = 0x000B_002C
+	CONTROL_RING_ENABLE   hid.Usage = 0x000B_002D
+	CONTROL_PHONE_MUTE    hid.Usage = 0x000B_002F
+	CONTROL_SEND          hid.Usage = 0x000B_0031
+	CONTROL_ANSWER_ON_OFF hid.Usage = 0x000B_0074
+	CONTROL_RINGER        hid.Usage = 0x000B_009E
+)
+
+// Telephony indicators on LED usage page, as extended usages
+const (
+	LED_MUTE            hid.Usage = 0x0008_0009
+	LED_OFF_HOOK        hid.Usage = 0x0008_0017
+	LED_RING            hid.Usage = 0x0008_0018
+	LED_MESSAGE_WAITING hid.Usage = 0x0008_0019
+	LED_SPEAKER         hid.Usage = 0x0008_001E
+	LED_HEADSET         hid.Usage = 0x0008_001F
+	LED_HOLD            hid.Usage = 0x0008_0020
+	LED_MICROPHONE      hid.Usage = 0x0008_0021
+)
+
+var (
+	// Names of common controls. Names of other usages are taken from HID Usage Tables.
+	ControlNames = map[hid.Usage]string{
+		CONTROL_PLAY_PAUSE:          "Play/Pause",
+		CONTROL_SCAN_NEXT_TRACK:     "Next Track",
+		CONTROL_SCAN_PREVIOUS_TRACK: "Previous Track",
+		CONTROL_VOLUME_INCREMENT:    "Volume Up",
+		CONTROL_VOLUME_DECREMENT:    "Volume Down",
+		CONTROL_MUTE:                "Mute",
+		CONTROL_HOOK_SWITCH:         "Hook Switch",
+		CONTROL_PHONE_MUTE:          "Phone Mute",
+		CONTROL_ANSWER_ON_OFF:       "Answer On/Off",
+	}
+)
+
+// Name returns a human-readable name of a usage
+func Name(u hid.Usage) string {
+	if name, ok := ControlNames[u]; ok {
+		return name
+	}
+
+	return usages.Name(u.Page(), u.ID())
+}
+
+// isControl reports whether a usage is a control on Consumer or Telephony usage page
+func isControl(u hid.Usage) bool {
+	return u.Page() == USAGE_PAGE_CONSUMER || u.Page() == USAGE_PAGE_TELEPHONY
+}
@@ -0,0 +1,258 @@
+package gamepad
+
+import (
+	"math"
+
+	"github.com/ntchjb/gohid/hid"
+)
+
+// Buttons is a bitset of pressed buttons, in which bit n is button n+1 on Button usage page
+type Buttons uint64
+
+// Pressed reports whether button at 0-based index is pressed
+func (b Buttons) Pressed(index int) bool {
+	if index < 0 || index >= MAX_BUTTON_COUNT {
+		return false
+	}
+
+	return b&(1<<index) != 0
+}
+
+// State is a normalized state of a game controller decoded from an Input report
+type State struct {
+	Controller *Controller
+	// Axis values scaled to range from -1 to 1. Axes not provided by the controller are zero.
+	Axes [AXIS_COUNT]float64
+	// Directions of hat switches, in declaration order
+	Hats [MAX_HAT_COUNT]Hat
+	// Pressed buttons
+	Buttons Buttons
+}
+
+// fieldValue is a reference to a value of a report field
+type fieldValue struct {
+	field *hid.ReportField
+	index int
+}
+
+// Controller is a joystick, gamepad or multi-axis controller collection found in a report descriptor
+type Controller struct {
+	Usage      hid.Usage
+	Collection *hid.ReportCollection
+	// Input report carrying state of this controller
+	Input *hid.ReportLayout
+
+	axes       [AXIS_COUNT]*fieldValue
+	hats       []fieldValue
+	buttons    []fieldValue
+	arrays     []*hid.ReportField
+	numButtons int
+}
+
+// HasAxis reports whether the controller provides given axis
+func (c *Controller) HasAxis(axis Axis) bool {
+	return axis < AXIS_COUNT && c.axes[axis] != nil
+}
+
+// AxisCount returns number of axes provided by the controller
+func (c *Controller) AxisCount() int {
+	count := 0
+	for _, axis := range c.axes {
+		if axis != nil {
+			count++
+		}
+	}
+
+	return count
+}
+
+// HatCount returns number of hat switches provided by the controller
+func (c *Controller) HatCount() int {
+	return len(c.hats)
+}
+
+// ButtonCount returns the highest button number provided by the controller
+func (c *Controller) ButtonCount() int {
+	return c.numButtons
+}
+
+func isControllerCollection(collection *hid.ReportCollection) bool {
+	if collection.Usage.Page() != USAGE_PAGE_GENERIC_DESKTOP {
+		return false
+	}
+	switch collection.Usage.ID() {
+	case USAGE_JOYSTICK, USAGE_GAMEPAD, USAGE_MULTI_AXIS_CONTROLLER:
+		return true
+	}
+
+	return false
+}
+
+func (c *Controller) addButton(usage hid.Usage) {
+	if usage.Page() == USAGE_PAGE_BUTTON && int(usage.ID()) > c.numButtons && usage.ID() <= MAX_BUTTON_COUNT {
+		c.numButtons = int(usage.ID())
+	}
+}
+
+// addArrayButtons adds buttons selectable by values of an array field. Values select its usages in order,
+// so buttons are found from its usages without enumerating its logical range, which may be as wide as int32.
+func (c *Controller) addArrayButtons(field *hid.ReportField) {
+	values := int64(field.LogicalMaximum) - int64(field.LogicalMinimum) + 1
+	for _, usage := range field.Usages {
+		if values <= 0 {
+			return
+		}
+		c.addButton(usage)
+		values--
+	}
+	if values <= 0 || !field.HasUsageRange || field.UsageMaximum < field.UsageMinimum {
+		return
+	}
+	if field.UsageMinimum.Page() != USAGE_PAGE_BUTTON || field.UsageMinimum.ID() > MAX_BUTTON_COUNT {
+		return
+	}
+	// Only the highest selectable button of the range is needed, as buttons are counted up to it
+	last := hid.Usage(min(uint64(field.UsageMaximum), uint64(field.UsageMinimum)+uint64(values)-1))
+	lastID := uint16(MAX_BUTTON_COUNT)
+	if last.Page() == USAGE_PAGE_BUTTON {
+		lastID = min(last.ID(), MAX_BUTTON_COUNT)
+	}
+	c.addButton(hid.NewUsage(USAGE_PAGE_BUTTON, lastID))
+}
+
+func newController(schema *hid.ReportSchema, collection *hid.ReportCollection) *Controller {
+	controller := &Controller{
+		Usage:      collection.Usage,
+		Collection: collection,
+	}
+
+	for _, field := range collection.AllFields() {
+		if field.ReportType != hid.REPORT_TYPE_INPUT || field.IsConstant() {
+			continue
+		}
+		if controller.Input == nil {
+			controller.Input, _ = schema.Layout(field.ReportType, field.ReportID)
+		}
+		if field.IsArray() {
+			controller.arrays = append(controller.arrays, field)
+			controller.addArrayButtons(field)
+			continue
+		}
+		for i := 0; i < int(field.ReportCount); i++ {
+			usage := field.Usage(i)
+			switch usage.Page() {
+			case USAGE_PAGE_GENERIC_DESKTOP:
+				if usage.ID() == USAGE_HAT_SWITCH {
+					if len(controller.hats) < MAX_HAT_COUNT {
+						controller.hats = append(controller.hats, fieldValue{field: field, index: i})
+					}
+					continue
+				}
+				for axis, axisUsage := range axisUsages {
+					if usage.ID() == axisUsage && controller.axes[axis] == nil {
+						controller.axes[axis] = &fieldValue{field: field, index: i}
+					}
+				}
+			case USAGE_PAGE_BUTTON:
+				if usage.ID() >= 1 && usage.ID() <= MAX_BUTTON_COUNT {
+					controller.buttons = append(controller.buttons, fieldValue{field: field, index: i})
+					controller.addButton(usage)
+				}
+			}
+		}
+	}
+
+	return controller
+}
+
+// Discover finds all game controllers in a parsed report descriptor
+func Discover(schema *hid.ReportSchema) []*Controller {
+	var controllers []*Controller
+	var walk func(collections []*hid.ReportCollection)
+	walk = func(collections []*hid.ReportCollection) {
+		for _, collection := range collections {
+			if isControllerCollection(collection) {
+				controllers = append(controllers, newController(schema, collection))
+				continue
+			}
+			walk(collection.Children)
+		}
+	}
+	walk(schema.Collections)
+
+	return controllers
+}
+
+// normalizeAxis scales a logical value into range from -1 to 1
+func normalizeAxis(field *hid.ReportField, value int32) float64 {
+	if field.LogicalMaximum <= field.LogicalMinimum {
+		return 0
+	}
+	res := 2*float64(int64(value)-int64(field.LogicalMinimum))/float64(int64(field.LogicalMaximum)-int64(field.LogicalMinimum)) - 1
+
+	return math.Max(-1, math.Min(1, res))
+}
+
+// decodeHat converts a logical value of hat switch into direction. Values out of logical range mean centered.
+func decodeHat(field *hid.ReportField, value int32) Hat {
+	if value < field.LogicalMinimum || value > field.LogicalMaximum {
+		return HAT_CENTERED
+	}
+	position := value - field.LogicalMinimum
+	switch field.LogicalMaximum - field.LogicalMinimum + 1 {
+	case 8:
+		return hatDirections8[position]
+	case 4:
+		return hatDirections4[position]
+	}
+
+	return HAT_CENTERED
+}
+
+// Decode decodes report data of Input report of this controller, excluding report ID
+func (c *Controller) Decode(payload []byte) (State, error) {
+	state := State{
+		Controller: c,
+	}
+
+	for axis, ref := range c.axes {
+		if ref == nil {
+			continue
+		}
+		value, err := ref.field.Value(payload, ref.index)
+		if err != nil {
+			return state, err
+		}
+		state.Axes[axis] = normalizeAxis(ref.field, value)
+	}
+	for i, ref := range c.hats {
+		value, err := ref.field.Value(payload, ref.index)
+		if err != nil {
+			return state, err
+		}
+		state.Hats[i] = decodeHat(ref.field, value)
+	}
+	for _, ref := range c.buttons {
+		value, err := ref.field.Value(payload, ref.index)
+		if err != nil {
+			return state, err
+		}
+		if value != 0 {
+			state.Buttons |= 1 << (ref.field.Usage(ref.index).ID() - 1)
+		}
+	}
+	for _, field := range c.arrays {
+		for i := 0; i < int(field.ReportCount); i++ {
+			value, err := field.Value(payload, i)
+			if err != nil {
+				return state, err
+			}
+			usage, ok := field.ArrayUsage(value)
+			if ok && usage.Page() == USAGE_PAGE_BUTTON && usage.ID() >= 1 && usage.ID() <= MAX_BUTTON_COUNT {
+				state.Buttons |= 1 << (usage.ID() - 1)
+			}
+		}
+	}
+
+	return state, nil
+}
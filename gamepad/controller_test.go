@@ -0,0 +1,164 @@
+package gamepad_test
+
+import (
+	"testing"
+
+	"github.com/ntchjb/gohid/gamepad"
+	"github.com/ntchjb/gohid/hid"
+	"github.com/stretchr/testify/assert"
+
+	hidreport "github.com/ntchjb/usbip-virtual-device/usb/protocol/hid/report"
+)
+
+var (
+	// Gamepad with 8 buttons, a hat switch and 4 axes, without report ID
+	gamepadReportDescriptor = hidreport.HIDReportDescriptor{
+		0x05, 0x01, // Usage Page (Generic Desktop)
+		0x09, 0x05, // Usage (Gamepad)
+		0xA1, 0x01, // Collection (Application)
+		0x05, 0x09, //   Usage Page (Button)
+		0x19, 0x01, //   Usage Minimum (1)
+		0x29, 0x08, //   Usage Maximum (8)
+		0x15, 0x00, //   Logical Minimum (0)
+		0x25, 0x01, //   Logical Maximum (1)
+		0x75, 0x01, //   Report Size (1)
+		0x95, 0x08, //   Report Count (8)
+		0x81, 0x02, //   Input (Data,Var,Abs)
+		0x05, 0x01, //   Usage Page (Generic Desktop)
+		0x09, 0x39, //   Usage (Hat switch)
+		0x25, 0x07, //   Logical Maximum (7)
+		0x35, 0x00, //   Physical Minimum (0)
+		0x46, 0x3B, 0x01, // Physical Maximum (315)
+		0x65, 0x14, //   Unit (Degrees)
+		0x75, 0x04, //   Report Size (4)
+		0x95, 0x01, //   Report Count (1)
+		0x81, 0x42, //   Input (Data,Var,Abs,Null)
+		0x81, 0x03, //   Input (Const,Var,Abs)
+		0x65, 0x00, //   Unit (None)
+		0x09, 0x30, //   Usage (X)
+		0x09, 0x31, //   Usage (Y)
+		0x09, 0x32, //   Usage (Z)
+		0x09, 0x35, //   Usage (Rz)
+		0x26, 0xFF, 0x00, // Logical Maximum (255)
+		0x75, 0x08, //   Report Size (8)
+		0x95, 0x04, //   Report Count (4)
+		0x81, 0x02, //   Input (Data,Var,Abs)
+		0xC0, //       End Collection
+	}
+)
+
+func TestDiscover(t *testing.T) {
+	schema, err := hid.ParseReportDescriptor(gamepadReportDescriptor)
+	assert.NoError(t, err)
+
+	controllers := gamepad.Discover(schema)
+	assert.Len(t, controllers, 1)
+
+	controller := controllers[0]
+	assert.Equal(t, hid.NewUsage(gamepad.USAGE_PAGE_GENERIC_DESKTOP, gamepad.USAGE_GAMEPAD), controller.Usage)
+	assert.Equal(t, 6, controller.Input.ByteSize())
+	assert.Equal(t, 4, controller.AxisCount())
+	assert.True(t, controller.HasAxis(gamepad.AXIS_RZ))
+	assert.False(t, controller.HasAxis(gamepad.AXIS_RX))
+	assert.Equal(t, 1, controller.HatCount())
+	assert.Equal(t, 8, controller.ButtonCount())
+}
+
+func TestController_Decode(t *testing.T) {
+	schema, err := hid.ParseReportDescriptor(gamepadReportDescriptor)
+	assert.NoError(t, err)
+	controller := gamepad.Discover(schema)[0]
+
+	tests := []struct {
+		name    string
+		payload []byte
+		axes    [gamepad.AXIS_COUNT]float64
+		hat     gamepad.Hat
+		buttons gamepad.Buttons
+		err     error
+	}{
+		{
+			name:    "Success",
+			payload: []byte{0b1000_0101, 0x02, 0x00, 0xFF, 0x00, 0xFF},
+			axes:    [gamepad.AXIS_COUNT]float64{gamepad.AXIS_X: -1, gamepad.AXIS_Y: 1, gamepad.AXIS_Z: -1, gamepad.AXIS_RZ: 1},
+			hat:     gamepad.HAT_RIGHT,
+			buttons: 0b1000_0101,
+		},
+		{
+			name:    "Success_HatCentered",
+			payload: []byte{0x00, 0x0F, 0xFF, 0xFF, 0xFF, 0xFF},
+			axes:    [gamepad.AXIS_COUNT]float64{gamepad.AXIS_X: 1, gamepad.AXIS_Y: 1, gamepad.AXIS_Z: 1, gamepad.AXIS_RZ: 1},
+			hat:     gamepad.HAT_CENTERED,
+		},
+		{
+			name:    "Error_PayloadTooShort",
+			payload: []byte{0x00, 0x00},
+			err:     hid.ErrReportPayloadIsTooShort,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			state, err := controller.Decode(test.payload)
+			assert.ErrorIs(t, err, test.err)
+			if test.err != nil {
+				return
+			}
+			assert.Equal(t, controller, state.Controller)
+			assert.InDeltaSlice(t, test.axes[:], state.Axes[:], 1e-9)
+			assert.Equal(t, test.hat, state.Hats[0])
+			assert.Equal(t, test.buttons, state.Buttons)
+			assert.Equal(t, test.buttons&1 != 0, state.Buttons.Pressed(0))
+		})
+	}
+}
+
+func TestController_DecodeButtonArray(t *testing.T) {
+	desc := hidreport.HIDReportDescriptor{
+		0x05, 0x01, // Usage Page (Generic Desktop)
+		0x09, 0x04, // Usage (Joystick)
+		0xA1, 0x01, // Collection (Application)
+		0x05, 0x09, //   Usage Page (Button)
+		0x19, 0x01, //   Usage Minimum (1)
+		0x29, 0x10, //   Usage Maximum (16)
+		0x15, 0x01, //   Logical Minimum (1)
+		0x25, 0x10, //   Logical Maximum (16)
+		0x75, 0x08, //   Report Size (8)
+		0x95, 0x02, //   Report Count (2)
+		0x81, 0x00, //   Input (Data,Arr,Abs)
+		0xC0, //       End Collection
+	}
+	schema, err := hid.ParseReportDescriptor(desc)
+	assert.NoError(t, err)
+	controller := gamepad.Discover(schema)[0]
+	assert.Equal(t, 16, controller.ButtonCount())
+
+	state, err := controller.Decode([]byte{0x10, 0x00})
+	assert.NoError(t, err)
+	assert.Equal(t, gamepad.Buttons(1<<15), state.Buttons)
+	assert.True(t, state.Buttons.Pressed(15))
+}
+
+func TestDiscover_WideButtonArray(t *testing.T) {
+	desc := hidreport.HIDReportDescriptor{
+		0x05, 0x01, // Usage Page (Generic Desktop)
+		0x09, 0x04, // Usage (Joystick)
+		0xA1, 0x01, // Collection (Application)
+		0x05, 0x09, //   Usage Page (Button)
+		0x19, 0x01, //   Usage Minimum (1)
+		0x29, 0x10, //   Usage Maximum (16)
+		0x17, 0x00, 0x00, 0x00, 0x80, // Logical Minimum (-2147483648)
+		0x27, 0xFF, 0xFF, 0xFF, 0x7F, // Logical Maximum (2147483647)
+		0x75, 0x20, //   Report Size (32)
+		0x95, 0x01, //   Report Count (1)
+		0x81, 0x00, //   Input (Data,Arr,Abs)
+		0xC0, //       End Collection
+	}
+	schema, err := hid.ParseReportDescriptor(desc)
+	assert.NoError(t, err)
+	controllers := gamepad.Discover(schema)
+	assert.Len(t, controllers, 1)
+	assert.Equal(t, 16, controllers[0].ButtonCount())
+}
@@ -0,0 +1,379 @@
+package gamepad
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/google/gousb"
+)
+
+var (
+	ErrInvalidMapping     = errors.New("invalid mapping")
+	ErrInvalidMappingGUID = errors.New("invalid mapping GUID")
+	ErrMappingNotFound    = errors.New("mapping not found")
+)
+
+const (
+	// Length of GUID in hex string format
+	MAPPING_GUID_LENGTH = 32
+	// Input value, scaled from 0 to 1 within its input range, above which a gamepad button is considered pressed
+	MAPPING_AXIS_BUTTON_THRESHOLD = 0.5
+)
+
+type BindingType uint8
+
+const (
+	BINDING_TYPE_NONE BindingType = iota
+	BINDING_TYPE_BUTTON
+	BINDING_TYPE_AXIS
+	BINDING_TYPE_HAT
+)
+
+// AxisRange is a range of axis value used by a Binding, either full range or a half of it
+type AxisRange uint8
+
+const (
+	AXIS_RANGE_FULL AxisRange = iota
+	AXIS_RANGE_POSITIVE
+	AXIS_RANGE_NEGATIVE
+)
+
+// bounds returns start and end values of this range, for an axis ranging from -1 to 1
+func (a AxisRange) bounds() (float64, float64) {
+	switch a {
+	case AXIS_RANGE_POSITIVE:
+		return 0, 1
+	case AXIS_RANGE_NEGATIVE:
+		return 0, -1
+	}
+
+	return -1, 1
+}
+
+// Binding is an input of a controller bound to a button or an axis of gamepad
+type Binding struct {
+	Type BindingType
+	// Button index, axis index or hat index of a controller
+	Index int
+	// Direction of hat switch, for hat bindings
+	HatMask Hat
+	// Part of input axis used by this Binding
+	InputRange AxisRange
+	// Part of output axis this Binding is mapped to, for gamepad axes
+	OutputRange AxisRange
+	// Whether input axis is inverted
+	Invert bool
+}
+
+// Mapping maps inputs of a game controller into a gamepad layout, in format of SDL game controller database
+// e.g. "03000000d62000001fa8000000000000,My Gamepad,a:b1,b:b2,leftx:a0,lefty:a1,dpup:h0.1,platform:Linux,"
+type Mapping struct {
+	GUID      string
+	VendorID  gousb.ID
+	ProductID gousb.ID
+	Name      string
+	// Platform this mapping is made for, or empty if it is for any platform
+	Platform string
+
+	Buttons [BUTTON_COUNT]Binding
+	// Gamepad axes can be bound to multiple inputs, e.g. one for each half of the axis
+	Axes [GAMEPAD_AXIS_COUNT][]Binding
+}
+
+// MappedState is a state of a gamepad after mapping
+type MappedState struct {
+	// Stick values range from -1 to 1 and trigger values range from 0 to 1
+	Axes    [GAMEPAD_AXIS_COUNT]float64
+	Buttons [BUTTON_COUNT]bool
+}
+
+// parseGUID extracts vendor ID and product ID from SDL joystick GUID,
+// in which bytes 4-5 are vendor ID and bytes 8-9 are product ID, in little-endian
+func parseGUID(guid string) (gousb.ID, gousb.ID, error) {
+	if len(guid) != MAPPING_GUID_LENGTH {
+		return 0, 0, fmt.Errorf("GUID %q must have %d characters: %w", guid, MAPPING_GUID_LENGTH, ErrInvalidMappingGUID)
+	}
+	data, err := hex.DecodeString(guid)
+	if err != nil {
+		return 0, 0, fmt.Errorf("GUID %q is not hex string: %w", guid, ErrInvalidMappingGUID)
+	}
+
+	return gousb.ID(binary.LittleEndian.Uint16(data[4:6])), gousb.ID(binary.LittleEndian.Uint16(data[8:10])), nil
+}
+
+// parseInput parses input part of a mapping element, e.g. "b1", "a0", "+a2", "a3~" or "h0.4"
+func parseInput(input string) (Binding, error) {
+	var res Binding
+	if strings.HasPrefix(input, "+") {
+		res.InputRange = AXIS_RANGE_POSITIVE
+		input = input[1:]
+	} else if strings.HasPrefix(input, "-") {
+		res.InputRange = AXIS_RANGE_NEGATIVE
+		input = input[1:]
+	}
+	if strings.HasSuffix(input, "~") {
+		res.Invert = true
+		input = input[:len(input)-1]
+	}
+	if len(input) < 2 {
+		return res, fmt.Errorf("input %q is too short: %w", input, ErrInvalidMapping)
+	}
+
+	switch input[0] {
+	case 'b':
+		res.Type = BINDING_TYPE_BUTTON
+	case 'a':
+		res.Type = BINDING_TYPE_AXIS
+	case 'h':
+		res.Type = BINDING_TYPE_HAT
+		hat, mask, ok := strings.Cut(input[1:], ".")
+		if !ok {
+			return res, fmt.Errorf("hat input %q has no direction: %w", input, ErrInvalidMapping)
+		}
+		index, err := strconv.Atoi(hat)
+		if err != nil || index < 0 || index >= MAX_HAT_COUNT {
+			return res, fmt.Errorf("hat input %q has invalid index: %w", input, ErrInvalidMapping)
+		}
+		direction, err := strconv.ParseUint(mask, 10, 8)
+		if err != nil {
+			return res, fmt.Errorf("hat input %q has invalid direction: %w", input, ErrInvalidMapping)
+		}
+		res.Index = index
+		res.HatMask = Hat(direction)
+		return res, nil
+	default:
+		return res, fmt.Errorf("input %q has unknown type: %w", input, ErrInvalidMapping)
+	}
+
+	index, err := strconv.Atoi(input[1:])
+	if err != nil || index < 0 {
+		return res, fmt.Errorf("input %q has invalid index: %w", input, ErrInvalidMapping)
+	}
+	res.Index = index
+
+	return res, nil
+}
+
+// ParseMapping parses a single line of SDL game controller database
+func ParseMapping(line string) (*Mapping, error) {
+	elements := strings.Split(strings.TrimSpace(line), ",")
+	if len(elements) < 2 {
+		return nil, fmt.Errorf("mapping %q has no name: %w", line, ErrInvalidMapping)
+	}
+	vendorID, productID, err := parseGUID(elements[0])
+	if err != nil {
+		return nil, err
+	}
+	mapping := &Mapping{
+		GUID:      elements[0],
+		VendorID:  vendorID,
+		ProductID: productID,
+		Name:      elements[1],
+	}
+
+	for _, element := range elements[2:] {
+		if element == "" {
+			continue
+		}
+		output, input, ok := strings.Cut(element, ":")
+		if !ok {
+			return nil, fmt.Errorf("element %q of mapping %q: %w", element, mapping.Name, ErrInvalidMapping)
+		}
+		if output == "platform" {
+			mapping.Platform = input
+			continue
+		}
+
+		outputRange := AXIS_RANGE_FULL
+		if strings.HasPrefix(output, "+") {
+			outputRange = AXIS_RANGE_POSITIVE
+			output = output[1:]
+		} else if strings.HasPrefix(output, "-") {
+			outputRange = AXIS_RANGE_NEGATIVE
+			output = output[1:]
+		}
+
+		button, isButton := findButton(output)
+		axis, isAxis := findGamepadAxis(output)
+		if !isButton && !isAxis {
+			// Unknown outputs, e.g. crc or hint fields, are ignored for compatibility with newer databases
+			continue
+		}
+
+		bind, err := parseInput(input)
+		if err != nil {
+			return nil, fmt.Errorf("element %q of mapping %q: %w", element, mapping.Name, err)
+		}
+		bind.OutputRange = outputRange
+
+		if isButton {
+			mapping.Buttons[button] = bind
+		} else {
+			mapping.Axes[axis] = append(mapping.Axes[axis], bind)
+		}
+	}
+
+	return mapping, nil
+}
+
+func findButton(name string) (Button, bool) {
+	for button, buttonName := range ButtonNames {
+		if buttonName == name {
+			return Button(button), true
+		}
+	}
+
+	return 0, false
+}
+
+func findGamepadAxis(name string) (GamepadAxis, bool) {
+	for axis, axisName := range GamepadAxisNames {
+		if axisName == name {
+			return GamepadAxis(axis), true
+		}
+	}
+
+	return 0, false
+}
+
+// Mappings is a list of mappings loaded from SDL game controller database
+type Mappings []*Mapping
+
+// LoadMappings reads mappings from SDL game controller database, e.g. gamecontrollerdb.txt
+func LoadMappings(reader io.Reader) (Mappings, error) {
+	var mappings Mappings
+	scanner := bufio.NewScanner(reader)
+	lineNumber := 0
+
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		mapping, err := ParseMapping(line)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse mapping at line %d: %w", lineNumber, err)
+		}
+		mappings = append(mappings, mapping)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read mappings: %w", err)
+	}
+
+	return mappings, nil
+}
+
+// Find finds mapping of a controller by vendor ID and product ID.
+// Mappings made for given platform are preferred over mappings for any platform.
+func (m Mappings) Find(vendorID, productID gousb.ID, platform string) (*Mapping, error) {
+	var found *Mapping
+	for _, mapping := range m {
+		if mapping.VendorID != vendorID || mapping.ProductID != productID {
+			continue
+		}
+		if mapping.Platform == platform {
+			return mapping, nil
+		}
+		if mapping.Platform == "" && found == nil {
+			found = mapping
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("controller %v:%v on platform %q: %w", vendorID, productID, platform, ErrMappingNotFound)
+	}
+
+	return found, nil
+}
+
+// axisByIndex returns an axis by its index of an axis in the order used by mapping files, which counts only axes the controller provides
+func axisByIndex(controller *Controller, index int) (Axis, bool) {
+	for axis := Axis(0); axis < AXIS_COUNT; axis++ {
+		if !controller.HasAxis(axis) {
+			continue
+		}
+		if index == 0 {
+			return axis, true
+		}
+		index--
+	}
+
+	return 0, false
+}
+
+// inputValue returns value of a Binding from controller state, scaled from 0 to 1 within its input range.
+// It reports false if the input is not active, e.g. an axis value is on the other half of a half-axis input.
+func (b Binding) inputValue(state State) (float64, bool) {
+	switch b.Type {
+	case BINDING_TYPE_BUTTON:
+		if state.Buttons.Pressed(b.Index) {
+			return 1, true
+		}
+		return 0, true
+	case BINDING_TYPE_HAT:
+		if b.Index >= 0 && b.Index < MAX_HAT_COUNT && state.Hats[b.Index]&b.HatMask != 0 {
+			return 1, true
+		}
+		return 0, true
+	case BINDING_TYPE_AXIS:
+		if state.Controller == nil {
+			return 0, false
+		}
+		axis, ok := axisByIndex(state.Controller, b.Index)
+		if !ok {
+			return 0, false
+		}
+		value := state.Axes[axis]
+		if b.Invert {
+			value = -value
+		}
+		start, end := b.InputRange.bounds()
+		scaled := (value - start) / (end - start)
+		if scaled < 0 {
+			return 0, b.InputRange == AXIS_RANGE_FULL
+		}
+
+		return math.Min(1, scaled), true
+	}
+
+	return 0, false
+}
+
+// Apply maps a controller state into gamepad state
+func (m *Mapping) Apply(state State) MappedState {
+	var res MappedState
+
+	for button, bind := range m.Buttons {
+		value, ok := bind.inputValue(state)
+		if !ok {
+			continue
+		}
+		res.Buttons[button] = value > MAPPING_AXIS_BUTTON_THRESHOLD
+	}
+	for axis, binds := range m.Axes {
+		for _, bind := range binds {
+			value, ok := bind.inputValue(state)
+			if !ok {
+				continue
+			}
+			start, end := bind.OutputRange.bounds()
+			if GamepadAxis(axis).isTrigger() && bind.OutputRange == AXIS_RANGE_FULL {
+				start, end = 0, 1
+			}
+			output := start + value*(end-start)
+			// Half axes mapped to the same output axis are combined, e.g. "-leftx:b13,+leftx:b14"
+			if math.Abs(output) > math.Abs(res.Axes[axis]) {
+				res.Axes[axis] = output
+			}
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,142 @@
+package gamepad_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/gousb"
+	"github.com/ntchjb/gohid/gamepad"
+	"github.com/ntchjb/gohid/hid"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testMappings = `# Game controller DB for tests
+030000005e040000e002000000000000,Test Pad,a:b0,b:b1,x:b2,y:b3,back:b6,start:b7,dpup:h0.1,dpright:h0.2,dpdown:h0.4,dpleft:h0.8,leftx:a0,lefty:a1~,rightx:a2,righty:a3,lefttrigger:b4,righttrigger:+a3,platform:Linux,
+030000005e040000e002000000000000,Test Pad (Windows),a:b1,platform:Windows,
+03000000d62000001fa8000000000000,Generic Pad,a:b0,-leftx:h0.8,+leftx:h0.2,
+`
+)
+
+func TestParseMapping(t *testing.T) {
+	tests := []struct {
+		name      string
+		line      string
+		vendorID  gousb.ID
+		productID gousb.ID
+		platform  string
+		err       error
+	}{
+		{
+			name:      "Success",
+			line:      "030000005e040000e002000000000000,Test Pad,a:b0,leftx:a0,platform:Linux,",
+			vendorID:  0x045E,
+			productID: 0x02E0,
+			platform:  "Linux",
+		},
+		{
+			name:      "Success_UnknownElementsIgnored",
+			line:      "03000000d62000001fa8000000000000,Pad,crc:1234,hint:!SDL_GAMECONTROLLER_USE_BUTTON_LABELS:=1,a:b0",
+			vendorID:  0x20D6,
+			productID: 0xA81F,
+		},
+		{
+			name: "Error_InvalidGUID",
+			line: "0300,Pad,a:b0",
+			err:  gamepad.ErrInvalidMappingGUID,
+		},
+		{
+			name: "Error_NoName",
+			line: "030000005e040000e002000000000000",
+			err:  gamepad.ErrInvalidMapping,
+		},
+		{
+			name: "Error_InvalidInput",
+			line: "030000005e040000e002000000000000,Pad,a:z0",
+			err:  gamepad.ErrInvalidMapping,
+		},
+		{
+			name: "Error_InvalidHat",
+			line: "030000005e040000e002000000000000,Pad,dpup:h0",
+			err:  gamepad.ErrInvalidMapping,
+		},
+		{
+			name: "Error_NegativeHatIndex",
+			line: "030000005e040000e002000000000000,Pad,dpup:h-1.1",
+			err:  gamepad.ErrInvalidMapping,
+		},
+		{
+			name: "Error_HatIndexOutOfRange",
+			line: "030000005e040000e002000000000000,Pad,dpup:h4.1",
+			err:  gamepad.ErrInvalidMapping,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mapping, err := gamepad.ParseMapping(test.line)
+			assert.ErrorIs(t, err, test.err)
+			if test.err != nil {
+				return
+			}
+			assert.Equal(t, test.vendorID, mapping.VendorID)
+			assert.Equal(t, test.productID, mapping.ProductID)
+			assert.Equal(t, test.platform, mapping.Platform)
+		})
+	}
+}
+
+func TestMappings_Find(t *testing.T) {
+	mappings, err := gamepad.LoadMappings(strings.NewReader(testMappings))
+	assert.NoError(t, err)
+	assert.Len(t, mappings, 3)
+
+	mapping, err := mappings.Find(0x045E, 0x02E0, "Windows")
+	assert.NoError(t, err)
+	assert.Equal(t, "Test Pad (Windows)", mapping.Name)
+
+	mapping, err = mappings.Find(0x20D6, 0xA81F, "Linux")
+	assert.NoError(t, err)
+	assert.Equal(t, "Generic Pad", mapping.Name)
+
+	_, err = mappings.Find(0x045E, 0x02E0, "Android")
+	assert.ErrorIs(t, err, gamepad.ErrMappingNotFound)
+
+	_, err = gamepad.LoadMappings(strings.NewReader("invalid,Pad,a:b0"))
+	assert.ErrorIs(t, err, gamepad.ErrInvalidMappingGUID)
+}
+
+func TestMapping_Apply(t *testing.T) {
+	schema, err := hid.ParseReportDescriptor(gamepadReportDescriptor)
+	assert.NoError(t, err)
+	controller := gamepad.Discover(schema)[0]
+	mappings, err := gamepad.LoadMappings(strings.NewReader(testMappings))
+	assert.NoError(t, err)
+
+	state := gamepad.State{
+		Controller: controller,
+		Axes:       [gamepad.AXIS_COUNT]float64{gamepad.AXIS_X: -0.5, gamepad.AXIS_Y: 0.25, gamepad.AXIS_Z: 1, gamepad.AXIS_RZ: 0.5},
+		Hats:       [gamepad.MAX_HAT_COUNT]gamepad.Hat{gamepad.HAT_LEFT_UP},
+		Buttons:    0b1001_0001,
+	}
+
+	mapped := mappings[0].Apply(state)
+	assert.True(t, mapped.Buttons[gamepad.BUTTON_A])
+	assert.False(t, mapped.Buttons[gamepad.BUTTON_B])
+	assert.True(t, mapped.Buttons[gamepad.BUTTON_START])
+	assert.True(t, mapped.Buttons[gamepad.BUTTON_DPAD_UP])
+	assert.True(t, mapped.Buttons[gamepad.BUTTON_DPAD_LEFT])
+	assert.False(t, mapped.Buttons[gamepad.BUTTON_DPAD_RIGHT])
+	assert.InDelta(t, -0.5, mapped.Axes[gamepad.GAMEPAD_AXIS_LEFT_X], 1e-9)
+	assert.InDelta(t, -0.25, mapped.Axes[gamepad.GAMEPAD_AXIS_LEFT_Y], 1e-9)
+	assert.InDelta(t, 1, mapped.Axes[gamepad.GAMEPAD_AXIS_RIGHT_X], 1e-9)
+	assert.InDelta(t, 0.5, mapped.Axes[gamepad.GAMEPAD_AXIS_RIGHT_Y], 1e-9)
+	assert.InDelta(t, 1, mapped.Axes[gamepad.GAMEPAD_AXIS_LEFT_TRIGGER], 1e-9)
+	assert.InDelta(t, 0.5, mapped.Axes[gamepad.GAMEPAD_AXIS_RIGHT_TRIGGER], 1e-9)
+
+	mapped = mappings[2].Apply(state)
+	assert.True(t, mapped.Buttons[gamepad.BUTTON_A])
+	assert.InDelta(t, -1, mapped.Axes[gamepad.GAMEPAD_AXIS_LEFT_X], 1e-9)
+}
@@ -0,0 +1,98 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./gamepad/reader.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./gamepad/reader.go -destination=./gamepad/mock_reader.go -package=gamepad
+//
+
+// Package gamepad is a generated GoMock package.
+package gamepad
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReader is a mock of Reader interface.
+type MockReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockReaderMockRecorder
+}
+
+// MockReaderMockRecorder is the mock recorder for MockReader.
+type MockReaderMockRecorder struct {
+	mock *MockReader
+}
+
+// NewMockReader creates a new mock instance.
+func NewMockReader(ctrl *gomock.Controller) *MockReader {
+	mock := &MockReader{ctrl: ctrl}
+	mock.recorder = &MockReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReader) EXPECT() *MockReaderMockRecorder {
+	return m.recorder
+}
+
+// Controllers mocks base method.
+func (m *MockReader) Controllers() []*Controller {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Controllers")
+	ret0, _ := ret[0].([]*Controller)
+	return ret0
+}
+
+// Controllers indicates an expected call of Controllers.
+func (mr *MockReaderMockRecorder) Controllers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Controllers", reflect.TypeOf((*MockReader)(nil).Controllers))
+}
+
+// Mapping mocks base method.
+func (m *MockReader) Mapping() *Mapping {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Mapping")
+	ret0, _ := ret[0].(*Mapping)
+	return ret0
+}
+
+// Mapping indicates an expected call of Mapping.
+func (mr *MockReaderMockRecorder) Mapping() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Mapping", reflect.TypeOf((*MockReader)(nil).Mapping))
+}
+
+// Read mocks base method.
+func (m *MockReader) Read(ctx context.Context) (State, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", ctx)
+	ret0, _ := ret[0].(State)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockReaderMockRecorder) Read(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockReader)(nil).Read), ctx)
+}
+
+// ReadMapped mocks base method.
+func (m *MockReader) ReadMapped(ctx context.Context) (MappedState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadMapped", ctx)
+	ret0, _ := ret[0].(MappedState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadMapped indicates an expected call of ReadMapped.
+func (mr *MockReaderMockRecorder) ReadMapped(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadMapped", reflect.TypeOf((*MockReader)(nil).ReadMapped), ctx)
+}
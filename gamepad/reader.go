@@ -0,0 +1,139 @@
+package gamepad
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"runtime"
+
+	"github.com/ntchjb/gohid/hid"
+)
+
+var (
+	ErrNoControllerFound = errors.New("no game controller found")
+)
+
+// Platform names used in SDL game controller database, keyed by GOOS
+var PlatformNames = map[string]string{
+	"linux":   "Linux",
+	"windows": "Windows",
+	"darwin":  "Mac OS X",
+	"android": "Android",
+	"ios":     "iOS",
+}
+
+// CurrentPlatform returns name of current platform used in SDL game controller database
+func CurrentPlatform() string {
+	return PlatformNames[runtime.GOOS]
+}
+
+// Reader reads states of game controllers of a HID device
+type Reader interface {
+	// Get game controllers found in report descriptor of the device
+	Controllers() []*Controller
+	// Get mapping of the device for current platform, or nil if there is no mapping for the device
+	Mapping() *Mapping
+	// Read Input reports until a report of a game controller is found, and decode it
+	Read(ctx context.Context) (State, error)
+	// Read state of a game controller and map it into gamepad layout
+	ReadMapped(ctx context.Context) (MappedState, error)
+}
+
+type readerImpl struct {
+	device      hid.Device
+	schema      *hid.ReportSchema
+	controllers []*Controller
+	mapping     *Mapping
+
+	// Size of buffer that fits all Input reports
+	inputSize int
+	logger    *slog.Logger
+}
+
+// NewReader gets report descriptor from an opened HID device and discovers game controllers from it.
+// Mapping of the device is looked up from given mappings by vendor ID and product ID of the device.
+func NewReader(device hid.Device, mappings Mappings, logger *slog.Logger) (Reader, error) {
+	if device == nil {
+		return nil, hid.ErrDeviceIsNil
+	}
+	desc, err := device.GetReportDescriptor()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get report descriptor: %w", err)
+	}
+	schema, err := hid.ParseReportDescriptor(desc)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse report descriptor: %w", err)
+	}
+	controllers := Discover(schema)
+	if len(controllers) == 0 {
+		return nil, ErrNoControllerFound
+	}
+
+	reader := &readerImpl{
+		device:      device,
+		schema:      schema,
+		controllers: controllers,
+		logger:      logger,
+	}
+	if deviceDesc := device.GetDeviceInfo().DeviceDesc; deviceDesc != nil {
+		if mapping, err := mappings.Find(deviceDesc.Vendor, deviceDesc.Product, CurrentPlatform()); err == nil {
+			reader.mapping = mapping
+		}
+	}
+	for _, layout := range schema.Layouts {
+		if layout.Type == hid.REPORT_TYPE_INPUT && layout.ByteSize()+1 > reader.inputSize {
+			reader.inputSize = layout.ByteSize() + 1
+		}
+	}
+
+	return reader, nil
+}
+
+func (r *readerImpl) Controllers() []*Controller {
+	return r.controllers
+}
+
+func (r *readerImpl) Mapping() *Mapping {
+	return r.mapping
+}
+
+func (r *readerImpl) Read(ctx context.Context) (State, error) {
+	buf := make([]byte, r.inputSize)
+	for {
+		n, err := r.device.ReadInput(ctx, buf)
+		if err != nil {
+			return State{}, fmt.Errorf("unable to read input report: %w", err)
+		}
+		if n == 0 {
+			if err := ctx.Err(); err != nil {
+				return State{}, err
+			}
+			continue
+		}
+
+		layout, payload, err := r.schema.SplitInputReport(buf[:n])
+		if err != nil {
+			r.logger.Debug("skip unknown input report", "err", err)
+			continue
+		}
+		for _, controller := range r.controllers {
+			if controller.Input == layout {
+				return controller.Decode(payload)
+			}
+		}
+		r.logger.Debug("skip input report of non-controller", "id", layout.ID)
+	}
+}
+
+func (r *readerImpl) ReadMapped(ctx context.Context) (MappedState, error) {
+	if r.mapping == nil {
+		return MappedState{}, ErrMappingNotFound
+	}
+	state, err := r.Read(ctx)
+	if err != nil {
+		return MappedState{}, err
+	}
+
+	return r.mapping.Apply(state), nil
+}
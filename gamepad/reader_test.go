@@ -0,0 +1,130 @@
+package gamepad_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/google/gousb"
+	"github.com/ntchjb/gohid/gamepad"
+	"github.com/ntchjb/gohid/hid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	hidreport "github.com/ntchjb/usbip-virtual-device/usb/protocol/hid/report"
+)
+
+func newDeviceInfo(t *testing.T, vendorID, productID gousb.ID) hid.DeviceInfo {
+	var info hid.DeviceInfo
+	err := info.FromDeviceDesc(&gousb.DeviceDesc{
+		Vendor:  vendorID,
+		Product: productID,
+		Configs: map[int]gousb.ConfigDesc{
+			1: {
+				Number: 1,
+				Interfaces: []gousb.InterfaceDesc{
+					{
+						Number: 0,
+						AltSettings: []gousb.InterfaceSetting{
+							{Number: 0, Alternate: 0, Class: gousb.ClassHID},
+						},
+					},
+				},
+			},
+		},
+	}, 1, 0, 0)
+	assert.NoError(t, err)
+
+	return info
+}
+
+func TestNewReader(t *testing.T) {
+	errControl := errors.New("control transfer error")
+
+	tests := []struct {
+		name    string
+		desc    hidreport.HIDReportDescriptor
+		descErr error
+		err     error
+	}{
+		{
+			name: "Success",
+			desc: gamepadReportDescriptor,
+		},
+		{
+			name:    "Error_GetReportDescriptor",
+			descErr: errControl,
+			err:     errControl,
+		},
+		{
+			name: "Error_NoControllerFound",
+			desc: hidreport.HIDReportDescriptor{0x05, 0x01, 0x09, 0x02, 0xA1, 0x01, 0xC0},
+			err:  gamepad.ErrNoControllerFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			device := hid.NewMockDevice(ctrl)
+			device.EXPECT().GetReportDescriptor().Return(test.desc, test.descErr)
+			if test.err == nil {
+				device.EXPECT().GetDeviceInfo().Return(newDeviceInfo(t, 0x045E, 0x02E0))
+			}
+
+			reader, err := gamepad.NewReader(device, nil, slog.Default())
+			assert.ErrorIs(t, err, test.err)
+			if test.err == nil {
+				assert.Len(t, reader.Controllers(), 1)
+				assert.Nil(t, reader.Mapping())
+			}
+		})
+	}
+}
+
+func TestReader_ReadMapped(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	device := hid.NewMockDevice(ctrl)
+	mappings, err := gamepad.LoadMappings(strings.NewReader(
+		"03000000d62000001fa8000000000000,Generic Pad,a:b0,b:b1,leftx:a0,lefty:a1,",
+	))
+	assert.NoError(t, err)
+
+	device.EXPECT().GetReportDescriptor().Return(gamepadReportDescriptor, nil)
+	device.EXPECT().GetDeviceInfo().Return(newDeviceInfo(t, 0x20D6, 0xA81F))
+	gomock.InOrder(
+		device.EXPECT().ReadInput(ctx, gomock.Len(7)).Return(0, nil),
+		device.EXPECT().ReadInput(ctx, gomock.Len(7)).DoAndReturn(func(ctx context.Context, data []byte) (int, error) {
+			return copy(data, []byte{0b0000_0010, 0x0F, 0xFF, 0x00, 0x80, 0x80}), nil
+		}),
+	)
+
+	reader, err := gamepad.NewReader(device, mappings, slog.Default())
+	assert.NoError(t, err)
+	assert.Equal(t, "Generic Pad", reader.Mapping().Name)
+
+	state, err := reader.ReadMapped(ctx)
+	assert.NoError(t, err)
+	assert.False(t, state.Buttons[gamepad.BUTTON_A])
+	assert.True(t, state.Buttons[gamepad.BUTTON_B])
+	assert.InDelta(t, 1, state.Axes[gamepad.GAMEPAD_AXIS_LEFT_X], 1e-9)
+	assert.InDelta(t, -1, state.Axes[gamepad.GAMEPAD_AXIS_LEFT_Y], 1e-9)
+}
+
+func TestReader_ReadMapped_NoMapping(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	device := hid.NewMockDevice(ctrl)
+	device.EXPECT().GetReportDescriptor().Return(gamepadReportDescriptor, nil)
+	device.EXPECT().GetDeviceInfo().Return(hid.DeviceInfo{})
+
+	reader, err := gamepad.NewReader(device, nil, slog.Default())
+	assert.NoError(t, err)
+
+	_, err = reader.ReadMapped(context.Background())
+	assert.ErrorIs(t, err, gamepad.ErrMappingNotFound)
+}
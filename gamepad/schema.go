@@ -0,0 +1,201 @@
+package gamepad
+
+const (
+	USAGE_PAGE_GENERIC_DESKTOP uint16 = 0x01
+	USAGE_PAGE_BUTTON          uint16 = 0x09
+)
+
+// Application collection usages of game controllers on Generic Desktop usage page
+const (
+	USAGE_JOYSTICK              uint16 = 0x04
+	USAGE_GAMEPAD               uint16 = 0x05
+	USAGE_MULTI_AXIS_CONTROLLER uint16 = 0x08
+)
+
+// Axis and hat switch usages on Generic Desktop usage page
+const (
+	USAGE_X          uint16 = 0x30
+	USAGE_Y          uint16 = 0x31
+	USAGE_Z          uint16 = 0x32
+	USAGE_RX         uint16 = 0x33
+	USAGE_RY         uint16 = 0x34
+	USAGE_RZ         uint16 = 0x35
+	USAGE_SLIDER     uint16 = 0x36
+	USAGE_DIAL       uint16 = 0x37
+	USAGE_WHEEL      uint16 = 0x38
+	USAGE_HAT_SWITCH uint16 = 0x39
+)
+
+// Axis is an axis of a game controller, in the order used for axis indexes of mapping files
+type Axis uint8
+
+const (
+	AXIS_X Axis = iota
+	AXIS_Y
+	AXIS_Z
+	AXIS_RX
+	AXIS_RY
+	AXIS_RZ
+	AXIS_SLIDER
+	AXIS_DIAL
+	AXIS_WHEEL
+	AXIS_COUNT
+)
+
+var (
+	axisUsages = [AXIS_COUNT]uint16{
+		AXIS_X:      USAGE_X,
+		AXIS_Y:      USAGE_Y,
+		AXIS_Z:      USAGE_Z,
+		AXIS_RX:     USAGE_RX,
+		AXIS_RY:     USAGE_RY,
+		AXIS_RZ:     USAGE_RZ,
+		AXIS_SLIDER: USAGE_SLIDER,
+		AXIS_DIAL:   USAGE_DIAL,
+		AXIS_WHEEL:  USAGE_WHEEL,
+	}
+	AxisNames = [AXIS_COUNT]string{
+		AXIS_X:      "X",
+		AXIS_Y:      "Y",
+		AXIS_Z:      "Z",
+		AXIS_RX:     "Rx",
+		AXIS_RY:     "Ry",
+		AXIS_RZ:     "Rz",
+		AXIS_SLIDER: "Slider",
+		AXIS_DIAL:   "Dial",
+		AXIS_WHEEL:  "Wheel",
+	}
+)
+
+func (a Axis) String() string {
+	if a < AXIS_COUNT {
+		return AxisNames[a]
+	}
+
+	return "Unknown Axis"
+}
+
+// Hat is a direction of a hat switch, as a bitmask of up, right, down and left
+type Hat uint8
+
+const (
+	HAT_CENTERED   Hat = 0
+	HAT_UP         Hat = 0b0001
+	HAT_RIGHT      Hat = 0b0010
+	HAT_DOWN       Hat = 0b0100
+	HAT_LEFT       Hat = 0b1000
+	HAT_RIGHT_UP   Hat = HAT_RIGHT | HAT_UP
+	HAT_RIGHT_DOWN Hat = HAT_RIGHT | HAT_DOWN
+	HAT_LEFT_UP    Hat = HAT_LEFT | HAT_UP
+	HAT_LEFT_DOWN  Hat = HAT_LEFT | HAT_DOWN
+)
+
+const (
+	// Maximum number of hat switches decoded from a controller
+	MAX_HAT_COUNT = 4
+	// Maximum number of buttons decoded from a controller
+	MAX_BUTTON_COUNT = 64
+)
+
+var (
+	// Hat directions of 8-way hat switches, clockwise from up
+	hatDirections8 = [8]Hat{HAT_UP, HAT_RIGHT_UP, HAT_RIGHT, HAT_RIGHT_DOWN, HAT_DOWN, HAT_LEFT_DOWN, HAT_LEFT, HAT_LEFT_UP}
+	// Hat directions of 4-way hat switches, clockwise from up
+	hatDirections4 = [4]Hat{HAT_UP, HAT_RIGHT, HAT_DOWN, HAT_LEFT}
+)
+
+// Button is a button of a gamepad after mapping, named after buttons of Xbox controller
+type Button uint8
+
+const (
+	BUTTON_A Button = iota
+	BUTTON_B
+	BUTTON_X
+	BUTTON_Y
+	BUTTON_BACK
+	BUTTON_GUIDE
+	BUTTON_START
+	BUTTON_LEFT_STICK
+	BUTTON_RIGHT_STICK
+	BUTTON_LEFT_SHOULDER
+	BUTTON_RIGHT_SHOULDER
+	BUTTON_DPAD_UP
+	BUTTON_DPAD_DOWN
+	BUTTON_DPAD_LEFT
+	BUTTON_DPAD_RIGHT
+	BUTTON_MISC1
+	BUTTON_PADDLE1
+	BUTTON_PADDLE2
+	BUTTON_PADDLE3
+	BUTTON_PADDLE4
+	BUTTON_TOUCHPAD
+	BUTTON_COUNT
+)
+
+// Names of buttons used in mapping files
+var ButtonNames = [BUTTON_COUNT]string{
+	BUTTON_A:              "a",
+	BUTTON_B:              "b",
+	BUTTON_X:              "x",
+	BUTTON_Y:              "y",
+	BUTTON_BACK:           "back",
+	BUTTON_GUIDE:          "guide",
+	BUTTON_START:          "start",
+	BUTTON_LEFT_STICK:     "leftstick",
+	BUTTON_RIGHT_STICK:    "rightstick",
+	BUTTON_LEFT_SHOULDER:  "leftshoulder",
+	BUTTON_RIGHT_SHOULDER: "rightshoulder",
+	BUTTON_DPAD_UP:        "dpup",
+	BUTTON_DPAD_DOWN:      "dpdown",
+	BUTTON_DPAD_LEFT:      "dpleft",
+	BUTTON_DPAD_RIGHT:     "dpright",
+	BUTTON_MISC1:          "misc1",
+	BUTTON_PADDLE1:        "paddle1",
+	BUTTON_PADDLE2:        "paddle2",
+	BUTTON_PADDLE3:        "paddle3",
+	BUTTON_PADDLE4:        "paddle4",
+	BUTTON_TOUCHPAD:       "touchpad",
+}
+
+func (b Button) String() string {
+	if b < BUTTON_COUNT {
+		return ButtonNames[b]
+	}
+
+	return "unknown"
+}
+
+// GamepadAxis is an axis of a gamepad after mapping. Sticks range from -1 to 1, and triggers range from 0 to 1.
+type GamepadAxis uint8
+
+const (
+	GAMEPAD_AXIS_LEFT_X GamepadAxis = iota
+	GAMEPAD_AXIS_LEFT_Y
+	GAMEPAD_AXIS_RIGHT_X
+	GAMEPAD_AXIS_RIGHT_Y
+	GAMEPAD_AXIS_LEFT_TRIGGER
+	GAMEPAD_AXIS_RIGHT_TRIGGER
+	GAMEPAD_AXIS_COUNT
+)
+
+// Names of gamepad axes used in mapping files
+var GamepadAxisNames = [GAMEPAD_AXIS_COUNT]string{
+	GAMEPAD_AXIS_LEFT_X:        "leftx",
+	GAMEPAD_AXIS_LEFT_Y:        "lefty",
+	GAMEPAD_AXIS_RIGHT_X:       "rightx",
+	GAMEPAD_AXIS_RIGHT_Y:       "righty",
+	GAMEPAD_AXIS_LEFT_TRIGGER:  "lefttrigger",
+	GAMEPAD_AXIS_RIGHT_TRIGGER: "righttrigger",
+}
+
+func (g GamepadAxis) String() string {
+	if g < GAMEPAD_AXIS_COUNT {
+		return GamepadAxisNames[g]
+	}
+
+	return "unknown"
+}
+
+func (g GamepadAxis) isTrigger() bool {
+	return g == GAMEPAD_AXIS_LEFT_TRIGGER || g == GAMEPAD_AXIS_RIGHT_TRIGGER
+}
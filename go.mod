@@ -4,13 +4,14 @@ go 1.22
 
 require (
 	github.com/google/gousb v1.1.3
+	github.com/gorilla/websocket v1.5.3
 	github.com/ntchjb/usbip-virtual-device v0.0.0-20240815145631-148bfeba3613
 	github.com/stretchr/testify v1.9.0
 	go.uber.org/mock v0.4.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
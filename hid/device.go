@@ -7,6 +7,9 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/google/gousb"
 	"github.com/ntchjb/gohid/usb"
@@ -29,6 +32,10 @@ const (
 type DeviceConfig struct {
 	// Number of stream lanes used for streaming data on interrupt endpoints
 	StreamLaneCount int
+	// Quirks of devices, applied to the device when its target is set
+	Quirks Quirks
+	// Retries of failed transfers, and reset of the device after repeated failures
+	RetryPolicy RetryPolicy
 }
 
 type Device interface {
@@ -40,7 +47,10 @@ type Device interface {
 	SetAutoDetach(autoDetach bool) error
 	// Write an Output report to HID device, via interrupt OUT endpoint
 	WriteOutput(ctx context.Context, data []byte) (int, error)
-	// Read an Input report from a HID device, via interrupt IN endpoint
+	// Read an Input report from a HID device, via interrupt IN endpoint.
+	// Reports larger than max packet size of the endpoint are reassembled from their packets.
+	// Once a read fails, e.g. a report overflows data or ctx is done, the stream of the endpoint is reopened
+	// before the next read, so reports pending in transfers of the failed stream are lost.
 	ReadInput(ctx context.Context, data []byte) (int, error)
 	// Send a Feature Report using Set_Report transfer, via control endpoint
 	// The first byte of data must contain the Report ID. For device that support single report type, set it to 0x00
@@ -67,6 +77,12 @@ type Device interface {
 	GetDeviceInfo() DeviceInfo
 }
 
+// StatsProvider is implemented by devices collecting statistics of their transfers, e.g. devices created by NewDevice
+type StatsProvider interface {
+	// Get a snapshot of statistics of transfers since the target of the device is set
+	Stats() Stats
+}
+
 func NewDevice(device usb.Device, config DeviceConfig, logger *slog.Logger) (Device, error) {
 	if device == nil {
 		return nil, ErrDeviceIsNil
@@ -76,6 +92,7 @@ func NewDevice(device usb.Device, config DeviceConfig, logger *slog.Logger) (Dev
 		device:  device,
 		dConfig: config,
 		logger:  logger,
+		stats:   newStats(),
 	}, nil
 }
 
@@ -85,11 +102,36 @@ type deviceImpl struct {
 	intf   usb.Interface
 	writer usb.StreamWriter
 	reader usb.StreamReader
+	// Interrupt IN endpoint of the reader, whose stream is reopened before the next read once a read fails
+	epIn usb.InEndpoint
+	// Stream of the reader cannot be read anymore, as streams of gousb cannot be read after a failed transfer
+	readerFailed atomic.Bool
+	// Interrupt OUT endpoint of the writer, whose stream is reopened before a write is retried, or before the next write
+	// once a write fails
+	epOut usb.OutEndpoint
+	// Serializes writes, and guards writer which is replaced when its stream is reopened
+	writerMu sync.Mutex
+	// Stream of the writer cannot be written anymore, as streams of gousb cannot be written after a failed transfer
+	writerFailed atomic.Bool
+	// Failed write of the writer was stalled, so halt of the endpoint is cleared before the stream is reopened
+	writerStalled bool
+	// Number of consecutive failed transfers, counted when reset threshold of retry policy is set
+	failures atomic.Int32
+	// Max packet sizes of interrupt endpoints, or zero if there is no such endpoint
+	inPacketSize  int
+	outPacketSize int
+	// Report descriptor used to know length of Input reports spanning multiple packets, loaded once it is needed
+	inputSchema       *ReportSchema
+	inputSchemaLoaded bool
+	inputSchemaMu     sync.Mutex
 
 	dConfig DeviceConfig
+	// Quirk of the current target, or zero value if the target has no quirk
+	quirk Quirk
 
 	deviceInfo DeviceInfo
 	logger     *slog.Logger
+	stats      *stats
 }
 
 func (d *deviceImpl) SetAutoDetach(autoDetach bool) error {
@@ -97,8 +139,8 @@ func (d *deviceImpl) SetAutoDetach(autoDetach bool) error {
 	// Without this call, manual detach of the device from kernel is required
 	// to successfully claim device interfaces.
 	if err := d.device.SetAutoDetach(autoDetach); err != nil {
-		desc := d.device.Descriptor()
-		return fmt.Errorf("unable to set auto detach for device %v:%v: %w", desc.Vendor, desc.Product, err)
+		// Error of the device names the device
+		return fmt.Errorf("unable to set auto detach: %w", d.usbError(usb.OPERATION_SET_AUTO_DETACH, err))
 	}
 
 	return nil
@@ -154,26 +196,33 @@ func (d *deviceImpl) SetTarget(confNumber, infNumber, altNumber int) error {
 
 	cfg, err = d.device.Config(confNumber)
 	if err != nil {
+		err = usb.NewError(usb.OPERATION_CLAIM_CONFIG, deviceDesc.Vendor, deviceDesc.Product, usb.NO_INTERFACE, err)
 		return fmt.Errorf("unable to get config #%d for device %v:%v: %w", confNumber, deviceDesc.Vendor, deviceDesc.Product, err)
 	}
 	logger := d.logger.With("cfg", confNumber)
 	intf, err = cfg.Interface(infNumber, altNumber)
 	if err != nil {
+		err = usb.NewError(usb.OPERATION_CLAIM_INTERFACE, deviceDesc.Vendor, deviceDesc.Product, infNumber, err)
 		return fmt.Errorf("unable to get interface #%d:%d for config #%d of device %v:%v: %w", infNumber, altNumber, confNumber, deviceDesc.Vendor, deviceDesc.Product, err)
 	}
 	logger = logger.With("intf", infNumber, "alt", altNumber)
+	var inPacketSize, outPacketSize int
 	endpoints := deviceInfo.GetEndpoints()
 	for _, endpoint := range endpoints {
 		if endpoint.Direction == gousb.EndpointDirectionIn && epIn == nil {
 			logger.Info("use endpoint IN", "number", endpoint.Number)
+			inPacketSize = endpoint.MaxPacketSize
 			epIn, err = intf.InEndpoint(endpoint.Number)
 			if err != nil {
+				err = usb.NewError(usb.OPERATION_OPEN_ENDPOINT, deviceDesc.Vendor, deviceDesc.Product, infNumber, err)
 				return fmt.Errorf("unable to get IN endpoint at #%d for device %04x:%04x: %w", endpoint.Number, deviceDesc.Vendor, deviceDesc.Product, err)
 			}
 		} else if endpoint.Direction == gousb.EndpointDirectionOut && epOut == nil {
 			logger.Info("use endpoint OUT", "number", endpoint.Number)
+			outPacketSize = endpoint.MaxPacketSize
 			epOut, err = intf.OutEndpoint(endpoint.Number)
 			if err != nil {
+				err = usb.NewError(usb.OPERATION_OPEN_ENDPOINT, deviceDesc.Vendor, deviceDesc.Product, infNumber, err)
 				return fmt.Errorf("unable to get OUT endpoint at #%d for device %04x:%04x: %w", endpoint.Number, deviceDesc.Vendor, deviceDesc.Product, err)
 			}
 		}
@@ -185,11 +234,13 @@ func (d *deviceImpl) SetTarget(confNumber, infNumber, altNumber int) error {
 	}
 	reader, err = epIn.NewStream(d.dConfig.StreamLaneCount)
 	if err != nil {
+		err = usb.NewError(usb.OPERATION_OPEN_STREAM, deviceDesc.Vendor, deviceDesc.Product, infNumber, err)
 		return fmt.Errorf("unable to create stream reader for endpoint %d: %w", epIn.Descriptor().Number, err)
 	}
 	if epOut != nil {
 		writer, err = epOut.NewStream(d.dConfig.StreamLaneCount)
 		if err != nil {
+			err = usb.NewError(usb.OPERATION_OPEN_STREAM, deviceDesc.Vendor, deviceDesc.Product, infNumber, err)
 			return fmt.Errorf("unable to create stream writer for endpoint %d: %w", epOut.Descriptor().Number, err)
 		}
 	}
@@ -197,8 +248,24 @@ func (d *deviceImpl) SetTarget(confNumber, infNumber, altNumber int) error {
 	d.config = cfg
 	d.intf = intf
 	d.writer = writer
+	d.writerFailed.Store(false)
 	d.reader = reader
+	d.readerFailed.Store(false)
+	d.epIn = epIn
+	d.epOut = epOut
+	d.inPacketSize = inPacketSize
+	d.outPacketSize = outPacketSize
 	d.deviceInfo = deviceInfo
+	quirk, ok := d.dConfig.Quirks.Find(uint16(deviceDesc.Vendor), uint16(deviceDesc.Product), infNumber)
+	if ok {
+		logger.Info("apply quirk", "vid", deviceDesc.Vendor, "pid", deviceDesc.Product)
+	}
+	d.quirk = quirk
+	d.inputSchemaMu.Lock()
+	d.inputSchema = nil
+	d.inputSchemaLoaded = false
+	d.inputSchemaMu.Unlock()
+	d.stats.reset(time.Now())
 
 	return nil
 }
@@ -210,11 +277,13 @@ func (d *deviceImpl) Close() error {
 			allErrs = errors.Join(allErrs, fmt.Errorf("unable to close stream reader: %w", err))
 		}
 	}
+	d.writerMu.Lock()
 	if d.writer != nil {
 		if err := d.writer.Close(); err != nil {
 			allErrs = errors.Join(allErrs, fmt.Errorf("unable to close stream writer: %w", err))
 		}
 	}
+	d.writerMu.Unlock()
 	if d.intf != nil {
 		if err := d.intf.Close(); err != nil {
 			allErrs = errors.Join(allErrs, fmt.Errorf("unable to close interface: %w", err))
@@ -244,15 +313,24 @@ func (d *deviceImpl) WriteOutput(ctx context.Context, data []byte) (int, error)
 	}
 	reportNumber := data[0]
 
-	if reportNumber == 0x00 {
+	if reportNumber == 0x00 || d.quirk.NoOutputReportID {
 		data = data[1:]
 		isSkippedReportID = true
 	}
 
-	byteWritten, err := d.writer.WriteContext(ctx, data)
+	padded := d.padOutput(data)
+	d.writerMu.Lock()
+	defer d.writerMu.Unlock()
+	byteWritten, err := d.write(ctx, padded)
 	if err != nil {
-		return byteWritten, fmt.Errorf("unable to write output report to interrupt OUT endpoint: %w", err)
+		return byteWritten, fmt.Errorf("unable to write output report to interrupt OUT endpoint: %w", d.usbError(usb.OPERATION_WRITE, err))
+	}
+	if err := d.writeZeroLengthPacket(ctx, len(padded)); err != nil {
+		return byteWritten, fmt.Errorf("unable to write zero-length packet to interrupt OUT endpoint: %w", d.usbError(usb.OPERATION_WRITE, err))
 	}
+	d.stats.recordOutput(byteWritten)
+	// Padding bytes are not part of caller's data
+	byteWritten = min(byteWritten, len(data))
 
 	if isSkippedReportID {
 		byteWritten += 1
@@ -265,11 +343,31 @@ func (d *deviceImpl) ReadInput(ctx context.Context, data []byte) (int, error) {
 	if len(data) == 0 {
 		return 0, nil
 	}
+	if d.reader == nil {
+		return 0, fmt.Errorf("interrupt IN endpoint: %w", ErrUninitializedEndpoint)
+	}
+
+	if d.readerFailed.Swap(false) {
+		if err := d.reopenReader(); err != nil {
+			d.readerFailed.Store(true)
+			return 0, fmt.Errorf("unable to reopen stream of interrupt IN endpoint: %w", d.usbError(usb.OPERATION_OPEN_STREAM, err))
+		}
+	}
 
-	byteRead, err := d.reader.ReadContext(ctx, data)
+	byteRead, err := d.readInput(ctx, data)
 	if err != nil {
-		return byteRead, fmt.Errorf("unable to read report from interrupt IN endpoint: %w", err)
+		// Stream is reopened before the next read, unless the device is gone
+		if usb.ErrorKind(err) != usb.ErrDisconnected {
+			d.readerFailed.Store(true)
+		}
+		return byteRead, fmt.Errorf("unable to read report from interrupt IN endpoint: %w", d.usbError(usb.OPERATION_READ, err))
+	}
+	if length := min(d.quirk.InputReportLength, len(data)); length > 0 {
+		clear(data[min(byteRead, length):length])
+		byteRead = length
 	}
+	// Timed when the report is returned to the caller, which may be later than its transfer completes
+	d.stats.recordInput(byteRead, time.Now())
 
 	return byteRead, nil
 }
@@ -286,7 +384,7 @@ func (d *deviceImpl) SendFeatureReport(data []byte) (int, error) {
 		data = data[1:]
 		isSkippedReportID = true
 	}
-	byteSend, err := d.device.Control(
+	byteSend, err := d.control(
 		uint8(SETUP_REQUEST_TYPE_CLASS)|uint8(SETUP_RECIPIENT_INTERFACE)|uint8(SETUP_EP_DIR_OUT),
 		uint8(SETUP_REQUEST_HID_SET_REPORT),
 		(uint16(REPORT_TYPE_FEATURE)<<8)|uint16(reportNumber),
@@ -295,7 +393,7 @@ func (d *deviceImpl) SendFeatureReport(data []byte) (int, error) {
 	)
 
 	if err != nil {
-		return 0, fmt.Errorf("unable set feature report via control endpoint: %w", err)
+		return 0, fmt.Errorf("unable set feature report via control endpoint: %w", d.usbError(usb.OPERATION_CONTROL, err))
 	}
 
 	if isSkippedReportID {
@@ -317,7 +415,7 @@ func (d *deviceImpl) GetFeatureReport(data []byte) (int, error) {
 		isSkippedReportID = true
 	}
 
-	byteSend, err := d.device.Control(
+	byteSend, err := d.control(
 		uint8(SETUP_REQUEST_TYPE_CLASS)|uint8(SETUP_RECIPIENT_INTERFACE)|uint8(SETUP_EP_DIR_IN),
 		uint8(SETUP_REQUEST_HID_GET_REPORT),
 		(uint16(REPORT_TYPE_FEATURE)<<8)|uint16(reportNumber),
@@ -326,7 +424,7 @@ func (d *deviceImpl) GetFeatureReport(data []byte) (int, error) {
 	)
 
 	if err != nil {
-		return 0, fmt.Errorf("unable get feature report via control endpoint: %w", err)
+		return 0, fmt.Errorf("unable get feature report via control endpoint: %w", d.usbError(usb.OPERATION_CONTROL, err))
 	}
 
 	if isSkippedReportID {
@@ -348,17 +446,20 @@ func (d *deviceImpl) SendOutputReport(data []byte) (int, error) {
 		isSkippedReportID = true
 	}
 
-	byteSend, err := d.device.Control(
+	byteSend, err := d.control(
 		uint8(SETUP_REQUEST_TYPE_CLASS)|uint8(SETUP_RECIPIENT_INTERFACE)|uint8(SETUP_EP_DIR_OUT),
 		uint8(SETUP_REQUEST_HID_SET_REPORT),
 		(uint16(REPORT_TYPE_OUTPUT)<<8)|uint16(reportNumber),
 		uint16(d.deviceInfo.GetInterfaceNumber()),
-		data,
+		d.padOutput(data),
 	)
 
 	if err != nil {
-		return 0, fmt.Errorf("unable send output report via control endpoint: %w", err)
+		return 0, fmt.Errorf("unable send output report via control endpoint: %w", d.usbError(usb.OPERATION_CONTROL, err))
 	}
+	// Bytes of the report are counted by its control transfer
+	d.stats.recordOutput(0)
+	byteSend = min(byteSend, len(data))
 
 	if isSkippedReportID {
 		byteSend++
@@ -379,7 +480,7 @@ func (d *deviceImpl) GetInputReport(data []byte) (int, error) {
 		isSkippedReportID = true
 	}
 
-	byteSend, err := d.device.Control(
+	byteSend, err := d.control(
 		uint8(SETUP_REQUEST_TYPE_CLASS)|uint8(SETUP_RECIPIENT_INTERFACE)|uint8(SETUP_EP_DIR_IN),
 		uint8(SETUP_REQUEST_HID_GET_REPORT),
 		(uint16(REPORT_TYPE_INPUT)<<8)|uint16(reportNumber),
@@ -388,7 +489,7 @@ func (d *deviceImpl) GetInputReport(data []byte) (int, error) {
 	)
 
 	if err != nil {
-		return 0, fmt.Errorf("unable send input report via control endpoint: %w", err)
+		return 0, fmt.Errorf("unable send input report via control endpoint: %w", d.usbError(usb.OPERATION_CONTROL, err))
 	}
 
 	if isSkippedReportID {
@@ -399,25 +500,38 @@ func (d *deviceImpl) GetInputReport(data []byte) (int, error) {
 }
 
 func (d *deviceImpl) GetManufacturer() (string, error) {
-	return d.device.Manufacturer()
+	str, err := d.device.Manufacturer()
+
+	return str, d.usbError(usb.OPERATION_STRING_DESCRIPTOR, err)
 }
 
 func (d *deviceImpl) GetProduct() (string, error) {
-	return d.device.Product()
+	str, err := d.device.Product()
+
+	return str, d.usbError(usb.OPERATION_STRING_DESCRIPTOR, err)
 }
 
 func (d *deviceImpl) GetSerialNumber() (string, error) {
-	return d.device.SerialNumber()
+	str, err := d.device.SerialNumber()
+
+	return str, d.usbError(usb.OPERATION_STRING_DESCRIPTOR, err)
 }
 
 func (d *deviceImpl) GetDeviceInfo() DeviceInfo {
 	return d.deviceInfo
 }
 
+func (d *deviceImpl) Stats() Stats {
+	return d.stats.snapshot()
+}
+
 func (d *deviceImpl) GetReportDescriptor() (hidreport.HIDReportDescriptor, error) {
+	if len(d.quirk.ReportDescriptor) > 0 {
+		return append(hidreport.HIDReportDescriptor{}, d.quirk.ReportDescriptor...), nil
+	}
 	buf := make([]byte, HID_MAX_REPORT_SIZE)
 
-	n, err := d.device.Control(
+	n, err := d.control(
 		uint8(SETUP_REQUEST_TYPE_STANDARD)|uint8(SETUP_RECIPIENT_INTERFACE)|uint8(SETUP_EP_DIR_IN),
 		uint8(SETUP_REQUEST_GET_DESCRIPTOR),
 		(uint16(DESCRIPTOR_TYPE_REPORT)<<8)|uint16(0), // Descriptor Index is zero for all HID descriptors except Physical descriptors
@@ -426,10 +540,31 @@ func (d *deviceImpl) GetReportDescriptor() (hidreport.HIDReportDescriptor, error
 	)
 
 	if err != nil {
-		return nil, fmt.Errorf("unable to get report descriptor via control endpoint: %w", err)
+		return nil, fmt.Errorf("unable to get report descriptor via control endpoint: %w", d.usbError(usb.OPERATION_CONTROL, err))
+	}
+
+	desc := hidreport.HIDReportDescriptor(buf[:n])
+	for _, patch := range d.quirk.ReportDescriptorPatches {
+		patched, err := patch.Apply(desc)
+		if err != nil {
+			d.logger.Warn("skip report descriptor patch", "err", err)
+			continue
+		}
+		desc = patched
 	}
 
-	return buf[:n], nil
+	return desc, nil
+}
+
+// padOutput pads Output report data with zeros, if the device needs Output reports of a fixed length
+func (d *deviceImpl) padOutput(data []byte) []byte {
+	if len(data) >= d.quirk.OutputReportLength {
+		return data
+	}
+	padded := make([]byte, d.quirk.OutputReportLength)
+	copy(padded, data)
+
+	return padded
 }
 
 func (d *deviceImpl) GetHIDDescriptor() (hid.HIDDescriptor, error) {
@@ -438,7 +573,7 @@ func (d *deviceImpl) GetHIDDescriptor() (hid.HIDDescriptor, error) {
 	// #1: Get partial data first to know the whole data size
 	data := make([]byte, hid.HID_DESCRIPTOR_LENGTH)
 
-	_, err := d.device.Control(
+	_, err := d.control(
 		uint8(SETUP_REQUEST_TYPE_STANDARD)|uint8(SETUP_RECIPIENT_INTERFACE)|uint8(SETUP_EP_DIR_IN),
 		uint8(SETUP_REQUEST_GET_DESCRIPTOR),
 		(uint16(DESCRIPTOR_TYPE_HID)<<8)|uint16(0), // Descriptor Index is zero
@@ -447,7 +582,7 @@ func (d *deviceImpl) GetHIDDescriptor() (hid.HIDDescriptor, error) {
 	)
 
 	if err != nil {
-		return desc, fmt.Errorf("unable send input report via control endpoint: %w", err)
+		return desc, fmt.Errorf("unable send input report via control endpoint: %w", d.usbError(usb.OPERATION_CONTROL, err))
 	}
 	if err := desc.Decode(bytes.NewBuffer(data)); err != nil && !errors.Is(err, io.EOF) {
 		return desc, fmt.Errorf("unable to decode HID descriptor: %w", err)
@@ -459,7 +594,7 @@ func (d *deviceImpl) GetHIDDescriptor() (hid.HIDDescriptor, error) {
 
 	// #2: Now get the whole descriptor data, if any
 	data = make([]byte, hid.HID_DESCRIPTOR_LENGTH+(desc.BNumDescriptors-1)*3)
-	_, err = d.device.Control(
+	_, err = d.control(
 		uint8(SETUP_REQUEST_TYPE_STANDARD)|uint8(SETUP_RECIPIENT_INTERFACE)|uint8(SETUP_EP_DIR_IN),
 		uint8(SETUP_REQUEST_GET_DESCRIPTOR),
 		(uint16(DESCRIPTOR_TYPE_HID)<<8)|uint16(0), // Descriptor Index is zero
@@ -468,7 +603,7 @@ func (d *deviceImpl) GetHIDDescriptor() (hid.HIDDescriptor, error) {
 	)
 
 	if err != nil {
-		return hid.HIDDescriptor{}, fmt.Errorf("unable send input report via control endpoint (2nd time): %w", err)
+		return hid.HIDDescriptor{}, fmt.Errorf("unable send input report via control endpoint (2nd time): %w", d.usbError(usb.OPERATION_CONTROL, err))
 	}
 	if err := desc.Decode(bytes.NewBuffer(data)); err != nil {
 		return hid.HIDDescriptor{}, fmt.Errorf("unable to decode HID descriptor (2nd time): %w", err)
@@ -478,5 +613,28 @@ func (d *deviceImpl) GetHIDDescriptor() (hid.HIDDescriptor, error) {
 }
 
 func (d *deviceImpl) GetStringDescriptor(index int) (string, error) {
-	return d.device.GetStringDescriptor(index)
+	str, err := d.device.GetStringDescriptor(index)
+
+	return str, d.usbError(usb.OPERATION_STRING_DESCRIPTOR, err)
+}
+
+// usbError wraps an error of the USB layer into usb.Error with the device and its target interface,
+// unless the USB layer has already wrapped it
+func (d *deviceImpl) usbError(op usb.Operation, err error) error {
+	if err == nil {
+		return nil
+	}
+	d.stats.recordError(op)
+	desc := d.deviceInfo.DeviceDesc
+	intf := usb.NO_INTERFACE
+	if desc != nil {
+		intf = d.deviceInfo.GetInterfaceNumber()
+	} else {
+		desc = d.device.Descriptor()
+	}
+	if desc == nil {
+		return usb.NewError(op, 0, 0, intf, err)
+	}
+
+	return usb.NewError(op, desc.Vendor, desc.Product, intf, err)
 }
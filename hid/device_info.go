@@ -121,6 +121,23 @@ func (d *DeviceInfo) GetEndpoints() map[gousb.EndpointAddress]gousb.EndpointDesc
 	return d.DeviceDesc.Configs[d.target[0]].Interfaces[d.target[1]].AltSettings[d.target[2]].Endpoints
 }
 
+// GetValidationOptions returns max packet sizes of interrupt endpoints, used for validating report descriptor
+func (d *DeviceInfo) GetValidationOptions() ValidationOptions {
+	var options ValidationOptions
+	for _, endpoint := range d.GetEndpoints() {
+		if endpoint.TransferType != gousb.TransferTypeInterrupt {
+			continue
+		}
+		if endpoint.Direction == gousb.EndpointDirectionIn {
+			options.MaxInputPacketSize = endpoint.MaxPacketSize
+		} else {
+			options.MaxOutputPacketSize = endpoint.MaxPacketSize
+		}
+	}
+
+	return options
+}
+
 type DeviceInfos []DeviceInfo
 
 func (d DeviceInfos) String() string {
@@ -3,6 +3,7 @@ package hid_test
 import (
 	"context"
 	"errors"
+	"io"
 	"log/slog"
 	"testing"
 	"time"
@@ -143,7 +144,7 @@ var (
 		mockUSBInf.EXPECT().InEndpoint(epIn).Return(mockUSBInEndpoint, nil)
 		mockUSBInf.EXPECT().OutEndpoint(epOut).Return(mockUSBOutEndpoint, nil).AnyTimes()
 		mockUSBInEndpoint.EXPECT().NewStream(hid.DEFAULT_ENDPOINT_STREAM_COUNT).Return(mockUSBStreamReader, nil)
-		mockUSBOutEndpoint.EXPECT().NewStream(hid.DEFAULT_ENDPOINT_STREAM_COUNT).Return(mockUSBStreamWriter, nil).AnyTimes()
+		mockUSBOutEndpoint.EXPECT().NewStream(hid.DEFAULT_ENDPOINT_STREAM_COUNT).Return(mockUSBStreamWriter, nil).MaxTimes(1)
 
 		return mocks{
 			device: mockUSBDevice,
@@ -159,6 +160,30 @@ var (
 	config = hid.DeviceConfig{
 		StreamLaneCount: hid.DEFAULT_ENDPOINT_STREAM_COUNT,
 	}
+
+	quirkInterface = 1
+	quirkConfig    = hid.DeviceConfig{
+		StreamLaneCount: hid.DEFAULT_ENDPOINT_STREAM_COUNT,
+		Quirks: hid.Quirks{
+			{
+				VendorID:           0xFF01,
+				ProductID:          0x0001,
+				Interface:          &quirkInterface,
+				OutputReportLength: 8,
+				InputReportLength:  4,
+				NoOutputReportID:   true,
+				ReportDescriptorPatches: []hid.QuirkPatch{
+					{Offset: 3, Original: hid.HexBytes{0x06}, Data: hid.HexBytes{0x07}},
+					{Offset: 5, Original: hid.HexBytes{0xFF}, Data: hid.HexBytes{0x00}},
+				},
+			},
+			{
+				VendorID:         0xFF01,
+				ProductID:        0x0001,
+				ReportDescriptor: hid.HexBytes{0x05, 0x01, 0x09, 0x02},
+			},
+		},
+	}
 )
 
 func TestDevice_NewDevice(t *testing.T) {
@@ -299,6 +324,13 @@ func TestDevice_SetAutoDetach(t *testing.T) {
 
 			err = hidDevice.SetAutoDetach(test.args.autoDetach)
 			assert.ErrorIs(t, err, test.err)
+			if test.err != nil {
+				var usbErr *usb.Error
+				if assert.ErrorAs(t, err, &usbErr) {
+					assert.Equal(t, usb.OPERATION_SET_AUTO_DETACH, usbErr.Op)
+					assert.Equal(t, gousb.ID(0x1234), usbErr.Vendor)
+				}
+			}
 		})
 	}
 }
@@ -917,6 +949,28 @@ func TestDevice_WriteOutput(t *testing.T) {
 			byteWritten: 6,
 			err:         nil,
 		},
+		{
+			name: "Success_Quirk",
+			fields: fields{
+				mocks: func(ctrl *gomock.Controller) mocks {
+					mocks := createSetupTargetMocks(ctrl, 1, 1, 0, 1, 1)
+
+					mocks.writer.EXPECT().WriteContext(ctx, []byte{
+						0x01, 0x02, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00,
+					}).Return(8, nil)
+
+					return mocks
+				},
+				config: quirkConfig,
+			},
+			args: args{
+				ctx:    ctx,
+				data:   []byte{0x01, 0x01, 0x02, 0x03},
+				infNum: 1,
+			},
+			byteWritten: 4,
+			err:         nil,
+		},
 		{
 			name: "Error_WriteContext",
 			fields: fields{
@@ -1009,6 +1063,29 @@ func TestDevice_ReadInput(t *testing.T) {
 			byteRead: 6,
 			err:      nil,
 		},
+		{
+			name: "Success_QuirkInputReportLength",
+			fields: fields{
+				mocks: func(ctrl *gomock.Controller) mocks {
+					mocks := createSetupTargetMocks(ctrl, 1, 1, 0, 1, 1)
+					mocks.reader.EXPECT().
+						ReadContext(ctx, make([]byte, 6)).
+						DoAndReturn(func(ctx context.Context, data []byte) (int, error) {
+							return copy(data, []byte{0x01, 0x01, 0x02, 0x03, 0x00, 0x00}), nil
+						})
+
+					return mocks
+				},
+				config: quirkConfig,
+			},
+			args: args{
+				ctx:    ctx,
+				data:   make([]byte, 6),
+				infNum: 1,
+			},
+			byteRead: 4,
+			err:      nil,
+		},
 		{
 			name: "Success_ZeroLengthData",
 			fields: fields{
@@ -1072,6 +1149,162 @@ func TestDevice_ReadInput(t *testing.T) {
 	}
 }
 
+func TestDevice_ReadInput_QuirkPadding(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	m := createSetupTargetMocks(ctrl, 1, 1, 0, 1, 1)
+	m.reader.EXPECT().ReadContext(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, data []byte) (int, error) {
+		return copy(data, []byte{0x01, 0x02}), nil
+	})
+
+	hidDevice, err := hid.NewDevice(m.device, quirkConfig, slog.Default())
+	assert.NoError(t, err)
+	assert.NoError(t, hidDevice.SetTarget(1, 1, 0))
+
+	data := []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}
+	n, err := hidDevice.ReadInput(ctx, data)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x01, 0x02, 0x00, 0x00}, data[:n])
+	assert.Equal(t, uint64(4), hidDevice.(hid.StatsProvider).Stats().BytesRead)
+}
+
+func TestDevice_ReadInput_StreamReopened(t *testing.T) {
+	ctx := context.Background()
+	// reopen expects the failed stream to be closed and a new stream to be created
+	reopen := func(m mocks, next usb.StreamReader, err error) {
+		gomock.InOrder(
+			m.reader.EXPECT().Close().Return(nil),
+			m.epIn.EXPECT().NewStream(hid.DEFAULT_ENDPOINT_STREAM_COUNT).Return(next, err),
+		)
+	}
+	tests := []struct {
+		name    string
+		failure error
+		mocks   func(ctrl *gomock.Controller, m mocks)
+		data    []byte
+		err     error
+	}{
+		{
+			name:    "Success_Overflow",
+			failure: gousb.TransferOverflow,
+			mocks: func(ctrl *gomock.Controller, m mocks) {
+				next := usb.NewMockStreamReader(ctrl)
+				reopen(m, next, nil)
+				next.EXPECT().ReadContext(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, data []byte) (int, error) {
+					return copy(data, []byte{0x01, 0x02}), nil
+				})
+			},
+			data: []byte{0x01, 0x02},
+		},
+		{
+			name:    "Success_Canceled",
+			failure: context.Canceled,
+			mocks: func(ctrl *gomock.Controller, m mocks) {
+				next := usb.NewMockStreamReader(ctrl)
+				reopen(m, next, nil)
+				next.EXPECT().ReadContext(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, data []byte) (int, error) {
+					return copy(data, []byte{0x01, 0x02}), nil
+				})
+			},
+			data: []byte{0x01, 0x02},
+		},
+		{
+			name:    "Error_ReopenFailed",
+			failure: gousb.TransferTimedOut,
+			mocks: func(ctrl *gomock.Controller, m mocks) {
+				reopen(m, nil, gousb.ErrorNoMem)
+			},
+			err: gousb.ErrorNoMem,
+		},
+		{
+			name:    "Error_DisconnectedNotReopened",
+			failure: gousb.TransferNoDevice,
+			mocks:   func(ctrl *gomock.Controller, m mocks) {},
+			err:     io.ErrClosedPipe,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			m := createSetupTargetMocks(ctrl, 1, 1, 0, 1, 1)
+			failed := m.reader.EXPECT().ReadContext(ctx, gomock.Any()).Return(0, test.failure)
+			// Stream of gousb cannot be read once a transfer fails
+			m.reader.EXPECT().ReadContext(ctx, gomock.Any()).Return(0, io.ErrClosedPipe).AnyTimes().After(failed)
+			test.mocks(ctrl, m)
+
+			hidDevice, err := hid.NewDevice(m.device, config, slog.Default())
+			assert.NoError(t, err)
+			assert.NoError(t, hidDevice.SetTarget(1, 1, 0))
+
+			data := make([]byte, 8)
+			_, err = hidDevice.ReadInput(ctx, data)
+			assert.ErrorIs(t, err, test.failure)
+			n, err := hidDevice.ReadInput(ctx, data)
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.data, data[:n])
+			}
+		})
+	}
+}
+
+func TestDevice_ReadInput_Uninitialized(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	hidDevice, err := hid.NewDevice(usb.NewMockDevice(ctrl), config, slog.Default())
+	assert.NoError(t, err)
+
+	_, err = hidDevice.ReadInput(context.Background(), make([]byte, 6))
+	assert.ErrorIs(t, err, hid.ErrUninitializedEndpoint)
+}
+
+func TestDevice_USBError(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	mocks := createSetupTargetMocks(ctrl, 1, 1, 0, 1, 1)
+	mocks.reader.EXPECT().ReadContext(ctx, gomock.Any()).Return(0, gousb.TransferNoDevice)
+	mocks.device.EXPECT().Control(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(0, gousb.ErrorPipe)
+	mocks.writer.EXPECT().WriteContext(ctx, gomock.Any()).Return(0, &usb.Error{
+		Op:        usb.OPERATION_WRITE,
+		Vendor:    0xFF01,
+		Product:   0x0001,
+		Interface: 1,
+		Kind:      usb.ErrTimeout,
+		Err:       gousb.ErrorTimeout,
+	})
+
+	hidDevice, err := hid.NewDevice(mocks.device, config, slog.Default())
+	assert.NoError(t, err)
+	assert.NoError(t, hidDevice.SetTarget(1, 1, 0))
+
+	var usbErr *usb.Error
+	_, err = hidDevice.ReadInput(ctx, make([]byte, 6))
+	assert.ErrorIs(t, err, usb.ErrDisconnected)
+	assert.ErrorIs(t, err, gousb.TransferNoDevice)
+	if assert.ErrorAs(t, err, &usbErr) {
+		assert.Equal(t, usb.OPERATION_READ, usbErr.Op)
+		assert.Equal(t, gousb.ID(0xFF01), usbErr.Vendor)
+		assert.Equal(t, gousb.ID(0x0001), usbErr.Product)
+		assert.Equal(t, 1, usbErr.Interface)
+	}
+
+	_, err = hidDevice.GetFeatureReport([]byte{0x01, 0x00})
+	assert.ErrorIs(t, err, usb.ErrStall)
+	if assert.ErrorAs(t, err, &usbErr) {
+		assert.Equal(t, usb.OPERATION_CONTROL, usbErr.Op)
+		assert.Equal(t, 1, usbErr.Interface)
+	}
+
+	// Errors typed by the USB layer are kept as they are
+	_, err = hidDevice.WriteOutput(ctx, []byte{0x01, 0x02})
+	assert.ErrorIs(t, err, usb.ErrTimeout)
+	if assert.ErrorAs(t, err, &usbErr) {
+		assert.Equal(t, usb.OPERATION_WRITE, usbErr.Op)
+	}
+}
+
 func TestDevice_SendFeatureReport(t *testing.T) {
 	errControl := errors.New("control transfer error")
 	ctx := context.Background()
@@ -1786,6 +2019,61 @@ func TestDevice_GetReportDescriptor(t *testing.T) {
 	}
 }
 
+func TestDevice_GetReportDescriptor_Quirk(t *testing.T) {
+	desc := hidreport.HIDReportDescriptor{0x05, 0x01, 0x09, 0x06, 0xA1, 0x01, 0xC0}
+
+	tests := []struct {
+		name   string
+		infNum int
+		mocks  func(ctrl *gomock.Controller) mocks
+		desc   hidreport.HIDReportDescriptor
+	}{
+		{
+			name:   "Success_Patched",
+			infNum: 1,
+			mocks: func(ctrl *gomock.Controller) mocks {
+				mocks := createSetupTargetMocks(ctrl, 1, 1, 0, 1, 1)
+				mocks.device.EXPECT().
+					Control(uint8(0b1000_0001), uint8(0x06), uint16(0x2200), uint16(1), make([]byte, hid.HID_MAX_REPORT_SIZE)).
+					DoAndReturn(func(bmRequestType, bRequest uint8, wValue, wIndex uint16, data []byte) (int, error) {
+						return copy(data, desc), nil
+					})
+
+				return mocks
+			},
+			// The second patch does not match the original byte, so that it is skipped
+			desc: hidreport.HIDReportDescriptor{0x05, 0x01, 0x09, 0x07, 0xA1, 0x01, 0xC0},
+		},
+		{
+			name:   "Success_Replaced",
+			infNum: 3,
+			mocks: func(ctrl *gomock.Controller) mocks {
+				return createSetupTargetMocks(ctrl, 1, 3, 0, 1, 1)
+			},
+			desc: hidreport.HIDReportDescriptor{0x05, 0x01, 0x09, 0x02},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockUSBs := test.mocks(ctrl)
+
+			hidDevice, err := hid.NewDevice(mockUSBs.device, quirkConfig, slog.Default())
+			assert.NoError(t, err)
+
+			err = hidDevice.SetTarget(1, test.infNum, 0)
+			assert.NoError(t, err)
+
+			desc, err := hidDevice.GetReportDescriptor()
+			assert.NoError(t, err)
+			assert.Equal(t, test.desc, desc)
+		})
+	}
+}
+
 func TestDevice_GetHIDDescriptor(t *testing.T) {
 	errControl := errors.New("control transfer error")
 
@@ -2012,4 +2300,5 @@ func TestDevice_GetDeviceInfo(t *testing.T) {
 	assert.Equal(t, 1, info.GetConfigNumber())
 	assert.Equal(t, 1, info.GetInterfaceNumber())
 	assert.Equal(t, 0, info.GetAltSettingNumber())
+	assert.Equal(t, hid.ValidationOptions{MaxInputPacketSize: 64, MaxOutputPacketSize: 64}, info.GetValidationOptions())
 }
@@ -276,3 +276,40 @@ func (mr *MockDeviceMockRecorder) WriteOutput(ctx, data any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteOutput", reflect.TypeOf((*MockDevice)(nil).WriteOutput), ctx, data)
 }
+
+// MockStatsProvider is a mock of StatsProvider interface.
+type MockStatsProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockStatsProviderMockRecorder
+}
+
+// MockStatsProviderMockRecorder is the mock recorder for MockStatsProvider.
+type MockStatsProviderMockRecorder struct {
+	mock *MockStatsProvider
+}
+
+// NewMockStatsProvider creates a new mock instance.
+func NewMockStatsProvider(ctrl *gomock.Controller) *MockStatsProvider {
+	mock := &MockStatsProvider{ctrl: ctrl}
+	mock.recorder = &MockStatsProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStatsProvider) EXPECT() *MockStatsProviderMockRecorder {
+	return m.recorder
+}
+
+// Stats mocks base method.
+func (m *MockStatsProvider) Stats() Stats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stats")
+	ret0, _ := ret[0].(Stats)
+	return ret0
+}
+
+// Stats indicates an expected call of Stats.
+func (mr *MockStatsProviderMockRecorder) Stats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockStatsProvider)(nil).Stats))
+}
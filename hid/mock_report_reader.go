@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./hid/report_reader.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./hid/report_reader.go -destination=./hid/mock_report_reader.go -package=hid
+//
+
+// Package hid is a generated GoMock package.
+package hid
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReportReader is a mock of ReportReader interface.
+type MockReportReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportReaderMockRecorder
+}
+
+// MockReportReaderMockRecorder is the mock recorder for MockReportReader.
+type MockReportReaderMockRecorder struct {
+	mock *MockReportReader
+}
+
+// NewMockReportReader creates a new mock instance.
+func NewMockReportReader(ctrl *gomock.Controller) *MockReportReader {
+	mock := &MockReportReader{ctrl: ctrl}
+	mock.recorder = &MockReportReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportReader) EXPECT() *MockReportReaderMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockReportReader) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockReportReaderMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockReportReader)(nil).Close))
+}
+
+// Read mocks base method.
+func (m *MockReportReader) Read(ctx context.Context) (*Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", ctx)
+	ret0, _ := ret[0].(*Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockReportReaderMockRecorder) Read(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockReportReader)(nil).Read), ctx)
+}
@@ -0,0 +1,114 @@
+package hid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/gousb"
+)
+
+// readInput reads an Input report from interrupt IN endpoint. A report larger than max packet size of the endpoint
+// is reassembled from its packets, and ends with a short packet or when its length known from quirk
+// or report descriptor is reached. The first packet is returned alone if the length is unknown.
+func (d *deviceImpl) readInput(ctx context.Context, data []byte) (int, error) {
+	n, err := d.reader.ReadContext(ctx, data)
+	if err != nil || d.inPacketSize == 0 || n != d.inPacketSize || n == len(data) {
+		return n, err
+	}
+
+	length := d.inputReportLength(data[:n])
+	if length == 0 {
+		return n, nil
+	}
+	total := n
+	for total < min(len(data), length) {
+		n, err = d.reader.ReadContext(ctx, data[total:])
+		total += n
+		if err != nil {
+			return total, err
+		}
+		if n < d.inPacketSize {
+			break
+		}
+	}
+
+	return total, nil
+}
+
+// reopenReader replaces the failed stream of interrupt IN endpoint by a new one.
+// It is called by ReadInput, which is not called concurrently, so the reader is not guarded.
+func (d *deviceImpl) reopenReader() error {
+	// Closing a failed stream returns error of its failed transfer, which is already handled
+	if err := d.reader.Close(); err != nil {
+		d.logger.Debug("close failed stream of interrupt IN endpoint", "err", err)
+	}
+	reader, err := d.epIn.NewStream(d.dConfig.StreamLaneCount)
+	if err != nil {
+		return fmt.Errorf("unable to reopen stream reader: %w", err)
+	}
+	d.reader = reader
+
+	return nil
+}
+
+// inputReportLength returns length of an Input report from its first packet, or zero if it is unknown.
+// Report descriptor is fetched when the first report spanning multiple packets is read.
+func (d *deviceImpl) inputReportLength(data []byte) int {
+	if d.quirk.InputReportLength > 0 {
+		return d.quirk.InputReportLength
+	}
+
+	d.inputSchemaMu.Lock()
+	defer d.inputSchemaMu.Unlock()
+	if !d.inputSchemaLoaded {
+		d.inputSchemaLoaded = true
+		schema, err := d.loadSchema()
+		if err != nil {
+			d.logger.Warn("unable to get length of Input reports, which end with short packets", "err", err)
+		}
+		d.inputSchema = schema
+	}
+	if d.inputSchema == nil {
+		return 0
+	}
+	length, err := d.inputSchema.InputReportLength(data)
+	if err != nil {
+		return 0
+	}
+
+	return length
+}
+
+// loadSchema gets and parses report descriptor of the target interface
+func (d *deviceImpl) loadSchema() (*ReportSchema, error) {
+	desc, err := d.GetReportDescriptor()
+	if err != nil {
+		return nil, err
+	}
+	schema, err := ParseReportDescriptor(desc)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse report descriptor: %w", err)
+	}
+
+	return schema, nil
+}
+
+// writeZeroLengthPacket ends an Output report with a zero-length packet, if the device needs it
+// and the report fills its last packet
+func (d *deviceImpl) writeZeroLengthPacket(ctx context.Context, length int) error {
+	if !d.quirk.OutputZeroLengthPacket || d.outPacketSize == 0 || length%d.outPacketSize != 0 {
+		return nil
+	}
+	_, err := d.writer.WriteContext(ctx, nil)
+	if err != nil {
+		d.failWriter(err)
+	}
+
+	return err
+}
+
+// isOverflow reports whether a read failed as the report is larger than its buffer
+func isOverflow(err error) bool {
+	return errors.Is(err, gousb.TransferOverflow) || errors.Is(err, gousb.ErrorOverflow)
+}
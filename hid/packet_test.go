@@ -0,0 +1,222 @@
+package hid_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/ntchjb/gohid/hid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// Vendor-defined device with 127-byte Input report 1, which is 128 bytes with report ID
+var largeReportDescriptor = []byte{
+	0x06, 0x00, 0xFF, 0x09, 0x01, 0xA1, 0x01,
+	0x85, 0x01, 0x75, 0x08, 0x96, 0x7F, 0x00, 0x09, 0x01, 0x81, 0x02,
+	0xC0,
+}
+
+func TestDevice_ReadInput_MultiPacket(t *testing.T) {
+	errInterrupt := errors.New("interrupt transfer error")
+	ctx := context.Background()
+	packet := bytes.Repeat([]byte{0x01}, 64)
+
+	// packets expects reads of interrupt IN stream, one for each packet
+	packets := func(m mocks, packets ...[]byte) {
+		calls := make([]any, 0, len(packets))
+		for _, packet := range packets {
+			calls = append(calls, m.reader.EXPECT().ReadContext(ctx, gomock.Any()).DoAndReturn(
+				func(ctx context.Context, data []byte) (int, error) {
+					return copy(data, packet), nil
+				},
+			))
+		}
+		gomock.InOrder(calls...)
+	}
+	getReportDescriptor := func(m mocks) *gomock.Call {
+		return m.device.EXPECT().Control(uint8(0b1000_0001), uint8(0x06), uint16(0x2200), uint16(0x0001), gomock.Any())
+	}
+	largeReportQuirk := hid.DeviceConfig{
+		StreamLaneCount: hid.DEFAULT_ENDPOINT_STREAM_COUNT,
+		Quirks:          hid.Quirks{{VendorID: 0xFF01, ProductID: 0x0001, InputReportLength: 128}},
+	}
+
+	tests := []struct {
+		name     string
+		mocks    func(m mocks)
+		config   hid.DeviceConfig
+		size     int
+		byteRead int
+		err      error
+	}{
+		{
+			name: "Success_ShortPacket",
+			mocks: func(m mocks) {
+				packets(m, packet, packet[:36])
+				getReportDescriptor(m).DoAndReturn(func(bmRequestType, bRequest uint8, wValue, wIndex uint16, data []byte) (int, error) {
+					return copy(data, largeReportDescriptor), nil
+				})
+			},
+			config:   config,
+			size:     256,
+			byteRead: 100,
+		},
+		{
+			name: "Success_UnknownLength",
+			mocks: func(m mocks) {
+				packets(m, packet)
+				getReportDescriptor(m).Return(0, errInterrupt)
+			},
+			config:   config,
+			size:     256,
+			byteRead: 64,
+		},
+		{
+			name: "Success_ReportDescriptorLength",
+			mocks: func(m mocks) {
+				packets(m, packet, packet)
+				getReportDescriptor(m).DoAndReturn(func(bmRequestType, bRequest uint8, wValue, wIndex uint16, data []byte) (int, error) {
+					return copy(data, largeReportDescriptor), nil
+				})
+			},
+			config:   config,
+			size:     256,
+			byteRead: 128,
+		},
+		{
+			name: "Success_QuirkInputReportLength",
+			mocks: func(m mocks) {
+				packets(m, packet, packet)
+			},
+			config:   largeReportQuirk,
+			size:     256,
+			byteRead: 128,
+		},
+		{
+			name: "Success_BufferFull",
+			mocks: func(m mocks) {
+				packets(m, packet, packet[:32])
+			},
+			config:   largeReportQuirk,
+			size:     96,
+			byteRead: 96,
+		},
+		{
+			name: "Success_WholeReport",
+			mocks: func(m mocks) {
+				packets(m, bytes.Repeat([]byte{0x01}, 100))
+			},
+			config:   config,
+			size:     256,
+			byteRead: 100,
+		},
+		{
+			name: "Success_SinglePacketBuffer",
+			mocks: func(m mocks) {
+				packets(m, packet)
+			},
+			config:   config,
+			size:     64,
+			byteRead: 64,
+		},
+		{
+			name: "Error_ReadContext",
+			mocks: func(m mocks) {
+				gomock.InOrder(
+					m.reader.EXPECT().ReadContext(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, data []byte) (int, error) {
+						return copy(data, packet), nil
+					}),
+					m.reader.EXPECT().ReadContext(ctx, gomock.Any()).Return(0, errInterrupt),
+				)
+			},
+			config:   largeReportQuirk,
+			size:     256,
+			byteRead: 64,
+			err:      errInterrupt,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			m := createSetupTargetMocks(ctrl, 1, 1, 0, 1, 1)
+			test.mocks(m)
+
+			hidDevice, err := hid.NewDevice(m.device, test.config, slog.Default())
+			require.NoError(t, err)
+			require.NoError(t, hidDevice.SetTarget(1, 1, 0))
+
+			byteRead, err := hidDevice.ReadInput(ctx, make([]byte, test.size))
+			assert.ErrorIs(t, err, test.err)
+			assert.Equal(t, test.byteRead, byteRead)
+		})
+	}
+}
+
+func TestDevice_ReadInput_ReportDescriptorLoadedOnce(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	m := createSetupTargetMocks(ctrl, 1, 1, 0, 1, 1)
+	m.reader.EXPECT().ReadContext(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, data []byte) (int, error) {
+		return copy(data, bytes.Repeat([]byte{0x01}, 64)), nil
+	}).Times(4)
+	m.device.EXPECT().Control(uint8(0b1000_0001), uint8(0x06), uint16(0x2200), uint16(0x0001), gomock.Any()).DoAndReturn(
+		func(bmRequestType, bRequest uint8, wValue, wIndex uint16, data []byte) (int, error) {
+			return copy(data, largeReportDescriptor), nil
+		},
+	)
+
+	hidDevice, err := hid.NewDevice(m.device, config, slog.Default())
+	require.NoError(t, err)
+	require.NoError(t, hidDevice.SetTarget(1, 1, 0))
+
+	for range 2 {
+		n, err := hidDevice.ReadInput(ctx, make([]byte, 256))
+		assert.NoError(t, err)
+		assert.Equal(t, 128, n)
+	}
+}
+
+func TestDevice_WriteOutput_ZeroLengthPacket(t *testing.T) {
+	ctx := context.Background()
+	zlpConfig := hid.DeviceConfig{
+		StreamLaneCount: hid.DEFAULT_ENDPOINT_STREAM_COUNT,
+		Quirks:          hid.Quirks{{VendorID: 0xFF01, ProductID: 0x0001, OutputZeroLengthPacket: true}},
+	}
+
+	tests := []struct {
+		name   string
+		config hid.DeviceConfig
+		data   []byte
+		zlp    bool
+	}{
+		{name: "MultipleOfPacketSize", config: zlpConfig, data: bytes.Repeat([]byte{0x01}, 128), zlp: true},
+		{name: "ShortLastPacket", config: zlpConfig, data: bytes.Repeat([]byte{0x01}, 100)},
+		{name: "WithoutQuirk", config: config, data: bytes.Repeat([]byte{0x01}, 128)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			m := createSetupTargetMocks(ctrl, 1, 1, 0, 1, 1)
+			write := m.writer.EXPECT().WriteContext(ctx, test.data).Return(len(test.data), nil)
+			if test.zlp {
+				m.writer.EXPECT().WriteContext(ctx, gomock.Len(0)).Return(0, nil).After(write)
+			}
+
+			hidDevice, err := hid.NewDevice(m.device, test.config, slog.Default())
+			require.NoError(t, err)
+			require.NoError(t, hidDevice.SetTarget(1, 1, 0))
+
+			n, err := hidDevice.WriteOutput(ctx, test.data)
+			assert.NoError(t, err)
+			assert.Equal(t, len(test.data), n)
+		})
+	}
+}
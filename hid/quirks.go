@@ -0,0 +1,157 @@
+package hid
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	hidreport "github.com/ntchjb/usbip-virtual-device/usb/protocol/hid/report"
+)
+
+var (
+	ErrQuirkPatchOutOfRange = errors.New("quirk patch is out of range of report descriptor")
+	ErrQuirkPatchMismatch   = errors.New("quirk patch does not match original report descriptor")
+)
+
+// HexBytes is a byte slice written as hex string in config files, e.g. "05 01 09 02".
+// Spaces and colons between bytes are ignored.
+type HexBytes []byte
+
+func (h HexBytes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(strings.ToUpper(hex.EncodeToString(h)))
+}
+
+func (h *HexBytes) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	str = strings.NewReplacer(" ", "", ":", "", "\n", "", "\t", "").Replace(str)
+	decoded, err := hex.DecodeString(str)
+	if err != nil {
+		return fmt.Errorf("unable to decode hex bytes: %w", err)
+	}
+	*h = decoded
+
+	return nil
+}
+
+func (h HexBytes) MarshalYAML() (any, error) {
+	return strings.ToUpper(hex.EncodeToString(h)), nil
+}
+
+func (h *HexBytes) UnmarshalYAML(unmarshal func(any) error) error {
+	var str string
+	if err := unmarshal(&str); err != nil {
+		return err
+	}
+	data, err := json.Marshal(str)
+	if err != nil {
+		return err
+	}
+
+	return h.UnmarshalJSON(data)
+}
+
+// QuirkPatch replaces bytes of report descriptor at an offset
+type QuirkPatch struct {
+	Offset int `json:"offset"`
+	// Bytes expected at the offset before patching. The patch is skipped if they do not match,
+	// e.g. when a new firmware has fixed its report descriptor. It is not checked if empty.
+	Original HexBytes `json:"original,omitempty"`
+	Data     HexBytes `json:"data"`
+}
+
+// Apply returns a copy of report descriptor with this patch applied
+func (p QuirkPatch) Apply(desc hidreport.HIDReportDescriptor) (hidreport.HIDReportDescriptor, error) {
+	if p.Offset < 0 || p.Offset+len(p.Data) > len(desc) || p.Offset+len(p.Original) > len(desc) {
+		return desc, fmt.Errorf("patch at offset %d with %d bytes: %w", p.Offset, len(p.Data), ErrQuirkPatchOutOfRange)
+	}
+	if len(p.Original) > 0 && !bytes.Equal(desc[p.Offset:p.Offset+len(p.Original)], p.Original) {
+		return desc, fmt.Errorf("patch at offset %d: %w", p.Offset, ErrQuirkPatchMismatch)
+	}
+	patched := append(hidreport.HIDReportDescriptor{}, desc...)
+	copy(patched[p.Offset:], p.Data)
+
+	return patched, nil
+}
+
+// Quirk overrides behaviors of a device whose report descriptor or reports do not work as expected
+type Quirk struct {
+	VendorID  uint16 `json:"vendorId"`
+	ProductID uint16 `json:"productId"`
+	// Interface number this quirk applies to, or nil for all interfaces of the device
+	Interface *int `json:"interface,omitempty"`
+
+	// Report descriptor replacing the one of the device. The device is not asked for its report descriptor if set.
+	ReportDescriptor HexBytes `json:"reportDescriptor,omitempty"`
+	// Patches applied to report descriptor of the device, in order
+	ReportDescriptorPatches []QuirkPatch `json:"reportDescriptorPatches,omitempty"`
+	// Output reports shorter than this length are padded with zeros before being sent to the device
+	OutputReportLength int `json:"outputReportLength,omitempty"`
+	// Input reports are truncated to this length, e.g. for devices sending full packets for short reports,
+	// and shorter reports are padded with zeros up to this length
+	InputReportLength int `json:"inputReportLength,omitempty"`
+	// Device expects no report ID on interrupt OUT endpoint, even though its reports have IDs
+	NoOutputReportID bool `json:"noOutputReportId,omitempty"`
+	// Device detects end of an Output report by a short packet, so a zero-length packet is sent after
+	// Output reports whose length is a multiple of max packet size of interrupt OUT endpoint
+	OutputZeroLengthPacket bool `json:"outputZeroLengthPacket,omitempty"`
+}
+
+// Quirks is a registry of quirks keyed by vendor ID, product ID and interface number
+type Quirks []Quirk
+
+// Find returns the quirk of a device interface. Quirks of the interface take precedence over quirks of the whole device.
+func (q Quirks) Find(vendorID, productID uint16, interfaceNumber int) (Quirk, bool) {
+	var found *Quirk
+	for i, quirk := range q {
+		if quirk.VendorID != vendorID || quirk.ProductID != productID {
+			continue
+		}
+		if quirk.Interface != nil {
+			if *quirk.Interface == interfaceNumber {
+				return q[i], true
+			}
+			continue
+		}
+		if found == nil {
+			found = &q[i]
+		}
+	}
+	if found == nil {
+		return Quirk{}, false
+	}
+
+	return *found, true
+}
+
+// LoadQuirks reads quirks from a JSON config, which is a list of quirks, e.g.
+//
+//	[{"vendorId": 1234, "productId": 5678, "interface": 0, "noOutputReportId": true}]
+func LoadQuirks(r io.Reader) (Quirks, error) {
+	var quirks Quirks
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&quirks); err != nil {
+		return nil, fmt.Errorf("unable to decode quirks: %w", err)
+	}
+
+	return quirks, nil
+}
+
+// LoadQuirksFile reads quirks from a JSON config file
+func LoadQuirksFile(path string) (Quirks, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open quirks file: %w", err)
+	}
+	defer file.Close()
+
+	return LoadQuirks(file)
+}
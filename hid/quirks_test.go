@@ -0,0 +1,131 @@
+package hid_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/ntchjb/gohid/hid"
+	"github.com/stretchr/testify/assert"
+
+	hidreport "github.com/ntchjb/usbip-virtual-device/usb/protocol/hid/report"
+)
+
+func TestLoadQuirks(t *testing.T) {
+	quirks, err := hid.LoadQuirks(strings.NewReader(`[
+		{
+			"vendorId": 4660,
+			"productId": 22136,
+			"interface": 2,
+			"reportDescriptorPatches": [{"offset": 3, "original": "06", "data": "07"}],
+			"outputReportLength": 64,
+			"noOutputReportId": true,
+			"outputZeroLengthPacket": true
+		},
+		{
+			"vendorId": 4660,
+			"productId": 22136,
+			"reportDescriptor": "05 01 09 02 A1 01 C0"
+		}
+	]`))
+	assert.NoError(t, err)
+	assert.Len(t, quirks, 2)
+
+	quirk, ok := quirks.Find(0x1234, 0x5678, 2)
+	assert.True(t, ok)
+	assert.True(t, quirk.NoOutputReportID)
+	assert.True(t, quirk.OutputZeroLengthPacket)
+	assert.Equal(t, 64, quirk.OutputReportLength)
+	assert.Equal(t, []hid.QuirkPatch{{Offset: 3, Original: hid.HexBytes{0x06}, Data: hid.HexBytes{0x07}}}, quirk.ReportDescriptorPatches)
+
+	quirk, ok = quirks.Find(0x1234, 0x5678, 0)
+	assert.True(t, ok)
+	assert.Equal(t, hid.HexBytes{0x05, 0x01, 0x09, 0x02, 0xA1, 0x01, 0xC0}, quirk.ReportDescriptor)
+
+	_, ok = quirks.Find(0x1234, 0x0001, 0)
+	assert.False(t, ok)
+}
+
+func TestLoadQuirks_Error(t *testing.T) {
+	tests := []struct {
+		name   string
+		config string
+	}{
+		{
+			name:   "InvalidHex",
+			config: `[{"vendorId": 1, "productId": 1, "reportDescriptor": "0G"}]`,
+		},
+		{
+			name:   "UnknownField",
+			config: `[{"vendorId": 1, "productId": 1, "noReportId": true}]`,
+		},
+		{
+			name:   "NotList",
+			config: `{"vendorId": 1}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := hid.LoadQuirks(strings.NewReader(test.config))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestQuirkPatch_Apply(t *testing.T) {
+	desc := hidreport.HIDReportDescriptor{0x05, 0x01, 0x09, 0x06}
+
+	tests := []struct {
+		name   string
+		patch  hid.QuirkPatch
+		result hidreport.HIDReportDescriptor
+		err    error
+	}{
+		{
+			name:   "Success",
+			patch:  hid.QuirkPatch{Offset: 2, Original: hid.HexBytes{0x09, 0x06}, Data: hid.HexBytes{0x09, 0x02}},
+			result: hidreport.HIDReportDescriptor{0x05, 0x01, 0x09, 0x02},
+		},
+		{
+			name:   "Success_WithoutOriginal",
+			patch:  hid.QuirkPatch{Offset: 3, Data: hid.HexBytes{0x04}},
+			result: hidreport.HIDReportDescriptor{0x05, 0x01, 0x09, 0x04},
+		},
+		{
+			name:   "Error_Mismatch",
+			patch:  hid.QuirkPatch{Offset: 3, Original: hid.HexBytes{0x05}, Data: hid.HexBytes{0x04}},
+			result: desc,
+			err:    hid.ErrQuirkPatchMismatch,
+		},
+		{
+			name:   "Error_OutOfRange",
+			patch:  hid.QuirkPatch{Offset: 3, Data: hid.HexBytes{0x04, 0x05}},
+			result: desc,
+			err:    hid.ErrQuirkPatchOutOfRange,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := test.patch.Apply(desc)
+			assert.ErrorIs(t, err, test.err)
+			assert.Equal(t, test.result, result)
+		})
+	}
+	assert.Equal(t, hidreport.HIDReportDescriptor{0x05, 0x01, 0x09, 0x06}, desc)
+}
+
+func TestHexBytes_MarshalJSON(t *testing.T) {
+	data, err := json.Marshal(hid.HexBytes{0x05, 0xAB})
+	assert.NoError(t, err)
+	assert.Equal(t, `"05AB"`, string(data))
+
+	var decoded hid.HexBytes
+	assert.NoError(t, json.Unmarshal([]byte(`"05:ab"`), &decoded))
+	assert.Equal(t, hid.HexBytes{0x05, 0xAB}, decoded)
+}
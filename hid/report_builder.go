@@ -0,0 +1,192 @@
+package hid
+
+import (
+	"errors"
+	"fmt"
+
+	hidreport "github.com/ntchjb/usbip-virtual-device/usb/protocol/hid/report"
+)
+
+var (
+	ErrReportItemOutOfRange = errors.New("report item value out of range")
+)
+
+// ReportDescriptorBuilder builds a report descriptor item by item, e.g.
+//
+//	desc, err := NewReportDescriptorBuilder().
+//		UsagePage(0x01).Usage(0x02).Collection(hidreport.HID_REPORT_COLLECTION_APPLICATION).
+//		LogicalMinimum(0).LogicalMaximum(1).ReportSize(1).ReportCount(3).
+//		Input(REPORT_FLAG_DATA | REPORT_FLAG_VARIABLE | REPORT_FLAG_ABSOLUTE).
+//		EndCollection().
+//		Build()
+//
+// Item data is encoded in the shortest of 1, 2 or 4 bytes that keeps its value. Zero still takes 1 byte,
+// as some hosts do not accept items without data. The first error found is returned by Build.
+type ReportDescriptorBuilder struct {
+	desc hidreport.HIDReportDescriptor
+	// Number of collections not yet ended
+	depth int
+	// Number of pushed global states not yet popped
+	pushed int
+	err    error
+}
+
+func NewReportDescriptorBuilder() *ReportDescriptorBuilder {
+	return &ReportDescriptorBuilder{}
+}
+
+func (b *ReportDescriptorBuilder) item(tag hidreport.HIDReportTag, data []byte) *ReportDescriptorBuilder {
+	b.desc = append(b.desc, byte(tag)|reportItemSizeBits(len(data)))
+	b.desc = append(b.desc, data...)
+
+	return b
+}
+
+func (b *ReportDescriptorBuilder) value(tag hidreport.HIDReportTag, value int64, signed bool) *ReportDescriptorBuilder {
+	data, err := encodeItemData(value, signed, shortestItemDataSize(value, signed))
+	if err != nil {
+		return b.fail(err)
+	}
+
+	return b.item(tag, data)
+}
+
+func (b *ReportDescriptorBuilder) unsigned(tag hidreport.HIDReportTag, value uint32) *ReportDescriptorBuilder {
+	return b.value(tag, int64(value), false)
+}
+
+func (b *ReportDescriptorBuilder) signed(tag hidreport.HIDReportTag, value int32) *ReportDescriptorBuilder {
+	return b.value(tag, int64(value), true)
+}
+
+func (b *ReportDescriptorBuilder) fail(err error) *ReportDescriptorBuilder {
+	if b.err == nil {
+		b.err = fmt.Errorf("item at offset %d: %w", len(b.desc), err)
+	}
+
+	return b
+}
+
+// Main items
+
+func (b *ReportDescriptorBuilder) Input(flags uint32) *ReportDescriptorBuilder {
+	return b.unsigned(hidreport.HID_REPORT_TAG_INPUT, flags)
+}
+
+func (b *ReportDescriptorBuilder) Output(flags uint32) *ReportDescriptorBuilder {
+	return b.unsigned(hidreport.HID_REPORT_TAG_OUTPUT, flags)
+}
+
+func (b *ReportDescriptorBuilder) Feature(flags uint32) *ReportDescriptorBuilder {
+	return b.unsigned(hidreport.HID_REPORT_TAG_FEATURE, flags)
+}
+
+func (b *ReportDescriptorBuilder) Collection(collectionType hidreport.HIDReportCollectionData) *ReportDescriptorBuilder {
+	b.depth++
+	return b.unsigned(hidreport.HID_REPORT_TAG_COLLECTION, uint32(collectionType))
+}
+
+func (b *ReportDescriptorBuilder) EndCollection() *ReportDescriptorBuilder {
+	if b.depth == 0 {
+		return b.fail(fmt.Errorf("end collection without collection: %w", ErrUnbalancedCollection))
+	}
+	b.depth--
+	return b.item(hidreport.HID_REPORT_TAG_END_COLLECTION, nil)
+}
+
+// Global items
+
+func (b *ReportDescriptorBuilder) UsagePage(page uint16) *ReportDescriptorBuilder {
+	return b.unsigned(hidreport.HID_REPORT_TAG_USAGE_PAGE, uint32(page))
+}
+
+// LogicalMinimum sets minimum of logical values. Logical extents are signed,
+// so that maximum of 255 is encoded in 2 bytes, for example.
+func (b *ReportDescriptorBuilder) LogicalMinimum(value int32) *ReportDescriptorBuilder {
+	return b.signed(hidreport.HID_REPORT_TAG_LOGICAL_MINIMUM, value)
+}
+
+func (b *ReportDescriptorBuilder) LogicalMaximum(value int32) *ReportDescriptorBuilder {
+	return b.signed(hidreport.HID_REPORT_TAG_LOGICAL_MAXIMUM, value)
+}
+
+func (b *ReportDescriptorBuilder) PhysicalMinimum(value int32) *ReportDescriptorBuilder {
+	return b.signed(hidreport.HID_REPORT_TAG_PHYSICAL_MINIMUM, value)
+}
+
+func (b *ReportDescriptorBuilder) PhysicalMaximum(value int32) *ReportDescriptorBuilder {
+	return b.signed(hidreport.HID_REPORT_TAG_PHYSICAL_MAXIMUM, value)
+}
+
+// UnitExponent sets exponent of unit, from -8 to 7, encoded as a 4-bit nibble
+func (b *ReportDescriptorBuilder) UnitExponent(exponent int8) *ReportDescriptorBuilder {
+	if exponent < -8 || exponent > 7 {
+		return b.fail(fmt.Errorf("unit exponent %d: %w", exponent, ErrReportItemOutOfRange))
+	}
+	return b.item(hidreport.HID_REPORT_TAG_UNIT_EXPONENT, []byte{byte(exponent) & 0x0F})
+}
+
+func (b *ReportDescriptorBuilder) Unit(unit uint32) *ReportDescriptorBuilder {
+	return b.unsigned(hidreport.HID_REPORT_TAG_UNIT, unit)
+}
+
+func (b *ReportDescriptorBuilder) ReportSize(size uint32) *ReportDescriptorBuilder {
+	return b.unsigned(hidreport.HID_REPORT_TAG_REPORT_SIZE, size)
+}
+
+func (b *ReportDescriptorBuilder) ReportCount(count uint32) *ReportDescriptorBuilder {
+	return b.unsigned(hidreport.HID_REPORT_TAG_REOPORT_COUNT, count)
+}
+
+// ReportID sets report ID of the following main items. Report ID 0 is reserved.
+func (b *ReportDescriptorBuilder) ReportID(id uint8) *ReportDescriptorBuilder {
+	if id == 0 {
+		return b.fail(fmt.Errorf("report ID 0: %w", ErrReportItemOutOfRange))
+	}
+	return b.unsigned(hidreport.HID_REPORT_TAG_REPORT_ID, uint32(id))
+}
+
+func (b *ReportDescriptorBuilder) Push() *ReportDescriptorBuilder {
+	b.pushed++
+	return b.item(hidreport.HID_REPORT_TAG_PUSH, nil)
+}
+
+func (b *ReportDescriptorBuilder) Pop() *ReportDescriptorBuilder {
+	if b.pushed == 0 {
+		return b.fail(fmt.Errorf("pop without push: %w", ErrGlobalStackUnderflow))
+	}
+	b.pushed--
+	return b.item(hidreport.HID_REPORT_TAG_POP, nil)
+}
+
+// Local items
+
+// Usage adds a usage ID on the current usage page
+func (b *ReportDescriptorBuilder) Usage(id uint16) *ReportDescriptorBuilder {
+	return b.unsigned(hidreport.HID_REPORT_TAG_USAGE, uint32(id))
+}
+
+// ExtendedUsage adds a usage with its own usage page, encoded in 4 bytes
+func (b *ReportDescriptorBuilder) ExtendedUsage(usage Usage) *ReportDescriptorBuilder {
+	return b.item(hidreport.HID_REPORT_TAG_USAGE, []byte{byte(usage), byte(usage >> 8), byte(usage >> 16), byte(usage >> 24)})
+}
+
+func (b *ReportDescriptorBuilder) UsageMinimum(id uint16) *ReportDescriptorBuilder {
+	return b.unsigned(hidreport.HID_REPORT_TAG_USAGE_MINIMUM, uint32(id))
+}
+
+func (b *ReportDescriptorBuilder) UsageMaximum(id uint16) *ReportDescriptorBuilder {
+	return b.unsigned(hidreport.HID_REPORT_TAG_USAGE_MAXIMUM, uint32(id))
+}
+
+// Build returns the report descriptor, or the first error found while building it
+func (b *ReportDescriptorBuilder) Build() (hidreport.HIDReportDescriptor, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	if b.depth != 0 {
+		return nil, fmt.Errorf("%d collections are not ended: %w", b.depth, ErrUnbalancedCollection)
+	}
+
+	return append(hidreport.HIDReportDescriptor{}, b.desc...), nil
+}
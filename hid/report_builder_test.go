@@ -0,0 +1,169 @@
+package hid_test
+
+import (
+	"testing"
+
+	"github.com/ntchjb/gohid/hid"
+	"github.com/stretchr/testify/assert"
+
+	hidreport "github.com/ntchjb/usbip-virtual-device/usb/protocol/hid/report"
+)
+
+func TestReportDescriptorBuilder_Build(t *testing.T) {
+	desc, err := hid.NewReportDescriptorBuilder().
+		UsagePage(0x01).Usage(0x02).Collection(hidreport.HID_REPORT_COLLECTION_APPLICATION).
+		ReportID(1).
+		Usage(0x01).Collection(hidreport.HID_REPORT_COLLECTION_PHYSICAL).
+		UsagePage(0x09).UsageMinimum(1).UsageMaximum(3).
+		LogicalMinimum(0).LogicalMaximum(1).ReportCount(3).ReportSize(1).
+		Input(hid.REPORT_FLAG_DATA | hid.REPORT_FLAG_VARIABLE | hid.REPORT_FLAG_ABSOLUTE).
+		ReportCount(1).ReportSize(5).
+		Input(hid.REPORT_FLAG_CONSTANT | hid.REPORT_FLAG_VARIABLE).
+		UsagePage(0x01).Usage(0x30).Usage(0x31).Usage(0x38).
+		LogicalMinimum(-127).LogicalMaximum(127).ReportSize(8).ReportCount(3).
+		Input(hid.REPORT_FLAG_DATA | hid.REPORT_FLAG_VARIABLE | hid.REPORT_FLAG_RELATIVE).
+		EndCollection().
+		ReportID(2).
+		UsagePage(0xFF00).Usage(0x01).
+		LogicalMinimum(0).LogicalMaximum(255).ReportSize(8).ReportCount(4).
+		Feature(hid.REPORT_FLAG_DATA | hid.REPORT_FLAG_VARIABLE).
+		EndCollection().
+		Build()
+
+	assert.NoError(t, err)
+	assert.Equal(t, mouseReportDescriptor, desc)
+}
+
+func TestReportDescriptorBuilder_Encoding(t *testing.T) {
+	tests := []struct {
+		name  string
+		build func(b *hid.ReportDescriptorBuilder) *hid.ReportDescriptorBuilder
+		desc  hidreport.HIDReportDescriptor
+	}{
+		{
+			name:  "SignedNegative",
+			build: func(b *hid.ReportDescriptorBuilder) *hid.ReportDescriptorBuilder { return b.LogicalMinimum(-1) },
+			desc:  hidreport.HIDReportDescriptor{0x15, 0xFF},
+		},
+		{
+			name:  "SignedNeedsTwoBytes",
+			build: func(b *hid.ReportDescriptorBuilder) *hid.ReportDescriptorBuilder { return b.LogicalMaximum(-129) },
+			desc:  hidreport.HIDReportDescriptor{0x26, 0x7F, 0xFF},
+		},
+		{
+			name:  "SignedNeedsFourBytes",
+			build: func(b *hid.ReportDescriptorBuilder) *hid.ReportDescriptorBuilder { return b.PhysicalMaximum(65535) },
+			desc:  hidreport.HIDReportDescriptor{0x47, 0xFF, 0xFF, 0x00, 0x00},
+		},
+		{
+			name:  "UnsignedTwoBytes",
+			build: func(b *hid.ReportDescriptorBuilder) *hid.ReportDescriptorBuilder { return b.ReportCount(256) },
+			desc:  hidreport.HIDReportDescriptor{0x96, 0x00, 0x01},
+		},
+		{
+			name:  "UnsignedFourBytes",
+			build: func(b *hid.ReportDescriptorBuilder) *hid.ReportDescriptorBuilder { return b.Unit(0x0001_0001) },
+			desc:  hidreport.HIDReportDescriptor{0x67, 0x01, 0x00, 0x01, 0x00},
+		},
+		{
+			name:  "UnitExponent",
+			build: func(b *hid.ReportDescriptorBuilder) *hid.ReportDescriptorBuilder { return b.UnitExponent(-2) },
+			desc:  hidreport.HIDReportDescriptor{0x55, 0x0E},
+		},
+		{
+			name: "ExtendedUsage",
+			build: func(b *hid.ReportDescriptorBuilder) *hid.ReportDescriptorBuilder {
+				return b.ExtendedUsage(hid.NewUsage(0x0C, 0xCD))
+			},
+			desc: hidreport.HIDReportDescriptor{0x0B, 0xCD, 0x00, 0x0C, 0x00},
+		},
+		{
+			name: "PushPop",
+			build: func(b *hid.ReportDescriptorBuilder) *hid.ReportDescriptorBuilder {
+				return b.Push().Pop()
+			},
+			desc: hidreport.HIDReportDescriptor{0xA4, 0xB4},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			desc, err := test.build(hid.NewReportDescriptorBuilder()).Build()
+			assert.NoError(t, err)
+			assert.Equal(t, test.desc, desc)
+		})
+	}
+}
+
+func TestReportDescriptorBuilder_RoundTrip(t *testing.T) {
+	desc, err := hid.NewReportDescriptorBuilder().
+		UsagePage(0x20).Usage(0x01).Collection(hidreport.HID_REPORT_COLLECTION_APPLICATION).
+		ExtendedUsage(hid.NewUsage(0x20, 0x0434)).
+		LogicalMinimum(-32768).LogicalMaximum(32767).
+		UnitExponent(-2).Unit(0x0001_0001).
+		ReportSize(16).ReportCount(1).
+		Input(hid.REPORT_FLAG_DATA | hid.REPORT_FLAG_VARIABLE | hid.REPORT_FLAG_NULL_STATE).
+		EndCollection().
+		Build()
+	assert.NoError(t, err)
+
+	schema, err := hid.ParseReportDescriptor(desc)
+	assert.NoError(t, err)
+	fields := schema.Fields()
+	assert.Len(t, fields, 1)
+	assert.Equal(t, hid.NewUsage(0x20, 0x0434), fields[0].Usage(0))
+	assert.Equal(t, int32(-32768), fields[0].LogicalMinimum)
+	assert.Equal(t, int32(32767), fields[0].LogicalMaximum)
+	assert.Equal(t, int8(-2), fields[0].UnitExponent)
+	assert.Equal(t, uint32(0x0001_0001), fields[0].Unit)
+	assert.True(t, fields[0].IsNullState())
+	assert.Equal(t, hid.NewUsage(0x20, 0x01), fields[0].Collection.Usage)
+}
+
+func TestReportDescriptorBuilder_Error(t *testing.T) {
+	tests := []struct {
+		name  string
+		build func(b *hid.ReportDescriptorBuilder) *hid.ReportDescriptorBuilder
+		err   error
+	}{
+		{
+			name:  "EndCollectionWithoutCollection",
+			build: func(b *hid.ReportDescriptorBuilder) *hid.ReportDescriptorBuilder { return b.EndCollection() },
+			err:   hid.ErrUnbalancedCollection,
+		},
+		{
+			name: "CollectionNotEnded",
+			build: func(b *hid.ReportDescriptorBuilder) *hid.ReportDescriptorBuilder {
+				return b.Collection(hidreport.HID_REPORT_COLLECTION_APPLICATION)
+			},
+			err: hid.ErrUnbalancedCollection,
+		},
+		{
+			name:  "PopWithoutPush",
+			build: func(b *hid.ReportDescriptorBuilder) *hid.ReportDescriptorBuilder { return b.Pop() },
+			err:   hid.ErrGlobalStackUnderflow,
+		},
+		{
+			name:  "UnitExponentOutOfRange",
+			build: func(b *hid.ReportDescriptorBuilder) *hid.ReportDescriptorBuilder { return b.UnitExponent(8) },
+			err:   hid.ErrReportItemOutOfRange,
+		},
+		{
+			name:  "ReportIDZero",
+			build: func(b *hid.ReportDescriptorBuilder) *hid.ReportDescriptorBuilder { return b.ReportID(0) },
+			err:   hid.ErrReportItemOutOfRange,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			desc, err := test.build(hid.NewReportDescriptorBuilder()).Build()
+			assert.ErrorIs(t, err, test.err)
+			assert.Nil(t, desc)
+		})
+	}
+}
@@ -0,0 +1,422 @@
+package hid
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ntchjb/gohid/usages"
+	hidreport "github.com/ntchjb/usbip-virtual-device/usb/protocol/hid/report"
+)
+
+var (
+	ErrUnknownReportItem = errors.New("unknown report item")
+)
+
+// Tags whose data is a signed integer
+var signedReportTags = map[hidreport.HIDReportTag]bool{
+	hidreport.HID_REPORT_TAG_LOGICAL_MINIMUM:  true,
+	hidreport.HID_REPORT_TAG_LOGICAL_MAXIMUM:  true,
+	hidreport.HID_REPORT_TAG_PHYSICAL_MINIMUM: true,
+	hidreport.HID_REPORT_TAG_PHYSICAL_MAXIMUM: true,
+	hidreport.HID_REPORT_TAG_UNIT_EXPONENT:    true,
+}
+
+// Tags of items without data
+var emptyReportTags = map[hidreport.HIDReportTag]bool{
+	hidreport.HID_REPORT_TAG_END_COLLECTION: true,
+	hidreport.HID_REPORT_TAG_PUSH:           true,
+	hidreport.HID_REPORT_TAG_POP:            true,
+}
+
+var collectionTypeNames = map[hidreport.HIDReportCollectionData]string{
+	hidreport.HID_REPORT_COLLECTION_PHYSICAL:       "Physical",
+	hidreport.HID_REPORT_COLLECTION_APPLICATION:    "Application",
+	hidreport.HID_REPORT_COLLECTION_LOGICAL:        "Logical",
+	hidreport.HID_REPORT_COLLECTION_REPORT:         "Report",
+	hidreport.HID_REPORT_COLLECTION_NAMED_ARRAY:    "Named Array",
+	hidreport.HID_REPORT_COLLECTION_USAGE_SWITCH:   "Usage Switch",
+	hidreport.HID_REPORT_COLLECTION_USAGE_MODIFIER: "Usage Modifier",
+}
+
+var reportTagsByName = make(map[string]hidreport.HIDReportTag)
+
+func init() {
+	for tag, name := range hidreport.HIDReportTagNames {
+		reportTagsByName[strings.ToLower(name)] = tag
+	}
+}
+
+// shortestItemDataSize returns the smallest number of data bytes, 1, 2 or 4, that keeps the value
+func shortestItemDataSize(value int64, signed bool) int {
+	switch {
+	case signed && value >= -0x80 && value <= 0x7F, !signed && value >= 0 && value <= 0xFF:
+		return 1
+	case signed && value >= -0x8000 && value <= 0x7FFF, !signed && value >= 0 && value <= 0xFFFF:
+		return 2
+	default:
+		return 4
+	}
+}
+
+// encodeItemData encodes value as little-endian data of an item with the given number of bytes
+func encodeItemData(value int64, signed bool, size int) ([]byte, error) {
+	var minValue, maxValue int64
+	switch size {
+	case 0:
+		minValue, maxValue = 0, 0
+	case 1, 2, 4:
+		if signed {
+			minValue, maxValue = -1<<(8*size-1), 1<<(8*size-1)-1
+		} else {
+			minValue, maxValue = 0, 1<<(8*size)-1
+		}
+	default:
+		return nil, fmt.Errorf("data size %d: %w", size, ErrReportItemOutOfRange)
+	}
+	if value < minValue || value > maxValue {
+		return nil, fmt.Errorf("value %d does not fit in %d bytes: %w", value, size, ErrReportItemOutOfRange)
+	}
+	data := make([]byte, size)
+	for i := range data {
+		data[i] = byte(value >> (8 * i))
+	}
+
+	return data, nil
+}
+
+// DescriptorItem is a report descriptor item in a form for reading and editing, e.g. as JSON or YAML.
+// Items inside a collection are children of the collection, and End Collection items are implicit.
+type DescriptorItem struct {
+	// Name of the item, e.g. "Usage Page" or "Input"
+	Item string `json:"item" yaml:"item"`
+	// Data of the item. It is signed for Logical/Physical Minimum/Maximum and Unit Exponent.
+	Value int64 `json:"value,omitempty" yaml:"value,omitempty"`
+	// Number of data bytes. The shortest size keeping the value is used if it is nil.
+	Size *int `json:"size,omitempty" yaml:"size,omitempty"`
+	// Annotation of the value, e.g. usage name. It is ignored when compiling.
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	// Tag and data of a long item
+	LongTag uint8    `json:"longTag,omitempty" yaml:"longTag,omitempty"`
+	Data    HexBytes `json:"data,omitempty" yaml:"data,omitempty"`
+	// Items inside a collection
+	Items []DescriptorItem `json:"items,omitempty" yaml:"items,omitempty"`
+}
+
+type reportFormatState struct {
+	usagePage   uint16
+	reportID    uint8
+	reportSize  uint32
+	reportCount uint32
+	stack       []reportFormatState
+	// Next bit offset of each report, keyed by report type and ID
+	bitOffsets map[[2]uint8]uint32
+}
+
+func formatUsagePage(page uint16) string {
+	name := usages.PageName(page)
+	switch name {
+	case usages.USAGE_VENDOR_NAME:
+		return fmt.Sprintf("Vendor 0x%04X", page)
+	case usages.USAGE_RESERVED_NAME:
+		return fmt.Sprintf("0x%04X", page)
+	}
+
+	return name
+}
+
+func formatUsage(item ReportItem, usagePage uint16) string {
+	page, id := usagePage, uint16(item.Uint())
+	if len(item.Data) == 4 {
+		page = uint16(item.Uint() >> 16)
+	}
+	name, ok := usages.Lookup(page, id)
+	desc := fmt.Sprintf("0x%02X", id)
+	if ok {
+		desc = name.Name
+	}
+	if len(item.Data) == 4 {
+		desc += " (" + formatUsagePage(page) + ")"
+	}
+
+	return desc
+}
+
+func formatMainItemFlags(item ReportItem) string {
+	flags := item.Uint()
+	names := []string{"Data", "Arr", "Abs"}
+	if flags&REPORT_FLAG_CONSTANT != 0 {
+		names[0] = "Const"
+	}
+	if flags&REPORT_FLAG_VARIABLE != 0 {
+		names[1] = "Var"
+	}
+	if flags&REPORT_FLAG_RELATIVE != 0 {
+		names[2] = "Rel"
+	}
+	optional := []struct {
+		flag uint32
+		name string
+	}{
+		{REPORT_FLAG_WRAP, "Wrap"},
+		{REPORT_FLAG_NON_LINEAR, "NonLinear"},
+		{REPORT_FLAG_NO_PREFERRED, "NoPref"},
+		{REPORT_FLAG_NULL_STATE, "Null"},
+		{REPORT_FLAG_VOLATILE, "Vol"},
+		{REPORT_FLAG_BUFFERED_BYTES, "Buf"},
+	}
+	for _, o := range optional {
+		// Bit 7 of Input items is reserved
+		if o.flag == REPORT_FLAG_VOLATILE && item.Tag == hidreport.HID_REPORT_TAG_INPUT {
+			continue
+		}
+		if flags&o.flag != 0 {
+			names = append(names, o.name)
+		}
+	}
+
+	return strings.Join(names, ",")
+}
+
+// describe updates state by an item and returns annotation of its data
+func (s *reportFormatState) describe(item ReportItem) string {
+	switch item.Tag {
+	case hidreport.HID_REPORT_TAG_INPUT, hidreport.HID_REPORT_TAG_OUTPUT, hidreport.HID_REPORT_TAG_FEATURE:
+		key := [2]uint8{uint8(item.Tag), s.reportID}
+		bits := s.reportSize * s.reportCount
+		start := s.bitOffsets[key]
+		s.bitOffsets[key] = start + bits
+		desc := formatMainItemFlags(item)
+		if bits == 0 {
+			return desc
+		}
+		if s.reportID != 0 {
+			desc += fmt.Sprintf("; report %d", s.reportID)
+		} else {
+			desc += ";"
+		}
+		return desc + fmt.Sprintf(" bits %d-%d", start, start+bits-1)
+	case hidreport.HID_REPORT_TAG_COLLECTION:
+		collectionType := hidreport.HIDReportCollectionData(item.Uint())
+		if name, ok := collectionTypeNames[collectionType]; ok {
+			return name
+		}
+		if collectionType >= 0x80 {
+			return fmt.Sprintf("Vendor 0x%02X", uint8(collectionType))
+		}
+		return fmt.Sprintf("0x%02X", uint8(collectionType))
+	case hidreport.HID_REPORT_TAG_USAGE_PAGE:
+		s.usagePage = uint16(item.Uint())
+		return formatUsagePage(s.usagePage)
+	case hidreport.HID_REPORT_TAG_USAGE, hidreport.HID_REPORT_TAG_USAGE_MINIMUM, hidreport.HID_REPORT_TAG_USAGE_MAXIMUM:
+		return formatUsage(item, s.usagePage)
+	case hidreport.HID_REPORT_TAG_LOGICAL_MINIMUM, hidreport.HID_REPORT_TAG_LOGICAL_MAXIMUM,
+		hidreport.HID_REPORT_TAG_PHYSICAL_MINIMUM, hidreport.HID_REPORT_TAG_PHYSICAL_MAXIMUM:
+		return strconv.FormatInt(int64(item.Int()), 10)
+	case hidreport.HID_REPORT_TAG_UNIT_EXPONENT:
+		return strconv.Itoa(int(ParseUnitExponent(item)))
+	case hidreport.HID_REPORT_TAG_UNIT:
+		if item.Uint() == 0 {
+			return "None"
+		}
+		return fmt.Sprintf("0x%X", item.Uint())
+	case hidreport.HID_REPORT_TAG_REPORT_SIZE:
+		s.reportSize = item.Uint()
+	case hidreport.HID_REPORT_TAG_REOPORT_COUNT:
+		s.reportCount = item.Uint()
+	case hidreport.HID_REPORT_TAG_REPORT_ID:
+		s.reportID = uint8(item.Uint())
+	case hidreport.HID_REPORT_TAG_PUSH:
+		s.stack = append(s.stack, *s)
+		return ""
+	case hidreport.HID_REPORT_TAG_POP:
+		if len(s.stack) > 0 {
+			top := s.stack[len(s.stack)-1]
+			s.usagePage, s.reportID, s.reportSize, s.reportCount = top.usagePage, top.reportID, top.reportSize, top.reportCount
+			s.stack = s.stack[:len(s.stack)-1]
+		}
+		return ""
+	case hidreport.HID_REPORT_TAG_DELIMITER:
+		if item.Uint() == 0 {
+			return "Close Set"
+		}
+		return "Open Set"
+	case hidreport.HID_REPORT_TAG_END_COLLECTION:
+		return ""
+	case hidreport.HID_REPORT_TAG_LONG_ITEM:
+		return fmt.Sprintf("tag 0x%02X, %d bytes", item.LongTag, len(item.Data))
+	}
+
+	return strconv.FormatUint(uint64(item.Uint()), 10)
+}
+
+func reportItemName(item ReportItem) string {
+	if name, ok := hidreport.HIDReportTagNames[item.Tag]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("Reserved 0x%02X", uint8(item.Tag))
+}
+
+// FormatReportDescriptor renders a report descriptor as annotated source, with item names, usage names
+// and bit positions of data in each report, e.g.
+//
+//	0x05, 0x01, // Usage Page (Generic Desktop)
+//	0xA1, 0x01, // Collection (Application)
+//	0x81, 0x02, //   Input (Data,Var,Abs; report 1 bits 0-2)
+func FormatReportDescriptor(desc hidreport.HIDReportDescriptor) (string, error) {
+	items, err := ParseReportItems(desc)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse report items: %w", err)
+	}
+
+	state := &reportFormatState{bitOffsets: make(map[[2]uint8]uint32)}
+	type line struct {
+		data string
+		text string
+	}
+	lines := make([]line, 0, len(items))
+	width := 0
+	depth := 0
+	for _, item := range items {
+		if item.Tag == hidreport.HID_REPORT_TAG_END_COLLECTION && depth > 0 {
+			depth--
+		}
+
+		var data strings.Builder
+		for _, b := range desc[item.Offset : item.Offset+item.Size()] {
+			fmt.Fprintf(&data, "0x%02X, ", b)
+		}
+		text := strings.Repeat("  ", depth) + reportItemName(item)
+		if annotation := state.describe(item); annotation != "" {
+			text += " (" + annotation + ")"
+		}
+		lines = append(lines, line{data: data.String(), text: text})
+		width = max(width, data.Len())
+
+		if item.Tag == hidreport.HID_REPORT_TAG_COLLECTION {
+			depth++
+		}
+	}
+
+	var builder strings.Builder
+	for _, l := range lines {
+		fmt.Fprintf(&builder, "%-*s// %s\n", width, l.data, l.text)
+	}
+
+	return builder.String(), nil
+}
+
+// DecompileReportDescriptor converts a report descriptor into items for reading and editing.
+// Sizes of item data are kept, so that CompileReportDescriptor returns the same report descriptor.
+func DecompileReportDescriptor(desc hidreport.HIDReportDescriptor) ([]DescriptorItem, error) {
+	items, err := ParseReportItems(desc)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse report items: %w", err)
+	}
+
+	state := &reportFormatState{bitOffsets: make(map[[2]uint8]uint32)}
+	root := &DescriptorItem{}
+	// Path of collections from root to the current collection
+	path := []*DescriptorItem{root}
+	for _, item := range items {
+		parent := path[len(path)-1]
+		description := state.describe(item)
+		switch {
+		case item.IsLong():
+			parent.Items = append(parent.Items, DescriptorItem{
+				Item:        reportItemName(item),
+				Description: description,
+				LongTag:     item.LongTag,
+				Data:        append(HexBytes{}, item.Data...),
+			})
+			continue
+		case item.Tag == hidreport.HID_REPORT_TAG_END_COLLECTION:
+			if len(path) == 1 {
+				return nil, fmt.Errorf("end collection at offset %d: %w", item.Offset, ErrUnbalancedCollection)
+			}
+			path = path[:len(path)-1]
+			continue
+		}
+		if _, ok := hidreport.HIDReportTagNames[item.Tag]; !ok {
+			return nil, fmt.Errorf("item 0x%02X at offset %d: %w", uint8(item.Tag), item.Offset, ErrUnknownReportItem)
+		}
+
+		descriptorItem := DescriptorItem{
+			Item:        reportItemName(item),
+			Value:       int64(item.Uint()),
+			Description: description,
+		}
+		if signedReportTags[item.Tag] {
+			descriptorItem.Value = int64(item.Int())
+		}
+		if !emptyReportTags[item.Tag] && len(item.Data) != shortestItemDataSize(descriptorItem.Value, signedReportTags[item.Tag]) {
+			size := len(item.Data)
+			descriptorItem.Size = &size
+		}
+		parent.Items = append(parent.Items, descriptorItem)
+		if item.Tag == hidreport.HID_REPORT_TAG_COLLECTION {
+			path = append(path, &parent.Items[len(parent.Items)-1])
+		}
+	}
+	if len(path) != 1 {
+		return nil, fmt.Errorf("%d collections are not ended: %w", len(path)-1, ErrUnbalancedCollection)
+	}
+
+	return root.Items, nil
+}
+
+// CompileReportDescriptor converts items into a report descriptor
+func CompileReportDescriptor(items []DescriptorItem) (hidreport.HIDReportDescriptor, error) {
+	var desc hidreport.HIDReportDescriptor
+	for _, item := range items {
+		tag, ok := reportTagsByName[strings.ToLower(item.Item)]
+		if !ok || tag == hidreport.HID_REPORT_TAG_END_COLLECTION {
+			return nil, fmt.Errorf("item %q: %w", item.Item, ErrUnknownReportItem)
+		}
+		if tag == hidreport.HID_REPORT_TAG_LONG_ITEM {
+			if len(item.Data) > 0xFF {
+				return nil, fmt.Errorf("long item with %d bytes: %w", len(item.Data), ErrReportItemOutOfRange)
+			}
+			desc = append(desc, REPORT_ITEM_LONG_PREFIX, byte(len(item.Data)), item.LongTag)
+			desc = append(desc, item.Data...)
+			continue
+		}
+
+		signed := signedReportTags[tag]
+		size := shortestItemDataSize(item.Value, signed)
+		if emptyReportTags[tag] {
+			size = 0
+		}
+		if item.Size != nil {
+			size = *item.Size
+		}
+		data, err := encodeItemData(item.Value, signed, size)
+		if err != nil {
+			return nil, fmt.Errorf("item %q: %w", item.Item, err)
+		}
+		desc = append(desc, byte(tag)|reportItemSizeBits(size))
+		desc = append(desc, data...)
+
+		if tag == hidreport.HID_REPORT_TAG_COLLECTION {
+			children, err := CompileReportDescriptor(item.Items)
+			if err != nil {
+				return nil, err
+			}
+			desc = append(desc, children...)
+			desc = append(desc, byte(hidreport.HID_REPORT_TAG_END_COLLECTION))
+		}
+	}
+
+	return desc, nil
+}
+
+// reportItemSizeBits returns bSize bits of a short item prefix
+func reportItemSizeBits(size int) byte {
+	if size == 4 {
+		return 0b11
+	}
+
+	return byte(size)
+}
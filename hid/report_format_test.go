@@ -0,0 +1,180 @@
+package hid_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/ntchjb/gohid/hid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	hidreport "github.com/ntchjb/usbip-virtual-device/usb/protocol/hid/report"
+)
+
+// Report descriptor with items not encoded in their shortest form, and a long item
+var unusualReportDescriptor = hidreport.HIDReportDescriptor{
+	0x06, 0x01, 0x00, // Usage Page (Generic Desktop), in 2 bytes
+	0x09, 0x06, // Usage (Keyboard)
+	0xA1, 0x01, // Collection (Application)
+	0x16, 0x00, 0x00, // Logical Minimum (0), in 2 bytes
+	0x25, 0x01, // Logical Maximum (1)
+	0x55, 0x0E, // Unit Exponent (-2)
+	0x75, 0x01, // Report Size (1)
+	0x95, 0x08, // Report Count (8)
+	0x80,                         // Input without data
+	0xFE, 0x02, 0x10, 0xAB, 0xCD, // Long item
+	0xC0, // End Collection
+}
+
+func TestFormatReportDescriptor(t *testing.T) {
+	text, err := hid.FormatReportDescriptor(mouseReportDescriptor)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+	assert.Len(t, lines, 36)
+	assert.Equal(t, "0x05, 0x01,       // Usage Page (Generic Desktop)", lines[0])
+	assert.Equal(t, "0x85, 0x01,       //   Report ID (1)", lines[3])
+	assert.Equal(t, "0x19, 0x01,       //     Usage Minimum (Button 1)", lines[7])
+	assert.Equal(t, "0x81, 0x02,       //     Input (Data,Var,Abs; report 1 bits 0-2)", lines[13])
+	assert.Equal(t, "0x81, 0x03,       //     Input (Const,Var,Abs; report 1 bits 3-7)", lines[16])
+	assert.Equal(t, "0x15, 0x81,       //     Logical Minimum (-127)", lines[21])
+	assert.Equal(t, "0x81, 0x06,       //     Input (Data,Var,Rel; report 1 bits 8-31)", lines[25])
+	assert.Equal(t, "0xC0,             //   End Collection", lines[26])
+	assert.Equal(t, "0x06, 0x00, 0xFF, //   Usage Page (Vendor 0xFF00)", lines[28])
+	assert.Equal(t, "0xB1, 0x02,       //   Feature (Data,Var,Abs; report 2 bits 0-31)", lines[34])
+	assert.Equal(t, "0xC0,             // End Collection", lines[35])
+
+	_, err = hid.FormatReportDescriptor(hidreport.HIDReportDescriptor{0x05})
+	assert.Error(t, err)
+}
+
+func TestDecompileReportDescriptor(t *testing.T) {
+	items, err := hid.DecompileReportDescriptor(unusualReportDescriptor)
+	require.NoError(t, err)
+
+	require.Len(t, items, 3)
+	require.NotNil(t, items[0].Size)
+	assert.Equal(t, 2, *items[0].Size)
+	assert.Equal(t, "Generic Desktop", items[0].Description)
+	assert.Equal(t, "Collection", items[2].Item)
+
+	children := items[2].Items
+	require.Len(t, children, 7)
+	assert.Equal(t, int64(0x0E), children[2].Value)
+	assert.Nil(t, children[2].Size)
+	assert.Equal(t, "Input", children[5].Item)
+	require.NotNil(t, children[5].Size)
+	assert.Equal(t, 0, *children[5].Size)
+	assert.Equal(t, "Long Item", children[6].Item)
+	assert.Equal(t, uint8(0x10), children[6].LongTag)
+	assert.Equal(t, hid.HexBytes{0xAB, 0xCD}, children[6].Data)
+}
+
+func TestDecompileReportDescriptor_Error(t *testing.T) {
+	tests := []struct {
+		name string
+		desc hidreport.HIDReportDescriptor
+		err  error
+	}{
+		{
+			name: "end collection without collection",
+			desc: hidreport.HIDReportDescriptor{0x05, 0x01, 0xC0},
+			err:  hid.ErrUnbalancedCollection,
+		},
+		{
+			name: "collection not ended",
+			desc: hidreport.HIDReportDescriptor{0xA1, 0x01},
+			err:  hid.ErrUnbalancedCollection,
+		},
+		{
+			name: "unknown item",
+			desc: hidreport.HIDReportDescriptor{0xF1, 0x00},
+			err:  hid.ErrUnknownReportItem,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := hid.DecompileReportDescriptor(test.desc)
+			assert.ErrorIs(t, err, test.err)
+		})
+	}
+}
+
+func TestCompileReportDescriptor_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		desc hidreport.HIDReportDescriptor
+	}{
+		{name: "mouse", desc: mouseReportDescriptor},
+		{name: "unusual", desc: unusualReportDescriptor},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			items, err := hid.DecompileReportDescriptor(test.desc)
+			require.NoError(t, err)
+
+			desc, err := hid.CompileReportDescriptor(items)
+			require.NoError(t, err)
+			assert.Equal(t, test.desc, desc)
+
+			data, err := json.Marshal(items)
+			require.NoError(t, err)
+			var fromJSON []hid.DescriptorItem
+			require.NoError(t, json.Unmarshal(data, &fromJSON))
+			desc, err = hid.CompileReportDescriptor(fromJSON)
+			require.NoError(t, err)
+			assert.Equal(t, test.desc, desc)
+
+			data, err = yaml.Marshal(items)
+			require.NoError(t, err)
+			var fromYAML []hid.DescriptorItem
+			require.NoError(t, yaml.Unmarshal(data, &fromYAML))
+			desc, err = hid.CompileReportDescriptor(fromYAML)
+			require.NoError(t, err)
+			assert.Equal(t, test.desc, desc)
+		})
+	}
+}
+
+func TestCompileReportDescriptor_Error(t *testing.T) {
+	one := 1
+	tests := []struct {
+		name  string
+		items []hid.DescriptorItem
+		err   error
+	}{
+		{
+			name:  "unknown item",
+			items: []hid.DescriptorItem{{Item: "Usage Pages", Value: 1}},
+			err:   hid.ErrUnknownReportItem,
+		},
+		{
+			name:  "explicit end collection",
+			items: []hid.DescriptorItem{{Item: "End Collection"}},
+			err:   hid.ErrUnknownReportItem,
+		},
+		{
+			name:  "value does not fit size",
+			items: []hid.DescriptorItem{{Item: "Logical Maximum", Value: 255, Size: &one}},
+			err:   hid.ErrReportItemOutOfRange,
+		},
+		{
+			name: "error in collection",
+			items: []hid.DescriptorItem{{Item: "Collection", Items: []hid.DescriptorItem{
+				{Item: "Usage Pages", Value: 1},
+			}}},
+			err: hid.ErrUnknownReportItem,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := hid.CompileReportDescriptor(test.items)
+			assert.ErrorIs(t, err, test.err)
+		})
+	}
+}
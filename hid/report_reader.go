@@ -0,0 +1,175 @@
+package hid
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrReportReaderClosed = errors.New("report reader is closed")
+)
+
+const (
+	// Number of reports of a report reader, if pool size of its config is zero
+	DEFAULT_REPORT_POOL_SIZE = 32
+)
+
+// ReportReaderConfig configures buffers of a report reader
+type ReportReaderConfig struct {
+	// Number of reports which can be queued or held by callers. Reports read while all of them are in use are dropped,
+	// so that the stream of interrupt IN endpoint keeps being drained.
+	PoolSize int
+	// Size of report buffers, or HID_MAX_REPORT_SIZE if zero
+	ReportSize int
+}
+
+// Report is an Input report read by a report reader into a pooled buffer
+type Report struct {
+	// Report data, which starts with report ID if the device uses report IDs
+	Data []byte
+	// Time when the report was received, which has monotonic clock reading to be compared with Sub
+	Timestamp time.Time
+	// Number of reports received from the device before this one, including dropped reports.
+	// A gap between sequences of consecutive reports is the number of reports dropped between them.
+	Sequence uint64
+	// Reports were dropped since the previous report, as they overflowed report buffer,
+	// or all reports of the pool were in use. Reports lost in the stream reopened after an overflow
+	// are not counted by Sequence.
+	Overflow bool
+
+	buf  []byte
+	pool chan *Report
+}
+
+// Release returns the report to its pool to be reused, after which its data must not be used.
+// It must be called once for each report returned by ReportReader.
+func (r *Report) Release() {
+	r.Data = nil
+	select {
+	case r.pool <- r:
+	default:
+	}
+}
+
+// ReportReader reads Input reports of a device in a goroutine, without allocating a buffer for each report.
+// Each report is stamped with the time it is received and its sequence number, as soon as it is read from the device.
+type ReportReader interface {
+	// Read returns the next Input report, waiting until it is read or ctx is done.
+	// Reports queued before reading stops are returned before the error which stopped it.
+	Read(ctx context.Context) (*Report, error)
+	// Stop reading reports. Reports held by callers stay valid until they are released.
+	Close() error
+}
+
+type reportReaderImpl struct {
+	device  Device
+	free    chan *Report
+	reports chan *Report
+	// Buffer of reports which are dropped as the pool is exhausted
+	scratch []byte
+	cancel  context.CancelFunc
+	// Closed when reading stops, after which err is the error which stopped it
+	done chan struct{}
+	err  error
+}
+
+// NewReportReader starts reading Input reports of a device whose target is set
+func NewReportReader(device Device, config ReportReaderConfig) ReportReader {
+	poolSize := config.PoolSize
+	if poolSize <= 0 {
+		poolSize = DEFAULT_REPORT_POOL_SIZE
+	}
+	reportSize := config.ReportSize
+	if reportSize <= 0 {
+		reportSize = int(HID_MAX_REPORT_SIZE)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &reportReaderImpl{
+		device:  device,
+		free:    make(chan *Report, poolSize),
+		reports: make(chan *Report, poolSize),
+		scratch: make([]byte, reportSize),
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+	for range poolSize {
+		r.free <- &Report{buf: make([]byte, reportSize), pool: r.free}
+	}
+	go r.run(ctx)
+
+	return r
+}
+
+// run reads reports into free buffers until ctx is done or reading fails
+func (r *reportReaderImpl) run(ctx context.Context) {
+	defer close(r.done)
+
+	var sequence uint64
+	var overflow bool
+	for {
+		var report *Report
+		buf := r.scratch
+		select {
+		case report = <-r.free:
+			buf = report.buf
+		default:
+		}
+
+		n, err := r.device.ReadInput(ctx, buf)
+		timestamp := time.Now()
+		if err != nil && !isOverflow(err) {
+			if report != nil {
+				report.Release()
+			}
+			if ctx.Err() != nil {
+				err = ErrReportReaderClosed
+			}
+			r.err = err
+			return
+		}
+		reportSequence := sequence
+		sequence++
+		// Report is dropped if it overflowed its buffer, after which the device has reopened its stream
+		// so reading continues, or if no buffer was free
+		if err != nil || report == nil {
+			if report != nil {
+				report.Release()
+			}
+			overflow = true
+			continue
+		}
+
+		report.Data = buf[:n]
+		report.Timestamp = timestamp
+		report.Sequence = reportSequence
+		report.Overflow = overflow
+		overflow = false
+		// Never blocks, as the queue can hold all reports of the pool
+		r.reports <- report
+	}
+}
+
+func (r *reportReaderImpl) Read(ctx context.Context) (*Report, error) {
+	select {
+	case report := <-r.reports:
+		return report, nil
+	case <-r.done:
+		select {
+		case report := <-r.reports:
+			return report, nil
+		default:
+			return nil, r.err
+		}
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (r *reportReaderImpl) Close() error {
+	r.cancel()
+	<-r.done
+
+	return nil
+}
@@ -0,0 +1,237 @@
+package hid_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/gousb"
+	"github.com/ntchjb/gohid/hid"
+	"github.com/ntchjb/gohid/usb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// readInput returns a ReadInput call which reads data
+func readInput(device *hid.MockDevice, data []byte) *gomock.Call {
+	return device.EXPECT().ReadInput(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, buf []byte) (int, error) {
+		return copy(buf, data), nil
+	})
+}
+
+// blockInput makes ReadInput wait until the reader is closed
+func blockInput(device *hid.MockDevice) *gomock.Call {
+	return device.EXPECT().ReadInput(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, buf []byte) (int, error) {
+		<-ctx.Done()
+		return 0, ctx.Err()
+	})
+}
+
+// feedInput makes ReadInput read reports sent to the returned channel, where each send returns
+// once the report is read
+func feedInput(device *hid.MockDevice) chan<- []byte {
+	inputs := make(chan []byte)
+	device.EXPECT().ReadInput(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, buf []byte) (int, error) {
+		select {
+		case data := <-inputs:
+			return copy(buf, data), nil
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	}).AnyTimes()
+
+	return inputs
+}
+
+func TestReportReader_Read(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	device := hid.NewMockDevice(ctrl)
+	inputs := feedInput(device)
+
+	reader := hid.NewReportReader(device, hid.ReportReaderConfig{PoolSize: 2, ReportSize: 8})
+	start := time.Now()
+	inputs <- []byte{0x01, 0x02}
+	report, err := reader.Read(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x01, 0x02}, report.Data)
+	assert.Equal(t, uint64(0), report.Sequence)
+	assert.False(t, report.Overflow)
+	assert.False(t, report.Timestamp.Before(start))
+	assert.False(t, report.Timestamp.After(time.Now()))
+
+	// Next report is read into the other buffer of the pool
+	inputs <- []byte{0x01, 0x03, 0x04}
+	next, err := reader.Read(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x01, 0x03, 0x04}, next.Data)
+	assert.Equal(t, uint64(1), next.Sequence)
+	assert.False(t, next.Overflow)
+	assert.False(t, next.Timestamp.Before(report.Timestamp))
+	assert.Equal(t, []byte{0x01, 0x02}, report.Data)
+	report.Release()
+
+	timeout, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	_, err = reader.Read(timeout)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	require.NoError(t, reader.Close())
+	_, err = reader.Read(ctx)
+	assert.ErrorIs(t, err, hid.ErrReportReaderClosed)
+	// Report held by caller stays valid after closing
+	assert.Equal(t, []byte{0x01, 0x03, 0x04}, next.Data)
+	next.Release()
+}
+
+func TestReportReader_PoolExhausted(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	device := hid.NewMockDevice(ctrl)
+	inputs := feedInput(device)
+
+	reader := hid.NewReportReader(device, hid.ReportReaderConfig{PoolSize: 1, ReportSize: 8})
+	defer reader.Close()
+	inputs <- []byte{0x01}
+	report, err := reader.Read(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), report.Sequence)
+
+	// Reports are dropped while the only report of the pool is held
+	inputs <- []byte{0x02}
+	inputs <- []byte{0x03}
+	report.Release()
+	// The report read after releasing may still be dropped, as its buffer is taken before it is released
+	inputs <- []byte{0x04}
+	inputs <- []byte{0x05}
+	report, err = reader.Read(ctx)
+	require.NoError(t, err)
+	assert.True(t, report.Overflow)
+	assert.Equal(t, uint64(report.Data[0]-1), report.Sequence)
+	report.Release()
+}
+
+func TestReportReader_TransferOverflow(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	device := hid.NewMockDevice(ctrl)
+	gomock.InOrder(
+		readInput(device, []byte{0x01}),
+		device.EXPECT().ReadInput(gomock.Any(), gomock.Any()).Return(8, gousb.TransferOverflow),
+		readInput(device, []byte{0x02}),
+		readInput(device, []byte{0x03}),
+		blockInput(device),
+	)
+
+	reader := hid.NewReportReader(device, hid.ReportReaderConfig{ReportSize: 8})
+	defer reader.Close()
+
+	expected := []struct {
+		data     byte
+		sequence uint64
+		overflow bool
+	}{
+		{data: 0x01, sequence: 0},
+		{data: 0x02, sequence: 2, overflow: true},
+		{data: 0x03, sequence: 3},
+	}
+	for _, e := range expected {
+		report, err := reader.Read(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{e.data}, report.Data)
+		assert.Equal(t, e.sequence, report.Sequence)
+		assert.Equal(t, e.overflow, report.Overflow)
+		report.Release()
+	}
+}
+
+func TestReportReader_TransferOverflow_StreamReopened(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	m := createSetupTargetMocks(ctrl, 1, 1, 0, 1, 1)
+	read := func(stream *usb.MockStreamReader, data []byte) *gomock.Call {
+		return stream.EXPECT().ReadContext(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, buf []byte) (int, error) {
+			return copy(buf, data), nil
+		})
+	}
+	next := usb.NewMockStreamReader(ctrl)
+	overflow := m.reader.EXPECT().ReadContext(gomock.Any(), gomock.Any()).Return(8, gousb.TransferOverflow).After(read(m.reader, []byte{0x01}))
+	// Stream of gousb cannot be read once a transfer fails
+	m.reader.EXPECT().ReadContext(gomock.Any(), gomock.Any()).Return(0, io.ErrClosedPipe).AnyTimes().After(overflow)
+	gomock.InOrder(
+		m.reader.EXPECT().Close().Return(gousb.TransferOverflow).After(overflow),
+		m.epIn.EXPECT().NewStream(hid.DEFAULT_ENDPOINT_STREAM_COUNT).Return(next, nil),
+		read(next, []byte{0x02}),
+		next.EXPECT().ReadContext(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, buf []byte) (int, error) {
+			<-ctx.Done()
+			return 0, ctx.Err()
+		}),
+	)
+
+	device, err := hid.NewDevice(m.device, config, slog.Default())
+	require.NoError(t, err)
+	require.NoError(t, device.SetTarget(1, 1, 0))
+	reader := hid.NewReportReader(device, hid.ReportReaderConfig{ReportSize: 8})
+	defer reader.Close()
+
+	report, err := reader.Read(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x01}, report.Data)
+	assert.False(t, report.Overflow)
+	report.Release()
+	// Report read from the reopened stream follows the dropped report
+	report, err = reader.Read(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x02}, report.Data)
+	assert.Equal(t, uint64(2), report.Sequence)
+	assert.True(t, report.Overflow)
+	report.Release()
+}
+
+func TestReportReader_Error(t *testing.T) {
+	errInterrupt := errors.New("interrupt transfer error")
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	device := hid.NewMockDevice(ctrl)
+	gomock.InOrder(
+		readInput(device, []byte{0x01}),
+		readInput(device, []byte{0x02}),
+		device.EXPECT().ReadInput(gomock.Any(), gomock.Any()).Return(0, errInterrupt),
+	)
+
+	reader := hid.NewReportReader(device, hid.ReportReaderConfig{})
+	defer reader.Close()
+
+	// Reports read before the error are returned first
+	for _, expected := range []byte{0x01, 0x02} {
+		report, err := reader.Read(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{expected}, report.Data)
+		report.Release()
+	}
+	_, err := reader.Read(ctx)
+	assert.ErrorIs(t, err, errInterrupt)
+	_, err = reader.Read(ctx)
+	assert.ErrorIs(t, err, errInterrupt)
+}
+
+func TestReportReader_Close(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	device := hid.NewMockDevice(ctrl)
+	blockInput(device)
+
+	reader := hid.NewReportReader(device, hid.ReportReaderConfig{})
+	readErr := make(chan error)
+	go func() {
+		_, err := reader.Read(context.Background())
+		readErr <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	require.NoError(t, reader.Close())
+	assert.ErrorIs(t, <-readErr, hid.ErrReportReaderClosed)
+}
@@ -0,0 +1,724 @@
+package hid
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	hidreport "github.com/ntchjb/usbip-virtual-device/usb/protocol/hid/report"
+)
+
+var (
+	ErrReportItemTruncated     = errors.New("report item is truncated")
+	ErrUnbalancedCollection    = errors.New("unbalanced collection")
+	ErrGlobalStackUnderflow    = errors.New("global item stack underflow")
+	ErrReportLayoutNotFound    = errors.New("report layout not found")
+	ErrReportFieldNotFound     = errors.New("report field not found")
+	ErrReportFieldOutOfRange   = errors.New("report field index out of range")
+	ErrReportPayloadIsTooShort = errors.New("report payload is too short")
+)
+
+const (
+	// Item prefix of a long item, followed by bDataSize and bLongItemTag
+	REPORT_ITEM_LONG_PREFIX byte = 0xFE
+	// Maximum number of bits of a field value that can be read or written at once
+	REPORT_FIELD_MAX_VALUE_BITS uint32 = 32
+)
+
+// Flags of Input, Output and Feature main items. Zero values of other bits are No Wrap, Linear,
+// Preferred State, No Null Position, Non Volatile and Bit Field respectively.
+const (
+	REPORT_FLAG_DATA           uint32 = 0
+	REPORT_FLAG_ARRAY          uint32 = 0
+	REPORT_FLAG_ABSOLUTE       uint32 = 0
+	REPORT_FLAG_CONSTANT       uint32 = 1 << 0
+	REPORT_FLAG_VARIABLE       uint32 = 1 << 1
+	REPORT_FLAG_RELATIVE       uint32 = 1 << 2
+	REPORT_FLAG_WRAP           uint32 = 1 << 3
+	REPORT_FLAG_NON_LINEAR     uint32 = 1 << 4
+	REPORT_FLAG_NO_PREFERRED   uint32 = 1 << 5
+	REPORT_FLAG_NULL_STATE     uint32 = 1 << 6
+	REPORT_FLAG_VOLATILE       uint32 = 1 << 7
+	REPORT_FLAG_BUFFERED_BYTES uint32 = 1 << 8
+)
+
+var reportItemDataSizes = [4]int{0, 1, 2, 4}
+
+// Usage is an extended usage, in which the upper 16 bits are usage page
+// and the lower 16 bits are usage ID
+type Usage uint32
+
+func NewUsage(page, id uint16) Usage {
+	return Usage(uint32(page)<<16 | uint32(id))
+}
+
+func (u Usage) Page() uint16 {
+	return uint16(u >> 16)
+}
+
+func (u Usage) ID() uint16 {
+	return uint16(u & 0xFFFF)
+}
+
+func (u Usage) String() string {
+	return fmt.Sprintf("0x%04X:0x%04X", u.Page(), u.ID())
+}
+
+// ReportItem is a single short or long item found in a report descriptor
+type ReportItem struct {
+	// Tag of this item, combined with its item type. For long items, it is HID_REPORT_TAG_LONG_ITEM
+	Tag hidreport.HIDReportTag
+	// Tag of a long item. It is zero for short items
+	LongTag uint8
+	// Data part of this item, excluding item prefix
+	Data []byte
+	// Position of this item's prefix in the report descriptor
+	Offset int
+}
+
+func (r ReportItem) Type() hidreport.HIDReportType {
+	return hidreport.HIDReportType((uint8(r.Tag) & 0b0000_1100) >> 2)
+}
+
+func (r ReportItem) IsLong() bool {
+	return r.Tag == hidreport.HID_REPORT_TAG_LONG_ITEM
+}
+
+// Uint returns data of this item as unsigned little-endian integer
+func (r ReportItem) Uint() uint32 {
+	var res uint32
+	for i := 0; i < len(r.Data) && i < 4; i++ {
+		res |= uint32(r.Data[i]) << (8 * i)
+	}
+	return res
+}
+
+// Int returns data of this item as signed little-endian integer
+func (r ReportItem) Int() int32 {
+	switch len(r.Data) {
+	case 0:
+		return 0
+	case 1:
+		return int32(int8(r.Data[0]))
+	case 2:
+		return int32(int16(r.Uint()))
+	default:
+		return int32(r.Uint())
+	}
+}
+
+// Size returns number of bytes this item occupies in a report descriptor
+func (r ReportItem) Size() int {
+	if r.IsLong() {
+		return 3 + len(r.Data)
+	}
+	return 1 + len(r.Data)
+}
+
+// ParseReportItems splits report descriptor into a list of short and long items
+func ParseReportItems(desc hidreport.HIDReportDescriptor) ([]ReportItem, error) {
+	var items []ReportItem
+	cursor := 0
+
+	for cursor < len(desc) {
+		prefix := desc[cursor]
+		if prefix == REPORT_ITEM_LONG_PREFIX {
+			if cursor+2 >= len(desc) {
+				return items, fmt.Errorf("long item at offset %d has no data size or tag: %w", cursor, ErrReportItemTruncated)
+			}
+			dataSize := int(desc[cursor+1])
+			dataStart := cursor + 3
+			if dataStart+dataSize > len(desc) {
+				return items, fmt.Errorf("long item at offset %d needs %d bytes of data: %w", cursor, dataSize, ErrReportItemTruncated)
+			}
+			items = append(items, ReportItem{
+				Tag:     hidreport.HID_REPORT_TAG_LONG_ITEM,
+				LongTag: desc[cursor+2],
+				Data:    desc[dataStart : dataStart+dataSize],
+				Offset:  cursor,
+			})
+			cursor = dataStart + dataSize
+			continue
+		}
+
+		dataSize := reportItemDataSizes[prefix&0b0000_0011]
+		dataStart := cursor + 1
+		if dataStart+dataSize > len(desc) {
+			return items, fmt.Errorf("item 0x%02X at offset %d needs %d bytes of data: %w", prefix, cursor, dataSize, ErrReportItemTruncated)
+		}
+		items = append(items, ReportItem{
+			Tag:    hidreport.HIDReportTag(prefix & 0b1111_1100),
+			Data:   desc[dataStart : dataStart+dataSize],
+			Offset: cursor,
+		})
+		cursor = dataStart + dataSize
+	}
+
+	return items, nil
+}
+
+// ReportCollection is a collection defined in a report descriptor, with its nested collections
+type ReportCollection struct {
+	Type     hidreport.HIDReportCollectionData
+	Usage    Usage
+	Parent   *ReportCollection
+	Children []*ReportCollection
+	// Fields declared directly in this collection
+	Fields []*ReportField
+}
+
+// AllFields returns fields of this collection and all of its nested collections, in declaration order
+func (c *ReportCollection) AllFields() []*ReportField {
+	fields := append([]*ReportField{}, c.Fields...)
+	for _, child := range c.Children {
+		fields = append(fields, child.AllFields()...)
+	}
+
+	return fields
+}
+
+// ReportLayout describes data layout of a report of specific type and report ID
+type ReportLayout struct {
+	Type ReportType
+	ID   uint8
+	// Size of report data in bits, excluding report ID
+	BitSize uint32
+	Fields  []*ReportField
+}
+
+// ByteSize returns size of report data in bytes, excluding report ID
+func (r *ReportLayout) ByteSize() int {
+	return int((r.BitSize + 7) / 8)
+}
+
+// NewBuffer creates a buffer for sending or receiving this report via hid.Device,
+// in which the first byte is report ID followed by report data
+func (r *ReportLayout) NewBuffer() []byte {
+	buf := make([]byte, 1+r.ByteSize())
+	buf[0] = r.ID
+
+	return buf
+}
+
+// FindField returns the field containing given usage and the index of that usage in the field.
+// Index is always zero for array fields.
+func (r *ReportLayout) FindField(usage Usage) (*ReportField, int, error) {
+	for _, field := range r.Fields {
+		if idx, ok := field.UsageIndex(usage); ok {
+			return field, idx, nil
+		}
+	}
+
+	return nil, 0, fmt.Errorf("usage %v in report type %d, ID %d: %w", usage, r.Type, r.ID, ErrReportFieldNotFound)
+}
+
+// ReportField is a set of data items created by a single Input, Output or Feature main item
+type ReportField struct {
+	ReportType ReportType
+	ReportID   uint8
+	// Data of the main item, e.g. Data/Constant, Array/Variable, Absolute/Relative
+	Flags uint32
+
+	// Usages declared by Usage local items, in declaration order
+	Usages        []Usage
+	UsageMinimum  Usage
+	UsageMaximum  Usage
+	HasUsageRange bool
+
+	LogicalMinimum  int32
+	LogicalMaximum  int32
+	PhysicalMinimum int32
+	PhysicalMaximum int32
+	UnitExponent    int8
+	Unit            uint32
+	ReportSize      uint32
+	ReportCount     uint32
+
+	// Position of the first bit of this field in report data, excluding report ID
+	BitOffset uint32
+	// Collection this field is declared in. It is nil for fields declared outside collections
+	Collection *ReportCollection
+}
+
+func (f *ReportField) IsConstant() bool {
+	return f.Flags&REPORT_FLAG_CONSTANT != 0
+}
+
+func (f *ReportField) IsVariable() bool {
+	return f.Flags&REPORT_FLAG_VARIABLE != 0
+}
+
+func (f *ReportField) IsArray() bool {
+	return !f.IsVariable()
+}
+
+func (f *ReportField) IsRelative() bool {
+	return f.Flags&REPORT_FLAG_RELATIVE != 0
+}
+
+func (f *ReportField) IsNullState() bool {
+	return f.Flags&REPORT_FLAG_NULL_STATE != 0
+}
+
+// usageCount returns number of usages assigned to this field
+func (f *ReportField) usageCount() int {
+	count := len(f.Usages)
+	if f.HasUsageRange && f.UsageMaximum >= f.UsageMinimum {
+		count += int(f.UsageMaximum-f.UsageMinimum) + 1
+	}
+
+	return count
+}
+
+// usageAt returns the n-th usage of this field, considering both Usage items and usage range
+func (f *ReportField) usageAt(n int) (Usage, bool) {
+	if n < 0 {
+		return 0, false
+	}
+	if n < len(f.Usages) {
+		return f.Usages[n], true
+	}
+	n -= len(f.Usages)
+	if f.HasUsageRange && uint64(f.UsageMinimum)+uint64(n) <= uint64(f.UsageMaximum) {
+		return f.UsageMinimum + Usage(n), true
+	}
+
+	return 0, false
+}
+
+// Usage returns usage of the value at given index of a variable field.
+// If there are fewer usages than values, the last usage applies to the remaining values.
+func (f *ReportField) Usage(index int) Usage {
+	if usage, ok := f.usageAt(index); ok {
+		return usage
+	}
+	if count := f.usageCount(); count > 0 {
+		usage, _ := f.usageAt(count - 1)
+		return usage
+	}
+
+	return 0
+}
+
+// ArrayUsage returns usage selected by a value of an array field
+func (f *ReportField) ArrayUsage(value int32) (Usage, bool) {
+	if value < f.LogicalMinimum || value > f.LogicalMaximum {
+		return 0, false
+	}
+
+	return f.usageAt(int(value - f.LogicalMinimum))
+}
+
+// ArrayValue returns value of an array field that selects given usage
+func (f *ReportField) ArrayValue(usage Usage) (int32, bool) {
+	for i := 0; i < f.usageCount(); i++ {
+		if u, _ := f.usageAt(i); u == usage {
+			return f.LogicalMinimum + int32(i), true
+		}
+	}
+
+	return 0, false
+}
+
+// UsageIndex returns index of the value assigned to given usage. For array fields,
+// it reports whether the usage can be selected by this field, and index is always zero.
+func (f *ReportField) UsageIndex(usage Usage) (int, bool) {
+	if f.IsArray() {
+		_, ok := f.ArrayValue(usage)
+		return 0, ok
+	}
+	for i := 0; i < int(f.ReportCount); i++ {
+		if f.Usage(i) == usage {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+// IsSigned reports whether values of this field are two's complement integers
+func (f *ReportField) IsSigned() bool {
+	return f.LogicalMinimum < 0
+}
+
+func (f *ReportField) valueBitRange(index int) (uint32, uint32, error) {
+	if index < 0 || index >= int(f.ReportCount) {
+		return 0, 0, fmt.Errorf("index %d of field with %d values: %w", index, f.ReportCount, ErrReportFieldOutOfRange)
+	}
+	size := f.ReportSize
+	if size > REPORT_FIELD_MAX_VALUE_BITS {
+		size = REPORT_FIELD_MAX_VALUE_BITS
+	}
+
+	return f.BitOffset + uint32(index)*f.ReportSize, size, nil
+}
+
+// Value reads value at given index of this field from report data, excluding report ID.
+func (f *ReportField) Value(payload []byte, index int) (int32, error) {
+	offset, size, err := f.valueBitRange(index)
+	if err != nil {
+		return 0, err
+	}
+	if int((offset+size+7)/8) > len(payload) {
+		return 0, fmt.Errorf("need %d bits, got %d bytes: %w", offset+size, len(payload), ErrReportPayloadIsTooShort)
+	}
+
+	var value uint32
+	for i := uint32(0); i < size; i++ {
+		bit := offset + i
+		if payload[bit/8]&(1<<(bit%8)) != 0 {
+			value |= 1 << i
+		}
+	}
+	if f.IsSigned() && size < 32 && value&(1<<(size-1)) != 0 {
+		value |= math.MaxUint32 << size
+	}
+
+	return int32(value), nil
+}
+
+// SetValue writes value at given index of this field to report data, excluding report ID.
+func (f *ReportField) SetValue(payload []byte, index int, value int32) error {
+	offset, size, err := f.valueBitRange(index)
+	if err != nil {
+		return err
+	}
+	if int((offset+size+7)/8) > len(payload) {
+		return fmt.Errorf("need %d bits, got %d bytes: %w", offset+size, len(payload), ErrReportPayloadIsTooShort)
+	}
+
+	for i := uint32(0); i < size; i++ {
+		bit := offset + i
+		if uint32(value)&(1<<i) != 0 {
+			payload[bit/8] |= 1 << (bit % 8)
+		} else {
+			payload[bit/8] &^= 1 << (bit % 8)
+		}
+	}
+
+	return nil
+}
+
+// Resolution returns the multiplier that converts logical values into physical values in units of Unit,
+// with Unit Exponent applied.
+func (f *ReportField) Resolution() float64 {
+	res := math.Pow10(int(f.UnitExponent))
+	if f.PhysicalMinimum == 0 && f.PhysicalMaximum == 0 {
+		return res
+	}
+	if f.LogicalMaximum == f.LogicalMinimum {
+		return res
+	}
+
+	return res * float64(int64(f.PhysicalMaximum)-int64(f.PhysicalMinimum)) / float64(int64(f.LogicalMaximum)-int64(f.LogicalMinimum))
+}
+
+// PhysicalValue converts logical value into physical value in units of Unit
+func (f *ReportField) PhysicalValue(value int32) float64 {
+	if f.PhysicalMinimum == 0 && f.PhysicalMaximum == 0 {
+		return float64(value) * f.Resolution()
+	}
+
+	return (float64(int64(value)-int64(f.LogicalMinimum))*f.Resolution() + float64(f.PhysicalMinimum)*math.Pow10(int(f.UnitExponent)))
+}
+
+// LogicalValue converts physical value in units of Unit into the nearest logical value,
+// clamped to logical range
+func (f *ReportField) LogicalValue(value float64) int32 {
+	var logical float64
+	if f.PhysicalMinimum == 0 && f.PhysicalMaximum == 0 {
+		logical = math.Round(value / f.Resolution())
+	} else {
+		logical = math.Round((value-float64(f.PhysicalMinimum)*math.Pow10(int(f.UnitExponent)))/f.Resolution()) + float64(f.LogicalMinimum)
+	}
+	if f.LogicalMaximum > f.LogicalMinimum {
+		logical = math.Max(float64(f.LogicalMinimum), math.Min(float64(f.LogicalMaximum), logical))
+	}
+
+	return int32(logical)
+}
+
+// ReportSchema is a parsed report descriptor
+type ReportSchema struct {
+	Items []ReportItem
+	// Top-level collections
+	Collections []*ReportCollection
+	// Report layouts in order of their first appearance
+	Layouts []*ReportLayout
+}
+
+// Layout returns report layout by given report type and report ID
+func (r *ReportSchema) Layout(reportType ReportType, reportID uint8) (*ReportLayout, error) {
+	for _, layout := range r.Layouts {
+		if layout.Type == reportType && layout.ID == reportID {
+			return layout, nil
+		}
+	}
+
+	return nil, fmt.Errorf("report type %d, ID %d: %w", reportType, reportID, ErrReportLayoutNotFound)
+}
+
+// UsesReportIDs reports whether reports of this device are prefixed with report ID
+func (r *ReportSchema) UsesReportIDs() bool {
+	for _, layout := range r.Layouts {
+		if layout.ID != 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SplitInputReport splits data read from interrupt IN endpoint into report layout and report data
+func (r *ReportSchema) SplitInputReport(data []byte) (*ReportLayout, []byte, error) {
+	var reportID uint8
+	if r.UsesReportIDs() {
+		if len(data) == 0 {
+			return nil, nil, ErrEmptyData
+		}
+		reportID = data[0]
+		data = data[1:]
+	}
+
+	layout, err := r.Layout(REPORT_TYPE_INPUT, reportID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return layout, data, nil
+}
+
+// InputReportLength returns length of an Input report read from interrupt IN endpoint, including report ID
+// if reports are prefixed with it, from the beginning of report data
+func (r *ReportSchema) InputReportLength(data []byte) (int, error) {
+	layout, _, err := r.SplitInputReport(data)
+	if err != nil {
+		return 0, err
+	}
+	if layout.ID != 0 {
+		return 1 + layout.ByteSize(), nil
+	}
+
+	return layout.ByteSize(), nil
+}
+
+// FindCollections returns all collections with given usage, including nested ones
+func (r *ReportSchema) FindCollections(usage Usage) []*ReportCollection {
+	var res []*ReportCollection
+	var walk func(collections []*ReportCollection)
+	walk = func(collections []*ReportCollection) {
+		for _, collection := range collections {
+			if collection.Usage == usage {
+				res = append(res, collection)
+			}
+			walk(collection.Children)
+		}
+	}
+	walk(r.Collections)
+
+	return res
+}
+
+// Fields returns all fields of the report descriptor, in declaration order
+func (r *ReportSchema) Fields() []*ReportField {
+	var fields []*ReportField
+	for _, layout := range r.Layouts {
+		fields = append(fields, layout.Fields...)
+	}
+
+	return fields
+}
+
+type reportGlobalState struct {
+	usagePage       uint16
+	logicalMinimum  int32
+	logicalMaximum  int32
+	physicalMinimum int32
+	physicalMaximum int32
+	unitExponent    int8
+	unit            uint32
+	reportSize      uint32
+	reportID        uint8
+	reportCount     uint32
+
+	// Number of bytes of Logical Maximum item, used to read it as unsigned integer when needed
+	logicalMaximumSize int
+}
+
+// reportLocalUsage keeps usage item data until a main item is found,
+// because usage page of a 1- or 2-byte usage is resolved at the main item
+type reportLocalUsage struct {
+	value      uint32
+	isExtended bool
+}
+
+func (r reportLocalUsage) resolve(usagePage uint16) Usage {
+	if r.isExtended {
+		return Usage(r.value)
+	}
+
+	return NewUsage(usagePage, uint16(r.value))
+}
+
+type reportLocalState struct {
+	usages       []reportLocalUsage
+	usageMinimum *reportLocalUsage
+	usageMaximum *reportLocalUsage
+}
+
+// ParseUnitExponent decodes Unit Exponent item data. Exponent is a signed nibble according to HID spec,
+// but some devices encode it as a whole signed integer, so both are accepted.
+func ParseUnitExponent(item ReportItem) int8 {
+	if len(item.Data) == 1 && item.Data[0] <= 0x0F {
+		nibble := item.Data[0]
+		if nibble >= 0x08 {
+			return int8(nibble) - 0x10
+		}
+		return int8(nibble)
+	}
+
+	return int8(item.Int())
+}
+
+// ParseReportDescriptor parses report descriptor into collections and report layouts
+func ParseReportDescriptor(desc hidreport.HIDReportDescriptor) (*ReportSchema, error) {
+	items, err := ParseReportItems(desc)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse report items: %w", err)
+	}
+
+	schema := &ReportSchema{
+		Items: items,
+	}
+	var global reportGlobalState
+	var local reportLocalState
+	var globalStack []reportGlobalState
+	var current *ReportCollection
+
+	for _, item := range items {
+		switch item.Tag {
+		// Main items
+		case hidreport.HID_REPORT_TAG_INPUT, hidreport.HID_REPORT_TAG_OUTPUT, hidreport.HID_REPORT_TAG_FEATURE:
+			var reportType ReportType
+			switch item.Tag {
+			case hidreport.HID_REPORT_TAG_INPUT:
+				reportType = REPORT_TYPE_INPUT
+			case hidreport.HID_REPORT_TAG_OUTPUT:
+				reportType = REPORT_TYPE_OUTPUT
+			default:
+				reportType = REPORT_TYPE_FEATURE
+			}
+			layout := schema.layout(reportType, global.reportID)
+			field := &ReportField{
+				ReportType:      reportType,
+				ReportID:        global.reportID,
+				Flags:           item.Uint(),
+				LogicalMinimum:  global.logicalMinimum,
+				LogicalMaximum:  global.logicalMaximum,
+				PhysicalMinimum: global.physicalMinimum,
+				PhysicalMaximum: global.physicalMaximum,
+				UnitExponent:    global.unitExponent,
+				Unit:            global.unit,
+				ReportSize:      global.reportSize,
+				ReportCount:     global.reportCount,
+				BitOffset:       layout.BitSize,
+				Collection:      current,
+			}
+			// Logical Minimum and Maximum are unsigned if no negative value is defined
+			if field.LogicalMinimum >= 0 && field.LogicalMaximum < 0 && global.logicalMaximumSize < 4 {
+				field.LogicalMaximum = int32(uint32(field.LogicalMaximum) & (1<<(8*global.logicalMaximumSize) - 1))
+			}
+			for _, usage := range local.usages {
+				field.Usages = append(field.Usages, usage.resolve(global.usagePage))
+			}
+			if local.usageMinimum != nil && local.usageMaximum != nil {
+				field.HasUsageRange = true
+				field.UsageMinimum = local.usageMinimum.resolve(global.usagePage)
+				field.UsageMaximum = local.usageMaximum.resolve(global.usagePage)
+			}
+			layout.BitSize += global.reportSize * global.reportCount
+			layout.Fields = append(layout.Fields, field)
+			if current != nil {
+				current.Fields = append(current.Fields, field)
+			}
+			local = reportLocalState{}
+		case hidreport.HID_REPORT_TAG_COLLECTION:
+			collection := &ReportCollection{
+				Type:   hidreport.ParseCollectionReportItem(byte(item.Uint())),
+				Parent: current,
+			}
+			if len(local.usages) > 0 {
+				collection.Usage = local.usages[0].resolve(global.usagePage)
+			} else if local.usageMinimum != nil {
+				collection.Usage = local.usageMinimum.resolve(global.usagePage)
+			}
+			if current != nil {
+				current.Children = append(current.Children, collection)
+			} else {
+				schema.Collections = append(schema.Collections, collection)
+			}
+			current = collection
+			local = reportLocalState{}
+		case hidreport.HID_REPORT_TAG_END_COLLECTION:
+			if current == nil {
+				return nil, fmt.Errorf("end collection at offset %d has no matching collection: %w", item.Offset, ErrUnbalancedCollection)
+			}
+			current = current.Parent
+			local = reportLocalState{}
+
+		// Global items
+		case hidreport.HID_REPORT_TAG_USAGE_PAGE:
+			global.usagePage = uint16(item.Uint())
+		case hidreport.HID_REPORT_TAG_LOGICAL_MINIMUM:
+			global.logicalMinimum = item.Int()
+		case hidreport.HID_REPORT_TAG_LOGICAL_MAXIMUM:
+			global.logicalMaximum = item.Int()
+			global.logicalMaximumSize = len(item.Data)
+		case hidreport.HID_REPORT_TAG_PHYSICAL_MINIMUM:
+			global.physicalMinimum = item.Int()
+		case hidreport.HID_REPORT_TAG_PHYSICAL_MAXIMUM:
+			global.physicalMaximum = item.Int()
+		case hidreport.HID_REPORT_TAG_UNIT_EXPONENT:
+			global.unitExponent = ParseUnitExponent(item)
+		case hidreport.HID_REPORT_TAG_UNIT:
+			global.unit = item.Uint()
+		case hidreport.HID_REPORT_TAG_REPORT_SIZE:
+			global.reportSize = item.Uint()
+		case hidreport.HID_REPORT_TAG_REPORT_ID:
+			global.reportID = uint8(item.Uint())
+		case hidreport.HID_REPORT_TAG_REOPORT_COUNT:
+			global.reportCount = item.Uint()
+		case hidreport.HID_REPORT_TAG_PUSH:
+			globalStack = append(globalStack, global)
+		case hidreport.HID_REPORT_TAG_POP:
+			if len(globalStack) == 0 {
+				return nil, fmt.Errorf("pop at offset %d: %w", item.Offset, ErrGlobalStackUnderflow)
+			}
+			global = globalStack[len(globalStack)-1]
+			globalStack = globalStack[:len(globalStack)-1]
+
+		// Local items
+		case hidreport.HID_REPORT_TAG_USAGE:
+			local.usages = append(local.usages, reportLocalUsage{value: item.Uint(), isExtended: len(item.Data) == 4})
+		case hidreport.HID_REPORT_TAG_USAGE_MINIMUM:
+			local.usageMinimum = &reportLocalUsage{value: item.Uint(), isExtended: len(item.Data) == 4}
+		case hidreport.HID_REPORT_TAG_USAGE_MAXIMUM:
+			local.usageMaximum = &reportLocalUsage{value: item.Uint(), isExtended: len(item.Data) == 4}
+		}
+	}
+
+	return schema, nil
+}
+
+func (r *ReportSchema) layout(reportType ReportType, reportID uint8) *ReportLayout {
+	if layout, err := r.Layout(reportType, reportID); err == nil {
+		return layout
+	}
+	layout := &ReportLayout{
+		Type: reportType,
+		ID:   reportID,
+	}
+	r.Layouts = append(r.Layouts, layout)
+
+	return layout
+}
@@ -0,0 +1,308 @@
+package hid_test
+
+import (
+	"testing"
+
+	"github.com/ntchjb/gohid/hid"
+	"github.com/stretchr/testify/assert"
+
+	hidreport "github.com/ntchjb/usbip-virtual-device/usb/protocol/hid/report"
+)
+
+var (
+	// Mouse with 3 buttons and X/Y/Wheel axes in report ID 1,
+	// and a vendor-defined feature report in report ID 2
+	mouseReportDescriptor = hidreport.HIDReportDescriptor{
+		0x05, 0x01, // Usage Page (Generic Desktop)
+		0x09, 0x02, // Usage (Mouse)
+		0xA1, 0x01, // Collection (Application)
+		0x85, 0x01, //   Report ID (1)
+		0x09, 0x01, //   Usage (Pointer)
+		0xA1, 0x00, //   Collection (Physical)
+		0x05, 0x09, //     Usage Page (Button)
+		0x19, 0x01, //     Usage Minimum (1)
+		0x29, 0x03, //     Usage Maximum (3)
+		0x15, 0x00, //     Logical Minimum (0)
+		0x25, 0x01, //     Logical Maximum (1)
+		0x95, 0x03, //     Report Count (3)
+		0x75, 0x01, //     Report Size (1)
+		0x81, 0x02, //     Input (Data,Var,Abs)
+		0x95, 0x01, //     Report Count (1)
+		0x75, 0x05, //     Report Size (5)
+		0x81, 0x03, //     Input (Const,Var,Abs)
+		0x05, 0x01, //     Usage Page (Generic Desktop)
+		0x09, 0x30, //     Usage (X)
+		0x09, 0x31, //     Usage (Y)
+		0x09, 0x38, //     Usage (Wheel)
+		0x15, 0x81, //     Logical Minimum (-127)
+		0x25, 0x7F, //     Logical Maximum (127)
+		0x75, 0x08, //     Report Size (8)
+		0x95, 0x03, //     Report Count (3)
+		0x81, 0x06, //     Input (Data,Var,Rel)
+		0xC0,       //         End Collection
+		0x85, 0x02, //   Report ID (2)
+		0x06, 0x00, 0xFF, // Usage Page (Vendor 0xFF00)
+		0x09, 0x01, //   Usage (0x01)
+		0x15, 0x00, //   Logical Minimum (0)
+		0x26, 0xFF, 0x00, // Logical Maximum (255)
+		0x75, 0x08, //   Report Size (8)
+		0x95, 0x04, //   Report Count (4)
+		0xB1, 0x02, //   Feature (Data,Var,Abs)
+		0xC0, //       End Collection
+	}
+)
+
+func TestParseReportItems(t *testing.T) {
+	tests := []struct {
+		name  string
+		desc  hidreport.HIDReportDescriptor
+		items []hid.ReportItem
+		err   error
+	}{
+		{
+			name: "Success_ShortItems",
+			desc: hidreport.HIDReportDescriptor{0x05, 0x01, 0x26, 0xFF, 0x00, 0xC0},
+			items: []hid.ReportItem{
+				{Tag: hidreport.HID_REPORT_TAG_USAGE_PAGE, Data: []byte{0x01}, Offset: 0},
+				{Tag: hidreport.HID_REPORT_TAG_LOGICAL_MAXIMUM, Data: []byte{0xFF, 0x00}, Offset: 2},
+				{Tag: hidreport.HID_REPORT_TAG_END_COLLECTION, Data: []byte{}, Offset: 5},
+			},
+		},
+		{
+			name: "Success_LongItem",
+			desc: hidreport.HIDReportDescriptor{0xFE, 0x02, 0xF1, 0xAA, 0xBB, 0xC0},
+			items: []hid.ReportItem{
+				{Tag: hidreport.HID_REPORT_TAG_LONG_ITEM, LongTag: 0xF1, Data: []byte{0xAA, 0xBB}, Offset: 0},
+				{Tag: hidreport.HID_REPORT_TAG_END_COLLECTION, Data: []byte{}, Offset: 5},
+			},
+		},
+		{
+			name: "Error_TruncatedShortItem",
+			desc: hidreport.HIDReportDescriptor{0x05, 0x01, 0x27, 0xFF, 0x00},
+			items: []hid.ReportItem{
+				{Tag: hidreport.HID_REPORT_TAG_USAGE_PAGE, Data: []byte{0x01}, Offset: 0},
+			},
+			err: hid.ErrReportItemTruncated,
+		},
+		{
+			name:  "Error_TruncatedLongItem",
+			desc:  hidreport.HIDReportDescriptor{0xFE, 0x04, 0xF1, 0xAA},
+			items: nil,
+			err:   hid.ErrReportItemTruncated,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			items, err := hid.ParseReportItems(test.desc)
+			assert.ErrorIs(t, err, test.err)
+			assert.Equal(t, test.items, items)
+		})
+	}
+}
+
+func TestParseReportDescriptor(t *testing.T) {
+	schema, err := hid.ParseReportDescriptor(mouseReportDescriptor)
+	assert.NoError(t, err)
+
+	assert.True(t, schema.UsesReportIDs())
+	assert.Len(t, schema.Collections, 1)
+	application := schema.Collections[0]
+	assert.Equal(t, hid.NewUsage(0x01, 0x02), application.Usage)
+	assert.Equal(t, hidreport.HIDReportCollectionData(hidreport.HID_REPORT_COLLECTION_APPLICATION), application.Type)
+	assert.Len(t, application.Children, 1)
+	assert.Equal(t, hid.NewUsage(0x01, 0x01), application.Children[0].Usage)
+	assert.Len(t, application.AllFields(), 4)
+	assert.Equal(t, []*hid.ReportCollection{application.Children[0]}, schema.FindCollections(hid.NewUsage(0x01, 0x01)))
+
+	input, err := schema.Layout(hid.REPORT_TYPE_INPUT, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(32), input.BitSize)
+	assert.Equal(t, 4, input.ByteSize())
+	assert.Len(t, input.Fields, 3)
+
+	buttons := input.Fields[0]
+	assert.True(t, buttons.IsVariable())
+	assert.False(t, buttons.IsConstant())
+	assert.Equal(t, uint32(0), buttons.BitOffset)
+	assert.Equal(t, hid.NewUsage(0x09, 0x03), buttons.Usage(2))
+	assert.True(t, input.Fields[1].IsConstant())
+
+	axes := input.Fields[2]
+	assert.Equal(t, uint32(8), axes.BitOffset)
+	assert.True(t, axes.IsRelative())
+	assert.Equal(t, int32(-127), axes.LogicalMinimum)
+
+	field, idx, err := input.FindField(hid.NewUsage(0x01, 0x31))
+	assert.NoError(t, err)
+	assert.Equal(t, axes, field)
+	assert.Equal(t, 1, idx)
+
+	_, _, err = input.FindField(hid.NewUsage(0x01, 0x32))
+	assert.ErrorIs(t, err, hid.ErrReportFieldNotFound)
+
+	feature, err := schema.Layout(hid.REPORT_TYPE_FEATURE, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x02, 0x00, 0x00, 0x00, 0x00}, feature.NewBuffer())
+	assert.Equal(t, int32(255), feature.Fields[0].LogicalMaximum)
+	assert.Equal(t, hid.NewUsage(0xFF00, 0x01), feature.Fields[0].Usage(3))
+
+	_, err = schema.Layout(hid.REPORT_TYPE_OUTPUT, 1)
+	assert.ErrorIs(t, err, hid.ErrReportLayoutNotFound)
+
+	layout, payload, err := schema.SplitInputReport([]byte{0x01, 0b0000_0101, 0xFF, 0x02, 0x00})
+	assert.NoError(t, err)
+	assert.Equal(t, input, layout)
+	assert.Equal(t, []byte{0b0000_0101, 0xFF, 0x02, 0x00}, payload)
+
+	length, err := schema.InputReportLength([]byte{0x01})
+	assert.NoError(t, err)
+	assert.Equal(t, 5, length)
+	_, err = schema.InputReportLength([]byte{0x02})
+	assert.ErrorIs(t, err, hid.ErrReportLayoutNotFound)
+}
+
+func TestParseReportDescriptor_Error(t *testing.T) {
+	tests := []struct {
+		name string
+		desc hidreport.HIDReportDescriptor
+		err  error
+	}{
+		{
+			name: "Error_TruncatedItem",
+			desc: hidreport.HIDReportDescriptor{0x05},
+			err:  hid.ErrReportItemTruncated,
+		},
+		{
+			name: "Error_UnbalancedCollection",
+			desc: hidreport.HIDReportDescriptor{0xA1, 0x01, 0xC0, 0xC0},
+			err:  hid.ErrUnbalancedCollection,
+		},
+		{
+			name: "Error_GlobalStackUnderflow",
+			desc: hidreport.HIDReportDescriptor{0xA4, 0xB4, 0xB4},
+			err:  hid.ErrGlobalStackUnderflow,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			schema, err := hid.ParseReportDescriptor(test.desc)
+			assert.ErrorIs(t, err, test.err)
+			assert.Nil(t, schema)
+		})
+	}
+}
+
+func TestParseReportDescriptor_PushPopAndUnitExponent(t *testing.T) {
+	desc := hidreport.HIDReportDescriptor{
+		0x05, 0x20, // Usage Page (Sensors)
+		0x75, 0x10, // Report Size (16)
+		0x95, 0x01, // Report Count (1)
+		0xA4,       // Push
+		0x55, 0x0E, // Unit Exponent (-2)
+		0x0A, 0x34, 0x04, // Usage (Temperature)
+		0x81, 0x02, // Input (Data,Var,Abs)
+		0xB4,             // Pop
+		0x0A, 0x33, 0x04, // Usage (0x0433)
+		0x81, 0x02, // Input (Data,Var,Abs)
+	}
+
+	schema, err := hid.ParseReportDescriptor(desc)
+	assert.NoError(t, err)
+	assert.False(t, schema.UsesReportIDs())
+
+	fields := schema.Fields()
+	assert.Len(t, fields, 2)
+	assert.Equal(t, int8(-2), fields[0].UnitExponent)
+	assert.Equal(t, int8(0), fields[1].UnitExponent)
+	assert.Equal(t, uint32(16), fields[1].BitOffset)
+	assert.Nil(t, fields[0].Collection)
+}
+
+func TestReportField_Value(t *testing.T) {
+	schema, err := hid.ParseReportDescriptor(mouseReportDescriptor)
+	assert.NoError(t, err)
+	input, err := schema.Layout(hid.REPORT_TYPE_INPUT, 1)
+	assert.NoError(t, err)
+	buttons, axes := input.Fields[0], input.Fields[2]
+
+	payload := []byte{0b0000_0101, 0xFF, 0x02, 0x80}
+
+	value, err := buttons.Value(payload, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), value)
+	value, err = buttons.Value(payload, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), value)
+
+	value, err = axes.Value(payload, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(-1), value)
+	value, err = axes.Value(payload, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(-128), value)
+
+	_, err = axes.Value(payload, 3)
+	assert.ErrorIs(t, err, hid.ErrReportFieldOutOfRange)
+	_, err = axes.Value(payload[:2], 2)
+	assert.ErrorIs(t, err, hid.ErrReportPayloadIsTooShort)
+
+	assert.NoError(t, buttons.SetValue(payload, 1, 1))
+	assert.NoError(t, buttons.SetValue(payload, 0, 0))
+	assert.NoError(t, axes.SetValue(payload, 1, -3))
+	assert.Equal(t, []byte{0b0000_0110, 0xFF, 0xFD, 0x80}, payload)
+}
+
+func TestReportField_PhysicalValue(t *testing.T) {
+	field := hid.ReportField{
+		LogicalMinimum:  0,
+		LogicalMaximum:  255,
+		PhysicalMinimum: -10,
+		PhysicalMaximum: 245,
+		UnitExponent:    -1,
+	}
+	assert.InDelta(t, -1.0, field.PhysicalValue(0), 1e-9)
+	assert.InDelta(t, 24.5, field.PhysicalValue(255), 1e-9)
+	assert.Equal(t, int32(110), field.LogicalValue(10))
+	assert.Equal(t, int32(255), field.LogicalValue(1000))
+
+	field = hid.ReportField{
+		LogicalMinimum: -32768,
+		LogicalMaximum: 32767,
+		UnitExponent:   -2,
+	}
+	assert.InDelta(t, 21.37, field.PhysicalValue(2137), 1e-9)
+	assert.Equal(t, int32(-150), field.LogicalValue(-1.5))
+}
+
+func TestReportField_ArrayUsage(t *testing.T) {
+	field := hid.ReportField{
+		Usages:         []hid.Usage{hid.NewUsage(0x0C, 0xCD)},
+		UsageMinimum:   hid.NewUsage(0x0C, 0xE9),
+		UsageMaximum:   hid.NewUsage(0x0C, 0xEA),
+		HasUsageRange:  true,
+		LogicalMinimum: 1,
+		LogicalMaximum: 3,
+		ReportSize:     8,
+		ReportCount:    1,
+	}
+
+	usage, ok := field.ArrayUsage(2)
+	assert.True(t, ok)
+	assert.Equal(t, hid.NewUsage(0x0C, 0xE9), usage)
+	_, ok = field.ArrayUsage(0)
+	assert.False(t, ok)
+
+	value, ok := field.ArrayValue(hid.NewUsage(0x0C, 0xEA))
+	assert.True(t, ok)
+	assert.Equal(t, int32(3), value)
+
+	idx, ok := field.UsageIndex(hid.NewUsage(0x0C, 0xCD))
+	assert.True(t, ok)
+	assert.Equal(t, 0, idx)
+}
@@ -0,0 +1,273 @@
+package hid
+
+import (
+	"fmt"
+	"sort"
+
+	hidreport "github.com/ntchjb/usbip-virtual-device/usb/protocol/hid/report"
+)
+
+type DiagnosticSeverity uint8
+
+const (
+	// Report descriptor works with most hosts, but it is likely a mistake or has limited support
+	DIAGNOSTIC_SEVERITY_WARNING DiagnosticSeverity = 1
+	// Report descriptor violates HID specification, or its reports cannot be transferred
+	DIAGNOSTIC_SEVERITY_ERROR DiagnosticSeverity = 2
+)
+
+func (s DiagnosticSeverity) String() string {
+	switch s {
+	case DIAGNOSTIC_SEVERITY_WARNING:
+		return "warning"
+	case DIAGNOSTIC_SEVERITY_ERROR:
+		return "error"
+	default:
+		return "unknown"
+	}
+}
+
+type DiagnosticCode uint8
+
+const (
+	DIAGNOSTIC_ITEM_TRUNCATED DiagnosticCode = iota + 1
+	DIAGNOSTIC_UNKNOWN_ITEM
+	DIAGNOSTIC_UNBALANCED_COLLECTION
+	DIAGNOSTIC_GLOBAL_STACK_UNDERFLOW
+	DIAGNOSTIC_MISSING_USAGE_PAGE
+	DIAGNOSTIC_FIELD_SIZE_OVERFLOW
+	DIAGNOSTIC_LOGICAL_RANGE_INVERTED
+	DIAGNOSTIC_MIXED_REPORT_IDS
+	DIAGNOSTIC_REPORT_TOO_LARGE
+	DIAGNOSTIC_REPORT_EXCEEDS_PACKET_SIZE
+)
+
+var (
+	DiagnosticCodeNames = map[DiagnosticCode]string{
+		DIAGNOSTIC_ITEM_TRUNCATED:             "item-truncated",
+		DIAGNOSTIC_UNKNOWN_ITEM:               "unknown-item",
+		DIAGNOSTIC_UNBALANCED_COLLECTION:      "unbalanced-collection",
+		DIAGNOSTIC_GLOBAL_STACK_UNDERFLOW:     "global-stack-underflow",
+		DIAGNOSTIC_MISSING_USAGE_PAGE:         "missing-usage-page",
+		DIAGNOSTIC_FIELD_SIZE_OVERFLOW:        "field-size-overflow",
+		DIAGNOSTIC_LOGICAL_RANGE_INVERTED:     "logical-range-inverted",
+		DIAGNOSTIC_MIXED_REPORT_IDS:           "mixed-report-ids",
+		DIAGNOSTIC_REPORT_TOO_LARGE:           "report-too-large",
+		DIAGNOSTIC_REPORT_EXCEEDS_PACKET_SIZE: "report-exceeds-packet-size",
+	}
+)
+
+func (c DiagnosticCode) String() string {
+	if name, ok := DiagnosticCodeNames[c]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("unknown-%d", uint8(c))
+}
+
+// Diagnostic is a problem found in a report descriptor
+type Diagnostic struct {
+	Severity DiagnosticSeverity
+	Code     DiagnosticCode
+	// Position of the item causing this problem in report descriptor.
+	// It is -1 for problems of a whole report, e.g. a report is too large.
+	Offset  int
+	Message string
+}
+
+func (d Diagnostic) String() string {
+	if d.Offset < 0 {
+		return fmt.Sprintf("%s [%s]: %s", d.Severity, d.Code, d.Message)
+	}
+
+	return fmt.Sprintf("%s [%s] at offset %d: %s", d.Severity, d.Code, d.Offset, d.Message)
+}
+
+type Diagnostics []Diagnostic
+
+// HasErrors reports whether any diagnostic has error severity
+func (d Diagnostics) HasErrors() bool {
+	for _, diagnostic := range d {
+		if diagnostic.Severity == DIAGNOSTIC_SEVERITY_ERROR {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidationOptions are limits of the device whose report descriptor is validated
+type ValidationOptions struct {
+	// Max packet size of interrupt IN endpoint. Input reports are not checked against it if it is zero.
+	MaxInputPacketSize int
+	// Max packet size of interrupt OUT endpoint. Output reports are not checked against it if it is zero.
+	MaxOutputPacketSize int
+}
+
+type validatorGlobalState struct {
+	hasUsagePage       bool
+	logicalMinimum     int32
+	logicalMaximum     int32
+	logicalMaximumSize int
+	reportSize         uint32
+	reportCount        uint32
+	reportID           uint8
+}
+
+type validatorReportKey struct {
+	reportType ReportType
+	reportID   uint8
+}
+
+// ValidateReportDescriptor checks a report descriptor for problems commonly found in devices.
+// Unlike ParseReportDescriptor, it continues after a problem is found, so that all problems are reported.
+func ValidateReportDescriptor(desc hidreport.HIDReportDescriptor, options ValidationOptions) Diagnostics {
+	var diagnostics Diagnostics
+	report := func(severity DiagnosticSeverity, code DiagnosticCode, offset int, format string, args ...any) {
+		diagnostics = append(diagnostics, Diagnostic{
+			Severity: severity,
+			Code:     code,
+			Offset:   offset,
+			Message:  fmt.Sprintf(format, args...),
+		})
+	}
+
+	items, err := ParseReportItems(desc)
+	if err != nil {
+		offset := 0
+		if len(items) > 0 {
+			offset = items[len(items)-1].Offset + items[len(items)-1].Size()
+		}
+		report(DIAGNOSTIC_SEVERITY_ERROR, DIAGNOSTIC_ITEM_TRUNCATED, offset, "%v", err)
+	}
+
+	var global validatorGlobalState
+	var globalStack []validatorGlobalState
+	var collectionOffsets []int
+	reportBits := make(map[validatorReportKey]uint64)
+	// Offset of the first main item declared without report ID, and of the first Report ID item
+	firstNoIDOffset, firstIDOffset := -1, -1
+
+	for _, item := range items {
+		if _, ok := hidreport.HIDReportTagNames[item.Tag]; !ok || item.IsLong() {
+			report(DIAGNOSTIC_SEVERITY_WARNING, DIAGNOSTIC_UNKNOWN_ITEM, item.Offset, "item with tag 0x%02X is reserved", uint8(item.Tag))
+			continue
+		}
+
+		switch item.Tag {
+		// Main items
+		case hidreport.HID_REPORT_TAG_INPUT, hidreport.HID_REPORT_TAG_OUTPUT, hidreport.HID_REPORT_TAG_FEATURE:
+			reportType := REPORT_TYPE_INPUT
+			switch item.Tag {
+			case hidreport.HID_REPORT_TAG_OUTPUT:
+				reportType = REPORT_TYPE_OUTPUT
+			case hidreport.HID_REPORT_TAG_FEATURE:
+				reportType = REPORT_TYPE_FEATURE
+			}
+			bits := uint64(global.reportSize) * uint64(global.reportCount)
+			if bits > uint64(HID_MAX_REPORT_SIZE)*8 {
+				report(DIAGNOSTIC_SEVERITY_ERROR, DIAGNOSTIC_FIELD_SIZE_OVERFLOW, item.Offset,
+					"report size %d * report count %d exceeds %d bytes", global.reportSize, global.reportCount, HID_MAX_REPORT_SIZE)
+			}
+			if item.Uint()&REPORT_FLAG_CONSTANT == 0 {
+				logicalMaximum := global.logicalMaximum
+				if global.logicalMinimum >= 0 && logicalMaximum < 0 && global.logicalMaximumSize < 4 {
+					// Same as ParseReportDescriptor, negative maximum with non-negative minimum is treated as unsigned
+					logicalMaximum = int32(uint32(logicalMaximum) & (1<<(8*global.logicalMaximumSize) - 1))
+				}
+				if global.logicalMinimum > logicalMaximum {
+					report(DIAGNOSTIC_SEVERITY_ERROR, DIAGNOSTIC_LOGICAL_RANGE_INVERTED, item.Offset,
+						"logical minimum %d is greater than logical maximum %d", global.logicalMinimum, logicalMaximum)
+				}
+			}
+			if global.reportID == 0 && firstNoIDOffset < 0 {
+				firstNoIDOffset = item.Offset
+			}
+			reportBits[validatorReportKey{reportType: reportType, reportID: global.reportID}] += bits
+		case hidreport.HID_REPORT_TAG_COLLECTION:
+			collectionOffsets = append(collectionOffsets, item.Offset)
+		case hidreport.HID_REPORT_TAG_END_COLLECTION:
+			if len(collectionOffsets) == 0 {
+				report(DIAGNOSTIC_SEVERITY_ERROR, DIAGNOSTIC_UNBALANCED_COLLECTION, item.Offset, "end collection without collection")
+				continue
+			}
+			collectionOffsets = collectionOffsets[:len(collectionOffsets)-1]
+
+		// Global items
+		case hidreport.HID_REPORT_TAG_USAGE_PAGE:
+			global.hasUsagePage = true
+		case hidreport.HID_REPORT_TAG_LOGICAL_MINIMUM:
+			global.logicalMinimum = item.Int()
+		case hidreport.HID_REPORT_TAG_LOGICAL_MAXIMUM:
+			global.logicalMaximum = item.Int()
+			global.logicalMaximumSize = len(item.Data)
+		case hidreport.HID_REPORT_TAG_REPORT_SIZE:
+			global.reportSize = item.Uint()
+		case hidreport.HID_REPORT_TAG_REOPORT_COUNT:
+			global.reportCount = item.Uint()
+		case hidreport.HID_REPORT_TAG_REPORT_ID:
+			global.reportID = uint8(item.Uint())
+			if firstIDOffset < 0 {
+				firstIDOffset = item.Offset
+			}
+		case hidreport.HID_REPORT_TAG_PUSH:
+			globalStack = append(globalStack, global)
+		case hidreport.HID_REPORT_TAG_POP:
+			if len(globalStack) == 0 {
+				report(DIAGNOSTIC_SEVERITY_ERROR, DIAGNOSTIC_GLOBAL_STACK_UNDERFLOW, item.Offset, "pop without push")
+				continue
+			}
+			global = globalStack[len(globalStack)-1]
+			globalStack = globalStack[:len(globalStack)-1]
+
+		// Local items
+		case hidreport.HID_REPORT_TAG_USAGE, hidreport.HID_REPORT_TAG_USAGE_MINIMUM, hidreport.HID_REPORT_TAG_USAGE_MAXIMUM:
+			if !global.hasUsagePage && len(item.Data) < 4 {
+				report(DIAGNOSTIC_SEVERITY_ERROR, DIAGNOSTIC_MISSING_USAGE_PAGE, item.Offset, "usage 0x%X is declared before any usage page", item.Uint())
+			}
+		}
+	}
+
+	for _, offset := range collectionOffsets {
+		report(DIAGNOSTIC_SEVERITY_ERROR, DIAGNOSTIC_UNBALANCED_COLLECTION, offset, "collection is not ended")
+	}
+	if firstNoIDOffset >= 0 && firstIDOffset >= 0 {
+		report(DIAGNOSTIC_SEVERITY_ERROR, DIAGNOSTIC_MIXED_REPORT_IDS, firstNoIDOffset,
+			"main item is declared without report ID, while report ID is declared at offset %d", firstIDOffset)
+	}
+
+	keys := make([]validatorReportKey, 0, len(reportBits))
+	for key := range reportBits {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].reportType != keys[j].reportType {
+			return keys[i].reportType < keys[j].reportType
+		}
+		return keys[i].reportID < keys[j].reportID
+	})
+	for _, key := range keys {
+		size := (reportBits[key] + 7) / 8
+		if key.reportID != 0 {
+			size++
+		}
+		if size > uint64(HID_MAX_REPORT_SIZE) {
+			report(DIAGNOSTIC_SEVERITY_ERROR, DIAGNOSTIC_REPORT_TOO_LARGE, -1,
+				"report type %d, ID %d has %d bytes, exceeding %d bytes", key.reportType, key.reportID, size, HID_MAX_REPORT_SIZE)
+			continue
+		}
+		maxPacketSize := 0
+		switch key.reportType {
+		case REPORT_TYPE_INPUT:
+			maxPacketSize = options.MaxInputPacketSize
+		case REPORT_TYPE_OUTPUT:
+			maxPacketSize = options.MaxOutputPacketSize
+		}
+		if maxPacketSize > 0 && size > uint64(maxPacketSize) {
+			report(DIAGNOSTIC_SEVERITY_WARNING, DIAGNOSTIC_REPORT_EXCEEDS_PACKET_SIZE, -1,
+				"report type %d, ID %d has %d bytes, exceeding max packet size %d of interrupt endpoint", key.reportType, key.reportID, size, maxPacketSize)
+		}
+	}
+
+	return diagnostics
+}
@@ -0,0 +1,165 @@
+package hid_test
+
+import (
+	"testing"
+
+	"github.com/ntchjb/gohid/hid"
+	"github.com/stretchr/testify/assert"
+
+	hidreport "github.com/ntchjb/usbip-virtual-device/usb/protocol/hid/report"
+)
+
+func TestValidateReportDescriptor(t *testing.T) {
+	tests := []struct {
+		name        string
+		desc        hidreport.HIDReportDescriptor
+		options     hid.ValidationOptions
+		diagnostics hid.Diagnostics
+	}{
+		{
+			name:    "Valid",
+			desc:    mouseReportDescriptor,
+			options: hid.ValidationOptions{MaxInputPacketSize: 8, MaxOutputPacketSize: 8},
+		},
+		{
+			name: "ItemTruncated",
+			desc: hidreport.HIDReportDescriptor{0x05, 0x01, 0x26, 0xFF},
+			diagnostics: hid.Diagnostics{
+				{Severity: hid.DIAGNOSTIC_SEVERITY_ERROR, Code: hid.DIAGNOSTIC_ITEM_TRUNCATED, Offset: 2},
+			},
+		},
+		{
+			name: "UnknownItem",
+			desc: hidreport.HIDReportDescriptor{
+				0x05, 0x01, // Usage Page (Generic Desktop)
+				0xF1, 0x00, // Reserved main item
+				0xFE, 0x01, 0x10, 0x00, // Long item
+			},
+			diagnostics: hid.Diagnostics{
+				{Severity: hid.DIAGNOSTIC_SEVERITY_WARNING, Code: hid.DIAGNOSTIC_UNKNOWN_ITEM, Offset: 2},
+				{Severity: hid.DIAGNOSTIC_SEVERITY_WARNING, Code: hid.DIAGNOSTIC_UNKNOWN_ITEM, Offset: 4},
+			},
+		},
+		{
+			name: "UnbalancedCollection",
+			desc: hidreport.HIDReportDescriptor{
+				0x05, 0x01, // Usage Page (Generic Desktop)
+				0xC0,       // End Collection
+				0xA1, 0x01, // Collection (Application)
+			},
+			diagnostics: hid.Diagnostics{
+				{Severity: hid.DIAGNOSTIC_SEVERITY_ERROR, Code: hid.DIAGNOSTIC_UNBALANCED_COLLECTION, Offset: 2},
+				{Severity: hid.DIAGNOSTIC_SEVERITY_ERROR, Code: hid.DIAGNOSTIC_UNBALANCED_COLLECTION, Offset: 3},
+			},
+		},
+		{
+			name: "GlobalStackUnderflow",
+			desc: hidreport.HIDReportDescriptor{0xB4},
+			diagnostics: hid.Diagnostics{
+				{Severity: hid.DIAGNOSTIC_SEVERITY_ERROR, Code: hid.DIAGNOSTIC_GLOBAL_STACK_UNDERFLOW, Offset: 0},
+			},
+		},
+		{
+			name: "MissingUsagePage",
+			desc: hidreport.HIDReportDescriptor{
+				0x09, 0x02, // Usage (0x02)
+				0x0B, 0x02, 0x00, 0x01, 0x00, // Usage (Generic Desktop: Mouse)
+			},
+			diagnostics: hid.Diagnostics{
+				{Severity: hid.DIAGNOSTIC_SEVERITY_ERROR, Code: hid.DIAGNOSTIC_MISSING_USAGE_PAGE, Offset: 0},
+			},
+		},
+		{
+			name: "FieldSizeOverflow",
+			desc: hidreport.HIDReportDescriptor{
+				0x85, 0x01, // Report ID (1)
+				0x75, 0x20, // Report Size (32)
+				0x96, 0x00, 0x04, // Report Count (1024)
+				0x81, 0x03, // Input (Const,Var,Abs)
+				0x97, 0x00, 0x00, 0x00, 0x40, // Report Count (0x40000000)
+				0xB1, 0x03, // Feature (Const,Var,Abs)
+			},
+			diagnostics: hid.Diagnostics{
+				{Severity: hid.DIAGNOSTIC_SEVERITY_ERROR, Code: hid.DIAGNOSTIC_FIELD_SIZE_OVERFLOW, Offset: 14},
+				{Severity: hid.DIAGNOSTIC_SEVERITY_ERROR, Code: hid.DIAGNOSTIC_REPORT_TOO_LARGE, Offset: -1},
+				{Severity: hid.DIAGNOSTIC_SEVERITY_ERROR, Code: hid.DIAGNOSTIC_REPORT_TOO_LARGE, Offset: -1},
+			},
+		},
+		{
+			name: "LogicalRangeInverted",
+			desc: hidreport.HIDReportDescriptor{
+				0x15, 0x01, // Logical Minimum (1)
+				0x25, 0x00, // Logical Maximum (0)
+				0x75, 0x08, // Report Size (8)
+				0x95, 0x01, // Report Count (1)
+				0x81, 0x03, // Input (Const,Var,Abs)
+				0x81, 0x02, // Input (Data,Var,Abs)
+				0x26, 0xFF, 0x00, // Logical Maximum (255)
+				0x81, 0x02, // Input (Data,Var,Abs)
+				0x25, 0xFF, // Logical Maximum (255, as unsigned)
+				0x81, 0x02, // Input (Data,Var,Abs)
+			},
+			diagnostics: hid.Diagnostics{
+				{Severity: hid.DIAGNOSTIC_SEVERITY_ERROR, Code: hid.DIAGNOSTIC_LOGICAL_RANGE_INVERTED, Offset: 10},
+			},
+		},
+		{
+			name: "MixedReportIDs",
+			desc: hidreport.HIDReportDescriptor{
+				0x75, 0x08, // Report Size (8)
+				0x95, 0x01, // Report Count (1)
+				0x81, 0x03, // Input (Const,Var,Abs)
+				0x85, 0x01, // Report ID (1)
+				0x81, 0x03, // Input (Const,Var,Abs)
+			},
+			diagnostics: hid.Diagnostics{
+				{Severity: hid.DIAGNOSTIC_SEVERITY_ERROR, Code: hid.DIAGNOSTIC_MIXED_REPORT_IDS, Offset: 4},
+			},
+		},
+		{
+			name:    "ReportExceedsPacketSize",
+			desc:    mouseReportDescriptor,
+			options: hid.ValidationOptions{MaxInputPacketSize: 4, MaxOutputPacketSize: 4},
+			diagnostics: hid.Diagnostics{
+				{Severity: hid.DIAGNOSTIC_SEVERITY_WARNING, Code: hid.DIAGNOSTIC_REPORT_EXCEEDS_PACKET_SIZE, Offset: -1},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			diagnostics := hid.ValidateReportDescriptor(test.desc, test.options)
+			assert.Len(t, diagnostics, len(test.diagnostics))
+			for i := range diagnostics {
+				if i >= len(test.diagnostics) {
+					break
+				}
+				assert.Equal(t, test.diagnostics[i].Severity, diagnostics[i].Severity, diagnostics[i].String())
+				assert.Equal(t, test.diagnostics[i].Code, diagnostics[i].Code, diagnostics[i].String())
+				assert.Equal(t, test.diagnostics[i].Offset, diagnostics[i].Offset, diagnostics[i].String())
+			}
+			hasErrors := false
+			for _, diagnostic := range test.diagnostics {
+				hasErrors = hasErrors || diagnostic.Severity == hid.DIAGNOSTIC_SEVERITY_ERROR
+			}
+			assert.Equal(t, hasErrors, diagnostics.HasErrors())
+		})
+	}
+}
+
+func TestDiagnostic_String(t *testing.T) {
+	assert.Equal(t, "error [unbalanced-collection] at offset 3: collection is not ended", hid.Diagnostic{
+		Severity: hid.DIAGNOSTIC_SEVERITY_ERROR,
+		Code:     hid.DIAGNOSTIC_UNBALANCED_COLLECTION,
+		Offset:   3,
+		Message:  "collection is not ended",
+	}.String())
+	assert.Equal(t, "warning [report-exceeds-packet-size]: too large", hid.Diagnostic{
+		Severity: hid.DIAGNOSTIC_SEVERITY_WARNING,
+		Code:     hid.DIAGNOSTIC_REPORT_EXCEEDS_PACKET_SIZE,
+		Offset:   -1,
+		Message:  "too large",
+	}.String())
+}
@@ -0,0 +1,175 @@
+package hid
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ntchjb/gohid/usb"
+)
+
+const (
+	// Delay before the first retry of a write, if backoff of retry policy is zero
+	DEFAULT_RETRY_BACKOFF = 10 * time.Millisecond
+	// Maximum delay between retries of a write, if maximum backoff of retry policy is zero
+	DEFAULT_RETRY_MAX_BACKOFF = time.Second
+)
+
+// RetryPolicy configures how failed transfers are recovered. Zero value disables all retries.
+type RetryPolicy struct {
+	// Number of retries of a control transfer which fails with timeout or busy error. A stalled control transfer
+	// is not retried, as the request is not supported by the device, and the stall is cleared by the next request.
+	ControlRetries int
+	// Number of retries of an interrupt OUT write which fails with timeout, stall or busy error.
+	// Each retry writes the report to a new stream, after clearing halt of the endpoint if the write is stalled.
+	// As stream writes are asynchronous, the failure may be of a previous report, which is not written again.
+	WriteRetries int
+	// Delay before the first retry of a transfer, which is doubled on each following retry up to MaxBackoff
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+	// Number of consecutive control transfers and writes which still fail with errors retried by this policy,
+	// after which USB port of the device is reset. Zero disables reset.
+	ResetThreshold int
+}
+
+// backoff returns delay before a retry of a transfer, where retry is counted from zero
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	delay := p.Backoff
+	if delay <= 0 {
+		delay = DEFAULT_RETRY_BACKOFF
+	}
+	maxDelay := p.MaxBackoff
+	if maxDelay <= 0 {
+		maxDelay = DEFAULT_RETRY_MAX_BACKOFF
+	}
+	for i := 0; i < retry && delay < maxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, maxDelay)
+}
+
+// isTransient reports whether a failed interrupt transfer may succeed if it is retried
+func isTransient(err error) bool {
+	kind := usb.ErrorKind(err)
+
+	return kind == usb.ErrTimeout || kind == usb.ErrStall || kind == usb.ErrBusy
+}
+
+// isControlTransient reports whether a failed control transfer may succeed if it is retried.
+// Stall of control endpoint is a protocol stall of an unsupported request, which fails again if it is retried.
+func isControlTransient(err error) bool {
+	kind := usb.ErrorKind(err)
+
+	return kind == usb.ErrTimeout || kind == usb.ErrBusy
+}
+
+// control sends a control transfer, which is retried with backoff if it fails with timeout or busy error
+func (d *deviceImpl) control(bmRequestType, bRequest uint8, wValue, wIndex uint16, data []byte) (int, error) {
+	start := time.Now()
+	n, err := d.device.Control(bmRequestType, bRequest, wValue, wIndex, data)
+	for retry := 0; retry < d.dConfig.RetryPolicy.ControlRetries && isControlTransient(err); retry++ {
+		d.logger.Warn("retry control transfer", "request", bRequest, "value", wValue, "retry", retry+1, "err", err)
+		time.Sleep(d.dConfig.RetryPolicy.backoff(retry))
+		start = time.Now()
+		n, err = d.device.Control(bmRequestType, bRequest, wValue, wIndex, data)
+	}
+	d.recordResult(err, isControlTransient(err))
+	if err == nil {
+		d.stats.recordControl(bmRequestType&uint8(SETUP_EP_DIR_IN) != 0, n, time.Since(start))
+	}
+
+	return n, err
+}
+
+// write writes to interrupt OUT endpoint, which is retried with backoff if it fails with a transient error.
+// A stream cannot be written after a failed write, so it is reopened before each retry, or before the next write.
+// The failed write is returned if the stream cannot be reopened.
+func (d *deviceImpl) write(ctx context.Context, data []byte) (int, error) {
+	if d.writerFailed.Swap(false) {
+		if err := d.reopenWriter(d.writerStalled); err != nil {
+			d.writerFailed.Store(true)
+			return 0, err
+		}
+	}
+	n, err := d.writer.WriteContext(ctx, data)
+	for retry := 0; retry < d.dConfig.RetryPolicy.WriteRetries && isTransient(err); retry++ {
+		d.logger.Warn("retry interrupt write", "retry", retry+1, "err", err)
+		select {
+		case <-ctx.Done():
+			return n, err
+		case <-time.After(d.dConfig.RetryPolicy.backoff(retry)):
+		}
+		if reopenErr := d.reopenWriter(usb.ErrorKind(err) == usb.ErrStall); reopenErr != nil {
+			d.logger.Warn("unable to reopen stream of interrupt OUT endpoint", "err", reopenErr)
+			break
+		}
+		n, err = d.writer.WriteContext(ctx, data)
+	}
+	if err != nil {
+		d.failWriter(err)
+	}
+	d.recordResult(err, isTransient(err))
+
+	return n, err
+}
+
+// reopenWriter replaces a failed stream of interrupt OUT endpoint by a new one,
+// after clearing halt of the endpoint if the failed write is stalled
+func (d *deviceImpl) reopenWriter(stalled bool) error {
+	if d.epOut == nil {
+		return fmt.Errorf("interrupt OUT endpoint: %w", ErrUninitializedEndpoint)
+	}
+	if stalled {
+		if err := d.epOut.ClearHalt(); err != nil {
+			return fmt.Errorf("unable to clear halt of interrupt OUT endpoint: %w", err)
+		}
+	}
+	// Closing a failed stream returns error of its failed transfer, which is already handled
+	if err := d.writer.Close(); err != nil {
+		d.logger.Debug("close failed stream of interrupt OUT endpoint", "err", err)
+	}
+	writer, err := d.epOut.NewStream(d.dConfig.StreamLaneCount)
+	if err != nil {
+		return fmt.Errorf("unable to reopen stream writer: %w", err)
+	}
+	d.writer = writer
+
+	return nil
+}
+
+// failWriter marks the stream of interrupt OUT endpoint to be reopened before the next write, after a write fails,
+// unless the device is gone
+func (d *deviceImpl) failWriter(err error) {
+	if usb.ErrorKind(err) == usb.ErrDisconnected {
+		return
+	}
+	d.writerStalled = usb.ErrorKind(err) == usb.ErrStall
+	d.writerFailed.Store(true)
+}
+
+// recordResult counts consecutive transfers which fail with transient errors, and resets USB port of the device
+// when the count reaches reset threshold. Transfers of interrupt streams are cancelled by the reset,
+// so the streams are reopened before their next use.
+func (d *deviceImpl) recordResult(err error, transient bool) {
+	threshold := d.dConfig.RetryPolicy.ResetThreshold
+	if threshold <= 0 {
+		return
+	}
+	if err == nil {
+		d.failures.Store(0)
+		return
+	}
+	if !transient || d.failures.Add(1) < int32(threshold) {
+		return
+	}
+
+	d.failures.Store(0)
+	d.logger.Warn("reset device after repeated failures", "failures", threshold, "err", err)
+	if resetErr := d.device.Reset(); resetErr != nil {
+		d.logger.Error("unable to reset device", "err", resetErr)
+		return
+	}
+	d.readerFailed.Store(true)
+	d.writerFailed.Store(true)
+}
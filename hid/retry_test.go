@@ -0,0 +1,287 @@
+package hid_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/gousb"
+	"github.com/ntchjb/gohid/hid"
+	"github.com/ntchjb/gohid/usb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// failStream makes the first write to a stream fail, after which the stream is dead as streams of gousb are,
+// so that its writes return io.ErrClosedPipe
+func failStream(stream *usb.MockStreamWriter, err error) *gomock.Call {
+	failed := stream.EXPECT().WriteContext(gomock.Any(), []byte{0x01, 0x02}).Return(0, err)
+	stream.EXPECT().WriteContext(gomock.Any(), gomock.Any()).Return(0, io.ErrClosedPipe).AnyTimes().After(failed)
+
+	return failed
+}
+
+// reopenStream expects a failed stream to be closed, and a new stream of interrupt OUT endpoint to be created
+func reopenStream(m mocks, failed, next *usb.MockStreamWriter, err error) []any {
+	return []any{
+		failed.EXPECT().Close().Return(err),
+		m.epOut.EXPECT().NewStream(hid.DEFAULT_ENDPOINT_STREAM_COUNT).Return(next, nil),
+	}
+}
+
+func TestDevice_RetryPolicy(t *testing.T) {
+	errControl := errors.New("control transfer error")
+	ctx := context.Background()
+	policy := hid.RetryPolicy{
+		ControlRetries: 1,
+		WriteRetries:   2,
+		Backoff:        time.Millisecond,
+		MaxBackoff:     2 * time.Millisecond,
+	}
+	getFeature := func(m mocks) *gomock.Call {
+		return m.device.EXPECT().Control(uint8(0b1010_0001), uint8(0x01), uint16(0x0301), uint16(0x0001), gomock.Any())
+	}
+
+	tests := []struct {
+		name   string
+		mocks  func(ctrl *gomock.Controller, m mocks)
+		policy hid.RetryPolicy
+		call   func(device hid.Device) (int, error)
+		n      int
+		err    error
+	}{
+		{
+			name: "Control_TimeoutRetried",
+			mocks: func(ctrl *gomock.Controller, m mocks) {
+				gomock.InOrder(
+					getFeature(m).Return(0, gousb.ErrorTimeout),
+					getFeature(m).Return(2, nil),
+				)
+			},
+			policy: policy,
+			call: func(device hid.Device) (int, error) {
+				return device.GetFeatureReport([]byte{0x01, 0x00})
+			},
+			n: 2,
+		},
+		{
+			name: "Control_StallNotRetried",
+			mocks: func(ctrl *gomock.Controller, m mocks) {
+				getFeature(m).Return(0, gousb.ErrorPipe)
+			},
+			policy: policy,
+			call: func(device hid.Device) (int, error) {
+				return device.GetFeatureReport([]byte{0x01, 0x00})
+			},
+			err: usb.ErrStall,
+		},
+		{
+			name: "Control_RetriesExhausted",
+			mocks: func(ctrl *gomock.Controller, m mocks) {
+				getFeature(m).Return(0, gousb.ErrorBusy).Times(2)
+			},
+			policy: policy,
+			call: func(device hid.Device) (int, error) {
+				return device.GetFeatureReport([]byte{0x01, 0x00})
+			},
+			err: usb.ErrBusy,
+		},
+		{
+			name: "Control_OtherErrorNotRetried",
+			mocks: func(ctrl *gomock.Controller, m mocks) {
+				getFeature(m).Return(0, errControl)
+			},
+			policy: policy,
+			call: func(device hid.Device) (int, error) {
+				return device.GetFeatureReport([]byte{0x01, 0x00})
+			},
+			err: errControl,
+		},
+		{
+			name: "Write_StallCleared",
+			mocks: func(ctrl *gomock.Controller, m mocks) {
+				next := usb.NewMockStreamWriter(ctrl)
+				calls := []any{failStream(m.writer, gousb.TransferStall), m.epOut.EXPECT().ClearHalt().Return(nil)}
+				calls = append(calls, reopenStream(m, m.writer, next, gousb.TransferStall)...)
+				gomock.InOrder(append(calls, next.EXPECT().WriteContext(ctx, []byte{0x01, 0x02}).Return(2, nil))...)
+			},
+			policy: policy,
+			call: func(device hid.Device) (int, error) {
+				return device.WriteOutput(ctx, []byte{0x01, 0x02})
+			},
+			n: 2,
+		},
+		{
+			name: "Write_TimeoutRetried",
+			mocks: func(ctrl *gomock.Controller, m mocks) {
+				second := usb.NewMockStreamWriter(ctrl)
+				third := usb.NewMockStreamWriter(ctrl)
+				calls := []any{failStream(m.writer, gousb.TransferTimedOut)}
+				calls = append(calls, reopenStream(m, m.writer, second, nil)...)
+				calls = append(calls, failStream(second, gousb.ErrorBusy))
+				calls = append(calls, reopenStream(m, second, third, nil)...)
+				gomock.InOrder(append(calls, third.EXPECT().WriteContext(ctx, []byte{0x01, 0x02}).Return(2, nil))...)
+			},
+			policy: policy,
+			call: func(device hid.Device) (int, error) {
+				return device.WriteOutput(ctx, []byte{0x01, 0x02})
+			},
+			n: 2,
+		},
+		{
+			name: "Write_RetriesExhausted",
+			mocks: func(ctrl *gomock.Controller, m mocks) {
+				second := usb.NewMockStreamWriter(ctrl)
+				third := usb.NewMockStreamWriter(ctrl)
+				calls := []any{failStream(m.writer, gousb.TransferTimedOut)}
+				calls = append(calls, reopenStream(m, m.writer, second, nil)...)
+				calls = append(calls, failStream(second, gousb.TransferTimedOut))
+				calls = append(calls, reopenStream(m, second, third, nil)...)
+				gomock.InOrder(append(calls, failStream(third, gousb.TransferTimedOut))...)
+			},
+			policy: policy,
+			call: func(device hid.Device) (int, error) {
+				return device.WriteOutput(ctx, []byte{0x01, 0x02})
+			},
+			err: usb.ErrTimeout,
+		},
+		{
+			name: "Write_ClearHaltFailed",
+			mocks: func(ctrl *gomock.Controller, m mocks) {
+				gomock.InOrder(
+					failStream(m.writer, gousb.TransferStall),
+					m.epOut.EXPECT().ClearHalt().Return(gousb.ErrorNoDevice),
+				)
+			},
+			policy: policy,
+			call: func(device hid.Device) (int, error) {
+				return device.WriteOutput(ctx, []byte{0x01, 0x02})
+			},
+			err: usb.ErrStall,
+		},
+		{
+			name: "Write_ReopenFailed",
+			mocks: func(ctrl *gomock.Controller, m mocks) {
+				gomock.InOrder(
+					failStream(m.writer, gousb.TransferTimedOut),
+					m.writer.EXPECT().Close().Return(gousb.TransferTimedOut),
+					m.epOut.EXPECT().NewStream(hid.DEFAULT_ENDPOINT_STREAM_COUNT).Return(nil, gousb.ErrorNoMem),
+				)
+			},
+			policy: policy,
+			call: func(device hid.Device) (int, error) {
+				return device.WriteOutput(ctx, []byte{0x01, 0x02})
+			},
+			err: usb.ErrTimeout,
+		},
+		{
+			name: "Write_DisconnectedNotRetried",
+			mocks: func(ctrl *gomock.Controller, m mocks) {
+				m.writer.EXPECT().WriteContext(ctx, []byte{0x01, 0x02}).Return(0, gousb.TransferNoDevice)
+			},
+			policy: policy,
+			call: func(device hid.Device) (int, error) {
+				return device.WriteOutput(ctx, []byte{0x01, 0x02})
+			},
+			err: usb.ErrDisconnected,
+		},
+		{
+			name: "Write_ContextCanceled",
+			mocks: func(ctrl *gomock.Controller, m mocks) {
+				m.writer.EXPECT().WriteContext(gomock.Any(), []byte{0x01, 0x02}).Return(0, gousb.TransferTimedOut)
+			},
+			policy: hid.RetryPolicy{WriteRetries: 1, Backoff: time.Hour},
+			call: func(device hid.Device) (int, error) {
+				ctx, cancel := context.WithCancel(ctx)
+				cancel()
+
+				return device.WriteOutput(ctx, []byte{0x01, 0x02})
+			},
+			err: usb.ErrTimeout,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			m := createSetupTargetMocks(ctrl, 1, 1, 0, 1, 1)
+			test.mocks(ctrl, m)
+
+			hidDevice, err := hid.NewDevice(m.device, hid.DeviceConfig{
+				StreamLaneCount: hid.DEFAULT_ENDPOINT_STREAM_COUNT,
+				RetryPolicy:     test.policy,
+			}, slog.Default())
+			require.NoError(t, err)
+			require.NoError(t, hidDevice.SetTarget(1, 1, 0))
+
+			n, err := test.call(hidDevice)
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.n, n)
+			}
+		})
+	}
+}
+
+func TestDevice_RetryPolicy_Reset(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	m := createSetupTargetMocks(ctrl, 1, 1, 0, 1, 1)
+	writers := []*usb.MockStreamWriter{m.writer, usb.NewMockStreamWriter(ctrl), usb.NewMockStreamWriter(ctrl), usb.NewMockStreamWriter(ctrl)}
+	// reopen expects a failed stream to be closed and replaced by the next one
+	reopen := func(i int) []any {
+		return reopenStream(m, writers[i-1], writers[i], nil)
+	}
+	write := func(i int) *gomock.Call {
+		return writers[i].EXPECT().WriteContext(ctx, gomock.Any())
+	}
+	reader := usb.NewMockStreamReader(ctrl)
+	calls := []any{
+		// Stalled control transfers of unsupported requests are not counted
+		m.device.EXPECT().Control(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(0, gousb.ErrorPipe).Times(2),
+		write(0).Return(0, gousb.TransferTimedOut),
+	}
+	calls = append(calls, reopen(1)...)
+	calls = append(calls, write(1).Return(2, nil), write(1).Return(0, gousb.TransferTimedOut))
+	calls = append(calls, reopen(2)...)
+	calls = append(calls, write(2).Return(0, gousb.TransferTimedOut), m.device.EXPECT().Reset().Return(nil))
+	// Streams are cancelled by the reset, so they are reopened before they are used again
+	calls = append(calls, reopen(3)...)
+	calls = append(calls,
+		write(3).Return(2, nil),
+		m.reader.EXPECT().Close().Return(nil),
+		m.epIn.EXPECT().NewStream(hid.DEFAULT_ENDPOINT_STREAM_COUNT).Return(reader, nil),
+		reader.EXPECT().ReadContext(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, data []byte) (int, error) {
+			return copy(data, []byte{0x01, 0x02}), nil
+		}),
+	)
+	gomock.InOrder(calls...)
+
+	hidDevice, err := hid.NewDevice(m.device, hid.DeviceConfig{
+		StreamLaneCount: hid.DEFAULT_ENDPOINT_STREAM_COUNT,
+		RetryPolicy:     hid.RetryPolicy{ResetThreshold: 2},
+	}, slog.Default())
+	require.NoError(t, err)
+	require.NoError(t, hidDevice.SetTarget(1, 1, 0))
+
+	for range 2 {
+		_, _ = hidDevice.GetFeatureReport([]byte{0x01, 0x00})
+	}
+	// Count of consecutive failures is restarted by a success, and after the device is reset
+	for range 4 {
+		_, _ = hidDevice.WriteOutput(ctx, []byte{0x01, 0x02})
+	}
+	n, err := hidDevice.WriteOutput(ctx, []byte{0x01, 0x02})
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	data := make([]byte, 8)
+	n, err = hidDevice.ReadInput(ctx, data)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x01, 0x02}, data[:n])
+}
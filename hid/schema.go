@@ -1,5 +1,7 @@
 package hid
 
+import "github.com/ntchjb/gohid/usb"
+
 const (
 	HID_CLASS_ID        uint8  = 3
 	HID_MAX_REPORT_SIZE uint16 = 4096
@@ -20,35 +22,41 @@ const (
 	HID_PROTOCOL_MOUSE    HIDProtocol = 2
 )
 
+// Protocols selected by HID SET_PROTOCOL request, where Report protocol is selected after reset
+const (
+	PROTOCOL_BOOT   = 0
+	PROTOCOL_REPORT = 1
+)
+
 type SetupRequestType uint8
 
 const (
-	SETUP_REQUEST_TYPE_STANDARD SetupRequestType = 0x00 << 5
-	SETUP_REQUEST_TYPE_CLASS    SetupRequestType = 0x01 << 5
-	SETUP_REQUEST_TYPE_VENDOR   SetupRequestType = 0x02 << 5
-	SETUP_REQUEST_TYPE_RESERVED SetupRequestType = 0x03 << 5
+	SETUP_REQUEST_TYPE_STANDARD SetupRequestType = usb.REQUEST_TYPE_STANDARD
+	SETUP_REQUEST_TYPE_CLASS    SetupRequestType = usb.REQUEST_TYPE_CLASS
+	SETUP_REQUEST_TYPE_VENDOR   SetupRequestType = usb.REQUEST_TYPE_VENDOR
+	SETUP_REQUEST_TYPE_RESERVED SetupRequestType = usb.REQUEST_TYPE_RESERVED
 )
 
 type SetupRequestRecipient uint8
 
 const (
-	SETUP_RECIPIENT_DEVICE    SetupRequestRecipient = 0x00
-	SETUP_RECIPIENT_INTERFACE SetupRequestRecipient = 0x01
-	SETUP_RECIPIENT_ENDPOINT  SetupRequestRecipient = 0x02
-	SETUP_RECIPIENT_OTHER     SetupRequestRecipient = 0x03
+	SETUP_RECIPIENT_DEVICE    SetupRequestRecipient = usb.RECIPIENT_DEVICE
+	SETUP_RECIPIENT_INTERFACE SetupRequestRecipient = usb.RECIPIENT_INTERFACE
+	SETUP_RECIPIENT_ENDPOINT  SetupRequestRecipient = usb.RECIPIENT_ENDPOINT
+	SETUP_RECIPIENT_OTHER     SetupRequestRecipient = usb.RECIPIENT_OTHER
 )
 
 type SetupEndpointDirection uint8
 
 const (
-	SETUP_EP_DIR_OUT SetupEndpointDirection = 0x00
-	SETUP_EP_DIR_IN  SetupEndpointDirection = 0x80
+	SETUP_EP_DIR_OUT SetupEndpointDirection = usb.REQUEST_DIRECTION_OUT
+	SETUP_EP_DIR_IN  SetupEndpointDirection = usb.REQUEST_DIRECTION_IN
 )
 
 type SetupRequest uint8
 
 const (
-	SETUP_REQUEST_GET_DESCRIPTOR SetupRequest = 0x06
+	SETUP_REQUEST_GET_DESCRIPTOR SetupRequest = usb.REQUEST_GET_DESCRIPTOR
 
 	SETUP_REQUEST_HID_GET_REPORT   SetupRequest = 0x01
 	SETUP_REQUEST_HID_GET_IDLE     SetupRequest = 0x02
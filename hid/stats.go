@@ -0,0 +1,252 @@
+package hid
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/ntchjb/gohid/usb"
+)
+
+const (
+	// Number of buckets of histograms, where the last bucket counts durations longer than all bounds
+	HISTOGRAM_BUCKET_COUNT = 16
+	// Gain of inter-arrival jitter, which smooths jitter over about 16 reports as RTP of RFC 3550
+	JITTER_GAIN = 1.0 / 16
+)
+
+// Upper bounds of histogram buckets, doubling from 62.5µs so that intervals of 8 kHz, 1 kHz and 125 Hz polling
+// are bucket bounds
+var histogramBounds = func() [HISTOGRAM_BUCKET_COUNT - 1]time.Duration {
+	var bounds [HISTOGRAM_BUCKET_COUNT - 1]time.Duration
+	bound := 62500 * time.Nanosecond
+	for i := range bounds {
+		bounds[i] = bound
+		bound *= 2
+	}
+
+	return bounds
+}()
+
+// Histogram is a distribution of durations. Counts[i] is the number of durations longer than Bounds[i-1]
+// and up to Bounds[i], where the last count is of durations longer than all bounds.
+type Histogram struct {
+	Bounds []time.Duration `json:"bounds"`
+	Counts []uint64        `json:"counts"`
+	Count  uint64          `json:"count"`
+	Sum    time.Duration   `json:"sum"`
+	Min    time.Duration   `json:"min"`
+	Max    time.Duration   `json:"max"`
+}
+
+// Mean returns mean of durations, or zero if there is no duration
+func (h Histogram) Mean() time.Duration {
+	if h.Count == 0 {
+		return 0
+	}
+
+	return h.Sum / time.Duration(h.Count)
+}
+
+// Quantile returns upper bound of the bucket containing q-quantile of durations, e.g. 0.99,
+// which is Max if it is in the last bucket
+func (h Histogram) Quantile(q float64) time.Duration {
+	if h.Count == 0 {
+		return 0
+	}
+	rank := uint64(math.Ceil(q * float64(h.Count)))
+	var count uint64
+	for i, c := range h.Counts {
+		count += c
+		if count >= rank && i < len(h.Bounds) {
+			return min(h.Bounds[i], h.Max)
+		}
+	}
+
+	return h.Max
+}
+
+// Stats is a snapshot of statistics of a device, collected since its target is set.
+// Input reports are timed when ReadInput returns them rather than when their transfers complete, as streams of gousb
+// do not expose completion time, so their rate, intervals and jitter include delays of the caller reading them,
+// e.g. reports queued in stream lanes while the caller is busy are timed back to back.
+type Stats struct {
+	// Time when the target is set, or zero if it is not set, and time when this snapshot is taken
+	Since time.Time `json:"since"`
+	Time  time.Time `json:"time"`
+
+	// Number of Input reports read from interrupt IN endpoint
+	InputReports uint64 `json:"inputReports"`
+	// Input reports per second between the first and the last Input report.
+	// Rate of a period is calculated from differences of snapshots taken at its start and end.
+	InputReportRate float64 `json:"inputReportRate"`
+	// Intervals between consecutive Input reports
+	InputIntervals Histogram `json:"inputIntervals"`
+	// Inter-arrival jitter of Input reports, which is smoothed difference between consecutive intervals
+	// as RTP of RFC 3550
+	InputJitter time.Duration `json:"inputJitter"`
+	// Number of Output reports written to interrupt OUT endpoint or sent by Set_Report
+	OutputReports uint64 `json:"outputReports"`
+	// Round-trip times of successful control transfers, from sending request until its response is received
+	ControlRoundTrips Histogram `json:"controlRoundTrips"`
+
+	// Bytes received and sent by interrupt and control transfers
+	BytesRead    uint64 `json:"bytesRead"`
+	BytesWritten uint64 `json:"bytesWritten"`
+	// Number of failed operations by operation, e.g. usb.OPERATION_READ
+	Errors map[usb.Operation]uint64 `json:"errors"`
+}
+
+// histogram collects durations without allocating
+type histogram struct {
+	counts [HISTOGRAM_BUCKET_COUNT]uint64
+	count  uint64
+	sum    time.Duration
+	min    time.Duration
+	max    time.Duration
+}
+
+func (h *histogram) add(d time.Duration) {
+	bucket := len(histogramBounds)
+	for i, bound := range histogramBounds {
+		if d <= bound {
+			bucket = i
+			break
+		}
+	}
+	h.counts[bucket]++
+	if h.count == 0 || d < h.min {
+		h.min = d
+	}
+	h.max = max(h.max, d)
+	h.count++
+	h.sum += d
+}
+
+func (h *histogram) snapshot() Histogram {
+	return Histogram{
+		Bounds: append([]time.Duration(nil), histogramBounds[:]...),
+		Counts: append([]uint64(nil), h.counts[:]...),
+		Count:  h.count,
+		Sum:    h.sum,
+		Min:    h.min,
+		Max:    h.max,
+	}
+}
+
+// stats collects statistics of a device, which are updated by concurrent transfers
+type stats struct {
+	mu    sync.Mutex
+	since time.Time
+
+	inputReports  uint64
+	firstInput    time.Time
+	lastInput     time.Time
+	lastInterval  time.Duration
+	inputInterval histogram
+	// Jitter in nanoseconds
+	jitter        float64
+	outputReports uint64
+	controlRTT    histogram
+
+	bytesRead    uint64
+	bytesWritten uint64
+	errors       map[usb.Operation]uint64
+}
+
+func newStats() *stats {
+	return &stats{
+		errors: make(map[usb.Operation]uint64),
+	}
+}
+
+// reset clears statistics, which are then collected since given time
+func (s *stats) reset(since time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.since = since
+	s.inputReports, s.outputReports = 0, 0
+	s.firstInput, s.lastInput, s.lastInterval = time.Time{}, time.Time{}, 0
+	s.inputInterval, s.controlRTT = histogram{}, histogram{}
+	s.jitter = 0
+	s.bytesRead, s.bytesWritten = 0, 0
+	clear(s.errors)
+}
+
+// recordInput records an Input report of n bytes received at given time
+func (s *stats) recordInput(n int, at time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.bytesRead += uint64(n)
+	s.inputReports++
+	if s.inputReports == 1 {
+		s.firstInput = at
+		s.lastInput = at
+		return
+	}
+	interval := at.Sub(s.lastInput)
+	s.lastInput = at
+	s.inputInterval.add(interval)
+	if s.inputReports > 2 {
+		diff := math.Abs(float64(interval - s.lastInterval))
+		s.jitter += (diff - s.jitter) * JITTER_GAIN
+	}
+	s.lastInterval = interval
+}
+
+// recordOutput records an Output report of n bytes
+func (s *stats) recordOutput(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.outputReports++
+	s.bytesWritten += uint64(n)
+}
+
+// recordControl records a successful control transfer of n bytes in given direction, and its round-trip time
+func (s *stats) recordControl(in bool, n int, rtt time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if in {
+		s.bytesRead += uint64(n)
+	} else {
+		s.bytesWritten += uint64(n)
+	}
+	s.controlRTT.add(rtt)
+}
+
+func (s *stats) recordError(op usb.Operation) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.errors[op]++
+}
+
+func (s *stats) snapshot() Stats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snapshot := Stats{
+		Since:             s.since,
+		Time:              time.Now(),
+		InputReports:      s.inputReports,
+		InputIntervals:    s.inputInterval.snapshot(),
+		InputJitter:       time.Duration(s.jitter),
+		OutputReports:     s.outputReports,
+		ControlRoundTrips: s.controlRTT.snapshot(),
+		BytesRead:         s.bytesRead,
+		BytesWritten:      s.bytesWritten,
+		Errors:            make(map[usb.Operation]uint64, len(s.errors)),
+	}
+	if elapsed := s.lastInput.Sub(s.firstInput); elapsed > 0 {
+		snapshot.InputReportRate = float64(s.inputReports-1) / elapsed.Seconds()
+	}
+	for op, count := range s.errors {
+		snapshot.Errors[op] = count
+	}
+
+	return snapshot
+}
@@ -0,0 +1,121 @@
+package hid_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/ntchjb/gohid/hid"
+	"github.com/ntchjb/gohid/usb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDevice_Stats(t *testing.T) {
+	errInterrupt := errors.New("interrupt transfer error")
+	errControl := errors.New("control transfer error")
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	m := createSetupTargetMocks(ctrl, 1, 1, 0, 1, 1)
+	gomock.InOrder(
+		m.reader.EXPECT().ReadContext(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, data []byte) (int, error) {
+			return copy(data, []byte{0x01, 0x02, 0x03}), nil
+		}).Times(3),
+		m.reader.EXPECT().ReadContext(ctx, gomock.Any()).Return(0, errInterrupt),
+	)
+	m.writer.EXPECT().WriteContext(ctx, []byte{0x01, 0x02}).Return(2, nil)
+	gomock.InOrder(
+		m.device.EXPECT().Control(uint8(0b1010_0001), uint8(0x01), uint16(0x0301), uint16(0x0001), gomock.Any()).Return(4, nil),
+		m.device.EXPECT().Control(uint8(0b0010_0001), uint8(0x09), uint16(0x0301), uint16(0x0001), gomock.Any()).Return(5, nil),
+		m.device.EXPECT().Control(uint8(0b1010_0001), uint8(0x01), uint16(0x0302), uint16(0x0001), gomock.Any()).Return(0, errControl),
+	)
+
+	hidDevice, err := hid.NewDevice(m.device, config, slog.Default())
+	require.NoError(t, err)
+	start := time.Now()
+	require.NoError(t, hidDevice.SetTarget(1, 1, 0))
+
+	buf := make([]byte, 64)
+	for range 3 {
+		_, err := hidDevice.ReadInput(ctx, buf)
+		require.NoError(t, err)
+	}
+	_, err = hidDevice.ReadInput(ctx, buf)
+	assert.ErrorIs(t, err, errInterrupt)
+	_, err = hidDevice.WriteOutput(ctx, []byte{0x00, 0x01, 0x02})
+	require.NoError(t, err)
+	_, err = hidDevice.GetFeatureReport([]byte{0x01, 0x00, 0x00, 0x00, 0x00})
+	require.NoError(t, err)
+	_, err = hidDevice.SendFeatureReport([]byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06})
+	require.NoError(t, err)
+	_, err = hidDevice.GetFeatureReport([]byte{0x02, 0x00})
+	assert.ErrorIs(t, err, errControl)
+
+	stats := hidDevice.(hid.StatsProvider).Stats()
+	assert.False(t, stats.Since.Before(start))
+	assert.False(t, stats.Time.Before(stats.Since))
+	assert.Equal(t, uint64(3), stats.InputReports)
+	assert.Positive(t, stats.InputReportRate)
+	assert.Equal(t, uint64(2), stats.InputIntervals.Count)
+	assert.Len(t, stats.InputIntervals.Counts, hid.HISTOGRAM_BUCKET_COUNT)
+	assert.Len(t, stats.InputIntervals.Bounds, hid.HISTOGRAM_BUCKET_COUNT-1)
+	// Bounds of a snapshot are not shared with other snapshots
+	bound := stats.InputIntervals.Bounds[0]
+	stats.InputIntervals.Bounds[0] = 0
+	assert.Equal(t, bound, hidDevice.(hid.StatsProvider).Stats().InputIntervals.Bounds[0])
+	assert.LessOrEqual(t, stats.InputIntervals.Min, stats.InputIntervals.Max)
+	assert.Equal(t, uint64(1), stats.OutputReports)
+	assert.Equal(t, uint64(2), stats.ControlRoundTrips.Count)
+	assert.Equal(t, uint64(3*3+4), stats.BytesRead)
+	assert.Equal(t, uint64(2+5), stats.BytesWritten)
+	assert.Equal(t, map[usb.Operation]uint64{
+		usb.OPERATION_READ:    1,
+		usb.OPERATION_CONTROL: 1,
+	}, stats.Errors)
+
+	// Statistics are collected again once the target is set
+	m = createSetupTargetMocks(ctrl, 1, 1, 0, 1, 1)
+	hidDevice, err = hid.NewDevice(m.device, config, slog.Default())
+	require.NoError(t, err)
+	assert.True(t, hidDevice.(hid.StatsProvider).Stats().Since.IsZero())
+	require.NoError(t, hidDevice.SetTarget(1, 1, 0))
+	stats = hidDevice.(hid.StatsProvider).Stats()
+	assert.Zero(t, stats.InputReports)
+	assert.Zero(t, stats.InputReportRate)
+	assert.Empty(t, stats.Errors)
+}
+
+func TestHistogram(t *testing.T) {
+	histogram := hid.Histogram{
+		Bounds: []time.Duration{time.Millisecond, 2 * time.Millisecond, 4 * time.Millisecond},
+		Counts: []uint64{2, 5, 2, 1},
+		Count:  10,
+		Sum:    25 * time.Millisecond,
+		Min:    500 * time.Microsecond,
+		Max:    9 * time.Millisecond,
+	}
+
+	tests := []struct {
+		name     string
+		q        float64
+		expected time.Duration
+	}{
+		{name: "Min", q: 0, expected: time.Millisecond},
+		{name: "FirstBucket", q: 0.2, expected: time.Millisecond},
+		{name: "Median", q: 0.5, expected: 2 * time.Millisecond},
+		{name: "LastBound", q: 0.9, expected: 4 * time.Millisecond},
+		{name: "Overflow", q: 0.99, expected: 9 * time.Millisecond},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, histogram.Quantile(test.q))
+		})
+	}
+	assert.Equal(t, 2500*time.Microsecond, histogram.Mean())
+	assert.Zero(t, hid.Histogram{}.Mean())
+	assert.Zero(t, hid.Histogram{}.Quantile(0.5))
+}
@@ -0,0 +1,332 @@
+package lamparray
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"time"
+
+	"github.com/ntchjb/gohid/hid"
+)
+
+var (
+	ErrNoLampArrayFound   = errors.New("no LampArray found")
+	ErrReportNotSupported = errors.New("report is not supported by LampArray")
+	ErrLampIDOutOfRange   = errors.New("lamp ID is out of range")
+)
+
+// Attributes of a LampArray, read from LampArrayAttributesReport
+type Attributes struct {
+	LampCount int
+	// Size of bounding box of all lamps, in micrometers
+	Width  int
+	Height int
+	Depth  int
+	Kind   Kind
+	// Minimum interval between lamp updates that the device supports
+	MinUpdateInterval time.Duration
+}
+
+// Lamp is attributes of a lamp, read from LampAttributesResponseReport
+type Lamp struct {
+	ID uint16
+	// Position of the lamp from top-left-front corner of the bounding box, in micrometers
+	X, Y, Z  int
+	Purposes Purposes
+	// Time the lamp takes to change its color after an update
+	UpdateLatency time.Duration
+	// Numbers of levels of each color channel. A lamp with a level count of 1 cannot change that channel.
+	RedLevelCount       int
+	GreenLevelCount     int
+	BlueLevelCount      int
+	IntensityLevelCount int
+	// Whether color of the lamp can be changed
+	IsProgrammable bool
+	// Usage on Keyboard/Keypad usage page of the key the lamp belongs to, or zero if it is not bound to a key
+	InputBinding uint16
+}
+
+// Color is a color of a lamp. Each channel ranges from 0 to 255 and is scaled to level counts of a lamp when sent.
+type Color struct {
+	Red       uint8
+	Green     uint8
+	Blue      uint8
+	Intensity uint8
+}
+
+// LampColor is a color to be set to a lamp
+type LampColor struct {
+	LampID uint16
+	Color  Color
+}
+
+func quantize(value uint8, levelCount int) int32 {
+	if levelCount <= 1 {
+		return 0
+	}
+
+	return int32(math.Round(float64(value) * float64(levelCount-1) / math.MaxUint8))
+}
+
+// Quantize scales a color to level counts of this lamp
+func (l Lamp) Quantize(color Color) [4]int32 {
+	return [4]int32{
+		quantize(color.Red, l.RedLevelCount),
+		quantize(color.Green, l.GreenLevelCount),
+		quantize(color.Blue, l.BlueLevelCount),
+		quantize(color.Intensity, l.IntensityLevelCount),
+	}
+}
+
+// LampArray controls lamps of a HID device implementing LampArray of Lighting and Illumination usage page
+type LampArray interface {
+	// Get attributes of the LampArray
+	Attributes() Attributes
+	// Get attributes of all lamps, ordered by lamp ID
+	Lamps() []Lamp
+	// Enable or disable autonomous mode. Lamps can only be updated by host when autonomous mode is disabled.
+	SetAutonomousMode(enabled bool) error
+	// Set colors of lamps, split into as many LampMultiUpdateReports as needed.
+	// The last report is flagged as complete so that the device applies all colors at once.
+	SetColors(colors []LampColor) error
+	// Set a color to lamps from start ID to end ID, inclusive, via LampRangeUpdateReport
+	SetRangeColor(start, end uint16, color Color) error
+}
+
+type lampArrayImpl struct {
+	device hid.Device
+
+	attributes  Attributes
+	lamps       []Lamp
+	control     *report
+	multiUpdate *report
+	rangeUpdate *report
+
+	logger *slog.Logger
+}
+
+// NewLampArray gets report descriptor from an opened HID device, and reads attributes of the LampArray
+// and all of its lamps via Feature reports
+func NewLampArray(device hid.Device, logger *slog.Logger) (LampArray, error) {
+	if device == nil {
+		return nil, hid.ErrDeviceIsNil
+	}
+	desc, err := device.GetReportDescriptor()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get report descriptor: %w", err)
+	}
+	schema, err := hid.ParseReportDescriptor(desc)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse report descriptor: %w", err)
+	}
+	collections := schema.FindCollections(hid.NewUsage(USAGE_PAGE_LIGHTING_AND_ILLUMINATION, USAGE_LAMP_ARRAY))
+	if len(collections) == 0 {
+		return nil, ErrNoLampArrayFound
+	}
+	collection := collections[0]
+
+	reports := make(map[uint16]*report)
+	for _, usage := range []uint16{
+		USAGE_LAMP_ARRAY_ATTRIBUTES_REPORT,
+		USAGE_LAMP_ATTRIBUTES_REQUEST_REPORT,
+		USAGE_LAMP_ATTRIBUTES_RESPONSE_REPORT,
+		USAGE_LAMP_MULTI_UPDATE_REPORT,
+		USAGE_LAMP_RANGE_UPDATE_REPORT,
+		USAGE_LAMP_ARRAY_CONTROL_REPORT,
+	} {
+		r, ok := findReport(collection, schema, usage)
+		if !ok {
+			return nil, fmt.Errorf("report 0x%02X: %w", usage, ErrReportNotSupported)
+		}
+		reports[usage] = r
+	}
+
+	l := &lampArrayImpl{
+		device:      device,
+		control:     reports[USAGE_LAMP_ARRAY_CONTROL_REPORT],
+		multiUpdate: reports[USAGE_LAMP_MULTI_UPDATE_REPORT],
+		rangeUpdate: reports[USAGE_LAMP_RANGE_UPDATE_REPORT],
+		logger:      logger,
+	}
+	if err := l.readAttributes(reports[USAGE_LAMP_ARRAY_ATTRIBUTES_REPORT]); err != nil {
+		return nil, err
+	}
+	for id := 0; id < l.attributes.LampCount; id++ {
+		lamp, err := l.readLamp(reports[USAGE_LAMP_ATTRIBUTES_REQUEST_REPORT], reports[USAGE_LAMP_ATTRIBUTES_RESPONSE_REPORT], uint16(id))
+		if err != nil {
+			return nil, err
+		}
+		l.lamps = append(l.lamps, lamp)
+	}
+
+	return l, nil
+}
+
+func (l *lampArrayImpl) readAttributes(r *report) error {
+	buf := r.layout.NewBuffer()
+	if _, err := l.device.GetFeatureReport(buf); err != nil {
+		return fmt.Errorf("unable to get LampArrayAttributesReport: %w", err)
+	}
+	l.attributes = Attributes{
+		LampCount:         int(r.get(buf, USAGE_LAMP_COUNT)),
+		Width:             int(r.get(buf, USAGE_BOUNDING_BOX_WIDTH_IN_MICROMETERS)),
+		Height:            int(r.get(buf, USAGE_BOUNDING_BOX_HEIGHT_IN_MICROMETERS)),
+		Depth:             int(r.get(buf, USAGE_BOUNDING_BOX_DEPTH_IN_MICROMETERS)),
+		Kind:              Kind(r.get(buf, USAGE_LAMP_ARRAY_KIND)),
+		MinUpdateInterval: time.Duration(r.get(buf, USAGE_MIN_UPDATE_INTERVAL_IN_MICROSECONDS)) * time.Microsecond,
+	}
+
+	return nil
+}
+
+// readLamp requests attributes of a lamp by its ID, then reads them from LampAttributesResponseReport
+func (l *lampArrayImpl) readLamp(request, response *report, id uint16) (Lamp, error) {
+	buf := request.layout.NewBuffer()
+	if err := request.set(buf, USAGE_LAMP_ID, 0, int32(id)); err != nil {
+		return Lamp{}, err
+	}
+	if _, err := l.device.SendFeatureReport(buf); err != nil {
+		return Lamp{}, fmt.Errorf("unable to send LampAttributesRequestReport of lamp %d: %w", id, err)
+	}
+
+	buf = response.layout.NewBuffer()
+	if _, err := l.device.GetFeatureReport(buf); err != nil {
+		return Lamp{}, fmt.Errorf("unable to get LampAttributesResponseReport of lamp %d: %w", id, err)
+	}
+	if responseID := uint16(response.get(buf, USAGE_LAMP_ID)); responseID != id {
+		l.logger.Warn("lamp ID of attributes response mismatched", "requested", id, "responded", responseID)
+	}
+
+	return Lamp{
+		ID:                  id,
+		X:                   int(response.get(buf, USAGE_POSITION_X_IN_MICROMETERS)),
+		Y:                   int(response.get(buf, USAGE_POSITION_Y_IN_MICROMETERS)),
+		Z:                   int(response.get(buf, USAGE_POSITION_Z_IN_MICROMETERS)),
+		Purposes:            Purposes(response.get(buf, USAGE_LAMP_PURPOSES)),
+		UpdateLatency:       time.Duration(response.get(buf, USAGE_UPDATE_LATENCY_IN_MICROSECONDS)) * time.Microsecond,
+		RedLevelCount:       int(response.get(buf, USAGE_RED_LEVEL_COUNT)),
+		GreenLevelCount:     int(response.get(buf, USAGE_GREEN_LEVEL_COUNT)),
+		BlueLevelCount:      int(response.get(buf, USAGE_BLUE_LEVEL_COUNT)),
+		IntensityLevelCount: int(response.get(buf, USAGE_INTENSITY_LEVEL_COUNT)),
+		IsProgrammable:      response.get(buf, USAGE_IS_PROGRAMMABLE) != 0,
+		InputBinding:        uint16(response.get(buf, USAGE_INPUT_BINDING)),
+	}, nil
+}
+
+func (l *lampArrayImpl) Attributes() Attributes {
+	return l.attributes
+}
+
+func (l *lampArrayImpl) Lamps() []Lamp {
+	return l.lamps
+}
+
+func (l *lampArrayImpl) SetAutonomousMode(enabled bool) error {
+	buf := l.control.layout.NewBuffer()
+	var value int32
+	if enabled {
+		value = 1
+	}
+	if err := l.control.set(buf, USAGE_AUTONOMOUS_MODE, 0, value); err != nil {
+		return err
+	}
+	if _, err := l.device.SendFeatureReport(buf); err != nil {
+		return fmt.Errorf("unable to send LampArrayControlReport: %w", err)
+	}
+
+	return nil
+}
+
+// setColor writes n-th color channels of a report
+func (l *lampArrayImpl) setColor(r *report, buf []byte, n int, channels [4]int32) error {
+	for i, usage := range []uint16{
+		USAGE_RED_UPDATE_CHANNEL,
+		USAGE_GREEN_UPDATE_CHANNEL,
+		USAGE_BLUE_UPDATE_CHANNEL,
+		USAGE_INTENSITY_UPDATE_CHANNEL,
+	} {
+		if err := r.set(buf, usage, n, channels[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (l *lampArrayImpl) lamp(id uint16) (Lamp, error) {
+	if int(id) >= len(l.lamps) {
+		return Lamp{}, fmt.Errorf("lamp %d of %d lamps: %w", id, len(l.lamps), ErrLampIDOutOfRange)
+	}
+
+	return l.lamps[id], nil
+}
+
+func (l *lampArrayImpl) SetColors(colors []LampColor) error {
+	r := l.multiUpdate
+	capacity := r.count(USAGE_LAMP_ID)
+	if capacity == 0 {
+		return fmt.Errorf("LampMultiUpdateReport has no lamp ID: %w", hid.ErrReportFieldNotFound)
+	}
+
+	for start := 0; start < len(colors); start += capacity {
+		batch := colors[start:min(start+capacity, len(colors))]
+		buf := r.layout.NewBuffer()
+		if err := r.set(buf, USAGE_LAMP_COUNT, 0, int32(len(batch))); err != nil {
+			return err
+		}
+		if start+capacity >= len(colors) {
+			if err := r.set(buf, USAGE_LAMP_UPDATE_FLAGS, 0, LAMP_UPDATE_FLAGS_COMPLETE); err != nil {
+				return err
+			}
+		}
+		for n, color := range batch {
+			lamp, err := l.lamp(color.LampID)
+			if err != nil {
+				return err
+			}
+			if err := r.set(buf, USAGE_LAMP_ID, n, int32(color.LampID)); err != nil {
+				return err
+			}
+			if err := l.setColor(r, buf, n, lamp.Quantize(color.Color)); err != nil {
+				return err
+			}
+		}
+		if _, err := l.device.SendFeatureReport(buf); err != nil {
+			return fmt.Errorf("unable to send LampMultiUpdateReport: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (l *lampArrayImpl) SetRangeColor(start, end uint16, color Color) error {
+	if start > end {
+		return fmt.Errorf("lamp range %d-%d: %w", start, end, ErrLampIDOutOfRange)
+	}
+	if _, err := l.lamp(end); err != nil {
+		return err
+	}
+	// Lamps in a range may have different level counts, so the color is scaled to the first lamp of the range
+	lamp, _ := l.lamp(start)
+
+	r := l.rangeUpdate
+	buf := r.layout.NewBuffer()
+	if err := r.set(buf, USAGE_LAMP_UPDATE_FLAGS, 0, LAMP_UPDATE_FLAGS_COMPLETE); err != nil {
+		return err
+	}
+	if err := r.set(buf, USAGE_LAMP_ID_START, 0, int32(start)); err != nil {
+		return err
+	}
+	if err := r.set(buf, USAGE_LAMP_ID_END, 0, int32(end)); err != nil {
+		return err
+	}
+	if err := l.setColor(r, buf, 0, lamp.Quantize(color)); err != nil {
+		return err
+	}
+	if _, err := l.device.SendFeatureReport(buf); err != nil {
+		return fmt.Errorf("unable to send LampRangeUpdateReport: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,348 @@
+package lamparray_test
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/ntchjb/gohid/hid"
+	"github.com/ntchjb/gohid/lamparray"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	hidreport "github.com/ntchjb/usbip-virtual-device/usb/protocol/hid/report"
+)
+
+var (
+	// LampArray with LampMultiUpdateReport updating up to 2 lamps at a time
+	lampArrayReportDescriptor = hidreport.HIDReportDescriptor{
+		0x05, 0x59, // Usage Page (Lighting and Illumination)
+		0x09, 0x01, // Usage (LampArray)
+		0xA1, 0x01, // Collection (Application)
+
+		0x09, 0x02, //   Usage (LampArrayAttributesReport)
+		0xA1, 0x02, //   Collection (Logical)
+		0x85, 0x01, //     Report ID (1)
+		0x09, 0x03, //     Usage (LampCount)
+		0x15, 0x00, //     Logical Minimum (0)
+		0x27, 0xFF, 0xFF, 0x00, 0x00, // Logical Maximum (65535)
+		0x75, 0x10, //     Report Size (16)
+		0x95, 0x01, //     Report Count (1)
+		0xB1, 0x03, //     Feature (Const,Var,Abs)
+		0x09, 0x04, //     Usage (BoundingBoxWidthInMicrometers)
+		0x09, 0x05, //     Usage (BoundingBoxHeightInMicrometers)
+		0x09, 0x06, //     Usage (BoundingBoxDepthInMicrometers)
+		0x09, 0x08, //     Usage (MinUpdateIntervalInMicroseconds)
+		0x09, 0x07, //     Usage (LampArrayKind)
+		0x27, 0xFF, 0xFF, 0xFF, 0x7F, // Logical Maximum (2147483647)
+		0x75, 0x20, //     Report Size (32)
+		0x95, 0x05, //     Report Count (5)
+		0xB1, 0x02, //     Feature (Data,Var,Abs)
+		0xC0, //         End Collection
+
+		0x09, 0x20, //   Usage (LampAttributesRequestReport)
+		0xA1, 0x02, //   Collection (Logical)
+		0x85, 0x02, //     Report ID (2)
+		0x09, 0x21, //     Usage (LampId)
+		0x27, 0xFF, 0xFF, 0x00, 0x00, // Logical Maximum (65535)
+		0x75, 0x10, //     Report Size (16)
+		0x95, 0x01, //     Report Count (1)
+		0xB1, 0x02, //     Feature (Data,Var,Abs)
+		0xC0, //         End Collection
+
+		0x09, 0x22, //   Usage (LampAttributesResponseReport)
+		0xA1, 0x02, //   Collection (Logical)
+		0x85, 0x03, //     Report ID (3)
+		0x09, 0x21, //     Usage (LampId)
+		0xB1, 0x02, //     Feature (Data,Var,Abs)
+		0x09, 0x23, //     Usage (PositionXInMicrometers)
+		0x09, 0x24, //     Usage (PositionYInMicrometers)
+		0x09, 0x25, //     Usage (PositionZInMicrometers)
+		0x09, 0x27, //     Usage (UpdateLatencyInMicroseconds)
+		0x09, 0x26, //     Usage (LampPurposes)
+		0x27, 0xFF, 0xFF, 0xFF, 0x7F, // Logical Maximum (2147483647)
+		0x75, 0x20, //     Report Size (32)
+		0x95, 0x05, //     Report Count (5)
+		0xB1, 0x02, //     Feature (Data,Var,Abs)
+		0x09, 0x28, //     Usage (RedLevelCount)
+		0x09, 0x29, //     Usage (GreenLevelCount)
+		0x09, 0x2A, //     Usage (BlueLevelCount)
+		0x09, 0x2B, //     Usage (IntensityLevelCount)
+		0x09, 0x2C, //     Usage (IsProgrammable)
+		0x26, 0xFF, 0x00, // Logical Maximum (255)
+		0x75, 0x08, //     Report Size (8)
+		0xB1, 0x02, //     Feature (Data,Var,Abs)
+		0x09, 0x2D, //     Usage (InputBinding)
+		0x27, 0xFF, 0xFF, 0x00, 0x00, // Logical Maximum (65535)
+		0x75, 0x10, //     Report Size (16)
+		0x95, 0x01, //     Report Count (1)
+		0xB1, 0x02, //     Feature (Data,Var,Abs)
+		0xC0, //         End Collection
+
+		0x09, 0x50, //   Usage (LampMultiUpdateReport)
+		0xA1, 0x02, //   Collection (Logical)
+		0x85, 0x04, //     Report ID (4)
+		0x09, 0x03, //     Usage (LampCount)
+		0x09, 0x55, //     Usage (LampUpdateFlags)
+		0x25, 0x08, //     Logical Maximum (8)
+		0x75, 0x08, //     Report Size (8)
+		0x95, 0x02, //     Report Count (2)
+		0xB1, 0x02, //     Feature (Data,Var,Abs)
+		0x09, 0x21, //     Usage (LampId)
+		0x09, 0x21, //     Usage (LampId)
+		0x27, 0xFF, 0xFF, 0x00, 0x00, // Logical Maximum (65535)
+		0x75, 0x10, //     Report Size (16)
+		0xB1, 0x02, //     Feature (Data,Var,Abs)
+		0x09, 0x51, //     Usage (RedUpdateChannel)
+		0x09, 0x52, //     Usage (GreenUpdateChannel)
+		0x09, 0x53, //     Usage (BlueUpdateChannel)
+		0x09, 0x54, //     Usage (IntensityUpdateChannel)
+		0x09, 0x51, //     Usage (RedUpdateChannel)
+		0x09, 0x52, //     Usage (GreenUpdateChannel)
+		0x09, 0x53, //     Usage (BlueUpdateChannel)
+		0x09, 0x54, //     Usage (IntensityUpdateChannel)
+		0x26, 0xFF, 0x00, // Logical Maximum (255)
+		0x75, 0x08, //     Report Size (8)
+		0x95, 0x08, //     Report Count (8)
+		0xB1, 0x02, //     Feature (Data,Var,Abs)
+		0xC0, //         End Collection
+
+		0x09, 0x60, //   Usage (LampRangeUpdateReport)
+		0xA1, 0x02, //   Collection (Logical)
+		0x85, 0x05, //     Report ID (5)
+		0x09, 0x55, //     Usage (LampUpdateFlags)
+		0x25, 0x08, //     Logical Maximum (8)
+		0x95, 0x01, //     Report Count (1)
+		0xB1, 0x02, //     Feature (Data,Var,Abs)
+		0x09, 0x61, //     Usage (LampIdStart)
+		0x09, 0x62, //     Usage (LampIdEnd)
+		0x27, 0xFF, 0xFF, 0x00, 0x00, // Logical Maximum (65535)
+		0x75, 0x10, //     Report Size (16)
+		0x95, 0x02, //     Report Count (2)
+		0xB1, 0x02, //     Feature (Data,Var,Abs)
+		0x09, 0x51, //     Usage (RedUpdateChannel)
+		0x09, 0x52, //     Usage (GreenUpdateChannel)
+		0x09, 0x53, //     Usage (BlueUpdateChannel)
+		0x09, 0x54, //     Usage (IntensityUpdateChannel)
+		0x26, 0xFF, 0x00, // Logical Maximum (255)
+		0x75, 0x08, //     Report Size (8)
+		0x95, 0x04, //     Report Count (4)
+		0xB1, 0x02, //     Feature (Data,Var,Abs)
+		0xC0, //         End Collection
+
+		0x09, 0x70, //   Usage (LampArrayControlReport)
+		0xA1, 0x02, //   Collection (Logical)
+		0x85, 0x06, //     Report ID (6)
+		0x09, 0x71, //     Usage (AutonomousMode)
+		0x25, 0x01, //     Logical Maximum (1)
+		0x95, 0x01, //     Report Count (1)
+		0xB1, 0x02, //     Feature (Data,Var,Abs)
+		0xC0, //         End Collection
+		0xC0, //       End Collection
+	}
+
+	// Keyboard of 3 lamps with 10 cm width, 5 cm height and 1 cm depth, updated at most every 10 ms
+	lampArrayAttributes = []byte{
+		0x01, 0x03, 0x00,
+		0xA0, 0x86, 0x01, 0x00,
+		0x50, 0xC3, 0x00, 0x00,
+		0x10, 0x27, 0x00, 0x00,
+		0x10, 0x27, 0x00, 0x00,
+		0x01, 0x00, 0x00, 0x00,
+	}
+)
+
+func lampAttributesResponse(id uint8, levelCount uint8) []byte {
+	return []byte{
+		0x03, id, 0x00,
+		0xE8, 0x03, 0x00, 0x00,
+		0xD0, 0x07, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00,
+		0xE8, 0x03, 0x00, 0x00,
+		0x01, 0x00, 0x00, 0x00,
+		levelCount, levelCount, levelCount, 0x01, 0x01,
+		0x04 + id, 0x00,
+	}
+}
+
+func newLampArray(t *testing.T, ctrl *gomock.Controller) (lamparray.LampArray, *hid.MockDevice) {
+	device := hid.NewMockDevice(ctrl)
+	device.EXPECT().GetReportDescriptor().Return(lampArrayReportDescriptor, nil)
+
+	calls := []any{
+		device.EXPECT().GetFeatureReport(gomock.Len(len(lampArrayAttributes))).DoAndReturn(func(data []byte) (int, error) {
+			return copy(data, lampArrayAttributes), nil
+		}),
+	}
+	for id, levelCount := range []uint8{0xFF, 0xFF, 0x02} {
+		response := lampAttributesResponse(uint8(id), levelCount)
+		calls = append(calls,
+			device.EXPECT().SendFeatureReport([]byte{0x02, uint8(id), 0x00}).Return(3, nil),
+			device.EXPECT().GetFeatureReport(gomock.Len(len(response))).DoAndReturn(func(data []byte) (int, error) {
+				return copy(data, response), nil
+			}),
+		)
+	}
+	gomock.InOrder(calls...)
+
+	lampArray, err := lamparray.NewLampArray(device, slog.Default())
+	assert.NoError(t, err)
+
+	return lampArray, device
+}
+
+func TestNewLampArray(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	lampArray, _ := newLampArray(t, ctrl)
+
+	assert.Equal(t, lamparray.Attributes{
+		LampCount:         3,
+		Width:             100000,
+		Height:            50000,
+		Depth:             10000,
+		Kind:              lamparray.KIND_KEYBOARD,
+		MinUpdateInterval: 10 * time.Millisecond,
+	}, lampArray.Attributes())
+	assert.Len(t, lampArray.Lamps(), 3)
+	assert.Equal(t, lamparray.Lamp{
+		ID:                  2,
+		X:                   1000,
+		Y:                   2000,
+		Purposes:            lamparray.PURPOSES_CONTROL,
+		UpdateLatency:       time.Millisecond,
+		RedLevelCount:       2,
+		GreenLevelCount:     2,
+		BlueLevelCount:      2,
+		IntensityLevelCount: 1,
+		IsProgrammable:      true,
+		InputBinding:        0x06,
+	}, lampArray.Lamps()[2])
+}
+
+func TestNewLampArray_Error(t *testing.T) {
+	errControl := errors.New("control transfer error")
+
+	tests := []struct {
+		name    string
+		desc    hidreport.HIDReportDescriptor
+		descErr error
+		err     error
+	}{
+		{
+			name:    "Error_GetReportDescriptor",
+			descErr: errControl,
+			err:     errControl,
+		},
+		{
+			name: "Error_NoLampArrayFound",
+			desc: hidreport.HIDReportDescriptor{0x05, 0x01, 0x09, 0x06, 0xA1, 0x01, 0xC0},
+			err:  lamparray.ErrNoLampArrayFound,
+		},
+		{
+			name: "Error_ReportNotSupported",
+			desc: hidreport.HIDReportDescriptor{0x05, 0x59, 0x09, 0x01, 0xA1, 0x01, 0xC0},
+			err:  lamparray.ErrReportNotSupported,
+		},
+		{
+			name: "Error_GetFeatureReport",
+			desc: lampArrayReportDescriptor,
+			err:  errControl,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			device := hid.NewMockDevice(ctrl)
+			device.EXPECT().GetReportDescriptor().Return(test.desc, test.descErr)
+			if test.desc != nil && test.descErr == nil && test.err == errControl {
+				device.EXPECT().GetFeatureReport(gomock.Any()).Return(0, errControl)
+			}
+
+			_, err := lamparray.NewLampArray(device, slog.Default())
+			assert.ErrorIs(t, err, test.err)
+		})
+	}
+}
+
+func TestLampArray_SetColors(t *testing.T) {
+	tests := []struct {
+		name   string
+		colors []lamparray.LampColor
+		sent   [][]byte
+		err    error
+	}{
+		{
+			name: "Success_SingleReport",
+			colors: []lamparray.LampColor{
+				{LampID: 0, Color: lamparray.Color{Red: 0xFF, Green: 0x80, Blue: 0x00, Intensity: 0xFF}},
+			},
+			sent: [][]byte{
+				{0x04, 0x01, 0x01, 0x00, 0x00, 0x00, 0x00, 0xFE, 0x7F, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+			},
+		},
+		{
+			name: "Success_MultipleReports",
+			colors: []lamparray.LampColor{
+				{LampID: 0, Color: lamparray.Color{Red: 0xFF}},
+				{LampID: 1, Color: lamparray.Color{Green: 0xFF}},
+				{LampID: 2, Color: lamparray.Color{Red: 0x40, Blue: 0xC0}},
+			},
+			sent: [][]byte{
+				{0x04, 0x02, 0x00, 0x00, 0x00, 0x01, 0x00, 0xFE, 0x00, 0x00, 0x00, 0x00, 0xFE, 0x00, 0x00},
+				{0x04, 0x01, 0x01, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00},
+			},
+		},
+		{
+			name: "Error_LampIDOutOfRange",
+			colors: []lamparray.LampColor{
+				{LampID: 3},
+			},
+			err: lamparray.ErrLampIDOutOfRange,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			lampArray, device := newLampArray(t, ctrl)
+			var calls []any
+			for _, sent := range test.sent {
+				calls = append(calls, device.EXPECT().SendFeatureReport(sent).Return(len(sent), nil))
+			}
+			gomock.InOrder(calls...)
+
+			err := lampArray.SetColors(test.colors)
+			assert.ErrorIs(t, err, test.err)
+		})
+	}
+}
+
+func TestLampArray_SetRangeColor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	lampArray, device := newLampArray(t, ctrl)
+
+	device.EXPECT().SendFeatureReport([]byte{0x05, 0x01, 0x00, 0x00, 0x01, 0x00, 0x10, 0x20, 0x30, 0x00}).Return(10, nil)
+	err := lampArray.SetRangeColor(0, 1, lamparray.Color{Red: 0x10, Green: 0x20, Blue: 0x30, Intensity: 0xFF})
+	assert.NoError(t, err)
+
+	err = lampArray.SetRangeColor(1, 3, lamparray.Color{})
+	assert.ErrorIs(t, err, lamparray.ErrLampIDOutOfRange)
+}
+
+func TestLampArray_SetAutonomousMode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	lampArray, device := newLampArray(t, ctrl)
+
+	gomock.InOrder(
+		device.EXPECT().SendFeatureReport([]byte{0x06, 0x00}).Return(2, nil),
+		device.EXPECT().SendFeatureReport([]byte{0x06, 0x01}).Return(2, nil),
+	)
+	assert.NoError(t, lampArray.SetAutonomousMode(false))
+	assert.NoError(t, lampArray.SetAutonomousMode(true))
+}
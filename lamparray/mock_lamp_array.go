@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./lamparray/lamp_array.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./lamparray/lamp_array.go -destination=./lamparray/mock_lamp_array.go -package=lamparray
+//
+
+// Package lamparray is a generated GoMock package.
+package lamparray
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLampArray is a mock of LampArray interface.
+type MockLampArray struct {
+	ctrl     *gomock.Controller
+	recorder *MockLampArrayMockRecorder
+}
+
+// MockLampArrayMockRecorder is the mock recorder for MockLampArray.
+type MockLampArrayMockRecorder struct {
+	mock *MockLampArray
+}
+
+// NewMockLampArray creates a new mock instance.
+func NewMockLampArray(ctrl *gomock.Controller) *MockLampArray {
+	mock := &MockLampArray{ctrl: ctrl}
+	mock.recorder = &MockLampArrayMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLampArray) EXPECT() *MockLampArrayMockRecorder {
+	return m.recorder
+}
+
+// Attributes mocks base method.
+func (m *MockLampArray) Attributes() Attributes {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Attributes")
+	ret0, _ := ret[0].(Attributes)
+	return ret0
+}
+
+// Attributes indicates an expected call of Attributes.
+func (mr *MockLampArrayMockRecorder) Attributes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attributes", reflect.TypeOf((*MockLampArray)(nil).Attributes))
+}
+
+// Lamps mocks base method.
+func (m *MockLampArray) Lamps() []Lamp {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lamps")
+	ret0, _ := ret[0].([]Lamp)
+	return ret0
+}
+
+// Lamps indicates an expected call of Lamps.
+func (mr *MockLampArrayMockRecorder) Lamps() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lamps", reflect.TypeOf((*MockLampArray)(nil).Lamps))
+}
+
+// SetAutonomousMode mocks base method.
+func (m *MockLampArray) SetAutonomousMode(enabled bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAutonomousMode", enabled)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAutonomousMode indicates an expected call of SetAutonomousMode.
+func (mr *MockLampArrayMockRecorder) SetAutonomousMode(enabled any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAutonomousMode", reflect.TypeOf((*MockLampArray)(nil).SetAutonomousMode), enabled)
+}
+
+// SetColors mocks base method.
+func (m *MockLampArray) SetColors(colors []LampColor) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetColors", colors)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetColors indicates an expected call of SetColors.
+func (mr *MockLampArrayMockRecorder) SetColors(colors any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetColors", reflect.TypeOf((*MockLampArray)(nil).SetColors), colors)
+}
+
+// SetRangeColor mocks base method.
+func (m *MockLampArray) SetRangeColor(start, end uint16, color Color) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRangeColor", start, end, color)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRangeColor indicates an expected call of SetRangeColor.
+func (mr *MockLampArrayMockRecorder) SetRangeColor(start, end, color any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRangeColor", reflect.TypeOf((*MockLampArray)(nil).SetRangeColor), start, end, color)
+}
@@ -0,0 +1,93 @@
+package lamparray
+
+import (
+	"fmt"
+
+	"github.com/ntchjb/gohid/hid"
+)
+
+// slot is a position of a value in report data
+type slot struct {
+	field *hid.ReportField
+	index int
+}
+
+// report is a LampArray Feature report found in report descriptor, identified by its logical collection.
+// Usages repeated in a report, e.g. Lamp ID of LampMultiUpdateReport, have multiple slots in declaration order.
+type report struct {
+	usage  uint16
+	layout *hid.ReportLayout
+	slots  map[uint16][]slot
+}
+
+func findReport(collection *hid.ReportCollection, schema *hid.ReportSchema, usage uint16) (*report, bool) {
+	var found *hid.ReportCollection
+	for _, child := range collection.Children {
+		if child.Usage == hid.NewUsage(USAGE_PAGE_LIGHTING_AND_ILLUMINATION, usage) {
+			found = child
+			break
+		}
+	}
+	if found == nil {
+		return nil, false
+	}
+	fields := found.AllFields()
+	if len(fields) == 0 {
+		return nil, false
+	}
+	layout, err := schema.Layout(fields[0].ReportType, fields[0].ReportID)
+	if err != nil {
+		return nil, false
+	}
+
+	res := &report{
+		usage:  usage,
+		layout: layout,
+		slots:  make(map[uint16][]slot),
+	}
+	for _, field := range fields {
+		// Read-only attributes are usually declared as constant, so only padding fields without usage are skipped
+		if field.IsArray() {
+			continue
+		}
+		for i := 0; i < int(field.ReportCount); i++ {
+			if u := field.Usage(i); u.Page() == USAGE_PAGE_LIGHTING_AND_ILLUMINATION {
+				res.slots[u.ID()] = append(res.slots[u.ID()], slot{field: field, index: i})
+			}
+		}
+	}
+
+	return res, true
+}
+
+// count returns number of values of given usage in this report
+func (r *report) count(usage uint16) int {
+	return len(r.slots[usage])
+}
+
+// set writes n-th value of given usage to a buffer created by layout.NewBuffer. Missing values are skipped.
+func (r *report) set(buf []byte, usage uint16, n int, value int32) error {
+	slots := r.slots[usage]
+	if n >= len(slots) {
+		return nil
+	}
+	if err := slots[n].field.SetValue(buf[1:], slots[n].index, value); err != nil {
+		return fmt.Errorf("unable to set usage 0x%02X of report 0x%02X: %w", usage, r.usage, err)
+	}
+
+	return nil
+}
+
+// get reads the first value of given usage from a buffer, or zero if the usage does not exist
+func (r *report) get(buf []byte, usage uint16) int32 {
+	slots := r.slots[usage]
+	if len(slots) == 0 {
+		return 0
+	}
+	value, err := slots[0].field.Value(buf[1:], slots[0].index)
+	if err != nil {
+		return 0
+	}
+
+	return value
+}
@@ -0,0 +1,104 @@
+package lamparray
+
+const (
+	USAGE_PAGE_LIGHTING_AND_ILLUMINATION uint16 = 0x59
+)
+
+// Collection usages on Lighting and Illumination usage page
+const (
+	USAGE_LAMP_ARRAY                      uint16 = 0x01
+	USAGE_LAMP_ARRAY_ATTRIBUTES_REPORT    uint16 = 0x02
+	USAGE_LAMP_ATTRIBUTES_REQUEST_REPORT  uint16 = 0x20
+	USAGE_LAMP_ATTRIBUTES_RESPONSE_REPORT uint16 = 0x22
+	USAGE_LAMP_MULTI_UPDATE_REPORT        uint16 = 0x50
+	USAGE_LAMP_RANGE_UPDATE_REPORT        uint16 = 0x60
+	USAGE_LAMP_ARRAY_CONTROL_REPORT       uint16 = 0x70
+)
+
+// Data usages on Lighting and Illumination usage page
+const (
+	USAGE_LAMP_COUNT                          uint16 = 0x03
+	USAGE_BOUNDING_BOX_WIDTH_IN_MICROMETERS   uint16 = 0x04
+	USAGE_BOUNDING_BOX_HEIGHT_IN_MICROMETERS  uint16 = 0x05
+	USAGE_BOUNDING_BOX_DEPTH_IN_MICROMETERS   uint16 = 0x06
+	USAGE_LAMP_ARRAY_KIND                     uint16 = 0x07
+	USAGE_MIN_UPDATE_INTERVAL_IN_MICROSECONDS uint16 = 0x08
+	USAGE_LAMP_ID                             uint16 = 0x21
+	USAGE_POSITION_X_IN_MICROMETERS           uint16 = 0x23
+	USAGE_POSITION_Y_IN_MICROMETERS           uint16 = 0x24
+	USAGE_POSITION_Z_IN_MICROMETERS           uint16 = 0x25
+	USAGE_LAMP_PURPOSES                       uint16 = 0x26
+	USAGE_UPDATE_LATENCY_IN_MICROSECONDS      uint16 = 0x27
+	USAGE_RED_LEVEL_COUNT                     uint16 = 0x28
+	USAGE_GREEN_LEVEL_COUNT                   uint16 = 0x29
+	USAGE_BLUE_LEVEL_COUNT                    uint16 = 0x2A
+	USAGE_INTENSITY_LEVEL_COUNT               uint16 = 0x2B
+	USAGE_IS_PROGRAMMABLE                     uint16 = 0x2C
+	USAGE_INPUT_BINDING                       uint16 = 0x2D
+	USAGE_RED_UPDATE_CHANNEL                  uint16 = 0x51
+	USAGE_GREEN_UPDATE_CHANNEL                uint16 = 0x52
+	USAGE_BLUE_UPDATE_CHANNEL                 uint16 = 0x53
+	USAGE_INTENSITY_UPDATE_CHANNEL            uint16 = 0x54
+	USAGE_LAMP_UPDATE_FLAGS                   uint16 = 0x55
+	USAGE_LAMP_ID_START                       uint16 = 0x61
+	USAGE_LAMP_ID_END                         uint16 = 0x62
+	USAGE_AUTONOMOUS_MODE                     uint16 = 0x71
+)
+
+const (
+	// Flag of LampUpdateFlags telling the device to apply all pending lamp updates
+	LAMP_UPDATE_FLAGS_COMPLETE = 0x01
+)
+
+// Kind is a kind of device a LampArray is part of
+type Kind uint32
+
+const (
+	KIND_UNDEFINED       Kind = 0x00
+	KIND_KEYBOARD        Kind = 0x01
+	KIND_MOUSE           Kind = 0x02
+	KIND_GAME_CONTROLLER Kind = 0x03
+	KIND_PERIPHERAL      Kind = 0x04
+	KIND_SCENE           Kind = 0x05
+	KIND_NOTIFICATION    Kind = 0x06
+	KIND_CHASSIS         Kind = 0x07
+	KIND_WEARABLE        Kind = 0x08
+	KIND_FURNITURE       Kind = 0x09
+	KIND_ART             Kind = 0x0A
+)
+
+var (
+	KindNames = map[Kind]string{
+		KIND_UNDEFINED:       "Undefined",
+		KIND_KEYBOARD:        "Keyboard",
+		KIND_MOUSE:           "Mouse",
+		KIND_GAME_CONTROLLER: "Game Controller",
+		KIND_PERIPHERAL:      "Peripheral",
+		KIND_SCENE:           "Scene",
+		KIND_NOTIFICATION:    "Notification",
+		KIND_CHASSIS:         "Chassis",
+		KIND_WEARABLE:        "Wearable",
+		KIND_FURNITURE:       "Furniture",
+		KIND_ART:             "Art",
+	}
+)
+
+func (k Kind) String() string {
+	if name, ok := KindNames[k]; ok {
+		return name
+	}
+
+	return "Unknown Kind"
+}
+
+// Purposes is a bitmask of purposes of a lamp
+type Purposes uint32
+
+const (
+	PURPOSES_CONTROL      Purposes = 0x01
+	PURPOSES_ACCENT       Purposes = 0x02
+	PURPOSES_BRANDING     Purposes = 0x04
+	PURPOSES_STATUS       Purposes = 0x08
+	PURPOSES_ILLUMINATION Purposes = 0x10
+	PURPOSES_PRESENTATION Purposes = 0x20
+)
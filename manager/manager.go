@@ -60,7 +60,7 @@ func (d *deviceManagerImpl) Enumerate(vendorID gousb.ID, productID gousb.ID) (hi
 func (d *deviceManagerImpl) Open(vendorID, productID gousb.ID, config hid.DeviceConfig) (hid.Device, error) {
 	device, err := d.usbCtx.OpenDevice(vendorID, productID)
 	if err != nil {
-		return nil, fmt.Errorf("unable to open device %v:%v: %w", vendorID, productID, err)
+		return nil, fmt.Errorf("unable to open device %v:%v: %w", vendorID, productID, usb.NewError(usb.OPERATION_OPEN, vendorID, productID, usb.NO_INTERFACE, err))
 	}
 
 	return hid.NewDevice(device, config, d.logger)
@@ -312,6 +312,28 @@ func TestDeviceManager_Open(t *testing.T) {
 			},
 			err: errBadAccess,
 		},
+		{
+			name: "Error_AccessDenied",
+			fields: fields{
+				usbCtx: func(ctrl *gomock.Controller, vendorID, productID gousb.ID) (usb.Context, usb.Device) {
+					usbCtx := usb.NewMockContext(ctrl)
+					usbCtx.EXPECT().OpenDevice(vendorID, productID).Return(nil, gousb.ErrorAccess)
+
+					return usbCtx, nil
+				},
+			},
+			args: args{
+				vendorID:  0xFF11,
+				productID: 0x0001,
+				config: hid.DeviceConfig{
+					StreamLaneCount: hid.DEFAULT_ENDPOINT_STREAM_COUNT,
+				},
+			},
+			res: func(t *testing.T, mockDevice *usb.MockDevice) hid.Device {
+				return nil
+			},
+			err: usb.ErrAccessDenied,
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,369 @@
+package pid
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/ntchjb/gohid/hid"
+)
+
+var (
+	ErrNoPIDFound              = errors.New("no physical interface device reports found")
+	ErrReportNotSupported      = errors.New("report is not supported by device")
+	ErrEffectPoolFull          = errors.New("effect pool is full")
+	ErrEffectLoadFailed        = errors.New("unable to load effect")
+	ErrEffectParametersMissing = errors.New("type-specific parameters of effect are missing")
+)
+
+// Device provides force feedback effects of a HID device implementing Physical Interface Device usage page
+type Device interface {
+	// Read PID Pool report, which describes effect memory of the device
+	Pool() (Pool, error)
+	// Allocate a new effect of given type via Create New Effect and PID Block Load reports, and return its effect block index
+	CreateEffect(effectType EffectType) (uint8, error)
+	// Upload parameters of an effect to given effect block index
+	UploadEffect(index uint8, effect Effect) error
+	// Start playing an effect. Loop count can be LOOP_COUNT_INFINITE.
+	StartEffect(index uint8, loopCount int) error
+	// Start playing an effect, and stop all other effects
+	StartEffectSolo(index uint8, loopCount int) error
+	// Stop playing an effect
+	StopEffect(index uint8) error
+	// Free memory of an effect, so that the effect block index can be reused
+	FreeEffect(index uint8) error
+	// Set gain of all effects, from 0 to 1
+	SetDeviceGain(gain float64) error
+	// Send a command to the device, e.g. DEVICE_CONTROL_ENABLE_ACTUATORS
+	SendDeviceControl(control DeviceControl) error
+}
+
+type deviceImpl struct {
+	device  hid.Device
+	reports map[uint16]*reportInfo
+	logger  *slog.Logger
+}
+
+// NewDevice gets report descriptor from an opened HID device, and finds PID reports from it
+func NewDevice(device hid.Device, logger *slog.Logger) (Device, error) {
+	if device == nil {
+		return nil, hid.ErrDeviceIsNil
+	}
+	desc, err := device.GetReportDescriptor()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get report descriptor: %w", err)
+	}
+	schema, err := hid.ParseReportDescriptor(desc)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse report descriptor: %w", err)
+	}
+
+	d := &deviceImpl{
+		device:  device,
+		reports: make(map[uint16]*reportInfo),
+		logger:  logger,
+	}
+	for _, usage := range []uint16{
+		USAGE_SET_EFFECT_REPORT,
+		USAGE_SET_ENVELOPE_REPORT,
+		USAGE_SET_CONDITION_REPORT,
+		USAGE_SET_PERIODIC_REPORT,
+		USAGE_SET_CONSTANT_FORCE_REPORT,
+		USAGE_SET_RAMP_FORCE_REPORT,
+		USAGE_EFFECT_OPERATION_REPORT,
+		USAGE_DEVICE_GAIN_REPORT,
+		USAGE_PID_POOL_REPORT,
+		USAGE_PID_BLOCK_LOAD_REPORT,
+		USAGE_PID_BLOCK_FREE_REPORT,
+		USAGE_PID_DEVICE_CONTROL_REPORT,
+		USAGE_CREATE_NEW_EFFECT_REPORT,
+	} {
+		if info, ok := findReport(schema, usage); ok {
+			d.reports[usage] = info
+		}
+	}
+	// Set Effect and Effect Operation reports are mandatory for all PID devices
+	if d.reports[USAGE_SET_EFFECT_REPORT] == nil || d.reports[USAGE_EFFECT_OPERATION_REPORT] == nil {
+		return nil, ErrNoPIDFound
+	}
+
+	return d, nil
+}
+
+func (d *deviceImpl) newReport(usage uint16) (*report, error) {
+	info, ok := d.reports[usage]
+	if !ok {
+		return nil, fmt.Errorf("report 0x%02X: %w", usage, ErrReportNotSupported)
+	}
+
+	return info.newReport(), nil
+}
+
+// send sends a report via SET_REPORT request, as either Output or Feature report according to its layout
+func (d *deviceImpl) send(r *report) error {
+	if r.err != nil {
+		return r.err
+	}
+	var err error
+	if r.info.layout.Type == hid.REPORT_TYPE_FEATURE {
+		_, err = d.device.SendFeatureReport(r.buf)
+	} else {
+		_, err = d.device.SendOutputReport(r.buf)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to send report 0x%02X with ID %d: %w", r.info.usage, r.info.layout.ID, err)
+	}
+
+	return nil
+}
+
+// get reads a Feature report via GET_REPORT request
+func (d *deviceImpl) get(usage uint16) (*report, error) {
+	r, err := d.newReport(usage)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := d.device.GetFeatureReport(r.buf); err != nil {
+		return nil, fmt.Errorf("unable to get report 0x%02X with ID %d: %w", usage, r.info.layout.ID, err)
+	}
+
+	return r, nil
+}
+
+func (d *deviceImpl) Pool() (Pool, error) {
+	r, err := d.get(USAGE_PID_POOL_REPORT)
+	if err != nil {
+		return Pool{}, err
+	}
+	var pool Pool
+	if value, ok := r.get(USAGE_RAM_POOL_SIZE); ok {
+		pool.RAMPoolSize = int(value)
+	}
+	if value, ok := r.get(USAGE_SIMULTANEOUS_EFFECTS_MAX); ok {
+		pool.SimultaneousEffectsMax = int(value)
+	}
+	if value, ok := r.get(USAGE_DEVICE_MANAGED_POOL); ok {
+		pool.DeviceManagedPool = value != 0
+	}
+	if value, ok := r.get(USAGE_SHARED_PARAMETER_BLOCKS); ok {
+		pool.SharedParameterBlocks = value != 0
+	}
+
+	return pool, nil
+}
+
+func (d *deviceImpl) CreateEffect(effectType EffectType) (uint8, error) {
+	r, err := d.newReport(USAGE_CREATE_NEW_EFFECT_REPORT)
+	if err != nil {
+		return 0, err
+	}
+	r.selectUsage(uint16(effectType))
+	if err := d.send(r); err != nil {
+		return 0, err
+	}
+
+	load, err := d.get(USAGE_PID_BLOCK_LOAD_REPORT)
+	if err != nil {
+		return 0, err
+	}
+	status, _ := load.selected(uint16(BLOCK_LOAD_STATUS_SUCCESS))
+	switch BlockLoadStatus(status) {
+	case BLOCK_LOAD_STATUS_SUCCESS:
+	case BLOCK_LOAD_STATUS_FULL:
+		return 0, fmt.Errorf("effect %v: %w", effectType, ErrEffectPoolFull)
+	default:
+		return 0, fmt.Errorf("effect %v, status 0x%02X: %w", effectType, status, ErrEffectLoadFailed)
+	}
+	index, ok := load.get(USAGE_EFFECT_BLOCK_INDEX)
+	if !ok {
+		return 0, fmt.Errorf("effect %v has no effect block index: %w", effectType, ErrEffectLoadFailed)
+	}
+	if available, ok := load.get(USAGE_RAM_POOL_AVAILABLE); ok {
+		d.logger.Debug("effect created", "type", effectType, "index", index, "ramPoolAvailable", available)
+	}
+
+	return uint8(index), nil
+}
+
+func (d *deviceImpl) uploadEnvelope(index uint8, envelope *Envelope) error {
+	r, err := d.newReport(USAGE_SET_ENVELOPE_REPORT)
+	if err != nil {
+		return err
+	}
+	r.setInt(USAGE_EFFECT_BLOCK_INDEX, int32(index))
+	r.setNormalized(USAGE_ATTACK_LEVEL, envelope.AttackLevel)
+	r.setDuration(USAGE_ATTACK_TIME, envelope.AttackTime)
+	r.setNormalized(USAGE_FADE_LEVEL, envelope.FadeLevel)
+	r.setDuration(USAGE_FADE_TIME, envelope.FadeTime)
+
+	return d.send(r)
+}
+
+// uploadParameters sends type-specific parameters of an effect
+func (d *deviceImpl) uploadParameters(index uint8, effect Effect) error {
+	var reports []*report
+	switch {
+	case effect.Type == EFFECT_TYPE_CONSTANT_FORCE:
+		if effect.ConstantForce == nil {
+			return fmt.Errorf("effect %v: %w", effect.Type, ErrEffectParametersMissing)
+		}
+		r, err := d.newReport(USAGE_SET_CONSTANT_FORCE_REPORT)
+		if err != nil {
+			return err
+		}
+		r.setInt(USAGE_EFFECT_BLOCK_INDEX, int32(index))
+		r.setNormalized(USAGE_MAGNITUDE, effect.ConstantForce.Magnitude)
+		reports = append(reports, r)
+	case effect.Type == EFFECT_TYPE_RAMP:
+		if effect.Ramp == nil {
+			return fmt.Errorf("effect %v: %w", effect.Type, ErrEffectParametersMissing)
+		}
+		r, err := d.newReport(USAGE_SET_RAMP_FORCE_REPORT)
+		if err != nil {
+			return err
+		}
+		r.setInt(USAGE_EFFECT_BLOCK_INDEX, int32(index))
+		r.setNormalized(USAGE_RAMP_START, effect.Ramp.Start)
+		r.setNormalized(USAGE_RAMP_END, effect.Ramp.End)
+		reports = append(reports, r)
+	case effect.Type.IsPeriodic():
+		if effect.Periodic == nil {
+			return fmt.Errorf("effect %v: %w", effect.Type, ErrEffectParametersMissing)
+		}
+		r, err := d.newReport(USAGE_SET_PERIODIC_REPORT)
+		if err != nil {
+			return err
+		}
+		r.setInt(USAGE_EFFECT_BLOCK_INDEX, int32(index))
+		r.setNormalized(USAGE_MAGNITUDE, effect.Periodic.Magnitude)
+		r.setNormalized(USAGE_OFFSET, effect.Periodic.Offset)
+		r.setAngle(hid.NewUsage(USAGE_PAGE_PID, USAGE_PHASE), effect.Periodic.Phase)
+		r.setDuration(USAGE_PERIOD, effect.Periodic.Period)
+		reports = append(reports, r)
+	case effect.Type.IsCondition():
+		if len(effect.Conditions) == 0 {
+			return fmt.Errorf("effect %v: %w", effect.Type, ErrEffectParametersMissing)
+		}
+		for axis, condition := range effect.Conditions {
+			r, err := d.newReport(USAGE_SET_CONDITION_REPORT)
+			if err != nil {
+				return err
+			}
+			r.setInt(USAGE_EFFECT_BLOCK_INDEX, int32(index))
+			// Parameter block offset selects condition of each axis, when effect blocks are managed by the device
+			r.setInt(USAGE_TYPE_SPECIFIC_BLOCK_OFFSET, int32(axis))
+			r.setNormalized(USAGE_CP_OFFSET, condition.CenterPointOffset)
+			r.setNormalized(USAGE_POSITIVE_COEFFICIENT, condition.PositiveCoefficient)
+			r.setNormalized(USAGE_NEGATIVE_COEFFICIENT, condition.NegativeCoefficient)
+			r.setNormalized(USAGE_POSITIVE_SATURATION, condition.PositiveSaturation)
+			r.setNormalized(USAGE_NEGATIVE_SATURATION, condition.NegativeSaturation)
+			r.setNormalized(USAGE_DEAD_BAND, condition.DeadBand)
+			reports = append(reports, r)
+		}
+	}
+
+	for _, r := range reports {
+		if err := d.send(r); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *deviceImpl) UploadEffect(index uint8, effect Effect) error {
+	if effect.Envelope != nil {
+		if err := d.uploadEnvelope(index, effect.Envelope); err != nil {
+			return err
+		}
+	}
+	if err := d.uploadParameters(index, effect); err != nil {
+		return err
+	}
+
+	r, err := d.newReport(USAGE_SET_EFFECT_REPORT)
+	if err != nil {
+		return err
+	}
+	r.setInt(USAGE_EFFECT_BLOCK_INDEX, int32(index))
+	r.selectUsage(uint16(effect.Type))
+	r.setDuration(USAGE_DURATION, effect.Duration)
+	r.setDuration(USAGE_TRIGGER_REPEAT_INTERVAL, effect.TriggerRepeatInterval)
+	r.setDuration(USAGE_SAMPLE_PERIOD, effect.SamplePeriod)
+	r.setDuration(USAGE_START_DELAY, effect.StartDelay)
+	r.setNormalized(USAGE_GAIN, effect.Gain)
+	if effect.TriggerButton > 0 {
+		r.setInt(USAGE_TRIGGER_BUTTON, int32(effect.TriggerButton))
+	} else {
+		// Null value, i.e. all bits set, means the effect is not triggered by a button
+		r.setInt(USAGE_TRIGGER_BUTTON, -1)
+	}
+
+	if effect.Type.IsCondition() {
+		// Conditions are applied to each enabled axis separately
+		r.setFlag(hid.NewUsage(USAGE_PAGE_GENERIC_DESKTOP, USAGE_X), len(effect.Conditions) > 0)
+		r.setFlag(hid.NewUsage(USAGE_PAGE_GENERIC_DESKTOP, USAGE_Y), len(effect.Conditions) > 1)
+		r.setFlag(hid.NewUsage(USAGE_PAGE_PID, USAGE_DIRECTION_ENABLE), false)
+	} else {
+		r.setFlag(hid.NewUsage(USAGE_PAGE_GENERIC_DESKTOP, USAGE_X), true)
+		r.setFlag(hid.NewUsage(USAGE_PAGE_GENERIC_DESKTOP, USAGE_Y), true)
+		r.setFlag(hid.NewUsage(USAGE_PAGE_PID, USAGE_DIRECTION_ENABLE), true)
+		r.setAngle(hid.NewUsage(USAGE_PAGE_ORDINAL, USAGE_INSTANCE_1), effect.Direction)
+	}
+
+	return d.send(r)
+}
+
+func (d *deviceImpl) operateEffect(index uint8, operation EffectOperation, loopCount int) error {
+	r, err := d.newReport(USAGE_EFFECT_OPERATION_REPORT)
+	if err != nil {
+		return err
+	}
+	r.setInt(USAGE_EFFECT_BLOCK_INDEX, int32(index))
+	r.selectUsage(uint16(operation))
+	r.setInt(USAGE_LOOP_COUNT, int32(loopCount))
+
+	return d.send(r)
+}
+
+func (d *deviceImpl) StartEffect(index uint8, loopCount int) error {
+	return d.operateEffect(index, EFFECT_OPERATION_START, loopCount)
+}
+
+func (d *deviceImpl) StartEffectSolo(index uint8, loopCount int) error {
+	return d.operateEffect(index, EFFECT_OPERATION_START_SOLO, loopCount)
+}
+
+func (d *deviceImpl) StopEffect(index uint8) error {
+	return d.operateEffect(index, EFFECT_OPERATION_STOP, 0)
+}
+
+func (d *deviceImpl) FreeEffect(index uint8) error {
+	r, err := d.newReport(USAGE_PID_BLOCK_FREE_REPORT)
+	if err != nil {
+		return err
+	}
+	r.setInt(USAGE_EFFECT_BLOCK_INDEX, int32(index))
+
+	return d.send(r)
+}
+
+func (d *deviceImpl) SetDeviceGain(gain float64) error {
+	r, err := d.newReport(USAGE_DEVICE_GAIN_REPORT)
+	if err != nil {
+		return err
+	}
+	r.setNormalized(USAGE_DEVICE_GAIN, gain)
+
+	return d.send(r)
+}
+
+func (d *deviceImpl) SendDeviceControl(control DeviceControl) error {
+	r, err := d.newReport(USAGE_PID_DEVICE_CONTROL_REPORT)
+	if err != nil {
+		return err
+	}
+	r.selectUsage(uint16(control))
+
+	return d.send(r)
+}
@@ -0,0 +1,602 @@
+package pid_test
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/ntchjb/gohid/hid"
+	"github.com/ntchjb/gohid/pid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	hidreport "github.com/ntchjb/usbip-virtual-device/usb/protocol/hid/report"
+)
+
+var (
+	// Joystick with PID reports supporting constant force, sine, spring and damper effects,
+	// in which effect blocks are managed by the device
+	pidReportDescriptor = hidreport.HIDReportDescriptor{
+		0x05, 0x01, // Usage Page (Generic Desktop)
+		0x09, 0x04, // Usage (Joystick)
+		0xA1, 0x01, // Collection (Application)
+		0x05, 0x0F, //   Usage Page (PID)
+
+		0x09, 0x21, //   Usage (Set Effect Report)
+		0xA1, 0x02, //   Collection (Logical)
+		0x85, 0x01, //     Report ID (1)
+		0x09, 0x22, //     Usage (Effect Block Index)
+		0x15, 0x01, //     Logical Minimum (1)
+		0x25, 0x28, //     Logical Maximum (40)
+		0x75, 0x08, //     Report Size (8)
+		0x95, 0x01, //     Report Count (1)
+		0x91, 0x02, //     Output (Data,Var,Abs)
+		0x09, 0x25, //     Usage (Effect Type)
+		0xA1, 0x02, //     Collection (Logical)
+		0x09, 0x26, //       Usage (ET Constant Force)
+		0x09, 0x31, //       Usage (ET Sine)
+		0x09, 0x40, //       Usage (ET Spring)
+		0x09, 0x41, //       Usage (ET Damper)
+		0x25, 0x04, //       Logical Maximum (4)
+		0x91, 0x00, //       Output (Data,Arr,Abs)
+		0xC0,       //           End Collection
+		0x09, 0x50, //     Usage (Duration)
+		0x09, 0x54, //     Usage (Trigger Repeat Interval)
+		0x09, 0x51, //     Usage (Sample Period)
+		0x09, 0xA7, //     Usage (Start Delay)
+		0x15, 0x00, //     Logical Minimum (0)
+		0x26, 0xFF, 0x7F, // Logical Maximum (32767)
+		0x75, 0x10, //     Report Size (16)
+		0x95, 0x04, //     Report Count (4)
+		0x91, 0x02, //     Output (Data,Var,Abs)
+		0x09, 0x52, //     Usage (Gain)
+		0x26, 0xFF, 0x00, // Logical Maximum (255)
+		0x75, 0x08, //     Report Size (8)
+		0x95, 0x01, //     Report Count (1)
+		0x91, 0x02, //     Output (Data,Var,Abs)
+		0x09, 0x53, //     Usage (Trigger Button)
+		0x15, 0x01, //     Logical Minimum (1)
+		0x25, 0x08, //     Logical Maximum (8)
+		0x91, 0x02, //     Output (Data,Var,Abs)
+		0x09, 0x55, //     Usage (Axes Enable)
+		0xA1, 0x02, //     Collection (Logical)
+		0x05, 0x01, //       Usage Page (Generic Desktop)
+		0x09, 0x30, //       Usage (X)
+		0x09, 0x31, //       Usage (Y)
+		0x15, 0x00, //       Logical Minimum (0)
+		0x25, 0x01, //       Logical Maximum (1)
+		0x75, 0x01, //       Report Size (1)
+		0x95, 0x02, //       Report Count (2)
+		0x91, 0x02, //       Output (Data,Var,Abs)
+		0xC0,       //           End Collection
+		0x05, 0x0F, //     Usage Page (PID)
+		0x09, 0x56, //     Usage (Direction Enable)
+		0x95, 0x01, //     Report Count (1)
+		0x91, 0x02, //     Output (Data,Var,Abs)
+		0x95, 0x05, //     Report Count (5)
+		0x91, 0x03, //     Output (Const,Var,Abs)
+		0x09, 0x57, //     Usage (Direction)
+		0xA1, 0x02, //     Collection (Logical)
+		0x05, 0x0A, //       Usage Page (Ordinal)
+		0x09, 0x01, //       Usage (Instance 1)
+		0x26, 0xFF, 0x00, // Logical Maximum (255)
+		0x35, 0x00, //       Physical Minimum (0)
+		0x47, 0xA0, 0x8C, 0x00, 0x00, // Physical Maximum (36000)
+		0x66, 0x14, 0x00, // Unit (Degrees)
+		0x55, 0x0E, //       Unit Exponent (-2)
+		0x75, 0x08, //       Report Size (8)
+		0x95, 0x01, //       Report Count (1)
+		0x91, 0x02, //       Output (Data,Var,Abs)
+		0x45, 0x00, //       Physical Maximum (0)
+		0x65, 0x00, //       Unit (None)
+		0x55, 0x00, //       Unit Exponent (0)
+		0xC0,       //           End Collection
+		0x05, 0x0F, //     Usage Page (PID)
+		0xC0, //         End Collection
+
+		0x09, 0x73, //   Usage (Set Constant Force Report)
+		0xA1, 0x02, //   Collection (Logical)
+		0x85, 0x02, //     Report ID (2)
+		0x09, 0x22, //     Usage (Effect Block Index)
+		0x15, 0x01, //     Logical Minimum (1)
+		0x25, 0x28, //     Logical Maximum (40)
+		0x91, 0x02, //     Output (Data,Var,Abs)
+		0x09, 0x70, //     Usage (Magnitude)
+		0x16, 0xF0, 0xD8, // Logical Minimum (-10000)
+		0x26, 0x10, 0x27, // Logical Maximum (10000)
+		0x75, 0x10, //     Report Size (16)
+		0x91, 0x02, //     Output (Data,Var,Abs)
+		0xC0, //         End Collection
+
+		0x09, 0x6E, //   Usage (Set Periodic Report)
+		0xA1, 0x02, //   Collection (Logical)
+		0x85, 0x03, //     Report ID (3)
+		0x09, 0x22, //     Usage (Effect Block Index)
+		0x15, 0x01, //     Logical Minimum (1)
+		0x25, 0x28, //     Logical Maximum (40)
+		0x75, 0x08, //     Report Size (8)
+		0x91, 0x02, //     Output (Data,Var,Abs)
+		0x09, 0x70, //     Usage (Magnitude)
+		0x15, 0x00, //     Logical Minimum (0)
+		0x26, 0xFF, 0x00, // Logical Maximum (255)
+		0x91, 0x02, //     Output (Data,Var,Abs)
+		0x09, 0x6F, //     Usage (Offset)
+		0x15, 0x80, //     Logical Minimum (-128)
+		0x25, 0x7F, //     Logical Maximum (127)
+		0x91, 0x02, //     Output (Data,Var,Abs)
+		0x09, 0x71, //     Usage (Phase)
+		0x15, 0x00, //     Logical Minimum (0)
+		0x26, 0xFF, 0x00, // Logical Maximum (255)
+		0x35, 0x00, //     Physical Minimum (0)
+		0x47, 0xA0, 0x8C, 0x00, 0x00, // Physical Maximum (36000)
+		0x66, 0x14, 0x00, // Unit (Degrees)
+		0x55, 0x0E, //     Unit Exponent (-2)
+		0x91, 0x02, //     Output (Data,Var,Abs)
+		0x45, 0x00, //     Physical Maximum (0)
+		0x65, 0x00, //     Unit (None)
+		0x55, 0x00, //     Unit Exponent (0)
+		0x09, 0x72, //     Usage (Period)
+		0x26, 0xFF, 0x7F, // Logical Maximum (32767)
+		0x75, 0x10, //     Report Size (16)
+		0x91, 0x02, //     Output (Data,Var,Abs)
+		0xC0, //         End Collection
+
+		0x09, 0x5F, //   Usage (Set Condition Report)
+		0xA1, 0x02, //   Collection (Logical)
+		0x85, 0x04, //     Report ID (4)
+		0x09, 0x22, //     Usage (Effect Block Index)
+		0x15, 0x01, //     Logical Minimum (1)
+		0x25, 0x28, //     Logical Maximum (40)
+		0x75, 0x08, //     Report Size (8)
+		0x91, 0x02, //     Output (Data,Var,Abs)
+		0x09, 0x58, //     Usage (Type Specific Block Offset)
+		0x15, 0x00, //     Logical Minimum (0)
+		0x25, 0x01, //     Logical Maximum (1)
+		0x75, 0x04, //     Report Size (4)
+		0x91, 0x02, //     Output (Data,Var,Abs)
+		0x91, 0x03, //     Output (Const,Var,Abs)
+		0x09, 0x60, //     Usage (CP Offset)
+		0x09, 0x61, //     Usage (Positive Coefficient)
+		0x09, 0x62, //     Usage (Negative Coefficient)
+		0x15, 0x80, //     Logical Minimum (-128)
+		0x25, 0x7F, //     Logical Maximum (127)
+		0x75, 0x08, //     Report Size (8)
+		0x95, 0x03, //     Report Count (3)
+		0x91, 0x02, //     Output (Data,Var,Abs)
+		0x09, 0x63, //     Usage (Positive Saturation)
+		0x09, 0x64, //     Usage (Negative Saturation)
+		0x09, 0x65, //     Usage (Dead Band)
+		0x15, 0x00, //     Logical Minimum (0)
+		0x26, 0xFF, 0x00, // Logical Maximum (255)
+		0x91, 0x02, //     Output (Data,Var,Abs)
+		0x95, 0x01, //     Report Count (1)
+		0xC0, //         End Collection
+
+		0x09, 0x77, //   Usage (Effect Operation Report)
+		0xA1, 0x02, //   Collection (Logical)
+		0x85, 0x05, //     Report ID (5)
+		0x09, 0x22, //     Usage (Effect Block Index)
+		0x15, 0x01, //     Logical Minimum (1)
+		0x25, 0x28, //     Logical Maximum (40)
+		0x91, 0x02, //     Output (Data,Var,Abs)
+		0x09, 0x78, //     Usage (Effect Operation)
+		0xA1, 0x02, //     Collection (Logical)
+		0x09, 0x79, //       Usage (Op Effect Start)
+		0x09, 0x7A, //       Usage (Op Effect Start Solo)
+		0x09, 0x7B, //       Usage (Op Effect Stop)
+		0x25, 0x03, //       Logical Maximum (3)
+		0x91, 0x00, //       Output (Data,Arr,Abs)
+		0xC0,       //           End Collection
+		0x09, 0x7C, //     Usage (Loop Count)
+		0x15, 0x00, //     Logical Minimum (0)
+		0x26, 0xFF, 0x00, // Logical Maximum (255)
+		0x91, 0x02, //     Output (Data,Var,Abs)
+		0xC0, //         End Collection
+
+		0x09, 0x90, //   Usage (PID Block Free Report)
+		0xA1, 0x02, //   Collection (Logical)
+		0x85, 0x06, //     Report ID (6)
+		0x09, 0x22, //     Usage (Effect Block Index)
+		0x15, 0x01, //     Logical Minimum (1)
+		0x25, 0x28, //     Logical Maximum (40)
+		0x91, 0x02, //     Output (Data,Var,Abs)
+		0xC0, //         End Collection
+
+		0x09, 0x95, //   Usage (PID Device Control Report)
+		0xA1, 0x02, //   Collection (Logical)
+		0x85, 0x07, //     Report ID (7)
+		0x09, 0x96, //     Usage (PID Device Control)
+		0xA1, 0x02, //     Collection (Logical)
+		0x09, 0x97, //       Usage (DC Enable Actuators)
+		0x09, 0x98, //       Usage (DC Disable Actuators)
+		0x09, 0x99, //       Usage (DC Stop All Effects)
+		0x09, 0x9A, //       Usage (DC Device Reset)
+		0x09, 0x9B, //       Usage (DC Device Pause)
+		0x09, 0x9C, //       Usage (DC Device Continue)
+		0x25, 0x06, //       Logical Maximum (6)
+		0x91, 0x00, //       Output (Data,Arr,Abs)
+		0xC0, //           End Collection
+		0xC0, //         End Collection
+
+		0x09, 0x7D, //   Usage (Device Gain Report)
+		0xA1, 0x02, //   Collection (Logical)
+		0x85, 0x08, //     Report ID (8)
+		0x09, 0x7E, //     Usage (Device Gain)
+		0x15, 0x00, //     Logical Minimum (0)
+		0x26, 0xFF, 0x00, // Logical Maximum (255)
+		0x91, 0x02, //     Output (Data,Var,Abs)
+		0xC0, //         End Collection
+
+		0x09, 0xAB, //   Usage (Create New Effect Report)
+		0xA1, 0x02, //   Collection (Logical)
+		0x85, 0x09, //     Report ID (9)
+		0x09, 0x25, //     Usage (Effect Type)
+		0xA1, 0x02, //     Collection (Logical)
+		0x09, 0x26, //       Usage (ET Constant Force)
+		0x09, 0x31, //       Usage (ET Sine)
+		0x09, 0x40, //       Usage (ET Spring)
+		0x09, 0x41, //       Usage (ET Damper)
+		0x15, 0x01, //       Logical Minimum (1)
+		0x25, 0x04, //       Logical Maximum (4)
+		0xB1, 0x00, //       Feature (Data,Arr,Abs)
+		0xC0, //           End Collection
+		0xC0, //         End Collection
+
+		0x09, 0x89, //   Usage (PID Block Load Report)
+		0xA1, 0x02, //   Collection (Logical)
+		0x85, 0x0A, //     Report ID (10)
+		0x09, 0x22, //     Usage (Effect Block Index)
+		0x25, 0x28, //     Logical Maximum (40)
+		0xB1, 0x02, //     Feature (Data,Var,Abs)
+		0x09, 0x8B, //     Usage (Block Load Status)
+		0xA1, 0x02, //     Collection (Logical)
+		0x09, 0x8C, //       Usage (Block Load Success)
+		0x09, 0x8D, //       Usage (Block Load Full)
+		0x09, 0x8E, //       Usage (Block Load Error)
+		0x25, 0x03, //       Logical Maximum (3)
+		0xB1, 0x00, //       Feature (Data,Arr,Abs)
+		0xC0,       //           End Collection
+		0x09, 0xAC, //     Usage (RAM Pool Available)
+		0x15, 0x00, //     Logical Minimum (0)
+		0x27, 0xFF, 0xFF, 0x00, 0x00, // Logical Maximum (65535)
+		0x75, 0x10, //     Report Size (16)
+		0xB1, 0x02, //     Feature (Data,Var,Abs)
+		0xC0, //         End Collection
+
+		0x09, 0x7F, //   Usage (PID Pool Report)
+		0xA1, 0x02, //   Collection (Logical)
+		0x85, 0x0B, //     Report ID (11)
+		0x09, 0x80, //     Usage (RAM Pool Size)
+		0xB1, 0x02, //     Feature (Data,Var,Abs)
+		0x09, 0x83, //     Usage (Simultaneous Effects Max)
+		0x26, 0xFF, 0x00, // Logical Maximum (255)
+		0x75, 0x08, //     Report Size (8)
+		0xB1, 0x02, //     Feature (Data,Var,Abs)
+		0x09, 0xA9, //     Usage (Device Managed Pool)
+		0x09, 0xAA, //     Usage (Shared Parameter Blocks)
+		0x25, 0x01, //     Logical Maximum (1)
+		0x75, 0x01, //     Report Size (1)
+		0x95, 0x02, //     Report Count (2)
+		0xB1, 0x02, //     Feature (Data,Var,Abs)
+		0x95, 0x06, //     Report Count (6)
+		0xB1, 0x03, //     Feature (Const,Var,Abs)
+		0xC0, //         End Collection
+		0xC0, //       End Collection
+	}
+)
+
+func newDevice(t *testing.T, ctrl *gomock.Controller) (pid.Device, *hid.MockDevice) {
+	device := hid.NewMockDevice(ctrl)
+	device.EXPECT().GetReportDescriptor().Return(pidReportDescriptor, nil)
+	ffb, err := pid.NewDevice(device, slog.Default())
+	assert.NoError(t, err)
+
+	return ffb, device
+}
+
+func TestNewDevice(t *testing.T) {
+	errControl := errors.New("control transfer error")
+
+	tests := []struct {
+		name string
+		desc hidreport.HIDReportDescriptor
+		err  error
+	}{
+		{
+			name: "Success",
+			desc: pidReportDescriptor,
+		},
+		{
+			name: "Error_GetReportDescriptor",
+			err:  errControl,
+		},
+		{
+			name: "Error_NoPIDFound",
+			desc: hidreport.HIDReportDescriptor{0x05, 0x01, 0x09, 0x04, 0xA1, 0x01, 0xC0},
+			err:  pid.ErrNoPIDFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			device := hid.NewMockDevice(ctrl)
+			if test.desc != nil {
+				device.EXPECT().GetReportDescriptor().Return(test.desc, nil)
+			} else {
+				device.EXPECT().GetReportDescriptor().Return(nil, test.err)
+			}
+
+			_, err := pid.NewDevice(device, slog.Default())
+			assert.ErrorIs(t, err, test.err)
+		})
+	}
+}
+
+func TestDevice_Pool(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ffb, device := newDevice(t, ctrl)
+
+	device.EXPECT().GetFeatureReport([]byte{0x0B, 0x00, 0x00, 0x00, 0x00}).DoAndReturn(func(data []byte) (int, error) {
+		return copy(data, []byte{0x0B, 0x00, 0x10, 0x08, 0b01}), nil
+	})
+
+	pool, err := ffb.Pool()
+	assert.NoError(t, err)
+	assert.Equal(t, pid.Pool{
+		RAMPoolSize:            4096,
+		SimultaneousEffectsMax: 8,
+		DeviceManagedPool:      true,
+	}, pool)
+}
+
+func TestDevice_CreateEffect(t *testing.T) {
+	errControl := errors.New("control transfer error")
+
+	tests := []struct {
+		name       string
+		effectType pid.EffectType
+		sent       []byte
+		sendErr    error
+		blockLoad  []byte
+		index      uint8
+		err        error
+	}{
+		{
+			name:       "Success",
+			effectType: pid.EFFECT_TYPE_SINE,
+			sent:       []byte{0x09, 0x02},
+			blockLoad:  []byte{0x0A, 0x03, 0x01, 0x00, 0x10},
+			index:      3,
+		},
+		{
+			name:       "Error_PoolFull",
+			effectType: pid.EFFECT_TYPE_SPRING,
+			sent:       []byte{0x09, 0x03},
+			blockLoad:  []byte{0x0A, 0x00, 0x02, 0x00, 0x00},
+			err:        pid.ErrEffectPoolFull,
+		},
+		{
+			name:       "Error_LoadFailed",
+			effectType: pid.EFFECT_TYPE_CONSTANT_FORCE,
+			sent:       []byte{0x09, 0x01},
+			blockLoad:  []byte{0x0A, 0x00, 0x03, 0x00, 0x00},
+			err:        pid.ErrEffectLoadFailed,
+		},
+		{
+			name:       "Error_SendFeatureReport",
+			effectType: pid.EFFECT_TYPE_DAMPER,
+			sent:       []byte{0x09, 0x04},
+			sendErr:    errControl,
+			err:        errControl,
+		},
+		{
+			name:       "Error_EffectTypeNotSupported",
+			effectType: pid.EFFECT_TYPE_RAMP,
+			err:        hid.ErrReportFieldNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			ffb, device := newDevice(t, ctrl)
+			if test.sent != nil {
+				device.EXPECT().SendFeatureReport(test.sent).Return(len(test.sent), test.sendErr)
+			}
+			if test.blockLoad != nil {
+				device.EXPECT().GetFeatureReport([]byte{0x0A, 0x00, 0x00, 0x00, 0x00}).DoAndReturn(func(data []byte) (int, error) {
+					return copy(data, test.blockLoad), nil
+				})
+			}
+
+			index, err := ffb.CreateEffect(test.effectType)
+			assert.ErrorIs(t, err, test.err)
+			assert.Equal(t, test.index, index)
+		})
+	}
+}
+
+func TestDevice_UploadEffect(t *testing.T) {
+	tests := []struct {
+		name   string
+		effect pid.Effect
+		sent   [][]byte
+		err    error
+	}{
+		{
+			name: "Success_ConstantForce",
+			effect: pid.Effect{
+				Type:          pid.EFFECT_TYPE_CONSTANT_FORCE,
+				Duration:      pid.DURATION_INFINITE,
+				Gain:          0.5,
+				TriggerButton: 2,
+				Direction:     90,
+				ConstantForce: &pid.ConstantForce{Magnitude: -0.5},
+			},
+			sent: [][]byte{
+				{0x02, 0x03, 0x78, 0xEC},
+				{
+					0x01, 0x03, 0x01, 0xFF, 0xFF, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+					0x80, 0x02, 0b0000_0111, 0x40,
+				},
+			},
+		},
+		{
+			name: "Success_Periodic",
+			effect: pid.Effect{
+				Type:       pid.EFFECT_TYPE_SINE,
+				Duration:   time.Second,
+				StartDelay: 10 * time.Millisecond,
+				Gain:       1,
+				Direction:  -90,
+				Periodic: &pid.Periodic{
+					Magnitude: 0.5,
+					Offset:    -0.5,
+					Phase:     90,
+					Period:    100 * time.Millisecond,
+				},
+			},
+			sent: [][]byte{
+				{0x03, 0x03, 0x80, 0xC0, 0x40, 0x64, 0x00},
+				{
+					0x01, 0x03, 0x02, 0xE8, 0x03, 0x00, 0x00, 0x00, 0x00, 0x0A, 0x00,
+					0xFF, 0xFF, 0b0000_0111, 0xBF,
+				},
+			},
+		},
+		{
+			name: "Success_Spring",
+			effect: pid.Effect{
+				Type:     pid.EFFECT_TYPE_SPRING,
+				Duration: pid.DURATION_INFINITE,
+				Gain:     1,
+				Conditions: []pid.Condition{
+					{PositiveCoefficient: 1, NegativeCoefficient: 1, PositiveSaturation: 1, NegativeSaturation: 1},
+					{CenterPointOffset: -1, PositiveCoefficient: 0.5, NegativeCoefficient: 0.5, DeadBand: 0.1},
+				},
+			},
+			sent: [][]byte{
+				{0x04, 0x03, 0x00, 0x00, 0x7F, 0x7F, 0xFF, 0xFF, 0x00},
+				{0x04, 0x03, 0x01, 0x80, 0x40, 0x40, 0x00, 0x00, 0x1A},
+				{
+					0x01, 0x03, 0x03, 0xFF, 0xFF, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+					0xFF, 0xFF, 0b0000_0011, 0x00,
+				},
+			},
+		},
+		{
+			name: "Error_ParametersMissing",
+			effect: pid.Effect{
+				Type: pid.EFFECT_TYPE_DAMPER,
+			},
+			err: pid.ErrEffectParametersMissing,
+		},
+		{
+			name: "Error_EnvelopeNotSupported",
+			effect: pid.Effect{
+				Type:          pid.EFFECT_TYPE_CONSTANT_FORCE,
+				Envelope:      &pid.Envelope{AttackLevel: 1, AttackTime: time.Second},
+				ConstantForce: &pid.ConstantForce{Magnitude: 1},
+			},
+			err: pid.ErrReportNotSupported,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			ffb, device := newDevice(t, ctrl)
+			var calls []any
+			for _, sent := range test.sent {
+				calls = append(calls, device.EXPECT().SendOutputReport(sent).Return(len(sent), nil))
+			}
+			gomock.InOrder(calls...)
+
+			err := ffb.UploadEffect(3, test.effect)
+			assert.ErrorIs(t, err, test.err)
+		})
+	}
+}
+
+func TestDevice_Operations(t *testing.T) {
+	errControl := errors.New("control transfer error")
+
+	tests := []struct {
+		name    string
+		operate func(ffb pid.Device) error
+		sent    []byte
+		sendErr error
+	}{
+		{
+			name: "Success_StartEffect",
+			operate: func(ffb pid.Device) error {
+				return ffb.StartEffect(3, pid.LOOP_COUNT_INFINITE)
+			},
+			sent: []byte{0x05, 0x03, 0x01, 0xFF},
+		},
+		{
+			name: "Success_StartEffectSolo",
+			operate: func(ffb pid.Device) error {
+				return ffb.StartEffectSolo(3, 2)
+			},
+			sent: []byte{0x05, 0x03, 0x02, 0x02},
+		},
+		{
+			name: "Success_StopEffect",
+			operate: func(ffb pid.Device) error {
+				return ffb.StopEffect(3)
+			},
+			sent: []byte{0x05, 0x03, 0x03, 0x00},
+		},
+		{
+			name: "Success_FreeEffect",
+			operate: func(ffb pid.Device) error {
+				return ffb.FreeEffect(3)
+			},
+			sent: []byte{0x06, 0x03},
+		},
+		{
+			name: "Success_SetDeviceGain",
+			operate: func(ffb pid.Device) error {
+				return ffb.SetDeviceGain(0.5)
+			},
+			sent: []byte{0x08, 0x80},
+		},
+		{
+			name: "Success_SendDeviceControl",
+			operate: func(ffb pid.Device) error {
+				return ffb.SendDeviceControl(pid.DEVICE_CONTROL_STOP_ALL_EFFECTS)
+			},
+			sent: []byte{0x07, 0x03},
+		},
+		{
+			name: "Error_SendOutputReport",
+			operate: func(ffb pid.Device) error {
+				return ffb.StopEffect(1)
+			},
+			sent:    []byte{0x05, 0x01, 0x03, 0x00},
+			sendErr: errControl,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			ffb, device := newDevice(t, ctrl)
+			device.EXPECT().SendOutputReport(test.sent).Return(len(test.sent), test.sendErr)
+
+			err := test.operate(ffb)
+			assert.ErrorIs(t, err, test.sendErr)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package pid
+
+import (
+	"time"
+)
+
+const (
+	// Duration of an effect that plays until it is stopped
+	DURATION_INFINITE time.Duration = -1
+	// Loop count of an effect that repeats until it is stopped
+	LOOP_COUNT_INFINITE = -1
+)
+
+// Effect contains parameters of a force feedback effect.
+// Exactly one of type-specific parameters, i.e. ConstantForce, Ramp, Periodic or Conditions, must be set
+// according to Type.
+type Effect struct {
+	Type EffectType
+	// Duration of the effect, or DURATION_INFINITE
+	Duration time.Duration
+	// Interval at which the effect is repeated while trigger button is held
+	TriggerRepeatInterval time.Duration
+	// Period at which the device plays the effect, or zero for default period of the device
+	SamplePeriod time.Duration
+	StartDelay   time.Duration
+	// Gain of the effect, from 0 to 1
+	Gain float64
+	// Button number, starting from 1, that triggers the effect, or zero if it is not triggered by a button
+	TriggerButton int
+	// Direction of the effect in degrees, in polar coordinates. It is not used by condition effects.
+	Direction float64
+
+	Envelope      *Envelope
+	ConstantForce *ConstantForce
+	Ramp          *Ramp
+	Periodic      *Periodic
+	// Conditions for each axis, starting from X axis
+	Conditions []Condition
+}
+
+// Envelope shapes start and end of constant force, ramp and periodic effects.
+// Levels range from 0 to 1.
+type Envelope struct {
+	AttackLevel float64
+	AttackTime  time.Duration
+	FadeLevel   float64
+	FadeTime    time.Duration
+}
+
+// ConstantForce is parameters of constant force effects. Magnitude ranges from -1 to 1.
+type ConstantForce struct {
+	Magnitude float64
+}
+
+// Ramp is parameters of ramp effects. Magnitudes range from -1 to 1.
+type Ramp struct {
+	Start float64
+	End   float64
+}
+
+// Periodic is parameters of square, sine, triangle and sawtooth effects
+type Periodic struct {
+	// Magnitude ranges from 0 to 1
+	Magnitude float64
+	// Offset ranges from -1 to 1
+	Offset float64
+	// Phase in degrees
+	Phase  float64
+	Period time.Duration
+}
+
+// Condition is parameters of spring, damper, inertia and friction effects on an axis.
+// Offset and coefficients range from -1 to 1, while saturations and dead band range from 0 to 1.
+type Condition struct {
+	CenterPointOffset   float64
+	PositiveCoefficient float64
+	NegativeCoefficient float64
+	PositiveSaturation  float64
+	NegativeSaturation  float64
+	DeadBand            float64
+}
+
+// Pool is information of effect memory of a device, read from PID Pool report
+type Pool struct {
+	// Size of RAM for effects, in bytes
+	RAMPoolSize int
+	// Maximum number of effects that can be played at the same time
+	SimultaneousEffectsMax int
+	// Whether the device allocates effect blocks by itself via Create New Effect report
+	DeviceManagedPool bool
+	// Whether type-specific parameter blocks are shared between effects
+	SharedParameterBlocks bool
+}
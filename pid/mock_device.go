@@ -0,0 +1,167 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./pid/device.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./pid/device.go -destination=./pid/mock_device.go -package=pid
+//
+
+// Package pid is a generated GoMock package.
+package pid
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDevice is a mock of Device interface.
+type MockDevice struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeviceMockRecorder
+}
+
+// MockDeviceMockRecorder is the mock recorder for MockDevice.
+type MockDeviceMockRecorder struct {
+	mock *MockDevice
+}
+
+// NewMockDevice creates a new mock instance.
+func NewMockDevice(ctrl *gomock.Controller) *MockDevice {
+	mock := &MockDevice{ctrl: ctrl}
+	mock.recorder = &MockDeviceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDevice) EXPECT() *MockDeviceMockRecorder {
+	return m.recorder
+}
+
+// CreateEffect mocks base method.
+func (m *MockDevice) CreateEffect(effectType EffectType) (uint8, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEffect", effectType)
+	ret0, _ := ret[0].(uint8)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEffect indicates an expected call of CreateEffect.
+func (mr *MockDeviceMockRecorder) CreateEffect(effectType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEffect", reflect.TypeOf((*MockDevice)(nil).CreateEffect), effectType)
+}
+
+// FreeEffect mocks base method.
+func (m *MockDevice) FreeEffect(index uint8) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FreeEffect", index)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FreeEffect indicates an expected call of FreeEffect.
+func (mr *MockDeviceMockRecorder) FreeEffect(index any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreeEffect", reflect.TypeOf((*MockDevice)(nil).FreeEffect), index)
+}
+
+// Pool mocks base method.
+func (m *MockDevice) Pool() (Pool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pool")
+	ret0, _ := ret[0].(Pool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Pool indicates an expected call of Pool.
+func (mr *MockDeviceMockRecorder) Pool() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pool", reflect.TypeOf((*MockDevice)(nil).Pool))
+}
+
+// SendDeviceControl mocks base method.
+func (m *MockDevice) SendDeviceControl(control DeviceControl) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendDeviceControl", control)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendDeviceControl indicates an expected call of SendDeviceControl.
+func (mr *MockDeviceMockRecorder) SendDeviceControl(control any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendDeviceControl", reflect.TypeOf((*MockDevice)(nil).SendDeviceControl), control)
+}
+
+// SetDeviceGain mocks base method.
+func (m *MockDevice) SetDeviceGain(gain float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDeviceGain", gain)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDeviceGain indicates an expected call of SetDeviceGain.
+func (mr *MockDeviceMockRecorder) SetDeviceGain(gain any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDeviceGain", reflect.TypeOf((*MockDevice)(nil).SetDeviceGain), gain)
+}
+
+// StartEffect mocks base method.
+func (m *MockDevice) StartEffect(index uint8, loopCount int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartEffect", index, loopCount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartEffect indicates an expected call of StartEffect.
+func (mr *MockDeviceMockRecorder) StartEffect(index, loopCount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartEffect", reflect.TypeOf((*MockDevice)(nil).StartEffect), index, loopCount)
+}
+
+// StartEffectSolo mocks base method.
+func (m *MockDevice) StartEffectSolo(index uint8, loopCount int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartEffectSolo", index, loopCount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartEffectSolo indicates an expected call of StartEffectSolo.
+func (mr *MockDeviceMockRecorder) StartEffectSolo(index, loopCount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartEffectSolo", reflect.TypeOf((*MockDevice)(nil).StartEffectSolo), index, loopCount)
+}
+
+// StopEffect mocks base method.
+func (m *MockDevice) StopEffect(index uint8) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopEffect", index)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopEffect indicates an expected call of StopEffect.
+func (mr *MockDeviceMockRecorder) StopEffect(index any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopEffect", reflect.TypeOf((*MockDevice)(nil).StopEffect), index)
+}
+
+// UploadEffect mocks base method.
+func (m *MockDevice) UploadEffect(index uint8, effect Effect) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadEffect", index, effect)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UploadEffect indicates an expected call of UploadEffect.
+func (mr *MockDeviceMockRecorder) UploadEffect(index, effect any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadEffect", reflect.TypeOf((*MockDevice)(nil).UploadEffect), index, effect)
+}
@@ -0,0 +1,189 @@
+package pid
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/ntchjb/gohid/hid"
+)
+
+// reportInfo is a PID report found in report descriptor, identified by its logical collection
+type reportInfo struct {
+	usage      uint16
+	layout     *hid.ReportLayout
+	collection *hid.ReportCollection
+}
+
+// findReport finds a PID report by usage of its collection
+func findReport(schema *hid.ReportSchema, usage uint16) (*reportInfo, bool) {
+	for _, collection := range schema.FindCollections(hid.NewUsage(USAGE_PAGE_PID, usage)) {
+		fields := collection.AllFields()
+		if len(fields) == 0 {
+			continue
+		}
+		layout, err := schema.Layout(fields[0].ReportType, fields[0].ReportID)
+		if err != nil {
+			continue
+		}
+
+		return &reportInfo{
+			usage:      usage,
+			layout:     layout,
+			collection: collection,
+		}, true
+	}
+
+	return nil, false
+}
+
+// report is a buffer of a PID report being written or read.
+// Most of PID report fields are optional, so fields not declared by the device are skipped when writing.
+// The first error occurred is kept and returned by send.
+type report struct {
+	info *reportInfo
+	buf  []byte
+	err  error
+}
+
+func (r *reportInfo) newReport() *report {
+	return &report{
+		info: r,
+		buf:  r.layout.NewBuffer(),
+	}
+}
+
+func (r *report) field(usage hid.Usage) (*hid.ReportField, int, bool) {
+	for _, field := range r.info.collection.AllFields() {
+		if idx, ok := field.UsageIndex(usage); ok {
+			return field, idx, true
+		}
+	}
+
+	return nil, 0, false
+}
+
+func (r *report) set(usage hid.Usage, value func(field *hid.ReportField) int32) {
+	if r.err != nil {
+		return
+	}
+	field, idx, ok := r.field(usage)
+	if !ok {
+		return
+	}
+	if err := field.SetValue(r.buf[1:], idx, value(field)); err != nil {
+		r.err = fmt.Errorf("unable to set %v of report 0x%02X: %w", usage, r.info.usage, err)
+	}
+}
+
+// setInt writes a logical value
+func (r *report) setInt(id uint16, value int32) {
+	r.set(hid.NewUsage(USAGE_PAGE_PID, id), func(field *hid.ReportField) int32 {
+		return value
+	})
+}
+
+// setFlag writes 1 or 0 to a 1-bit field
+func (r *report) setFlag(usage hid.Usage, value bool) {
+	r.set(usage, func(field *hid.ReportField) int32 {
+		if value {
+			return 1
+		}
+		return 0
+	})
+}
+
+// setNormalized writes a value ranging from -1 to 1, scaled to logical range of the field
+func (r *report) setNormalized(id uint16, value float64) {
+	r.set(hid.NewUsage(USAGE_PAGE_PID, id), func(field *hid.ReportField) int32 {
+		value = math.Max(-1, math.Min(1, value))
+		var logical float64
+		if value >= 0 {
+			logical = value * float64(field.LogicalMaximum)
+		} else {
+			logical = -value * float64(field.LogicalMinimum)
+		}
+
+		return int32(math.Max(float64(field.LogicalMinimum), math.Min(float64(field.LogicalMaximum), math.Round(logical))))
+	})
+}
+
+// setDuration writes a duration. Durations are in milliseconds, unless the descriptor specifies unit explicitly.
+// Negative durations, e.g. DURATION_INFINITE, are written as null value with all bits set.
+func (r *report) setDuration(id uint16, duration time.Duration) {
+	r.set(hid.NewUsage(USAGE_PAGE_PID, id), func(field *hid.ReportField) int32 {
+		if duration < 0 {
+			return -1
+		}
+		value := float64(duration) / float64(time.Millisecond)
+		if field.Unit != 0 {
+			value = duration.Seconds()
+		}
+
+		return field.LogicalValue(value)
+	})
+}
+
+// setAngle writes an angle in degrees. If the field has no physical range, full logical range is mapped to 360 degrees.
+func (r *report) setAngle(usage hid.Usage, degrees float64) {
+	r.set(usage, func(field *hid.ReportField) int32 {
+		degrees = math.Mod(degrees, 360)
+		if degrees < 0 {
+			degrees += 360
+		}
+		if field.PhysicalMinimum != field.PhysicalMaximum {
+			return field.LogicalValue(degrees)
+		}
+
+		return field.LogicalMinimum + int32(math.Round(degrees/360*float64(int64(field.LogicalMaximum)-int64(field.LogicalMinimum))))
+	})
+}
+
+// selectUsage writes a usage to an array field, e.g. effect type or effect operation.
+// Unlike other fields, the usage must be declared by the device.
+func (r *report) selectUsage(id uint16) {
+	usage := hid.NewUsage(USAGE_PAGE_PID, id)
+	if _, _, ok := r.field(usage); !ok && r.err == nil {
+		r.err = fmt.Errorf("usage %v of report 0x%02X: %w", usage, r.info.usage, hid.ErrReportFieldNotFound)
+		return
+	}
+	r.set(usage, func(field *hid.ReportField) int32 {
+		if !field.IsArray() {
+			return 1
+		}
+		value, _ := field.ArrayValue(usage)
+		return value
+	})
+}
+
+// get reads a logical value of a field
+func (r *report) get(id uint16) (int32, bool) {
+	field, idx, ok := r.field(hid.NewUsage(USAGE_PAGE_PID, id))
+	if !ok {
+		return 0, false
+	}
+	value, err := field.Value(r.buf[1:], idx)
+	if err != nil {
+		return 0, false
+	}
+
+	return value, true
+}
+
+// selected reads the usage selected in an array field containing given usage
+func (r *report) selected(id uint16) (uint16, bool) {
+	field, _, ok := r.field(hid.NewUsage(USAGE_PAGE_PID, id))
+	if !ok || !field.IsArray() {
+		return 0, false
+	}
+	value, err := field.Value(r.buf[1:], 0)
+	if err != nil {
+		return 0, false
+	}
+	usage, ok := field.ArrayUsage(value)
+	if !ok {
+		return 0, false
+	}
+
+	return usage.ID(), true
+}
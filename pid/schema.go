@@ -0,0 +1,163 @@
+package pid
+
+const (
+	USAGE_PAGE_GENERIC_DESKTOP uint16 = 0x01
+	USAGE_PAGE_ORDINAL         uint16 = 0x0A
+	USAGE_PAGE_PID             uint16 = 0x0F
+)
+
+// Usages on Generic Desktop and Ordinal usage pages used by PID reports
+const (
+	USAGE_X          uint16 = 0x30
+	USAGE_Y          uint16 = 0x31
+	USAGE_INSTANCE_1 uint16 = 0x01
+	USAGE_INSTANCE_2 uint16 = 0x02
+)
+
+// Report collection usages on Physical Interface Device usage page
+const (
+	USAGE_SET_EFFECT_REPORT         uint16 = 0x21
+	USAGE_SET_ENVELOPE_REPORT       uint16 = 0x5A
+	USAGE_SET_CONDITION_REPORT      uint16 = 0x5F
+	USAGE_SET_PERIODIC_REPORT       uint16 = 0x6E
+	USAGE_SET_CONSTANT_FORCE_REPORT uint16 = 0x73
+	USAGE_SET_RAMP_FORCE_REPORT     uint16 = 0x74
+	USAGE_EFFECT_OPERATION_REPORT   uint16 = 0x77
+	USAGE_DEVICE_GAIN_REPORT        uint16 = 0x7D
+	USAGE_PID_POOL_REPORT           uint16 = 0x7F
+	USAGE_PID_BLOCK_LOAD_REPORT     uint16 = 0x89
+	USAGE_PID_BLOCK_FREE_REPORT     uint16 = 0x90
+	USAGE_PID_STATE_REPORT          uint16 = 0x92
+	USAGE_PID_DEVICE_CONTROL_REPORT uint16 = 0x95
+	USAGE_CREATE_NEW_EFFECT_REPORT  uint16 = 0xAB
+)
+
+// Data usages on Physical Interface Device usage page
+const (
+	USAGE_EFFECT_BLOCK_INDEX         uint16 = 0x22
+	USAGE_EFFECT_TYPE                uint16 = 0x25
+	USAGE_DURATION                   uint16 = 0x50
+	USAGE_SAMPLE_PERIOD              uint16 = 0x51
+	USAGE_GAIN                       uint16 = 0x52
+	USAGE_TRIGGER_BUTTON             uint16 = 0x53
+	USAGE_TRIGGER_REPEAT_INTERVAL    uint16 = 0x54
+	USAGE_AXES_ENABLE                uint16 = 0x55
+	USAGE_DIRECTION_ENABLE           uint16 = 0x56
+	USAGE_DIRECTION                  uint16 = 0x57
+	USAGE_TYPE_SPECIFIC_BLOCK_OFFSET uint16 = 0x58
+	USAGE_ATTACK_LEVEL               uint16 = 0x5B
+	USAGE_ATTACK_TIME                uint16 = 0x5C
+	USAGE_FADE_LEVEL                 uint16 = 0x5D
+	USAGE_FADE_TIME                  uint16 = 0x5E
+	USAGE_CP_OFFSET                  uint16 = 0x60
+	USAGE_POSITIVE_COEFFICIENT       uint16 = 0x61
+	USAGE_NEGATIVE_COEFFICIENT       uint16 = 0x62
+	USAGE_POSITIVE_SATURATION        uint16 = 0x63
+	USAGE_NEGATIVE_SATURATION        uint16 = 0x64
+	USAGE_DEAD_BAND                  uint16 = 0x65
+	USAGE_OFFSET                     uint16 = 0x6F
+	USAGE_MAGNITUDE                  uint16 = 0x70
+	USAGE_PHASE                      uint16 = 0x71
+	USAGE_PERIOD                     uint16 = 0x72
+	USAGE_RAMP_START                 uint16 = 0x75
+	USAGE_RAMP_END                   uint16 = 0x76
+	USAGE_EFFECT_OPERATION           uint16 = 0x78
+	USAGE_LOOP_COUNT                 uint16 = 0x7C
+	USAGE_DEVICE_GAIN                uint16 = 0x7E
+	USAGE_RAM_POOL_SIZE              uint16 = 0x80
+	USAGE_ROM_POOL_SIZE              uint16 = 0x81
+	USAGE_ROM_EFFECT_BLOCK_COUNT     uint16 = 0x82
+	USAGE_SIMULTANEOUS_EFFECTS_MAX   uint16 = 0x83
+	USAGE_BLOCK_LOAD_STATUS          uint16 = 0x8B
+	USAGE_EFFECT_PLAYING             uint16 = 0x94
+	USAGE_PID_DEVICE_CONTROL         uint16 = 0x96
+	USAGE_DEVICE_PAUSED              uint16 = 0x9F
+	USAGE_ACTUATORS_ENABLED          uint16 = 0xA0
+	USAGE_START_DELAY                uint16 = 0xA7
+	USAGE_DEVICE_MANAGED_POOL        uint16 = 0xA9
+	USAGE_SHARED_PARAMETER_BLOCKS    uint16 = 0xAA
+	USAGE_RAM_POOL_AVAILABLE         uint16 = 0xAC
+)
+
+// EffectType is a usage of effect type on Physical Interface Device usage page
+type EffectType uint16
+
+const (
+	EFFECT_TYPE_CONSTANT_FORCE EffectType = 0x26
+	EFFECT_TYPE_RAMP           EffectType = 0x27
+	EFFECT_TYPE_CUSTOM_FORCE   EffectType = 0x28
+	EFFECT_TYPE_SQUARE         EffectType = 0x30
+	EFFECT_TYPE_SINE           EffectType = 0x31
+	EFFECT_TYPE_TRIANGLE       EffectType = 0x32
+	EFFECT_TYPE_SAWTOOTH_UP    EffectType = 0x33
+	EFFECT_TYPE_SAWTOOTH_DOWN  EffectType = 0x34
+	EFFECT_TYPE_SPRING         EffectType = 0x40
+	EFFECT_TYPE_DAMPER         EffectType = 0x41
+	EFFECT_TYPE_INERTIA        EffectType = 0x42
+	EFFECT_TYPE_FRICTION       EffectType = 0x43
+)
+
+var (
+	EffectTypeNames = map[EffectType]string{
+		EFFECT_TYPE_CONSTANT_FORCE: "Constant Force",
+		EFFECT_TYPE_RAMP:           "Ramp",
+		EFFECT_TYPE_CUSTOM_FORCE:   "Custom Force",
+		EFFECT_TYPE_SQUARE:         "Square",
+		EFFECT_TYPE_SINE:           "Sine",
+		EFFECT_TYPE_TRIANGLE:       "Triangle",
+		EFFECT_TYPE_SAWTOOTH_UP:    "Sawtooth Up",
+		EFFECT_TYPE_SAWTOOTH_DOWN:  "Sawtooth Down",
+		EFFECT_TYPE_SPRING:         "Spring",
+		EFFECT_TYPE_DAMPER:         "Damper",
+		EFFECT_TYPE_INERTIA:        "Inertia",
+		EFFECT_TYPE_FRICTION:       "Friction",
+	}
+)
+
+func (e EffectType) String() string {
+	if name, ok := EffectTypeNames[e]; ok {
+		return name
+	}
+
+	return "Unknown Effect"
+}
+
+// IsPeriodic reports whether parameters of this effect type are sent via Set Periodic report
+func (e EffectType) IsPeriodic() bool {
+	return e >= EFFECT_TYPE_SQUARE && e <= EFFECT_TYPE_SAWTOOTH_DOWN
+}
+
+// IsCondition reports whether parameters of this effect type are sent via Set Condition report
+func (e EffectType) IsCondition() bool {
+	return e >= EFFECT_TYPE_SPRING && e <= EFFECT_TYPE_FRICTION
+}
+
+// BlockLoadStatus is a result of creating a new effect, read from PID Block Load report
+type BlockLoadStatus uint16
+
+const (
+	BLOCK_LOAD_STATUS_SUCCESS BlockLoadStatus = 0x8C
+	BLOCK_LOAD_STATUS_FULL    BlockLoadStatus = 0x8D
+	BLOCK_LOAD_STATUS_ERROR   BlockLoadStatus = 0x8E
+)
+
+// EffectOperation is an operation on an effect, sent via Effect Operation report
+type EffectOperation uint16
+
+const (
+	EFFECT_OPERATION_START      EffectOperation = 0x79
+	EFFECT_OPERATION_START_SOLO EffectOperation = 0x7A
+	EFFECT_OPERATION_STOP       EffectOperation = 0x7B
+)
+
+// DeviceControl is a command sent to the whole device via PID Device Control report
+type DeviceControl uint16
+
+const (
+	DEVICE_CONTROL_ENABLE_ACTUATORS  DeviceControl = 0x97
+	DEVICE_CONTROL_DISABLE_ACTUATORS DeviceControl = 0x98
+	DEVICE_CONTROL_STOP_ALL_EFFECTS  DeviceControl = 0x99
+	DEVICE_CONTROL_DEVICE_RESET      DeviceControl = 0x9A
+	DEVICE_CONTROL_DEVICE_PAUSE      DeviceControl = 0x9B
+	DEVICE_CONTROL_DEVICE_CONTINUE   DeviceControl = 0x9C
+)
@@ -0,0 +1,391 @@
+package remote
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"sync"
+
+	"github.com/google/gousb"
+	"github.com/ntchjb/gohid/hid"
+	"github.com/ntchjb/gohid/manager"
+	hiddesc "github.com/ntchjb/usbip-virtual-device/usb/protocol/hid"
+	hidreport "github.com/ntchjb/usbip-virtual-device/usb/protocol/hid/report"
+)
+
+type clientImpl struct {
+	conn   net.Conn
+	logger *slog.Logger
+
+	writeMu sync.Mutex
+	mu      sync.Mutex
+	ids     uint32
+	pending map[uint32]chan Message
+	closed  chan struct{}
+	err     error
+}
+
+// Dial connects to a server at address of network, e.g. "tcp" or "unix", and returns a device manager of its devices.
+// Closing the device manager closes the connection, and devices opened by it.
+func Dial(network, address string, logger *slog.Logger) (manager.DeviceManager, error) {
+	conn, err := net.Dial(network, address)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to %s %s: %w", network, address, err)
+	}
+
+	return NewDeviceManager(conn, logger), nil
+}
+
+// NewDeviceManager creates a device manager of devices served by server on the other side of conn
+func NewDeviceManager(conn net.Conn, logger *slog.Logger) manager.DeviceManager {
+	c := &clientImpl{
+		conn:    conn,
+		logger:  logger,
+		pending: make(map[uint32]chan Message),
+		closed:  make(chan struct{}),
+	}
+	go c.receive()
+
+	return c
+}
+
+// receive dispatches replies to pending requests until the connection is closed
+func (c *clientImpl) receive() {
+	for {
+		msg, err := readMessage(c.conn)
+		if err != nil {
+			c.shutdown(err)
+			return
+		}
+
+		c.mu.Lock()
+		reply, ok := c.pending[msg.ID]
+		delete(c.pending, msg.ID)
+		c.mu.Unlock()
+		if !ok {
+			c.logger.Debug("drop reply of unknown request", "id", msg.ID)
+			continue
+		}
+		reply <- msg
+	}
+}
+
+// shutdown closes the connection, and fails pending and future requests with err
+func (c *clientImpl) shutdown(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	select {
+	case <-c.closed:
+		return
+	default:
+	}
+	c.err = err
+	close(c.closed)
+	c.conn.Close()
+}
+
+func (c *clientImpl) closeError() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return fmt.Errorf("%w: %w", ErrConnectionClosed, c.err)
+}
+
+// send writes a message, where the connection is closed if the message cannot be written to it
+func (c *clientImpl) send(msg Message) error {
+	select {
+	case <-c.closed:
+		return c.closeError()
+	default:
+	}
+
+	c.writeMu.Lock()
+	err := writeMessage(c.conn, msg)
+	c.writeMu.Unlock()
+	if errors.Is(err, ErrMessageTooLarge) {
+		// Nothing has been written
+		return err
+	}
+	if err != nil {
+		c.shutdown(err)
+		return c.closeError()
+	}
+
+	return nil
+}
+
+// call sends a request and waits for its reply. When ctx is done, the request is cancelled,
+// and it returns the reply if the request has been completed before being cancelled.
+func (c *clientImpl) call(ctx context.Context, req Message) (Message, error) {
+	reply := make(chan Message, 1)
+	c.mu.Lock()
+	c.ids++
+	req.ID = c.ids
+	c.pending[req.ID] = reply
+	c.mu.Unlock()
+
+	if err := c.send(req); err != nil {
+		c.mu.Lock()
+		delete(c.pending, req.ID)
+		c.mu.Unlock()
+		return Message{}, err
+	}
+
+	var msg Message
+	select {
+	case msg = <-reply:
+	case <-c.closed:
+		return Message{}, c.closeError()
+	case <-ctx.Done():
+		if err := c.send(Message{ID: req.ID, Method: METHOD_CANCEL}); err != nil {
+			return Message{}, err
+		}
+		select {
+		case msg = <-reply:
+		case <-c.closed:
+			return Message{}, c.closeError()
+		}
+		if errors.Is(msg.Error.Err(), context.Canceled) {
+			return msg, ctx.Err()
+		}
+	}
+
+	return msg, msg.Error.Err()
+}
+
+func (c *clientImpl) Close() error {
+	c.shutdown(net.ErrClosed)
+
+	return nil
+}
+
+func (c *clientImpl) Enumerate(vendorID gousb.ID, productID gousb.ID) (hid.DeviceInfos, error) {
+	reply, err := c.call(context.Background(), Message{
+		Method:    METHOD_ENUMERATE,
+		VendorID:  vendorID,
+		ProductID: productID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to enumerate devices with vendorID: %d, productID: %d: %w", vendorID, productID, err)
+	}
+
+	var deviceInfos hid.DeviceInfos
+	for _, info := range reply.Devices {
+		deviceInfo, err := info.DeviceInfo()
+		if err != nil {
+			return nil, err
+		}
+		deviceInfos = append(deviceInfos, deviceInfo)
+	}
+
+	return deviceInfos, nil
+}
+
+func (c *clientImpl) Open(vendorID, productID gousb.ID, config hid.DeviceConfig) (hid.Device, error) {
+	reply, err := c.call(context.Background(), Message{
+		Method:    METHOD_OPEN,
+		VendorID:  vendorID,
+		ProductID: productID,
+		Config:    &config,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to open device %v:%v: %w", vendorID, productID, err)
+	}
+
+	return &deviceImpl{
+		client: c,
+		handle: reply.Device,
+	}, nil
+}
+
+// deviceImpl is a device opened by server, where its methods are called remotely
+type deviceImpl struct {
+	client *clientImpl
+	handle uint32
+
+	mu         sync.Mutex
+	deviceInfo hid.DeviceInfo
+}
+
+func (d *deviceImpl) call(ctx context.Context, req Message) (Message, error) {
+	req.Device = d.handle
+
+	return d.client.call(ctx, req)
+}
+
+func (d *deviceImpl) SetTarget(confNumber, infNumber, altNumber int) error {
+	reply, err := d.call(context.Background(), Message{
+		Method: METHOD_SET_TARGET,
+		Target: &Target{
+			Config:     confNumber,
+			Interface:  infNumber,
+			AltSetting: altNumber,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if reply.DeviceInfo == nil {
+		return fmt.Errorf("%w: device info is missing", ErrUnexpectedMessage)
+	}
+	deviceInfo, err := reply.DeviceInfo.DeviceInfo()
+	if err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.deviceInfo = deviceInfo
+
+	return nil
+}
+
+func (d *deviceImpl) Close() error {
+	_, err := d.call(context.Background(), Message{Method: METHOD_CLOSE})
+
+	return err
+}
+
+func (d *deviceImpl) SetAutoDetach(autoDetach bool) error {
+	_, err := d.call(context.Background(), Message{
+		Method:     METHOD_SET_AUTO_DETACH,
+		AutoDetach: autoDetach,
+	})
+
+	return err
+}
+
+func (d *deviceImpl) WriteOutput(ctx context.Context, data []byte) (int, error) {
+	reply, err := d.call(ctx, Message{
+		Method: METHOD_WRITE_OUTPUT,
+		Data:   data,
+	})
+
+	return reply.N, err
+}
+
+func (d *deviceImpl) ReadInput(ctx context.Context, data []byte) (int, error) {
+	if len(data) == 0 {
+		return 0, nil
+	}
+	reply, err := d.call(ctx, Message{
+		Method: METHOD_READ_INPUT,
+		Length: len(data),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return copy(data, reply.Data), nil
+}
+
+func (d *deviceImpl) SendFeatureReport(data []byte) (int, error) {
+	reply, err := d.call(context.Background(), Message{
+		Method: METHOD_SEND_FEATURE_REPORT,
+		Data:   data,
+	})
+
+	return reply.N, err
+}
+
+// getReport gets a report into data, where report ID is the first byte of data
+func (d *deviceImpl) getReport(method Method, data []byte) (int, error) {
+	if len(data) == 0 {
+		return 0, hid.ErrEmptyData
+	}
+	reply, err := d.call(context.Background(), Message{
+		Method: method,
+		Data:   data,
+	})
+	if err != nil {
+		return reply.N, err
+	}
+	copy(data, reply.Data)
+
+	return reply.N, nil
+}
+
+func (d *deviceImpl) GetFeatureReport(data []byte) (int, error) {
+	return d.getReport(METHOD_GET_FEATURE_REPORT, data)
+}
+
+func (d *deviceImpl) SendOutputReport(data []byte) (int, error) {
+	reply, err := d.call(context.Background(), Message{
+		Method: METHOD_SEND_OUTPUT_REPORT,
+		Data:   data,
+	})
+
+	return reply.N, err
+}
+
+func (d *deviceImpl) GetInputReport(data []byte) (int, error) {
+	return d.getReport(METHOD_GET_INPUT_REPORT, data)
+}
+
+func (d *deviceImpl) getString(method Method, index int) (string, error) {
+	reply, err := d.call(context.Background(), Message{
+		Method: method,
+		Index:  index,
+	})
+
+	return reply.String, err
+}
+
+func (d *deviceImpl) GetSerialNumber() (string, error) {
+	return d.getString(METHOD_GET_SERIAL_NUMBER, 0)
+}
+
+func (d *deviceImpl) GetProduct() (string, error) {
+	return d.getString(METHOD_GET_PRODUCT, 0)
+}
+
+func (d *deviceImpl) GetManufacturer() (string, error) {
+	return d.getString(METHOD_GET_MANUFACTURER, 0)
+}
+
+func (d *deviceImpl) GetStringDescriptor(index int) (string, error) {
+	return d.getString(METHOD_GET_STRING_DESCRIPTOR, index)
+}
+
+func (d *deviceImpl) GetReportDescriptor() (hidreport.HIDReportDescriptor, error) {
+	reply, err := d.call(context.Background(), Message{Method: METHOD_GET_REPORT_DESCRIPTOR})
+	if err != nil {
+		return nil, err
+	}
+
+	return reply.Data, nil
+}
+
+func (d *deviceImpl) GetHIDDescriptor() (hiddesc.HIDDescriptor, error) {
+	reply, err := d.call(context.Background(), Message{Method: METHOD_GET_HID_DESCRIPTOR})
+	if err != nil {
+		return hiddesc.HIDDescriptor{}, err
+	}
+	if reply.HIDDescriptor == nil {
+		return hiddesc.HIDDescriptor{}, fmt.Errorf("%w: HID descriptor is missing", ErrUnexpectedMessage)
+	}
+
+	return *reply.HIDDescriptor, nil
+}
+
+// GetDeviceInfo returns device info of the target, or zero value if the target is not set
+func (d *deviceImpl) GetDeviceInfo() hid.DeviceInfo {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.deviceInfo
+}
+
+// Stats returns statistics of the device collected by the server, or zero value if they cannot be got
+func (d *deviceImpl) Stats() hid.Stats {
+	reply, err := d.call(context.Background(), Message{Method: METHOD_GET_STATS})
+	if err != nil || reply.Stats == nil {
+		d.client.logger.Warn("unable to get stats of remote device", "err", err)
+		return hid.Stats{}
+	}
+
+	return *reply.Stats
+}
@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./remote/server.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./remote/server.go -destination=./remote/mock_server.go -package=remote
+//
+
+// Package remote is a generated GoMock package.
+package remote
+
+import (
+	net "net"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockServer is a mock of Server interface.
+type MockServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockServerMockRecorder
+}
+
+// MockServerMockRecorder is the mock recorder for MockServer.
+type MockServerMockRecorder struct {
+	mock *MockServer
+}
+
+// NewMockServer creates a new mock instance.
+func NewMockServer(ctrl *gomock.Controller) *MockServer {
+	mock := &MockServer{ctrl: ctrl}
+	mock.recorder = &MockServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServer) EXPECT() *MockServerMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockServer) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockServerMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockServer)(nil).Close))
+}
+
+// ListenAndServe mocks base method.
+func (m *MockServer) ListenAndServe(network, address string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListenAndServe", network, address)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListenAndServe indicates an expected call of ListenAndServe.
+func (mr *MockServerMockRecorder) ListenAndServe(network, address any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListenAndServe", reflect.TypeOf((*MockServer)(nil).ListenAndServe), network, address)
+}
+
+// Serve mocks base method.
+func (m *MockServer) Serve(listener net.Listener) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Serve", listener)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Serve indicates an expected call of Serve.
+func (mr *MockServerMockRecorder) Serve(listener any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Serve", reflect.TypeOf((*MockServer)(nil).Serve), listener)
+}
@@ -0,0 +1,242 @@
+// Package remote serves HID devices over a network connection, such as TCP or Unix socket, so that an unprivileged
+// process can use devices opened by a privileged broker, e.g.
+//
+//	server := remote.NewServer(manager.NewDeviceManager(usb.NewGOUSBContext(), logger), logger)
+//	go server.ListenAndServe("unix", "/run/gohid.sock")
+//
+// and in the unprivileged process
+//
+//	man, _ := remote.Dial("unix", "/run/gohid.sock", logger)
+//	device, _ := man.Open(0x046D, 0xC52B, hid.DeviceConfig{})
+//
+// Each message is framed by its length as 4-byte big endian integer, followed by the message in JSON.
+// Requests are replied with the same ID, and they can be replied in any order.
+package remote
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/google/gousb"
+	"github.com/ntchjb/gohid/hid"
+	"github.com/ntchjb/gohid/usb"
+	hiddesc "github.com/ntchjb/usbip-virtual-device/usb/protocol/hid"
+)
+
+var (
+	ErrRemoteError       = errors.New("remote error")
+	ErrMessageTooLarge   = errors.New("message too large")
+	ErrUnknownMethod     = errors.New("unknown method")
+	ErrInvalidRequest    = errors.New("invalid request")
+	ErrDeviceNotOpened   = errors.New("device is not opened")
+	ErrConnectionClosed  = errors.New("connection closed")
+	ErrServerClosed      = errors.New("server closed")
+	ErrUnexpectedMessage = errors.New("unexpected message")
+	ErrStatsNotCollected = errors.New("statistics are not collected by the device")
+)
+
+const (
+	// Maximum size of a message, excluding its length
+	MAX_MESSAGE_SIZE = 1 << 20
+	// Size of message length before each message
+	MESSAGE_LENGTH_SIZE = 4
+)
+
+type Method string
+
+const (
+	METHOD_ENUMERATE             Method = "enumerate"
+	METHOD_OPEN                  Method = "open"
+	METHOD_CLOSE                 Method = "close"
+	METHOD_SET_AUTO_DETACH       Method = "setAutoDetach"
+	METHOD_SET_TARGET            Method = "setTarget"
+	METHOD_WRITE_OUTPUT          Method = "writeOutput"
+	METHOD_READ_INPUT            Method = "readInput"
+	METHOD_SEND_FEATURE_REPORT   Method = "sendFeatureReport"
+	METHOD_GET_FEATURE_REPORT    Method = "getFeatureReport"
+	METHOD_SEND_OUTPUT_REPORT    Method = "sendOutputReport"
+	METHOD_GET_INPUT_REPORT      Method = "getInputReport"
+	METHOD_GET_SERIAL_NUMBER     Method = "getSerialNumber"
+	METHOD_GET_PRODUCT           Method = "getProduct"
+	METHOD_GET_MANUFACTURER      Method = "getManufacturer"
+	METHOD_GET_REPORT_DESCRIPTOR Method = "getReportDescriptor"
+	METHOD_GET_HID_DESCRIPTOR    Method = "getHIDDescriptor"
+	METHOD_GET_STRING_DESCRIPTOR Method = "getStringDescriptor"
+	METHOD_GET_STATS             Method = "getStats"
+	// Cancel a pending request having the same ID. It is not replied, but the cancelled request is replied
+	// with context.Canceled error if it has not been completed yet.
+	METHOD_CANCEL Method = "cancel"
+)
+
+// Message is a request from client, or a reply from server
+type Message struct {
+	// ID of request, which is used by its reply
+	ID uint32 `json:"id"`
+	// Method of request, or empty for replies
+	Method Method `json:"method,omitempty"`
+	// Handle of opened device, numbered from 1 in each connection
+	Device uint32 `json:"device,omitempty"`
+
+	// Device to be enumerated or opened
+	VendorID  gousb.ID          `json:"vendorId,omitempty"`
+	ProductID gousb.ID          `json:"productId,omitempty"`
+	Config    *hid.DeviceConfig `json:"config,omitempty"`
+
+	// Target of SetTarget
+	Target     *Target `json:"target,omitempty"`
+	AutoDetach bool    `json:"autoDetach,omitempty"`
+
+	// Report with report ID as the first byte, or buffer of Get_Report with report ID as the first byte
+	Data []byte `json:"data,omitempty"`
+	// Size of buffer of ReadInput
+	Length int `json:"length,omitempty"`
+	// Index of string descriptor
+	Index int `json:"index,omitempty"`
+
+	// Number of bytes transferred
+	N             int                    `json:"n,omitempty"`
+	String        string                 `json:"string,omitempty"`
+	Devices       []DeviceInfo           `json:"devices,omitempty"`
+	DeviceInfo    *DeviceInfo            `json:"deviceInfo,omitempty"`
+	HIDDescriptor *hiddesc.HIDDescriptor `json:"hidDescriptor,omitempty"`
+	Stats         *hid.Stats             `json:"stats,omitempty"`
+	Error         *Error                 `json:"error,omitempty"`
+}
+
+// Target is configuration, interface and alternate setting numbers of a device
+type Target struct {
+	Config     int `json:"config"`
+	Interface  int `json:"interface"`
+	AltSetting int `json:"altSetting"`
+}
+
+// DeviceInfo is hid.DeviceInfo sent over connection
+type DeviceInfo struct {
+	Desc   *gousb.DeviceDesc `json:"desc"`
+	Target Target            `json:"target"`
+}
+
+func newDeviceInfo(info hid.DeviceInfo) DeviceInfo {
+	return DeviceInfo{
+		Desc: info.DeviceDesc,
+		Target: Target{
+			Config:     info.GetConfigNumber(),
+			Interface:  info.GetInterfaceNumber(),
+			AltSetting: info.GetAltSettingNumber(),
+		},
+	}
+}
+
+// DeviceInfo converts it back to hid.DeviceInfo
+func (d DeviceInfo) DeviceInfo() (hid.DeviceInfo, error) {
+	var info hid.DeviceInfo
+	if d.Desc == nil {
+		return info, fmt.Errorf("%w: %w", ErrUnexpectedMessage, hid.ErrDeviceDescNotFound)
+	}
+	if err := info.FromDeviceDesc(d.Desc, d.Target.Config, d.Target.Interface, d.Target.AltSetting); err != nil {
+		return info, fmt.Errorf("%w: %w", ErrUnexpectedMessage, err)
+	}
+
+	return info, nil
+}
+
+// Errors which are sent by their messages, so that they can be matched by errors.Is on client side
+var sentinelErrors = []error{
+	hid.ErrEmptyData,
+	hid.ErrUninitializedDevice,
+	hid.ErrUninitializedEndpoint,
+	hid.ErrEndpointInNotFound,
+	hid.ErrDeviceProfileNotFound,
+	usb.ErrTimeout,
+	usb.ErrDisconnected,
+	usb.ErrStall,
+	usb.ErrAccessDenied,
+	usb.ErrBusy,
+	ErrUnknownMethod,
+	ErrInvalidRequest,
+	ErrDeviceNotOpened,
+	context.Canceled,
+	context.DeadlineExceeded,
+}
+
+// Error is an error returned by server. Errors of gousb and errors of this library are returned
+// to client as the same error values.
+type Error struct {
+	usb.EncodedError
+}
+
+func newError(err error) *Error {
+	if err == nil {
+		return nil
+	}
+
+	return &Error{EncodedError: usb.EncodeError(err, sentinelErrors)}
+}
+
+// remoteError keeps message of an error returned by server, and wraps ErrRemoteError and known errors
+type remoteError struct {
+	message string
+	errs    []error
+}
+
+func (e *remoteError) Error() string {
+	return e.message
+}
+
+func (e *remoteError) Unwrap() []error {
+	return e.errs
+}
+
+// Err converts an error returned by server into an error, or nil if there is no error
+func (e *Error) Err() error {
+	if e == nil {
+		return nil
+	}
+
+	return &remoteError{
+		message: e.Message,
+		errs:    append([]error{ErrRemoteError}, e.Errors(sentinelErrors)...),
+	}
+}
+
+// writeMessage writes a message with its length in a single write, so that it is not interleaved with other messages
+func writeMessage(w io.Writer, msg Message) error {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("unable to encode message: %w", err)
+	}
+	if len(body) > MAX_MESSAGE_SIZE {
+		return fmt.Errorf("message of %d bytes: %w", len(body), ErrMessageTooLarge)
+	}
+	buf := binary.BigEndian.AppendUint32(make([]byte, 0, MESSAGE_LENGTH_SIZE+len(body)), uint32(len(body)))
+	if _, err := w.Write(append(buf, body...)); err != nil {
+		return fmt.Errorf("unable to write message: %w", err)
+	}
+
+	return nil
+}
+
+func readMessage(r io.Reader) (Message, error) {
+	var msg Message
+	var length [MESSAGE_LENGTH_SIZE]byte
+	if _, err := io.ReadFull(r, length[:]); err != nil {
+		return msg, err
+	}
+	size := binary.BigEndian.Uint32(length[:])
+	if size > MAX_MESSAGE_SIZE {
+		return msg, fmt.Errorf("message of %d bytes: %w", size, ErrMessageTooLarge)
+	}
+	body := make([]byte, size)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return msg, err
+	}
+	if err := json.Unmarshal(body, &msg); err != nil {
+		return msg, fmt.Errorf("%w: %w", ErrUnexpectedMessage, err)
+	}
+
+	return msg, nil
+}
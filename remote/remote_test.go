@@ -0,0 +1,282 @@
+package remote_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/gousb"
+	"github.com/ntchjb/gohid/hid"
+	"github.com/ntchjb/gohid/manager"
+	"github.com/ntchjb/gohid/remote"
+	"github.com/ntchjb/gohid/usb"
+	"github.com/ntchjb/gohid/usb/emulator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var deviceDesc = &gousb.DeviceDesc{
+	Bus:                  1,
+	Address:              3,
+	Speed:                gousb.SpeedFull,
+	Spec:                 0x0200,
+	Device:               0x0100,
+	Vendor:               0xFF01,
+	Product:              0x0001,
+	MaxControlPacketSize: 64,
+	Configs: map[int]gousb.ConfigDesc{
+		1: {
+			Number:   1,
+			MaxPower: 100,
+			Interfaces: []gousb.InterfaceDesc{
+				{
+					Number: 0,
+					AltSettings: []gousb.InterfaceSetting{
+						{
+							Alternate: 0,
+							Class:     gousb.ClassHID,
+							Endpoints: map[gousb.EndpointAddress]gousb.EndpointDesc{
+								0x81: {
+									Address:       0x81,
+									Number:        1,
+									Direction:     gousb.EndpointDirectionIn,
+									MaxPacketSize: 64,
+									TransferType:  gousb.TransferTypeInterrupt,
+									PollInterval:  10 * time.Millisecond,
+								},
+								0x01: {
+									Address:       0x01,
+									Number:        1,
+									Direction:     gousb.EndpointDirectionOut,
+									MaxPacketSize: 64,
+									TransferType:  gousb.TransferTypeInterrupt,
+									PollInterval:  10 * time.Millisecond,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+// Vendor-defined device with 2-byte Input, Output and Feature reports
+var reportDescriptor = []byte{
+	0x06, 0x00, 0xFF, 0x09, 0x01, 0xA1, 0x01,
+	0x15, 0x00, 0x26, 0xFF, 0x00, 0x75, 0x08, 0x95, 0x02,
+	0x09, 0x01, 0x81, 0x02,
+	0x09, 0x02, 0x91, 0x02,
+	0x09, 0x03, 0xB1, 0x02,
+	0xC0,
+}
+
+// serve plugs an emulated device, and serves it on a listener of network, then returns a connected device manager
+func serve(t *testing.T, network string, handler emulator.Handler) (emulator.Device, remote.Server, manager.DeviceManager) {
+	emulatorCtx := emulator.NewContext()
+	device, err := emulatorCtx.Connect(emulator.DeviceConfig{
+		Desc:         deviceDesc,
+		Manufacturer: "gohid",
+		Product:      "Emulated device",
+		Interfaces: map[int]emulator.InterfaceConfig{
+			0: {ReportDescriptor: reportDescriptor, Handler: handler},
+		},
+	})
+	require.NoError(t, err)
+
+	address := "127.0.0.1:0"
+	if network == "unix" {
+		address = filepath.Join(t.TempDir(), "gohid.sock")
+	}
+	listener, err := net.Listen(network, address)
+	require.NoError(t, err)
+	server := remote.NewServer(manager.NewDeviceManager(emulatorCtx, slog.Default()), slog.Default())
+	served := make(chan error, 1)
+	go func() {
+		served <- server.Serve(listener)
+	}()
+	t.Cleanup(func() {
+		server.Close()
+		assert.ErrorIs(t, <-served, remote.ErrServerClosed)
+	})
+
+	man, err := remote.Dial(network, listener.Addr().String(), slog.Default())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		man.Close()
+	})
+
+	return device, server, man
+}
+
+func TestRemote_Enumerate(t *testing.T) {
+	for _, network := range []string{"tcp", "unix"} {
+		t.Run(network, func(t *testing.T) {
+			_, _, man := serve(t, network, nil)
+
+			deviceInfos, err := man.Enumerate(0xFF01, 0)
+			require.NoError(t, err)
+			require.Len(t, deviceInfos, 1)
+			assert.Equal(t, deviceDesc, deviceInfos[0].DeviceDesc)
+			assert.Equal(t, 1, deviceInfos[0].GetConfigNumber())
+			assert.Equal(t, 0, deviceInfos[0].GetInterfaceNumber())
+			assert.Equal(t, 0, deviceInfos[0].GetAltSettingNumber())
+
+			deviceInfos, err = man.Enumerate(0xFF01, 0x0002)
+			require.NoError(t, err)
+			assert.Empty(t, deviceInfos)
+		})
+	}
+}
+
+func TestRemote_Device(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	handler := emulator.NewMockHandler(ctrl)
+	device, _, man := serve(t, "tcp", handler)
+
+	hidDevice, err := man.Open(0xFF01, 0x0001, hid.DeviceConfig{StreamLaneCount: hid.DEFAULT_ENDPOINT_STREAM_COUNT})
+	require.NoError(t, err)
+	defer hidDevice.Close()
+	assert.Nil(t, hidDevice.GetDeviceInfo().DeviceDesc)
+	require.NoError(t, hidDevice.SetAutoDetach(true))
+	require.NoError(t, hidDevice.SetTarget(1, 0, 0))
+	deviceInfo := hidDevice.GetDeviceInfo()
+	assert.Equal(t, deviceDesc, deviceInfo.DeviceDesc)
+	assert.Len(t, deviceInfo.GetEndpoints(), 2)
+
+	desc, err := hidDevice.GetReportDescriptor()
+	require.NoError(t, err)
+	assert.Equal(t, reportDescriptor, []byte(desc))
+	hidDesc, err := hidDevice.GetHIDDescriptor()
+	require.NoError(t, err)
+	assert.Equal(t, uint16(len(reportDescriptor)), hidDesc.WDescriptorLength)
+	str, err := hidDevice.GetManufacturer()
+	assert.NoError(t, err)
+	assert.Equal(t, "gohid", str)
+	str, err = hidDevice.GetProduct()
+	assert.NoError(t, err)
+	assert.Equal(t, "Emulated device", str)
+
+	handler.EXPECT().GetReport(hid.REPORT_TYPE_FEATURE, uint8(0), gomock.Len(2)).DoAndReturn(
+		func(reportType hid.ReportType, reportID uint8, data []byte) (int, error) {
+			return copy(data, []byte{0x12, 0x34}), nil
+		},
+	)
+	handler.EXPECT().SetReport(hid.REPORT_TYPE_FEATURE, uint8(0), []byte{0x56, 0x78}).Return(nil)
+	handler.EXPECT().Output([]byte{0x9A, 0xBC}).Return(nil)
+
+	data := []byte{0x00, 0x00, 0x00}
+	n, err := hidDevice.GetFeatureReport(data)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x00, 0x12, 0x34}, data[:n])
+	n, err = hidDevice.SendFeatureReport([]byte{0x00, 0x56, 0x78})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+	n, err = hidDevice.WriteOutput(context.Background(), []byte{0x00, 0x9A, 0xBC})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	require.NoError(t, device.SendInput(context.Background(), 0, []byte{0x01, 0x02}))
+	data = make([]byte, 64)
+	n, err = hidDevice.ReadInput(context.Background(), data)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x01, 0x02}, data[:n])
+
+	// Pending read is cancelled on server when ctx is done, then the device is still usable
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = hidDevice.ReadInput(ctx, data)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	require.NoError(t, device.SendInput(context.Background(), 0, []byte{0x03, 0x04}))
+	n, err = hidDevice.ReadInput(context.Background(), data)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x03, 0x04}, data[:n])
+
+	stats := hidDevice.(hid.StatsProvider).Stats()
+	assert.Equal(t, uint64(2), stats.InputReports)
+	assert.Equal(t, uint64(1), stats.OutputReports)
+	assert.Equal(t, uint64(4), stats.ControlRoundTrips.Count)
+	assert.Equal(t, uint64(1), stats.Errors[usb.OPERATION_READ])
+}
+
+func TestRemote_Errors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	handler := emulator.NewMockHandler(ctrl)
+	_, _, man := serve(t, "tcp", handler)
+
+	_, err := man.Open(0xFF01, 0x0002, hid.DeviceConfig{})
+	assert.ErrorIs(t, err, gousb.ErrorNotFound)
+	assert.ErrorIs(t, err, remote.ErrRemoteError)
+
+	hidDevice, err := man.Open(0xFF01, 0x0001, hid.DeviceConfig{})
+	require.NoError(t, err)
+	_, err = hidDevice.ReadInput(context.Background(), make([]byte, 64))
+	assert.ErrorIs(t, err, hid.ErrUninitializedEndpoint)
+	assert.ErrorIs(t, hidDevice.SetTarget(1, 1, 0), hid.ErrDeviceProfileNotFound)
+
+	require.NoError(t, hidDevice.SetTarget(1, 0, 0))
+	handler.EXPECT().GetReport(hid.REPORT_TYPE_FEATURE, uint8(0), gomock.Any()).Return(0, errors.New("unsupported"))
+	_, err = hidDevice.GetFeatureReport([]byte{0x00, 0x00, 0x00})
+	assert.ErrorIs(t, err, gousb.ErrorPipe)
+	_, err = hidDevice.ReadInput(context.Background(), make([]byte, hid.HID_MAX_REPORT_SIZE+1))
+	assert.ErrorIs(t, err, remote.ErrInvalidRequest)
+
+	require.NoError(t, hidDevice.Close())
+	_, err = hidDevice.GetManufacturer()
+	assert.ErrorIs(t, err, remote.ErrDeviceNotOpened)
+
+	// Requests fail after the connection is closed
+	require.NoError(t, man.Close())
+	_, err = man.Enumerate(0, 0)
+	assert.ErrorIs(t, err, remote.ErrConnectionClosed)
+}
+
+func TestRemote_ServerClosed(t *testing.T) {
+	_, server, man := serve(t, "tcp", nil)
+
+	hidDevice, err := man.Open(0xFF01, 0x0001, hid.DeviceConfig{StreamLaneCount: hid.DEFAULT_ENDPOINT_STREAM_COUNT})
+	require.NoError(t, err)
+	require.NoError(t, hidDevice.SetTarget(1, 0, 0))
+
+	// Pending read is cancelled when server is closed
+	read := make(chan error, 1)
+	go func() {
+		_, err := hidDevice.ReadInput(context.Background(), make([]byte, 64))
+		read <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(t, server.Close())
+	assert.Error(t, <-read)
+	_, err = hidDevice.GetManufacturer()
+	assert.ErrorIs(t, err, remote.ErrConnectionClosed)
+}
+
+func TestRemote_CloseDevice(t *testing.T) {
+	_, _, man := serve(t, "tcp", nil)
+
+	hidDevice, err := man.Open(0xFF01, 0x0001, hid.DeviceConfig{StreamLaneCount: hid.DEFAULT_ENDPOINT_STREAM_COUNT})
+	require.NoError(t, err)
+	require.NoError(t, hidDevice.SetTarget(1, 0, 0))
+
+	// Pending read is cancelled before the device is closed
+	read := make(chan error, 1)
+	go func() {
+		_, err := hidDevice.ReadInput(context.Background(), make([]byte, 64))
+		read <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(t, hidDevice.Close())
+	select {
+	case err := <-read:
+		assert.ErrorIs(t, err, context.Canceled)
+	case <-time.After(time.Second):
+		require.FailNow(t, "read is not cancelled")
+	}
+	_, err = hidDevice.GetManufacturer()
+	assert.ErrorIs(t, err, remote.ErrDeviceNotOpened)
+}
@@ -0,0 +1,381 @@
+package remote
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"sync"
+
+	"github.com/ntchjb/gohid/hid"
+	"github.com/ntchjb/gohid/manager"
+	hiddesc "github.com/ntchjb/usbip-virtual-device/usb/protocol/hid"
+)
+
+// Server serves devices of a device manager to clients of its connections
+type Server interface {
+	// Accept connections from listener, and serve them until the server is closed, which returns ErrServerClosed
+	Serve(listener net.Listener) error
+	// Listen to address of network, e.g. "tcp" or "unix", and serve its connections
+	ListenAndServe(network, address string) error
+	// Close all listeners and connections, and close devices opened by clients
+	Close() error
+}
+
+type serverImpl struct {
+	man    manager.DeviceManager
+	logger *slog.Logger
+
+	mu        sync.Mutex
+	listeners map[net.Listener]struct{}
+	sessions  map[*session]struct{}
+	closed    bool
+	wg        sync.WaitGroup
+}
+
+// NewServer creates a server of devices opened by man. The device manager is not closed by the server.
+func NewServer(man manager.DeviceManager, logger *slog.Logger) Server {
+	return &serverImpl{
+		man:       man,
+		logger:    logger,
+		listeners: make(map[net.Listener]struct{}),
+		sessions:  make(map[*session]struct{}),
+	}
+}
+
+func (s *serverImpl) Serve(listener net.Listener) error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		listener.Close()
+		return ErrServerClosed
+	}
+	s.listeners[listener] = struct{}{}
+	s.mu.Unlock()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			delete(s.listeners, listener)
+			if s.closed {
+				return ErrServerClosed
+			}
+			listener.Close()
+			return fmt.Errorf("unable to accept connection: %w", err)
+		}
+
+		sess := &session{
+			server:  s,
+			conn:    conn,
+			devices: make(map[uint32]*openedDevice),
+			cancels: make(map[uint32]context.CancelFunc),
+		}
+		s.mu.Lock()
+		if s.closed {
+			s.mu.Unlock()
+			conn.Close()
+			continue
+		}
+		s.sessions[sess] = struct{}{}
+		s.wg.Add(1)
+		s.mu.Unlock()
+
+		go func() {
+			defer s.wg.Done()
+			sess.serve()
+
+			s.mu.Lock()
+			delete(s.sessions, sess)
+			s.mu.Unlock()
+		}()
+	}
+}
+
+func (s *serverImpl) ListenAndServe(network, address string) error {
+	listener, err := net.Listen(network, address)
+	if err != nil {
+		return fmt.Errorf("unable to listen to %s %s: %w", network, address, err)
+	}
+
+	return s.Serve(listener)
+}
+
+func (s *serverImpl) Close() error {
+	var errs []error
+	s.mu.Lock()
+	s.closed = true
+	for listener := range s.listeners {
+		if err := listener.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	// Sessions close their devices when their connections are closed
+	for sess := range s.sessions {
+		sess.conn.Close()
+	}
+	s.mu.Unlock()
+	s.wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+// session serves requests of a connection, where devices opened in a session are closed when its connection is closed
+type session struct {
+	server *serverImpl
+	conn   net.Conn
+
+	writeMu sync.Mutex
+	mu      sync.Mutex
+	devices map[uint32]*openedDevice
+	handles uint32
+	// Cancellation of requests being handled, by request ID
+	cancels map[uint32]context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+// openedDevice is a device opened by a session, whose requests being handled are stopped before it is closed
+type openedDevice struct {
+	device hid.Device
+	// Cancellation of requests of the device being handled, by request ID
+	cancels map[uint32]context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+func (s *session) serve() {
+	logger := s.server.logger.With("addr", s.conn.RemoteAddr())
+	for {
+		msg, err := readMessage(s.conn)
+		if err != nil {
+			if !errors.Is(err, io.EOF) && !errors.Is(err, net.ErrClosed) {
+				logger.Warn("unable to read request, closing connection", "err", err)
+			}
+			break
+		}
+		if msg.Method == METHOD_CANCEL {
+			s.cancel(msg.ID)
+			continue
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		s.mu.Lock()
+		s.cancels[msg.ID] = cancel
+		s.mu.Unlock()
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			reply := s.handle(ctx, msg)
+			reply.ID = msg.ID
+
+			s.mu.Lock()
+			delete(s.cancels, msg.ID)
+			s.mu.Unlock()
+			cancel()
+
+			if err := s.send(reply); err != nil {
+				logger.Debug("unable to send reply", "method", msg.Method, "err", err)
+			}
+		}()
+	}
+
+	s.close()
+}
+
+func (s *session) cancel(id uint32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if cancel, ok := s.cancels[id]; ok {
+		cancel()
+	}
+}
+
+// close cancels pending requests, then closes the connection and devices opened by the session
+func (s *session) close() {
+	s.mu.Lock()
+	for _, cancel := range s.cancels {
+		cancel()
+	}
+	s.mu.Unlock()
+	s.wg.Wait()
+	s.conn.Close()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for handle, opened := range s.devices {
+		if err := opened.device.Close(); err != nil {
+			s.server.logger.Warn("unable to close device of closed connection", "device", handle, "err", err)
+		}
+	}
+	clear(s.devices)
+}
+
+func (s *session) send(msg Message) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	return writeMessage(s.conn, msg)
+}
+
+// acquire returns an opened device to handle a request, which must be released after it is handled
+func (s *session) acquire(handle, id uint32) (*openedDevice, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	opened, ok := s.devices[handle]
+	if !ok {
+		return nil, fmt.Errorf("device %d: %w", handle, ErrDeviceNotOpened)
+	}
+	opened.cancels[id] = s.cancels[id]
+	opened.wg.Add(1)
+
+	return opened, nil
+}
+
+// release ends a request of an opened device
+func (s *session) release(opened *openedDevice, id uint32) {
+	s.mu.Lock()
+	delete(opened.cancels, id)
+	s.mu.Unlock()
+	opened.wg.Done()
+}
+
+// handle handles a request, and returns its reply
+func (s *session) handle(ctx context.Context, msg Message) Message {
+	var reply Message
+	var err error
+	switch msg.Method {
+	case METHOD_ENUMERATE:
+		reply.Devices, err = s.enumerate(msg)
+	case METHOD_OPEN:
+		reply.Device, err = s.open(msg)
+	case METHOD_CLOSE:
+		err = s.closeDevice(msg.Device)
+	default:
+		var opened *openedDevice
+		opened, err = s.acquire(msg.Device, msg.ID)
+		if err == nil {
+			reply, err = s.handleDevice(ctx, opened.device, msg)
+			s.release(opened, msg.ID)
+		}
+	}
+	reply.Error = newError(err)
+
+	return reply
+}
+
+func (s *session) enumerate(msg Message) ([]DeviceInfo, error) {
+	deviceInfos, err := s.server.man.Enumerate(msg.VendorID, msg.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]DeviceInfo, 0, len(deviceInfos))
+	for _, info := range deviceInfos {
+		res = append(res, newDeviceInfo(info))
+	}
+
+	return res, nil
+}
+
+func (s *session) open(msg Message) (uint32, error) {
+	var config hid.DeviceConfig
+	if msg.Config != nil {
+		config = *msg.Config
+	}
+	device, err := s.server.man.Open(msg.VendorID, msg.ProductID, config)
+	if err != nil {
+		return 0, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handles++
+	s.devices[s.handles] = &openedDevice{device: device, cancels: make(map[uint32]context.CancelFunc)}
+
+	return s.handles, nil
+}
+
+// closeDevice cancels requests of a device being handled, and closes the device after they return
+func (s *session) closeDevice(handle uint32) error {
+	s.mu.Lock()
+	opened, ok := s.devices[handle]
+	delete(s.devices, handle)
+	if ok {
+		for _, cancel := range opened.cancels {
+			cancel()
+		}
+	}
+	s.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("device %d: %w", handle, ErrDeviceNotOpened)
+	}
+	opened.wg.Wait()
+
+	return opened.device.Close()
+}
+
+// handleDevice handles requests of an opened device
+func (s *session) handleDevice(ctx context.Context, device hid.Device, msg Message) (Message, error) {
+	var reply Message
+	var err error
+	switch msg.Method {
+	case METHOD_SET_AUTO_DETACH:
+		err = device.SetAutoDetach(msg.AutoDetach)
+	case METHOD_SET_TARGET:
+		if msg.Target == nil {
+			return reply, fmt.Errorf("%w: target is required", ErrInvalidRequest)
+		}
+		if err = device.SetTarget(msg.Target.Config, msg.Target.Interface, msg.Target.AltSetting); err == nil {
+			info := newDeviceInfo(device.GetDeviceInfo())
+			reply.DeviceInfo = &info
+		}
+	case METHOD_WRITE_OUTPUT:
+		reply.N, err = device.WriteOutput(ctx, msg.Data)
+	case METHOD_READ_INPUT:
+		if msg.Length <= 0 || msg.Length > int(hid.HID_MAX_REPORT_SIZE) {
+			return reply, fmt.Errorf("%w: buffer of %d bytes", ErrInvalidRequest, msg.Length)
+		}
+		data := make([]byte, msg.Length)
+		reply.N, err = device.ReadInput(ctx, data)
+		reply.Data = data[:reply.N]
+	case METHOD_SEND_FEATURE_REPORT:
+		reply.N, err = device.SendFeatureReport(msg.Data)
+	case METHOD_GET_FEATURE_REPORT:
+		reply.N, err = device.GetFeatureReport(msg.Data)
+		reply.Data = msg.Data[:min(reply.N, len(msg.Data))]
+	case METHOD_SEND_OUTPUT_REPORT:
+		reply.N, err = device.SendOutputReport(msg.Data)
+	case METHOD_GET_INPUT_REPORT:
+		reply.N, err = device.GetInputReport(msg.Data)
+		reply.Data = msg.Data[:min(reply.N, len(msg.Data))]
+	case METHOD_GET_SERIAL_NUMBER:
+		reply.String, err = device.GetSerialNumber()
+	case METHOD_GET_PRODUCT:
+		reply.String, err = device.GetProduct()
+	case METHOD_GET_MANUFACTURER:
+		reply.String, err = device.GetManufacturer()
+	case METHOD_GET_STRING_DESCRIPTOR:
+		reply.String, err = device.GetStringDescriptor(msg.Index)
+	case METHOD_GET_REPORT_DESCRIPTOR:
+		reply.Data, err = device.GetReportDescriptor()
+	case METHOD_GET_HID_DESCRIPTOR:
+		var desc hiddesc.HIDDescriptor
+		desc, err = device.GetHIDDescriptor()
+		reply.HIDDescriptor = &desc
+	case METHOD_GET_STATS:
+		provider, ok := device.(hid.StatsProvider)
+		if !ok {
+			err = ErrStatsNotCollected
+			break
+		}
+		stats := provider.Stats()
+		reply.Stats = &stats
+	default:
+		err = fmt.Errorf("%w: %q", ErrUnknownMethod, msg.Method)
+	}
+
+	return reply, err
+}
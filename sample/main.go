@@ -86,19 +86,22 @@ func main() {
 		logger.Info("String sent", "length", n)
 	}
 
+	// Read reports into pooled buffers, which are reused once they are released
+	reader := hid.NewReportReader(hidDevice, hid.ReportReaderConfig{ReportSize: 64})
+	defer reader.Close()
 	for i := 0; i < 50; {
-		readData := make([]byte, 64)
-		n, err := hidDevice.ReadInput(ctx, readData)
+		report, err := reader.Read(ctx)
 		if err != nil {
 			logger.Error("unable to read string from IN endpoint", "err", err)
 			return
 		}
-		if n != 0 {
+		if len(report.Data) != 0 {
 			i++
-			logger.Info("String ECHO!", "length", n, "data", string(readData[:n]))
+			logger.Info("String ECHO!", "length", len(report.Data), "data", string(report.Data))
 		} else {
 			logger.Info("Input is empty...")
 		}
+		report.Release()
 		time.Sleep(100 * time.Millisecond)
 	}
 }
@@ -0,0 +1,261 @@
+package sensors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/ntchjb/gohid/hid"
+)
+
+var (
+	ErrNoSensorFound         = errors.New("no sensor found")
+	ErrPropertyNotSupported  = errors.New("property is not supported by sensor")
+	ErrNotSensorInputReport  = errors.New("input report does not belong to any sensor")
+	ErrSensorHasNoFeature    = errors.New("sensor has no feature report")
+	ErrReportIntervalInvalid = errors.New("report interval must not be negative")
+	ErrUnitUnsupported       = errors.New("unit of property is not supported")
+)
+
+// Hub provides access to HID sensors of a HID device
+type Hub interface {
+	// Get all sensors found in report descriptor of the device
+	Sensors() []*Sensor
+	// Set a property of a sensor via Feature report. Value is in units of the property, with unit exponent applied.
+	SetProperty(sensor *Sensor, property uint16, value float64) error
+	// Get a property of a sensor via Feature report. Value is in units of the property, with unit exponent applied.
+	GetProperty(sensor *Sensor, property uint16) (float64, error)
+	// Select a value of a selector property via Feature report, e.g. USAGE_REPORTING_STATE_REPORT_ALL_EVENTS
+	SelectProperty(sensor *Sensor, selector uint16) error
+	// Set interval at which a sensor sends Input reports
+	SetReportInterval(sensor *Sensor, interval time.Duration) error
+	// Set minimum change of a data field that makes the sensor send Input report,
+	// using either per-data-field sensitivity or Change Sensitivity Absolute property of the sensor
+	SetSensitivity(sensor *Sensor, dataField uint16, value float64) error
+	// Read an Input report and decode it as a sensor reading
+	Read(ctx context.Context) (Reading, error)
+	// Continuously read sensor readings and send them to given channel until context is done or error occurred
+	Stream(ctx context.Context, readings chan<- Reading) error
+}
+
+type hubImpl struct {
+	device  hid.Device
+	schema  *hid.ReportSchema
+	sensors []*Sensor
+
+	// Size of buffer that fits all Input reports
+	inputSize int
+	logger    *slog.Logger
+}
+
+// NewHub gets report descriptor from an opened HID device, and discovers sensors from it
+func NewHub(device hid.Device, logger *slog.Logger) (Hub, error) {
+	if device == nil {
+		return nil, hid.ErrDeviceIsNil
+	}
+	desc, err := device.GetReportDescriptor()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get report descriptor: %w", err)
+	}
+	schema, err := hid.ParseReportDescriptor(desc)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse report descriptor: %w", err)
+	}
+	sensors := Discover(schema)
+	if len(sensors) == 0 {
+		return nil, ErrNoSensorFound
+	}
+
+	hub := &hubImpl{
+		device:  device,
+		schema:  schema,
+		sensors: sensors,
+		logger:  logger,
+	}
+	for _, layout := range schema.Layouts {
+		if layout.Type == hid.REPORT_TYPE_INPUT && layout.ByteSize()+1 > hub.inputSize {
+			hub.inputSize = layout.ByteSize() + 1
+		}
+	}
+
+	return hub, nil
+}
+
+func (h *hubImpl) Sensors() []*Sensor {
+	return h.sensors
+}
+
+func (h *hubImpl) getFeature(sensor *Sensor) ([]byte, error) {
+	if sensor.Feature == nil {
+		return nil, fmt.Errorf("sensor %v: %w", sensor.Type, ErrSensorHasNoFeature)
+	}
+	buf := sensor.Feature.NewBuffer()
+	if _, err := h.device.GetFeatureReport(buf); err != nil {
+		return nil, fmt.Errorf("unable to get feature report #%d of sensor %v: %w", sensor.Feature.ID, sensor.Type, err)
+	}
+
+	return buf, nil
+}
+
+func (h *hubImpl) findProperty(sensor *Sensor, property uint16) (*hid.ReportField, int, error) {
+	if sensor.Feature == nil {
+		return nil, 0, fmt.Errorf("sensor %v: %w", sensor.Type, ErrSensorHasNoFeature)
+	}
+	field, idx, err := sensor.Feature.FindField(hid.NewUsage(USAGE_PAGE_SENSORS, property))
+	if err != nil {
+		return nil, 0, fmt.Errorf("property 0x%04X of sensor %v: %w", property, sensor.Type, ErrPropertyNotSupported)
+	}
+
+	return field, idx, nil
+}
+
+func (h *hubImpl) SetProperty(sensor *Sensor, property uint16, value float64) error {
+	field, idx, err := h.findProperty(sensor, property)
+	if err != nil {
+		return err
+	}
+	buf, err := h.getFeature(sensor)
+	if err != nil {
+		return err
+	}
+	if err := field.SetValue(buf[1:], idx, field.LogicalValue(value)); err != nil {
+		return fmt.Errorf("unable to set property 0x%04X of sensor %v: %w", property, sensor.Type, err)
+	}
+	if _, err := h.device.SendFeatureReport(buf); err != nil {
+		return fmt.Errorf("unable to send feature report #%d of sensor %v: %w", sensor.Feature.ID, sensor.Type, err)
+	}
+
+	return nil
+}
+
+func (h *hubImpl) GetProperty(sensor *Sensor, property uint16) (float64, error) {
+	field, idx, err := h.findProperty(sensor, property)
+	if err != nil {
+		return 0, err
+	}
+	buf, err := h.getFeature(sensor)
+	if err != nil {
+		return 0, err
+	}
+	value, err := field.Value(buf[1:], idx)
+	if err != nil {
+		return 0, fmt.Errorf("unable to get property 0x%04X of sensor %v: %w", property, sensor.Type, err)
+	}
+
+	return field.PhysicalValue(value), nil
+}
+
+func (h *hubImpl) SelectProperty(sensor *Sensor, selector uint16) error {
+	field, _, err := h.findProperty(sensor, selector)
+	if err != nil {
+		return err
+	}
+	value, _ := field.ArrayValue(hid.NewUsage(USAGE_PAGE_SENSORS, selector))
+	buf, err := h.getFeature(sensor)
+	if err != nil {
+		return err
+	}
+	if err := field.SetValue(buf[1:], 0, value); err != nil {
+		return fmt.Errorf("unable to select 0x%04X of sensor %v: %w", selector, sensor.Type, err)
+	}
+	if _, err := h.device.SendFeatureReport(buf); err != nil {
+		return fmt.Errorf("unable to send feature report #%d of sensor %v: %w", sensor.Feature.ID, sensor.Type, err)
+	}
+
+	return nil
+}
+
+func (h *hubImpl) SetReportInterval(sensor *Sensor, interval time.Duration) error {
+	if interval < 0 {
+		return ErrReportIntervalInvalid
+	}
+	field, _, err := h.findProperty(sensor, USAGE_PROPERTY_REPORT_INTERVAL)
+	if err != nil {
+		return err
+	}
+
+	value, err := reportInterval(field, interval)
+	if err != nil {
+		return fmt.Errorf("unable to set report interval of sensor %v: %w", sensor.Type, err)
+	}
+
+	return h.SetProperty(sensor, USAGE_PROPERTY_REPORT_INTERVAL, value)
+}
+
+// reportInterval converts interval into units of Unit item of report interval field, which is millisecond
+// if the descriptor does not specify unit, or second otherwise. Unit exponent is applied by SetProperty.
+func reportInterval(field *hid.ReportField, interval time.Duration) (float64, error) {
+	if field.Unit == 0 {
+		return float64(interval) / float64(time.Millisecond), nil
+	}
+	system := field.Unit & UNIT_SYSTEM_MASK
+	if system < UNIT_SYSTEM_SI_LINEAR || system > UNIT_SYSTEM_ENGLISH_ROTATION ||
+		field.Unit&^UNIT_SYSTEM_MASK != UNIT_TIME_SECOND {
+		return 0, fmt.Errorf("unit 0x%08X: %w", field.Unit, ErrUnitUnsupported)
+	}
+
+	return interval.Seconds(), nil
+}
+
+func (h *hubImpl) SetSensitivity(sensor *Sensor, dataField uint16, value float64) error {
+	property := dataField | USAGE_MODIFIER_CHANGE_SENSITIVITY_ABSOLUTE
+	if _, _, err := h.findProperty(sensor, property); err != nil {
+		property = USAGE_PROPERTY_CHANGE_SENSITIVITY_ABSOLUTE
+	}
+
+	return h.SetProperty(sensor, property, value)
+}
+
+func (h *hubImpl) decode(data []byte) (Reading, error) {
+	layout, payload, err := h.schema.SplitInputReport(data)
+	if err != nil {
+		return Reading{}, fmt.Errorf("unable to find layout of input report: %w", err)
+	}
+	for _, sensor := range h.sensors {
+		if sensor.Input == layout {
+			return sensor.Decode(payload)
+		}
+	}
+
+	return Reading{}, fmt.Errorf("input report #%d: %w", layout.ID, ErrNotSensorInputReport)
+}
+
+func (h *hubImpl) Read(ctx context.Context) (Reading, error) {
+	buf := make([]byte, h.inputSize)
+	for {
+		n, err := h.device.ReadInput(ctx, buf)
+		if err != nil {
+			return Reading{}, fmt.Errorf("unable to read input report: %w", err)
+		}
+		if n > 0 {
+			return h.decode(buf[:n])
+		}
+		if err := ctx.Err(); err != nil {
+			return Reading{}, err
+		}
+	}
+}
+
+func (h *hubImpl) Stream(ctx context.Context, readings chan<- Reading) error {
+	for {
+		reading, err := h.Read(ctx)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return ctxErr
+			}
+			if errors.Is(err, hid.ErrReportLayoutNotFound) || errors.Is(err, ErrNotSensorInputReport) {
+				h.logger.Debug("skip input report of non-sensor", "err", err)
+				continue
+			}
+			return err
+		}
+
+		select {
+		case readings <- reading:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
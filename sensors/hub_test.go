@@ -0,0 +1,404 @@
+package sensors_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/ntchjb/gohid/hid"
+	"github.com/ntchjb/gohid/sensors"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	hidreport "github.com/ntchjb/usbip-virtual-device/usb/protocol/hid/report"
+)
+
+var (
+	// Accelerometer 3D in report ID 1 and ambient light sensor in report ID 2
+	sensorReportDescriptor = hidreport.HIDReportDescriptor{
+		0x05, 0x20, // Usage Page (Sensors)
+		0x09, 0x01, // Usage (Sensor)
+		0xA1, 0x01, // Collection (Application)
+		0x09, 0x73, //   Usage (Accelerometer 3D)
+		0xA1, 0x00, //   Collection (Physical)
+		0x85, 0x01, //     Report ID (1)
+		0x15, 0x00, //     Logical Minimum (0)
+		0x26, 0xFF, 0xFF, // Logical Maximum (65535)
+		0x75, 0x10, //     Report Size (16)
+		0x95, 0x01, //     Report Count (1)
+		0x0A, 0x0E, 0x03, // Usage (Property: Report Interval)
+		0xB1, 0x02, //     Feature (Data,Var,Abs)
+		0x55, 0x0E, //     Unit Exponent (-2)
+		0x0A, 0x0F, 0x03, // Usage (Property: Change Sensitivity Absolute)
+		0xB1, 0x02, //     Feature (Data,Var,Abs)
+		0x55, 0x00, //     Unit Exponent (0)
+		0x25, 0x01, //     Logical Maximum (1)
+		0x75, 0x08, //     Report Size (8)
+		0x0A, 0x16, 0x03, // Usage (Property: Reporting State)
+		0xA1, 0x02, //     Collection (Logical)
+		0x0A, 0x40, 0x08, //   Usage (Reporting State: Report No Events)
+		0x0A, 0x41, 0x08, //   Usage (Reporting State: Report All Events)
+		0xB1, 0x00, //       Feature (Data,Arr,Abs)
+		0xC0,             //           End Collection
+		0x0A, 0x01, 0x02, // Usage (Event: Sensor State)
+		0xA1, 0x02, //     Collection (Logical)
+		0x0A, 0x00, 0x08, //   Usage (Sensor State: Undefined)
+		0x0A, 0x01, 0x08, //   Usage (Sensor State: Ready)
+		0x81, 0x00, //       Input (Data,Arr,Abs)
+		0xC0,             //           End Collection
+		0x16, 0x00, 0x80, // Logical Minimum (-32768)
+		0x26, 0xFF, 0x7F, // Logical Maximum (32767)
+		0x75, 0x10, //     Report Size (16)
+		0x55, 0x0E, //     Unit Exponent (-2)
+		0x0A, 0x53, 0x04, // Usage (Data Field: Acceleration Axis X)
+		0x0A, 0x54, 0x04, // Usage (Data Field: Acceleration Axis Y)
+		0x0A, 0x55, 0x04, // Usage (Data Field: Acceleration Axis Z)
+		0x95, 0x03, //     Report Count (3)
+		0x81, 0x02, //     Input (Data,Var,Abs)
+		0xC0,       //         End Collection
+		0x09, 0x41, //   Usage (Ambient Light)
+		0xA1, 0x00, //   Collection (Physical)
+		0x85, 0x02, //     Report ID (2)
+		0x15, 0x00, //     Logical Minimum (0)
+		0x26, 0xFF, 0xFF, // Logical Maximum (65535)
+		0x95, 0x01, //     Report Count (1)
+		0x55, 0x00, //     Unit Exponent (0)
+		0x0A, 0x0E, 0x03, // Usage (Property: Report Interval)
+		0xB1, 0x02, //     Feature (Data,Var,Abs)
+		0x0A, 0xD1, 0x04, // Usage (Data Field: Illuminance)
+		0x81, 0x02, //     Input (Data,Var,Abs)
+		0xC0, //         End Collection
+		0xC0, //       End Collection
+	}
+)
+
+func newHub(t *testing.T, ctrl *gomock.Controller) (sensors.Hub, *hid.MockDevice) {
+	device := hid.NewMockDevice(ctrl)
+	device.EXPECT().GetReportDescriptor().Return(sensorReportDescriptor, nil)
+
+	hub, err := sensors.NewHub(device, slog.Default())
+	assert.NoError(t, err)
+
+	return hub, device
+}
+
+func TestDiscover(t *testing.T) {
+	schema, err := hid.ParseReportDescriptor(sensorReportDescriptor)
+	assert.NoError(t, err)
+
+	found := sensors.Discover(schema)
+	assert.Len(t, found, 2)
+
+	assert.Equal(t, sensors.SENSOR_TYPE_ACCELEROMETER_3D, found[0].Type)
+	assert.Equal(t, "Accelerometer 3D", found[0].Type.String())
+	assert.Equal(t, uint8(1), found[0].Input.ID)
+	assert.Equal(t, 7, found[0].Input.ByteSize())
+	assert.Equal(t, uint8(1), found[0].Feature.ID)
+	assert.Equal(t, 5, found[0].Feature.ByteSize())
+
+	assert.Equal(t, sensors.SENSOR_TYPE_AMBIENT_LIGHT, found[1].Type)
+	assert.Equal(t, uint8(2), found[1].Input.ID)
+	assert.Equal(t, uint8(2), found[1].Feature.ID)
+}
+
+func TestNewHub(t *testing.T) {
+	errControl := errors.New("control transfer error")
+
+	tests := []struct {
+		name string
+		desc hidreport.HIDReportDescriptor
+		err  error
+	}{
+		{
+			name: "Success",
+			desc: sensorReportDescriptor,
+		},
+		{
+			name: "Error_GetReportDescriptor",
+			err:  errControl,
+		},
+		{
+			name: "Error_InvalidReportDescriptor",
+			desc: hidreport.HIDReportDescriptor{0xC0},
+			err:  hid.ErrUnbalancedCollection,
+		},
+		{
+			name: "Error_NoSensorFound",
+			desc: hidreport.HIDReportDescriptor{0x05, 0x01, 0x09, 0x02, 0xA1, 0x01, 0xC0},
+			err:  sensors.ErrNoSensorFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			device := hid.NewMockDevice(ctrl)
+			if test.desc != nil {
+				device.EXPECT().GetReportDescriptor().Return(test.desc, nil)
+			} else {
+				device.EXPECT().GetReportDescriptor().Return(nil, test.err)
+			}
+
+			hub, err := sensors.NewHub(device, slog.Default())
+			assert.ErrorIs(t, err, test.err)
+			if test.err == nil {
+				assert.Len(t, hub.Sensors(), 2)
+			}
+		})
+	}
+}
+
+func TestHub_SetProperties(t *testing.T) {
+	errControl := errors.New("control transfer error")
+	currentFeature := []byte{0x01, 0x10, 0x00, 0x05, 0x00, 0x00}
+
+	tests := []struct {
+		name   string
+		sensor int
+		set    func(hub sensors.Hub, sensor *sensors.Sensor) error
+		get    error
+		sent   []byte
+		err    error
+	}{
+		{
+			name:   "Success_SetReportInterval",
+			sensor: 0,
+			set: func(hub sensors.Hub, sensor *sensors.Sensor) error {
+				return hub.SetReportInterval(sensor, 100*time.Millisecond)
+			},
+			sent: []byte{0x01, 0x64, 0x00, 0x05, 0x00, 0x00},
+		},
+		{
+			name:   "Success_SetSensitivity",
+			sensor: 0,
+			set: func(hub sensors.Hub, sensor *sensors.Sensor) error {
+				return hub.SetSensitivity(sensor, sensors.USAGE_DATA_FIELD_ACCELERATION_AXIS_X, 0.5)
+			},
+			sent: []byte{0x01, 0x10, 0x00, 0x32, 0x00, 0x00},
+		},
+		{
+			name:   "Success_SelectProperty",
+			sensor: 0,
+			set: func(hub sensors.Hub, sensor *sensors.Sensor) error {
+				return hub.SelectProperty(sensor, sensors.USAGE_REPORTING_STATE_REPORT_ALL_EVENTS)
+			},
+			sent: []byte{0x01, 0x10, 0x00, 0x05, 0x00, 0x01},
+		},
+		{
+			name:   "Error_PropertyNotSupported",
+			sensor: 1,
+			set: func(hub sensors.Hub, sensor *sensors.Sensor) error {
+				return hub.SetSensitivity(sensor, sensors.USAGE_DATA_FIELD_ILLUMINANCE, 10)
+			},
+			err: sensors.ErrPropertyNotSupported,
+		},
+		{
+			name:   "Error_GetFeatureReport",
+			sensor: 0,
+			set: func(hub sensors.Hub, sensor *sensors.Sensor) error {
+				return hub.SetReportInterval(sensor, time.Second)
+			},
+			get: errControl,
+			err: errControl,
+		},
+		{
+			name:   "Error_NegativeReportInterval",
+			sensor: 0,
+			set: func(hub sensors.Hub, sensor *sensors.Sensor) error {
+				return hub.SetReportInterval(sensor, -time.Second)
+			},
+			err: sensors.ErrReportIntervalInvalid,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			hub, device := newHub(t, ctrl)
+
+			if test.sent != nil || test.get != nil {
+				device.EXPECT().GetFeatureReport([]byte{0x01, 0x00, 0x00, 0x00, 0x00, 0x00}).
+					DoAndReturn(func(data []byte) (int, error) {
+						if test.get != nil {
+							return 0, test.get
+						}
+						return copy(data, currentFeature), nil
+					})
+			}
+			if test.sent != nil {
+				device.EXPECT().SendFeatureReport(test.sent).Return(len(test.sent), nil)
+			}
+
+			err := test.set(hub, hub.Sensors()[test.sensor])
+			assert.ErrorIs(t, err, test.err)
+		})
+	}
+}
+
+func TestHub_SetReportInterval_Unit(t *testing.T) {
+	// Ambient light sensor with report interval in the given unit and unit exponent
+	descriptor := func(unit ...byte) hidreport.HIDReportDescriptor {
+		desc := hidreport.HIDReportDescriptor{
+			0x05, 0x20, // Usage Page (Sensors)
+			0x09, 0x41, // Usage (Ambient Light)
+			0xA1, 0x00, // Collection (Physical)
+			0x15, 0x00, //   Logical Minimum (0)
+			0x26, 0xFF, 0xFF, // Logical Maximum (65535)
+			0x75, 0x10, //   Report Size (16)
+			0x95, 0x01, //   Report Count (1)
+		}
+		desc = append(desc, unit...)
+		return append(desc,
+			0x0A, 0x0E, 0x03, // Usage (Property: Report Interval)
+			0xB1, 0x02, //   Feature (Data,Var,Abs)
+			0x0A, 0xD1, 0x04, // Usage (Data Field: Illuminance)
+			0x81, 0x02, //   Input (Data,Var,Abs)
+			0xC0, // End Collection
+		)
+	}
+
+	tests := []struct {
+		name string
+		desc hidreport.HIDReportDescriptor
+		sent []byte
+		err  error
+	}{
+		{
+			name: "Success_Milliseconds",
+			desc: descriptor(),
+			sent: []byte{0x00, 0xFA, 0x00},
+		},
+		{
+			name: "Success_SecondsSILinear",
+			desc: descriptor(
+				0x66, 0x01, 0x10, // Unit (SI Linear: Seconds)
+				0x55, 0x0D, //       Unit Exponent (-3)
+			),
+			sent: []byte{0x00, 0xFA, 0x00},
+		},
+		{
+			name: "Success_SecondsEnglishRotation",
+			desc: descriptor(
+				0x66, 0x04, 0x10, // Unit (English Rotation: Seconds)
+				0x55, 0x0E, //       Unit Exponent (-2)
+			),
+			sent: []byte{0x00, 0x19, 0x00},
+		},
+		{
+			name: "Error_Frequency",
+			desc: descriptor(
+				0x66, 0x01, 0xF0, // Unit (SI Linear: 1/Seconds)
+			),
+			err: sensors.ErrUnitUnsupported,
+		},
+		{
+			name: "Error_Length",
+			desc: descriptor(
+				0x65, 0x11, // Unit (SI Linear: Centimeter)
+			),
+			err: sensors.ErrUnitUnsupported,
+		},
+		{
+			name: "Error_VendorDefined",
+			desc: descriptor(
+				0x66, 0x0F, 0x10, // Unit (Vendor Defined)
+			),
+			err: sensors.ErrUnitUnsupported,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			device := hid.NewMockDevice(ctrl)
+			device.EXPECT().GetReportDescriptor().Return(test.desc, nil)
+			if test.sent != nil {
+				device.EXPECT().GetFeatureReport(gomock.Len(3)).Return(3, nil)
+				device.EXPECT().SendFeatureReport(test.sent).Return(len(test.sent), nil)
+			}
+
+			hub, err := sensors.NewHub(device, slog.Default())
+			assert.NoError(t, err)
+
+			err = hub.SetReportInterval(hub.Sensors()[0], 250*time.Millisecond)
+			assert.ErrorIs(t, err, test.err)
+		})
+	}
+}
+
+func TestHub_GetProperty(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	hub, device := newHub(t, ctrl)
+
+	device.EXPECT().GetFeatureReport(gomock.Any()).DoAndReturn(func(data []byte) (int, error) {
+		return copy(data, []byte{0x01, 0x10, 0x00, 0x05, 0x00, 0x00}), nil
+	})
+
+	value, err := hub.GetProperty(hub.Sensors()[0], sensors.USAGE_PROPERTY_CHANGE_SENSITIVITY_ABSOLUTE)
+	assert.NoError(t, err)
+	assert.InDelta(t, 0.05, value, 1e-9)
+}
+
+func TestHub_Read(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	hub, device := newHub(t, ctrl)
+	ctx := context.Background()
+
+	gomock.InOrder(
+		device.EXPECT().ReadInput(ctx, gomock.Len(8)).Return(0, nil),
+		device.EXPECT().ReadInput(ctx, gomock.Len(8)).DoAndReturn(func(ctx context.Context, data []byte) (int, error) {
+			return copy(data, []byte{0x01, 0x01, 0x64, 0x00, 0x9C, 0xFF, 0x00, 0x00}), nil
+		}),
+	)
+
+	reading, err := hub.Read(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, hub.Sensors()[0], reading.Sensor)
+	assert.Equal(t, uint16(0x0801), reading.State)
+	x, ok := reading.Value(sensors.USAGE_DATA_FIELD_ACCELERATION_AXIS_X)
+	assert.True(t, ok)
+	assert.InDelta(t, 1.0, x, 1e-9)
+	y, _ := reading.Value(sensors.USAGE_DATA_FIELD_ACCELERATION_AXIS_Y)
+	assert.InDelta(t, -1.0, y, 1e-9)
+	z, _ := reading.Value(sensors.USAGE_DATA_FIELD_ACCELERATION_AXIS_Z)
+	assert.InDelta(t, 0.0, z, 1e-9)
+}
+
+func TestHub_Stream(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	hub, device := newHub(t, ctrl)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	gomock.InOrder(
+		// Unknown report ID should be skipped
+		device.EXPECT().ReadInput(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, data []byte) (int, error) {
+			return copy(data, []byte{0x09, 0x00}), nil
+		}),
+		device.EXPECT().ReadInput(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, data []byte) (int, error) {
+			return copy(data, []byte{0x02, 0xE8, 0x03}), nil
+		}),
+		device.EXPECT().ReadInput(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, data []byte) (int, error) {
+			cancel()
+			return 0, context.Canceled
+		}),
+	)
+
+	readings := make(chan sensors.Reading, 1)
+	err := hub.Stream(ctx, readings)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	reading := <-readings
+	assert.Equal(t, sensors.SENSOR_TYPE_AMBIENT_LIGHT, reading.Sensor.Type)
+	illuminance, ok := reading.Value(sensors.USAGE_DATA_FIELD_ILLUMINANCE)
+	assert.True(t, ok)
+	assert.InDelta(t, 1000.0, illuminance, 1e-9)
+}
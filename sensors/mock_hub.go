@@ -0,0 +1,155 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./sensors/hub.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./sensors/hub.go -destination=./sensors/mock_hub.go -package=sensors
+//
+
+// Package sensors is a generated GoMock package.
+package sensors
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockHub is a mock of Hub interface.
+type MockHub struct {
+	ctrl     *gomock.Controller
+	recorder *MockHubMockRecorder
+}
+
+// MockHubMockRecorder is the mock recorder for MockHub.
+type MockHubMockRecorder struct {
+	mock *MockHub
+}
+
+// NewMockHub creates a new mock instance.
+func NewMockHub(ctrl *gomock.Controller) *MockHub {
+	mock := &MockHub{ctrl: ctrl}
+	mock.recorder = &MockHubMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHub) EXPECT() *MockHubMockRecorder {
+	return m.recorder
+}
+
+// GetProperty mocks base method.
+func (m *MockHub) GetProperty(sensor *Sensor, property uint16) (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProperty", sensor, property)
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProperty indicates an expected call of GetProperty.
+func (mr *MockHubMockRecorder) GetProperty(sensor, property any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProperty", reflect.TypeOf((*MockHub)(nil).GetProperty), sensor, property)
+}
+
+// Read mocks base method.
+func (m *MockHub) Read(ctx context.Context) (Reading, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", ctx)
+	ret0, _ := ret[0].(Reading)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockHubMockRecorder) Read(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockHub)(nil).Read), ctx)
+}
+
+// SelectProperty mocks base method.
+func (m *MockHub) SelectProperty(sensor *Sensor, selector uint16) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectProperty", sensor, selector)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SelectProperty indicates an expected call of SelectProperty.
+func (mr *MockHubMockRecorder) SelectProperty(sensor, selector any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectProperty", reflect.TypeOf((*MockHub)(nil).SelectProperty), sensor, selector)
+}
+
+// Sensors mocks base method.
+func (m *MockHub) Sensors() []*Sensor {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sensors")
+	ret0, _ := ret[0].([]*Sensor)
+	return ret0
+}
+
+// Sensors indicates an expected call of Sensors.
+func (mr *MockHubMockRecorder) Sensors() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sensors", reflect.TypeOf((*MockHub)(nil).Sensors))
+}
+
+// SetProperty mocks base method.
+func (m *MockHub) SetProperty(sensor *Sensor, property uint16, value float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProperty", sensor, property, value)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetProperty indicates an expected call of SetProperty.
+func (mr *MockHubMockRecorder) SetProperty(sensor, property, value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProperty", reflect.TypeOf((*MockHub)(nil).SetProperty), sensor, property, value)
+}
+
+// SetReportInterval mocks base method.
+func (m *MockHub) SetReportInterval(sensor *Sensor, interval time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReportInterval", sensor, interval)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetReportInterval indicates an expected call of SetReportInterval.
+func (mr *MockHubMockRecorder) SetReportInterval(sensor, interval any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReportInterval", reflect.TypeOf((*MockHub)(nil).SetReportInterval), sensor, interval)
+}
+
+// SetSensitivity mocks base method.
+func (m *MockHub) SetSensitivity(sensor *Sensor, dataField uint16, value float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSensitivity", sensor, dataField, value)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSensitivity indicates an expected call of SetSensitivity.
+func (mr *MockHubMockRecorder) SetSensitivity(sensor, dataField, value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSensitivity", reflect.TypeOf((*MockHub)(nil).SetSensitivity), sensor, dataField, value)
+}
+
+// Stream mocks base method.
+func (m *MockHub) Stream(ctx context.Context, readings chan<- Reading) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stream", ctx, readings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Stream indicates an expected call of Stream.
+func (mr *MockHubMockRecorder) Stream(ctx, readings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stream", reflect.TypeOf((*MockHub)(nil).Stream), ctx, readings)
+}
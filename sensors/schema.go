@@ -0,0 +1,96 @@
+package sensors
+
+const (
+	USAGE_PAGE_SENSORS uint16 = 0x20
+)
+
+type SensorType uint16
+
+// Sensor collection usages on Sensors usage page. Usages from SENSOR_TYPE_MINIMUM to SENSOR_TYPE_MAXIMUM
+// identify types of sensors, while SENSOR_TYPE_SENSOR is used as a collection of multiple sensors.
+const (
+	SENSOR_TYPE_SENSOR               SensorType = 0x0001
+	SENSOR_TYPE_MINIMUM              SensorType = 0x0010
+	SENSOR_TYPE_BODY_TEMPERATURE     SensorType = 0x0015
+	SENSOR_TYPE_HUMIDITY             SensorType = 0x0032
+	SENSOR_TYPE_TEMPERATURE          SensorType = 0x0033
+	SENSOR_TYPE_AMBIENT_LIGHT        SensorType = 0x0041
+	SENSOR_TYPE_ACCELEROMETER_1D     SensorType = 0x0071
+	SENSOR_TYPE_ACCELEROMETER_2D     SensorType = 0x0072
+	SENSOR_TYPE_ACCELEROMETER_3D     SensorType = 0x0073
+	SENSOR_TYPE_ACCELEROMETER        SensorType = 0x0079
+	SENSOR_TYPE_LINEAR_ACCELEROMETER SensorType = 0x007C
+	SENSOR_TYPE_MAXIMUM              SensorType = 0x00FF
+)
+
+var (
+	SensorTypeNames = map[SensorType]string{
+		SENSOR_TYPE_SENSOR:               "Sensor",
+		SENSOR_TYPE_BODY_TEMPERATURE:     "Body Temperature",
+		SENSOR_TYPE_HUMIDITY:             "Humidity",
+		SENSOR_TYPE_TEMPERATURE:          "Temperature",
+		SENSOR_TYPE_AMBIENT_LIGHT:        "Ambient Light",
+		SENSOR_TYPE_ACCELEROMETER_1D:     "Accelerometer 1D",
+		SENSOR_TYPE_ACCELEROMETER_2D:     "Accelerometer 2D",
+		SENSOR_TYPE_ACCELEROMETER_3D:     "Accelerometer 3D",
+		SENSOR_TYPE_ACCELEROMETER:        "Accelerometer",
+		SENSOR_TYPE_LINEAR_ACCELEROMETER: "Linear Accelerometer",
+	}
+)
+
+func (s SensorType) String() string {
+	if name, ok := SensorTypeNames[s]; ok {
+		return name
+	}
+
+	return "Unknown Sensor"
+}
+
+// Property usages on Sensors usage page, used in Feature reports
+const (
+	USAGE_PROPERTY_MINIMUM_REPORT_INTERVAL     uint16 = 0x0304
+	USAGE_PROPERTY_REPORT_INTERVAL             uint16 = 0x030E
+	USAGE_PROPERTY_CHANGE_SENSITIVITY_ABSOLUTE uint16 = 0x030F
+)
+
+// Nibbles of Unit item. Time nibble counts seconds in every system from SI linear to English rotation.
+const (
+	UNIT_SYSTEM_MASK             uint32 = 0x0000000F
+	UNIT_SYSTEM_SI_LINEAR        uint32 = 0x00000001
+	UNIT_SYSTEM_ENGLISH_ROTATION uint32 = 0x00000004
+	UNIT_TIME_SECOND             uint32 = 0x00001000
+)
+
+// Data field usages on Sensors usage page, used in Input reports
+const (
+	USAGE_DATA_FIELD_MINIMUM             uint16 = 0x0400
+	USAGE_DATA_FIELD_RELATIVE_HUMIDITY   uint16 = 0x0433
+	USAGE_DATA_FIELD_TEMPERATURE         uint16 = 0x0434
+	USAGE_DATA_FIELD_ACCELERATION_AXIS_X uint16 = 0x0453
+	USAGE_DATA_FIELD_ACCELERATION_AXIS_Y uint16 = 0x0454
+	USAGE_DATA_FIELD_ACCELERATION_AXIS_Z uint16 = 0x0455
+	USAGE_DATA_FIELD_ILLUMINANCE         uint16 = 0x04D1
+	USAGE_DATA_FIELD_COLOR_TEMPERATURE   uint16 = 0x04D2
+	USAGE_DATA_FIELD_MAXIMUM             uint16 = 0x07FF
+)
+
+// Data field modifiers are combined with data field usages with bitwise OR
+// e.g. sensitivity of Acceleration Axis X is 0x1453
+const (
+	USAGE_MODIFIER_MASK                        uint16 = 0xF000
+	USAGE_MODIFIER_CHANGE_SENSITIVITY_ABSOLUTE uint16 = 0x1000
+)
+
+// Usages of logical collections containing selectors of sensor state and sensor event
+const (
+	USAGE_SENSOR_STATE uint16 = 0x0201
+	USAGE_SENSOR_EVENT uint16 = 0x0202
+)
+
+// Selector usages of Reporting State and Power State properties
+const (
+	USAGE_REPORTING_STATE_REPORT_NO_EVENTS  uint16 = 0x0840
+	USAGE_REPORTING_STATE_REPORT_ALL_EVENTS uint16 = 0x0841
+	USAGE_POWER_STATE_D0_FULL_POWER         uint16 = 0x0851
+	USAGE_POWER_STATE_D4_POWER_OFF          uint16 = 0x0855
+)
@@ -0,0 +1,124 @@
+package sensors
+
+import (
+	"github.com/ntchjb/gohid/hid"
+)
+
+// Sensor is a sensor collection found in a report descriptor
+type Sensor struct {
+	Type       SensorType
+	Collection *hid.ReportCollection
+	// Input report carrying data fields of this sensor. It is nil if the sensor has no data field.
+	Input *hid.ReportLayout
+	// Feature report carrying properties of this sensor. It is nil if the sensor has no property.
+	Feature *hid.ReportLayout
+}
+
+// Reading is data decoded from an Input report of a sensor
+type Reading struct {
+	Sensor *Sensor
+	// Values of data fields, keyed by data field usage ID. Unit exponent is already applied to the values.
+	Values map[uint16]float64
+	// Selected usage ID of Sensor State, or zero if the sensor does not report its state
+	State uint16
+	// Selected usage ID of Sensor Event, or zero if the sensor does not report events
+	Event uint16
+}
+
+// Value returns value of a data field, e.g. USAGE_DATA_FIELD_ILLUMINANCE
+func (r Reading) Value(dataField uint16) (float64, bool) {
+	value, ok := r.Values[dataField]
+	return value, ok
+}
+
+func isSensorCollection(collection *hid.ReportCollection) bool {
+	sensorType := SensorType(collection.Usage.ID())
+
+	return collection.Usage.Page() == USAGE_PAGE_SENSORS &&
+		sensorType >= SENSOR_TYPE_MINIMUM && sensorType <= SENSOR_TYPE_MAXIMUM
+}
+
+func isDataField(usage hid.Usage) bool {
+	id := usage.ID()
+
+	return usage.Page() == USAGE_PAGE_SENSORS &&
+		id&USAGE_MODIFIER_MASK == 0 &&
+		id >= USAGE_DATA_FIELD_MINIMUM && id <= USAGE_DATA_FIELD_MAXIMUM
+}
+
+// Discover finds all sensor collections in a parsed report descriptor
+func Discover(schema *hid.ReportSchema) []*Sensor {
+	var sensors []*Sensor
+	var walk func(collections []*hid.ReportCollection)
+	walk = func(collections []*hid.ReportCollection) {
+		for _, collection := range collections {
+			if !isSensorCollection(collection) {
+				walk(collection.Children)
+				continue
+			}
+			sensor := &Sensor{
+				Type:       SensorType(collection.Usage.ID()),
+				Collection: collection,
+			}
+			for _, field := range collection.AllFields() {
+				layout, err := schema.Layout(field.ReportType, field.ReportID)
+				if err != nil {
+					continue
+				}
+				if field.ReportType == hid.REPORT_TYPE_INPUT && sensor.Input == nil {
+					sensor.Input = layout
+				} else if field.ReportType == hid.REPORT_TYPE_FEATURE && sensor.Feature == nil {
+					sensor.Feature = layout
+				}
+			}
+			sensors = append(sensors, sensor)
+		}
+	}
+	walk(schema.Collections)
+
+	return sensors
+}
+
+// Decode decodes report data of Input report of this sensor, excluding report ID
+func (s *Sensor) Decode(payload []byte) (Reading, error) {
+	reading := Reading{
+		Sensor: s,
+		Values: make(map[uint16]float64),
+	}
+
+	for _, field := range s.Collection.AllFields() {
+		if field.ReportType != hid.REPORT_TYPE_INPUT || field.IsConstant() {
+			continue
+		}
+		if field.IsArray() {
+			value, err := field.Value(payload, 0)
+			if err != nil {
+				return reading, err
+			}
+			selected, ok := field.ArrayUsage(value)
+			if !ok || field.Collection == nil || field.Collection.Usage.Page() != USAGE_PAGE_SENSORS {
+				continue
+			}
+			switch field.Collection.Usage.ID() {
+			case USAGE_SENSOR_STATE:
+				reading.State = selected.ID()
+			case USAGE_SENSOR_EVENT:
+				reading.Event = selected.ID()
+			}
+			continue
+		}
+		for i := 0; i < int(field.ReportCount); i++ {
+			usage := field.Usage(i)
+			if !isDataField(usage) {
+				continue
+			}
+			value, err := field.Value(payload, i)
+			if err != nil {
+				return reading, err
+			}
+			reading.Values[usage.ID()] = field.PhysicalValue(value)
+		}
+	}
+
+	return reading, nil
+}
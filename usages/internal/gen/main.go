@@ -0,0 +1,116 @@
+// Command gen generates usage tables of package usages
+// from the JSON attachment of HID Usage Tables specification.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/ntchjb/usbip-virtual-device/usb/protocol/hid/report/usage"
+)
+
+var kindConstants = map[string]string{
+	"LC":            "KIND_LC",
+	"OOC":           "KIND_OOC",
+	"MC":            "KIND_MC",
+	"OSC":           "KIND_OSC",
+	"RTC":           "KIND_RTC",
+	"Sel":           "KIND_SEL",
+	"SV":            "KIND_SV",
+	"SF":            "KIND_SF",
+	"DV":            "KIND_DV",
+	"DF":            "KIND_DF",
+	"NAry":          "KIND_NARY",
+	"CA":            "KIND_CA",
+	"CL":            "KIND_CL",
+	"CP":            "KIND_CP",
+	"US":            "KIND_US",
+	"UM":            "KIND_UM",
+	"BufferedBytes": "KIND_BUFFERED_BYTES",
+}
+
+func kinds(names []string) (string, error) {
+	if len(names) == 0 {
+		return "0", nil
+	}
+	var constants []string
+	for _, name := range names {
+		constant, ok := kindConstants[name]
+		if !ok {
+			return "", fmt.Errorf("unknown usage kind %q", name)
+		}
+		constants = append(constants, constant)
+	}
+
+	return strings.Join(constants, " | "), nil
+}
+
+func generate(table usage.OUsageTable) ([]byte, error) {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "// Code generated by internal/gen from HID Usage Tables %d.%d; DO NOT EDIT.\n\n", table.UsageTableVersion, table.UsageTableRevision)
+	fmt.Fprintf(&buf, "package usages\n\n")
+	fmt.Fprintf(&buf, "// Version of HID Usage Tables\n")
+	fmt.Fprintf(&buf, "const VERSION = \"%d.%d\"\n\n", table.UsageTableVersion, table.UsageTableRevision)
+	fmt.Fprintf(&buf, "var pages = []*Page{\n")
+
+	sort.Slice(table.UsagePages, func(i, j int) bool {
+		return table.UsagePages[i].ID < table.UsagePages[j].ID
+	})
+	for _, page := range table.UsagePages {
+		fmt.Fprintf(&buf, "{\nID: 0x%04X,\nName: %q,\n", page.ID, page.Name)
+		switch page.Kind {
+		case usage.USAGE_PAGE_KIND_GENERATED:
+			generator := page.UsageIDGenerator
+			generatorKinds, err := kinds(generator.Kinds)
+			if err != nil {
+				return nil, fmt.Errorf("page %s: %w", page.Name, err)
+			}
+			fmt.Fprintf(&buf, "Generator: &Generator{Prefix: %q, StartID: 0x%04X, EndID: 0x%04X, Kinds: %s},\n",
+				generator.NamePrefix, generator.StartUsageID, generator.EndUsageID, generatorKinds)
+		case usage.USAGE_PAGE_KIND_DEFINED:
+			sort.Slice(page.UsageIDs, func(i, j int) bool {
+				return page.UsageIDs[i].ID < page.UsageIDs[j].ID
+			})
+			fmt.Fprintf(&buf, "Usages: []Usage{\n")
+			for _, u := range page.UsageIDs {
+				usageKinds, err := kinds(u.Kinds)
+				if err != nil {
+					return nil, fmt.Errorf("page %s, usage %s: %w", page.Name, u.Name, err)
+				}
+				fmt.Fprintf(&buf, "{Page: 0x%04X, ID: 0x%04X, Name: %q, Kinds: %s},\n", page.ID, u.ID, u.Name, usageKinds)
+			}
+			fmt.Fprintf(&buf, "},\n")
+		default:
+			return nil, fmt.Errorf("page %s has unknown kind %q", page.Name, page.Kind)
+		}
+		fmt.Fprintf(&buf, "},\n")
+	}
+	fmt.Fprintf(&buf, "}\n")
+
+	return format.Source(buf.Bytes())
+}
+
+func main() {
+	output := flag.String("o", "tables.go", "output file")
+	flag.Parse()
+
+	var table usage.OUsageTable
+	if err := json.Unmarshal([]byte(usage.USAGE_SPEC_JSON), &table); err != nil {
+		log.Fatalf("unable to parse HID usage tables: %v", err)
+	}
+	src, err := generate(table)
+	if err != nil {
+		log.Fatalf("unable to generate usage tables: %v", err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatalf("unable to write %s: %v", *output, err)
+	}
+}
@@ -0,0 +1,2969 @@
+// Code generated by internal/gen from HID Usage Tables 1.5; DO NOT EDIT.
+
+package usages
+
+// Version of HID Usage Tables
+const VERSION = "1.5"
+
+var pages = []*Page{
+	{
+		ID:   0x0001,
+		Name: "Generic Desktop",
+		Usages: []Usage{
+			{Page: 0x0001, ID: 0x0001, Name: "Pointer", Kinds: KIND_CP},
+			{Page: 0x0001, ID: 0x0002, Name: "Mouse", Kinds: KIND_CA},
+			{Page: 0x0001, ID: 0x0004, Name: "Joystick", Kinds: KIND_CA},
+			{Page: 0x0001, ID: 0x0005, Name: "Gamepad", Kinds: KIND_CA},
+			{Page: 0x0001, ID: 0x0006, Name: "Keyboard", Kinds: KIND_CA},
+			{Page: 0x0001, ID: 0x0007, Name: "Keypad", Kinds: KIND_CA},
+			{Page: 0x0001, ID: 0x0008, Name: "Multi-axis Controller", Kinds: KIND_CA},
+			{Page: 0x0001, ID: 0x0009, Name: "Tablet PC System Controls", Kinds: KIND_CA},
+			{Page: 0x0001, ID: 0x000A, Name: "Water Cooling Device", Kinds: KIND_CA},
+			{Page: 0x0001, ID: 0x000B, Name: "Computer Chassis Device", Kinds: KIND_CA},
+			{Page: 0x0001, ID: 0x000C, Name: "Wireless Radio Controls", Kinds: KIND_CA},
+			{Page: 0x0001, ID: 0x000D, Name: "Portable Device Control", Kinds: KIND_CA},
+			{Page: 0x0001, ID: 0x000E, Name: "System Multi-Axis Controller", Kinds: KIND_CA},
+			{Page: 0x0001, ID: 0x000F, Name: "Spatial Controller", Kinds: KIND_CA},
+			{Page: 0x0001, ID: 0x0010, Name: "Assistive Control", Kinds: KIND_CA},
+			{Page: 0x0001, ID: 0x0011, Name: "Device Dock", Kinds: KIND_CA},
+			{Page: 0x0001, ID: 0x0012, Name: "Dockable Device", Kinds: KIND_CA},
+			{Page: 0x0001, ID: 0x0013, Name: "Call State Management Control", Kinds: KIND_CA},
+			{Page: 0x0001, ID: 0x0030, Name: "X", Kinds: KIND_DV},
+			{Page: 0x0001, ID: 0x0031, Name: "Y", Kinds: KIND_DV},
+			{Page: 0x0001, ID: 0x0032, Name: "Z", Kinds: KIND_DV},
+			{Page: 0x0001, ID: 0x0033, Name: "Rx", Kinds: KIND_DV},
+			{Page: 0x0001, ID: 0x0034, Name: "Ry", Kinds: KIND_DV},
+			{Page: 0x0001, ID: 0x0035, Name: "Rz", Kinds: KIND_DV},
+			{Page: 0x0001, ID: 0x0036, Name: "Slider", Kinds: KIND_DV},
+			{Page: 0x0001, ID: 0x0037, Name: "Dial", Kinds: KIND_DV},
+			{Page: 0x0001, ID: 0x0038, Name: "Wheel", Kinds: KIND_DV},
+			{Page: 0x0001, ID: 0x0039, Name: "Hat Switch", Kinds: KIND_DV},
+			{Page: 0x0001, ID: 0x003A, Name: "Counted Buffer", Kinds: KIND_CL},
+			{Page: 0x0001, ID: 0x003B, Name: "Byte Count", Kinds: KIND_DV},
+			{Page: 0x0001, ID: 0x003C, Name: "Motion Wakeup", Kinds: KIND_OSC | KIND_DF},
+			{Page: 0x0001, ID: 0x003D, Name: "Start", Kinds: KIND_OOC},
+			{Page: 0x0001, ID: 0x003E, Name: "Select", Kinds: KIND_OOC},
+			{Page: 0x0001, ID: 0x0040, Name: "Vx", Kinds: KIND_DV},
+			{Page: 0x0001, ID: 0x0041, Name: "Vy", Kinds: KIND_DV},
+			{Page: 0x0001, ID: 0x0042, Name: "Vz", Kinds: KIND_DV},
+			{Page: 0x0001, ID: 0x0043, Name: "Vbrx", Kinds: KIND_DV},
+			{Page: 0x0001, ID: 0x0044, Name: "Vbry", Kinds: KIND_DV},
+			{Page: 0x0001, ID: 0x0045, Name: "Vbrz", Kinds: KIND_DV},
+			{Page: 0x0001, ID: 0x0046, Name: "Vno", Kinds: KIND_DV},
+			{Page: 0x0001, ID: 0x0047, Name: "Feature Notification", Kinds: KIND_DV | KIND_DF},
+			{Page: 0x0001, ID: 0x0048, Name: "Resolution Multiplier", Kinds: KIND_DV},
+			{Page: 0x0001, ID: 0x0049, Name: "Qx", Kinds: KIND_DV},
+			{Page: 0x0001, ID: 0x004A, Name: "Qy", Kinds: KIND_DV},
+			{Page: 0x0001, ID: 0x004B, Name: "Qz", Kinds: KIND_DV},
+			{Page: 0x0001, ID: 0x004C, Name: "Qw", Kinds: KIND_DV},
+			{Page: 0x0001, ID: 0x0080, Name: "System Control", Kinds: KIND_CA},
+			{Page: 0x0001, ID: 0x0081, Name: "System Power Down", Kinds: KIND_OSC},
+			{Page: 0x0001, ID: 0x0082, Name: "System Sleep", Kinds: KIND_OSC},
+			{Page: 0x0001, ID: 0x0083, Name: "System Wake Up", Kinds: KIND_OSC},
+			{Page: 0x0001, ID: 0x0084, Name: "System Context Menu", Kinds: KIND_OSC},
+			{Page: 0x0001, ID: 0x0085, Name: "System Main Menu", Kinds: KIND_OSC},
+			{Page: 0x0001, ID: 0x0086, Name: "System App Menu", Kinds: KIND_OSC},
+			{Page: 0x0001, ID: 0x0087, Name: "System Menu Help", Kinds: KIND_OSC},
+			{Page: 0x0001, ID: 0x0088, Name: "System Menu Exit", Kinds: KIND_OSC},
+			{Page: 0x0001, ID: 0x0089, Name: "System Menu Select", Kinds: KIND_OSC},
+			{Page: 0x0001, ID: 0x008A, Name: "System Menu Right", Kinds: KIND_RTC},
+			{Page: 0x0001, ID: 0x008B, Name: "System Menu Left", Kinds: KIND_RTC},
+			{Page: 0x0001, ID: 0x008C, Name: "System Menu Up", Kinds: KIND_RTC},
+			{Page: 0x0001, ID: 0x008D, Name: "System Menu Down", Kinds: KIND_RTC},
+			{Page: 0x0001, ID: 0x008E, Name: "System Cold Restart", Kinds: KIND_OSC},
+			{Page: 0x0001, ID: 0x008F, Name: "System Warm Restart", Kinds: KIND_OSC},
+			{Page: 0x0001, ID: 0x0090, Name: "D-pad Up", Kinds: KIND_OOC},
+			{Page: 0x0001, ID: 0x0091, Name: "D-pad Down", Kinds: KIND_OOC},
+			{Page: 0x0001, ID: 0x0092, Name: "D-pad Right", Kinds: KIND_OOC},
+			{Page: 0x0001, ID: 0x0093, Name: "D-pad Left", Kinds: KIND_OOC},
+			{Page: 0x0001, ID: 0x0094, Name: "Index Trigger", Kinds: KIND_MC | KIND_DV},
+			{Page: 0x0001, ID: 0x0095, Name: "Palm Trigger", Kinds: KIND_MC | KIND_DV},
+			{Page: 0x0001, ID: 0x0096, Name: "Thumbstick", Kinds: KIND_CP},
+			{Page: 0x0001, ID: 0x0097, Name: "System Function Shift", Kinds: KIND_MC},
+			{Page: 0x0001, ID: 0x0098, Name: "System Function Shift Lock", Kinds: KIND_OOC},
+			{Page: 0x0001, ID: 0x0099, Name: "System Function Shift Lock Indicator", Kinds: KIND_DV},
+			{Page: 0x0001, ID: 0x009A, Name: "System Dismiss Notification", Kinds: KIND_OSC},
+			{Page: 0x0001, ID: 0x009B, Name: "System Do Not Disturb", Kinds: KIND_OOC},
+			{Page: 0x0001, ID: 0x00A0, Name: "System Dock", Kinds: KIND_OSC},
+			{Page: 0x0001, ID: 0x00A1, Name: "System Undock", Kinds: KIND_OSC},
+			{Page: 0x0001, ID: 0x00A2, Name: "System Setup", Kinds: KIND_OSC},
+			{Page: 0x0001, ID: 0x00A3, Name: "System Break", Kinds: KIND_OSC},
+			{Page: 0x0001, ID: 0x00A4, Name: "System Debugger Break", Kinds: KIND_OSC},
+			{Page: 0x0001, ID: 0x00A5, Name: "Application Break", Kinds: KIND_OSC},
+			{Page: 0x0001, ID: 0x00A6, Name: "Application Debugger Break", Kinds: KIND_OSC},
+			{Page: 0x0001, ID: 0x00A7, Name: "System Speaker Mute", Kinds: KIND_OSC},
+			{Page: 0x0001, ID: 0x00A8, Name: "System Hibernate", Kinds: KIND_OSC},
+			{Page: 0x0001, ID: 0x00A9, Name: "System Microphone Mute", Kinds: KIND_OOC},
+			{Page: 0x0001, ID: 0x00B0, Name: "System Display Invert", Kinds: KIND_OSC},
+			{Page: 0x0001, ID: 0x00B1, Name: "System Display Internal", Kinds: KIND_OSC},
+			{Page: 0x0001, ID: 0x00B2, Name: "System Display External", Kinds: KIND_OSC},
+			{Page: 0x0001, ID: 0x00B3, Name: "System Display Both", Kinds: KIND_OSC},
+			{Page: 0x0001, ID: 0x00B4, Name: "System Display Dual", Kinds: KIND_OSC},
+			{Page: 0x0001, ID: 0x00B5, Name: "System Display Toggle Int/Ext Mode", Kinds: KIND_OSC},
+			{Page: 0x0001, ID: 0x00B6, Name: "System Display Swap Primary/Secondary", Kinds: KIND_OSC},
+			{Page: 0x0001, ID: 0x00B7, Name: "System Display Toggle LCD Autoscale", Kinds: KIND_OSC},
+			{Page: 0x0001, ID: 0x00C0, Name: "Sensor Zone", Kinds: KIND_CL},
+			{Page: 0x0001, ID: 0x00C1, Name: "RPM", Kinds: KIND_DV},
+			{Page: 0x0001, ID: 0x00C2, Name: "Coolant Level", Kinds: KIND_DV},
+			{Page: 0x0001, ID: 0x00C3, Name: "Coolant Critical Level", Kinds: KIND_SV},
+			{Page: 0x0001, ID: 0x00C4, Name: "Coolant Pump", Kinds: KIND_US},
+			{Page: 0x0001, ID: 0x00C5, Name: "Chassis Enclosure", Kinds: KIND_CL},
+			{Page: 0x0001, ID: 0x00C6, Name: "Wireless Radio Button", Kinds: KIND_OOC},
+			{Page: 0x0001, ID: 0x00C7, Name: "Wireless Radio LED", Kinds: KIND_OOC},
+			{Page: 0x0001, ID: 0x00C8, Name: "Wireless Radio Slider Switch", Kinds: KIND_OOC},
+			{Page: 0x0001, ID: 0x00C9, Name: "System Display Rotation Lock Button", Kinds: KIND_OOC},
+			{Page: 0x0001, ID: 0x00CA, Name: "System Display Rotation Lock Slider Switch", Kinds: KIND_OOC},
+			{Page: 0x0001, ID: 0x00CB, Name: "Control Enable", Kinds: KIND_DF},
+			{Page: 0x0001, ID: 0x00D0, Name: "Dockable Device Unique ID", Kinds: KIND_DV},
+			{Page: 0x0001, ID: 0x00D1, Name: "Dockable Device Vendor ID", Kinds: KIND_DV},
+			{Page: 0x0001, ID: 0x00D2, Name: "Dockable Device Primary Usage Page", Kinds: KIND_DV},
+			{Page: 0x0001, ID: 0x00D3, Name: "Dockable Device Primary Usage ID", Kinds: KIND_DV},
+			{Page: 0x0001, ID: 0x00D4, Name: "Dockable Device Docking State", Kinds: KIND_DF},
+			{Page: 0x0001, ID: 0x00D5, Name: "Dockable Device Display Occlusion", Kinds: KIND_CL},
+			{Page: 0x0001, ID: 0x00D6, Name: "Dockable Device Object Type", Kinds: KIND_DV},
+			{Page: 0x0001, ID: 0x00E0, Name: "Call Active LED", Kinds: KIND_OOC},
+			{Page: 0x0001, ID: 0x00E1, Name: "Call Mute Toggle", Kinds: KIND_OSC},
+			{Page: 0x0001, ID: 0x00E2, Name: "Call Mute LED", Kinds: KIND_OOC},
+		},
+	},
+	{
+		ID:   0x0002,
+		Name: "Simulation Controls",
+		Usages: []Usage{
+			{Page: 0x0002, ID: 0x0001, Name: "Flight Simulation Device", Kinds: KIND_CA},
+			{Page: 0x0002, ID: 0x0002, Name: "Automobile Simulation Device", Kinds: KIND_CA},
+			{Page: 0x0002, ID: 0x0003, Name: "Tank Simulation Device", Kinds: KIND_CA},
+			{Page: 0x0002, ID: 0x0004, Name: "Spaceship Simulation Device", Kinds: KIND_CA},
+			{Page: 0x0002, ID: 0x0005, Name: "Submarine Simulation Device", Kinds: KIND_CA},
+			{Page: 0x0002, ID: 0x0006, Name: "Sailing Simulation Device", Kinds: KIND_CA},
+			{Page: 0x0002, ID: 0x0007, Name: "Motorcycle Simulation Device", Kinds: KIND_CA},
+			{Page: 0x0002, ID: 0x0008, Name: "Sports Simulation Device", Kinds: KIND_CA},
+			{Page: 0x0002, ID: 0x0009, Name: "Airplane Simulation Device", Kinds: KIND_CA},
+			{Page: 0x0002, ID: 0x000A, Name: "Helicopter Simulation Device", Kinds: KIND_CA},
+			{Page: 0x0002, ID: 0x000B, Name: "Magic Carpet Simulation Device", Kinds: KIND_CA},
+			{Page: 0x0002, ID: 0x000C, Name: "Bicycle Simulation Device", Kinds: KIND_CA},
+			{Page: 0x0002, ID: 0x0020, Name: "Flight Control Stick", Kinds: KIND_CA},
+			{Page: 0x0002, ID: 0x0021, Name: "Flight Stick", Kinds: KIND_CA},
+			{Page: 0x0002, ID: 0x0022, Name: "Cyclic Control", Kinds: KIND_CP},
+			{Page: 0x0002, ID: 0x0023, Name: "Cyclic Trim", Kinds: KIND_CP},
+			{Page: 0x0002, ID: 0x0024, Name: "Flight Yoke", Kinds: KIND_CA},
+			{Page: 0x0002, ID: 0x0025, Name: "Track Control", Kinds: KIND_CP},
+			{Page: 0x0002, ID: 0x00B0, Name: "Aileron", Kinds: KIND_DV},
+			{Page: 0x0002, ID: 0x00B1, Name: "Aileron Trim", Kinds: KIND_DV},
+			{Page: 0x0002, ID: 0x00B2, Name: "Anti-Torque Control", Kinds: KIND_DV},
+			{Page: 0x0002, ID: 0x00B3, Name: "Autopilot Enable", Kinds: KIND_OOC},
+			{Page: 0x0002, ID: 0x00B4, Name: "Chaff Release", Kinds: KIND_OSC},
+			{Page: 0x0002, ID: 0x00B5, Name: "Collective Control", Kinds: KIND_DV},
+			{Page: 0x0002, ID: 0x00B6, Name: "Dive Brake", Kinds: KIND_DV},
+			{Page: 0x0002, ID: 0x00B7, Name: "Electronic Countermeasures", Kinds: KIND_OOC},
+			{Page: 0x0002, ID: 0x00B8, Name: "Elevator", Kinds: KIND_DV},
+			{Page: 0x0002, ID: 0x00B9, Name: "Elevator Trim", Kinds: KIND_DV},
+			{Page: 0x0002, ID: 0x00BA, Name: "Rudder", Kinds: KIND_DV},
+			{Page: 0x0002, ID: 0x00BB, Name: "Throttle", Kinds: KIND_DV},
+			{Page: 0x0002, ID: 0x00BC, Name: "Flight Communications", Kinds: KIND_OOC},
+			{Page: 0x0002, ID: 0x00BD, Name: "Flare Release", Kinds: KIND_OSC},
+			{Page: 0x0002, ID: 0x00BE, Name: "Landing Gear", Kinds: KIND_OOC},
+			{Page: 0x0002, ID: 0x00BF, Name: "Toe Brake", Kinds: KIND_DV},
+			{Page: 0x0002, ID: 0x00C0, Name: "Trigger", Kinds: KIND_MC},
+			{Page: 0x0002, ID: 0x00C1, Name: "Weapons Arm", Kinds: KIND_OOC},
+			{Page: 0x0002, ID: 0x00C2, Name: "Weapons Select", Kinds: KIND_OSC},
+			{Page: 0x0002, ID: 0x00C3, Name: "Wing Flaps", Kinds: KIND_DV},
+			{Page: 0x0002, ID: 0x00C4, Name: "Accelerator", Kinds: KIND_DV},
+			{Page: 0x0002, ID: 0x00C5, Name: "Brake", Kinds: KIND_DV},
+			{Page: 0x0002, ID: 0x00C6, Name: "Clutch", Kinds: KIND_DV},
+			{Page: 0x0002, ID: 0x00C7, Name: "Shifter", Kinds: KIND_DV},
+			{Page: 0x0002, ID: 0x00C8, Name: "Steering", Kinds: KIND_DV},
+			{Page: 0x0002, ID: 0x00C9, Name: "Turret Direction", Kinds: KIND_DV},
+			{Page: 0x0002, ID: 0x00CA, Name: "Barrel Elevation", Kinds: KIND_DV},
+			{Page: 0x0002, ID: 0x00CB, Name: "Dive Plane", Kinds: KIND_DV},
+			{Page: 0x0002, ID: 0x00CC, Name: "Ballast", Kinds: KIND_DV},
+			{Page: 0x0002, ID: 0x00CD, Name: "Bicycle Crank", Kinds: KIND_DV},
+			{Page: 0x0002, ID: 0x00CE, Name: "Handle Bars", Kinds: KIND_DV},
+			{Page: 0x0002, ID: 0x00CF, Name: "Front Brake", Kinds: KIND_DV},
+			{Page: 0x0002, ID: 0x00D0, Name: "Rear Brake", Kinds: KIND_DV},
+		},
+	},
+	{
+		ID:   0x0003,
+		Name: "VR Controls",
+		Usages: []Usage{
+			{Page: 0x0003, ID: 0x0001, Name: "Belt", Kinds: KIND_CA},
+			{Page: 0x0003, ID: 0x0002, Name: "Body Suit", Kinds: KIND_CA},
+			{Page: 0x0003, ID: 0x0003, Name: "Flexor", Kinds: KIND_CP},
+			{Page: 0x0003, ID: 0x0004, Name: "Glove", Kinds: KIND_CA},
+			{Page: 0x0003, ID: 0x0005, Name: "Head Tracker", Kinds: KIND_CP},
+			{Page: 0x0003, ID: 0x0006, Name: "Head Mounted Display", Kinds: KIND_CA},
+			{Page: 0x0003, ID: 0x0007, Name: "Hand Tracker", Kinds: KIND_CA},
+			{Page: 0x0003, ID: 0x0008, Name: "Oculometer", Kinds: KIND_CA},
+			{Page: 0x0003, ID: 0x0009, Name: "Vest", Kinds: KIND_CA},
+			{Page: 0x0003, ID: 0x000A, Name: "Animatronic Device", Kinds: KIND_CA},
+			{Page: 0x0003, ID: 0x0020, Name: "Stereo Enable", Kinds: KIND_OOC},
+			{Page: 0x0003, ID: 0x0021, Name: "Display Enable", Kinds: KIND_OOC},
+		},
+	},
+	{
+		ID:   0x0004,
+		Name: "Sport Controls",
+		Usages: []Usage{
+			{Page: 0x0004, ID: 0x0001, Name: "Baseball Bat", Kinds: KIND_CA},
+			{Page: 0x0004, ID: 0x0002, Name: "Golf Club", Kinds: KIND_CA},
+			{Page: 0x0004, ID: 0x0003, Name: "Rowing Machine", Kinds: KIND_CA},
+			{Page: 0x0004, ID: 0x0004, Name: "Treadmill", Kinds: KIND_CA},
+			{Page: 0x0004, ID: 0x0030, Name: "Oar", Kinds: KIND_DV},
+			{Page: 0x0004, ID: 0x0031, Name: "Slope", Kinds: KIND_DV},
+			{Page: 0x0004, ID: 0x0032, Name: "Rate", Kinds: KIND_DV},
+			{Page: 0x0004, ID: 0x0033, Name: "Stick Speed", Kinds: KIND_DV},
+			{Page: 0x0004, ID: 0x0034, Name: "Stick Face Angle", Kinds: KIND_DV},
+			{Page: 0x0004, ID: 0x0035, Name: "Stick Heel/Toe", Kinds: KIND_DV},
+			{Page: 0x0004, ID: 0x0036, Name: "Stick Follow Through", Kinds: KIND_DV},
+			{Page: 0x0004, ID: 0x0037, Name: "Stick Tempo", Kinds: KIND_DV},
+			{Page: 0x0004, ID: 0x0038, Name: "Stick Type", Kinds: KIND_NARY},
+			{Page: 0x0004, ID: 0x0039, Name: "Stick Height", Kinds: KIND_DV},
+			{Page: 0x0004, ID: 0x0050, Name: "Putter", Kinds: KIND_SEL},
+			{Page: 0x0004, ID: 0x0051, Name: "1 Iron", Kinds: KIND_SEL},
+			{Page: 0x0004, ID: 0x0052, Name: "2 Iron", Kinds: KIND_SEL},
+			{Page: 0x0004, ID: 0x0053, Name: "3 Iron", Kinds: KIND_SEL},
+			{Page: 0x0004, ID: 0x0054, Name: "4 Iron", Kinds: KIND_SEL},
+			{Page: 0x0004, ID: 0x0055, Name: "5 Iron", Kinds: KIND_SEL},
+			{Page: 0x0004, ID: 0x0056, Name: "6 Iron", Kinds: KIND_SEL},
+			{Page: 0x0004, ID: 0x0057, Name: "7 Iron", Kinds: KIND_SEL},
+			{Page: 0x0004, ID: 0x0058, Name: "8 Iron", Kinds: KIND_SEL},
+			{Page: 0x0004, ID: 0x0059, Name: "9 Iron", Kinds: KIND_SEL},
+			{Page: 0x0004, ID: 0x005A, Name: "10 Iron", Kinds: KIND_SEL},
+			{Page: 0x0004, ID: 0x005B, Name: "11 Iron", Kinds: KIND_SEL},
+			{Page: 0x0004, ID: 0x005C, Name: "Sand Wedge", Kinds: KIND_SEL},
+			{Page: 0x0004, ID: 0x005D, Name: "Loft Wedge", Kinds: KIND_SEL},
+			{Page: 0x0004, ID: 0x005E, Name: "Power Wedge", Kinds: KIND_SEL},
+			{Page: 0x0004, ID: 0x005F, Name: "1 Wood", Kinds: KIND_SEL},
+			{Page: 0x0004, ID: 0x0060, Name: "3 Wood", Kinds: KIND_SEL},
+			{Page: 0x0004, ID: 0x0061, Name: "5 Wood", Kinds: KIND_SEL},
+			{Page: 0x0004, ID: 0x0062, Name: "7 Wood", Kinds: KIND_SEL},
+			{Page: 0x0004, ID: 0x0063, Name: "9 Wood", Kinds: KIND_SEL},
+		},
+	},
+	{
+		ID:   0x0005,
+		Name: "Game Controls",
+		Usages: []Usage{
+			{Page: 0x0005, ID: 0x0001, Name: "3D Game Controller", Kinds: KIND_CA},
+			{Page: 0x0005, ID: 0x0002, Name: "Pinball Device", Kinds: KIND_CA},
+			{Page: 0x0005, ID: 0x0003, Name: "Gun Device", Kinds: KIND_CA},
+			{Page: 0x0005, ID: 0x0020, Name: "Point of View", Kinds: KIND_CP},
+			{Page: 0x0005, ID: 0x0021, Name: "Turn Right/Left", Kinds: KIND_DV},
+			{Page: 0x0005, ID: 0x0022, Name: "Pitch Forward/Backward", Kinds: KIND_DV},
+			{Page: 0x0005, ID: 0x0023, Name: "Roll Right/Left", Kinds: KIND_DV},
+			{Page: 0x0005, ID: 0x0024, Name: "Move Right/Left", Kinds: KIND_DV},
+			{Page: 0x0005, ID: 0x0025, Name: "Move Forward/Backward", Kinds: KIND_DV},
+			{Page: 0x0005, ID: 0x0026, Name: "Move Up/Down", Kinds: KIND_DV},
+			{Page: 0x0005, ID: 0x0027, Name: "Lean Right/Left", Kinds: KIND_DV},
+			{Page: 0x0005, ID: 0x0028, Name: "Lean Forward/Backward", Kinds: KIND_DV},
+			{Page: 0x0005, ID: 0x0029, Name: "Height of POV", Kinds: KIND_DV},
+			{Page: 0x0005, ID: 0x002A, Name: "Flipper", Kinds: KIND_MC},
+			{Page: 0x0005, ID: 0x002B, Name: "Secondary Flipper", Kinds: KIND_MC},
+			{Page: 0x0005, ID: 0x002C, Name: "Bump", Kinds: KIND_MC},
+			{Page: 0x0005, ID: 0x002D, Name: "New Game", Kinds: KIND_OSC},
+			{Page: 0x0005, ID: 0x002E, Name: "Shoot Ball", Kinds: KIND_OSC},
+			{Page: 0x0005, ID: 0x002F, Name: "Player", Kinds: KIND_OSC},
+			{Page: 0x0005, ID: 0x0030, Name: "Gun Bolt", Kinds: KIND_OOC},
+			{Page: 0x0005, ID: 0x0031, Name: "Gun Clip", Kinds: KIND_OOC},
+			{Page: 0x0005, ID: 0x0032, Name: "Gun Selector", Kinds: KIND_NARY},
+			{Page: 0x0005, ID: 0x0033, Name: "Gun Single Shot", Kinds: KIND_SEL},
+			{Page: 0x0005, ID: 0x0034, Name: "Gun Burst", Kinds: KIND_SEL},
+			{Page: 0x0005, ID: 0x0035, Name: "Gun Automatic", Kinds: KIND_SEL},
+			{Page: 0x0005, ID: 0x0036, Name: "Gun Safety", Kinds: KIND_OOC},
+			{Page: 0x0005, ID: 0x0037, Name: "Gamepad Fire/Jump", Kinds: KIND_CL},
+			{Page: 0x0005, ID: 0x0039, Name: "Gamepad Trigger", Kinds: KIND_CL},
+			{Page: 0x0005, ID: 0x003A, Name: "Form-fitting Gamepad", Kinds: KIND_SF},
+		},
+	},
+	{
+		ID:   0x0006,
+		Name: "Generic Device Controls",
+		Usages: []Usage{
+			{Page: 0x0006, ID: 0x0001, Name: "Background/Nonuser Controls", Kinds: KIND_CA},
+			{Page: 0x0006, ID: 0x0020, Name: "Battery Strength", Kinds: KIND_DV},
+			{Page: 0x0006, ID: 0x0021, Name: "Wireless Channel", Kinds: KIND_DV},
+			{Page: 0x0006, ID: 0x0022, Name: "Wireless ID", Kinds: KIND_DV},
+			{Page: 0x0006, ID: 0x0023, Name: "Discover Wireless Control", Kinds: KIND_OSC},
+			{Page: 0x0006, ID: 0x0024, Name: "Security Code Character Entered", Kinds: KIND_OSC},
+			{Page: 0x0006, ID: 0x0025, Name: "Security Code Character Erased", Kinds: KIND_OSC},
+			{Page: 0x0006, ID: 0x0026, Name: "Security Code Cleared", Kinds: KIND_OSC},
+			{Page: 0x0006, ID: 0x0027, Name: "Sequence ID", Kinds: KIND_DV},
+			{Page: 0x0006, ID: 0x0028, Name: "Sequence ID Reset", Kinds: KIND_DF},
+			{Page: 0x0006, ID: 0x0029, Name: "RF Signal Strength", Kinds: KIND_DV},
+			{Page: 0x0006, ID: 0x002A, Name: "Software Version", Kinds: KIND_CL},
+			{Page: 0x0006, ID: 0x002B, Name: "Protocol Version", Kinds: KIND_CL},
+			{Page: 0x0006, ID: 0x002C, Name: "Hardware Version", Kinds: KIND_CL},
+			{Page: 0x0006, ID: 0x002D, Name: "Major", Kinds: KIND_SV},
+			{Page: 0x0006, ID: 0x002E, Name: "Minor", Kinds: KIND_SV},
+			{Page: 0x0006, ID: 0x002F, Name: "Revision", Kinds: KIND_SV},
+			{Page: 0x0006, ID: 0x0030, Name: "Handedness", Kinds: KIND_NARY},
+			{Page: 0x0006, ID: 0x0031, Name: "Either Hand", Kinds: KIND_SEL},
+			{Page: 0x0006, ID: 0x0032, Name: "Left Hand", Kinds: KIND_SEL},
+			{Page: 0x0006, ID: 0x0033, Name: "Right Hand", Kinds: KIND_SEL},
+			{Page: 0x0006, ID: 0x0034, Name: "Both Hands", Kinds: KIND_SEL},
+			{Page: 0x0006, ID: 0x0040, Name: "Grip Pose Offset", Kinds: KIND_CP},
+			{Page: 0x0006, ID: 0x0041, Name: "Pointer Pose Offset", Kinds: KIND_CP},
+		},
+	},
+	{
+		ID:   0x0007,
+		Name: "Keyboard/Keypad",
+		Usages: []Usage{
+			{Page: 0x0007, ID: 0x0001, Name: "ErrorRollOver", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0002, Name: "POSTFail", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0003, Name: "ErrorUndefined", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0004, Name: "Keyboard A", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0005, Name: "Keyboard B", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0006, Name: "Keyboard C", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0007, Name: "Keyboard D", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0008, Name: "Keyboard E", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0009, Name: "Keyboard F", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x000A, Name: "Keyboard G", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x000B, Name: "Keyboard H", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x000C, Name: "Keyboard I", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x000D, Name: "Keyboard J", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x000E, Name: "Keyboard K", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x000F, Name: "Keyboard L", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0010, Name: "Keyboard M", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0011, Name: "Keyboard N", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0012, Name: "Keyboard O", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0013, Name: "Keyboard P", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0014, Name: "Keyboard Q", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0015, Name: "Keyboard R", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0016, Name: "Keyboard S", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0017, Name: "Keyboard T", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0018, Name: "Keyboard U", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0019, Name: "Keyboard V", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x001A, Name: "Keyboard W", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x001B, Name: "Keyboard X", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x001C, Name: "Keyboard Y", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x001D, Name: "Keyboard Z", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x001E, Name: "Keyboard 1 and Bang", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x001F, Name: "Keyboard 2 and At", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0020, Name: "Keyboard 3 and Hash", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0021, Name: "Keyboard 4 and Dollar", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0022, Name: "Keyboard 5 and Percent", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0023, Name: "Keyboard 6 and Caret", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0024, Name: "Keyboard 7 and Ampersand", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0025, Name: "Keyboard 8 and Star", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0026, Name: "Keyboard 9 and Left Bracket", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0027, Name: "Keyboard 0 and Right Bracket", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0028, Name: "Keyboard Return Enter", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0029, Name: "Keyboard Escape", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x002A, Name: "Keyboard Delete", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x002B, Name: "Keyboard Tab", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x002C, Name: "Keyboard Spacebar", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x002D, Name: "Keyboard Dash and Underscore", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x002E, Name: "Keyboard Equals and Plus", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x002F, Name: "Keyboard Left Brace", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0030, Name: "Keyboard Right Brace", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0031, Name: "Keyboard Backslash and Pipe", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0032, Name: "Keyboard Non-US Hash and Tilde", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0033, Name: "Keyboard SemiColon and Colon", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0034, Name: "Keyboard Left Apos and Double", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0035, Name: "Keyboard Grave Accent and Tilde", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0036, Name: "Keyboard Comma and LessThan", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0037, Name: "Keyboard Period and GreaterThan", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0038, Name: "Keyboard ForwardSlash and QuestionMark", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0039, Name: "Keyboard Caps Lock", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x003A, Name: "Keyboard F1", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x003B, Name: "Keyboard F2", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x003C, Name: "Keyboard F3", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x003D, Name: "Keyboard F4", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x003E, Name: "Keyboard F5", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x003F, Name: "Keyboard F6", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0040, Name: "Keyboard F7", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0041, Name: "Keyboard F8", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0042, Name: "Keyboard F9", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0043, Name: "Keyboard F10", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0044, Name: "Keyboard F11", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0045, Name: "Keyboard F12", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0046, Name: "Keyboard PrintScreen", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0047, Name: "Keyboard Scroll Lock", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0048, Name: "Keyboard Pause", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0049, Name: "Keyboard Insert", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x004A, Name: "Keyboard Home", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x004B, Name: "Keyboard PageUp", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x004C, Name: "Keyboard Delete Forward", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x004D, Name: "Keyboard End", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x004E, Name: "Keyboard PageDown", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x004F, Name: "Keyboard RightArrow", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0050, Name: "Keyboard LeftArrow", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0051, Name: "Keyboard DownArrow", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0052, Name: "Keyboard UpArrow", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0053, Name: "Keypad Num Lock and Clear", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0054, Name: "Keypad ForwardSlash", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0055, Name: "Keypad Star", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0056, Name: "Keypad Dash", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0057, Name: "Keypad Plus", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0058, Name: "Keypad ENTER", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0059, Name: "Keypad 1 and End", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x005A, Name: "Keypad 2 and Down Arrow", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x005B, Name: "Keypad 3 and PageDn", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x005C, Name: "Keypad 4 and Left Arrow", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x005D, Name: "Keypad 5", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x005E, Name: "Keypad 6 and Right Arrow", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x005F, Name: "Keypad 7 and Home", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0060, Name: "Keypad 8 and Up Arrow", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0061, Name: "Keypad 9 and PageUp", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0062, Name: "Keypad 0 and Insert", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0063, Name: "Keypad Period and Delete", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0064, Name: "Keyboard Non-US Backslash and Pipe", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0065, Name: "Keyboard Application", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0066, Name: "Keyboard Power", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0067, Name: "Keypad Equals", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0068, Name: "Keyboard F13", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0069, Name: "Keyboard F14", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x006A, Name: "Keyboard F15", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x006B, Name: "Keyboard F16", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x006C, Name: "Keyboard F17", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x006D, Name: "Keyboard F18", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x006E, Name: "Keyboard F19", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x006F, Name: "Keyboard F20", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0070, Name: "Keyboard F21", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0071, Name: "Keyboard F22", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0072, Name: "Keyboard F23", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0073, Name: "Keyboard F24", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0074, Name: "Keyboard Execute", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0075, Name: "Keyboard Help", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0076, Name: "Keyboard Menu", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0077, Name: "Keyboard Select", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0078, Name: "Keyboard Stop", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0079, Name: "Keyboard Again", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x007A, Name: "Keyboard Undo", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x007B, Name: "Keyboard Cut", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x007C, Name: "Keyboard Copy", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x007D, Name: "Keyboard Paste", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x007E, Name: "Keyboard Find", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x007F, Name: "Keyboard Mute", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0080, Name: "Keyboard Volume Up", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0081, Name: "Keyboard Volume Down", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0082, Name: "Keyboard Locking Caps Lock", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0083, Name: "Keyboard Locking Num Lock", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0084, Name: "Keyboard Locking Scroll Lock", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0085, Name: "Keypad Comma", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0086, Name: "Keypad Equal Sign", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0087, Name: "Keyboard International1", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0088, Name: "Keyboard International2", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0089, Name: "Keyboard International3", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x008A, Name: "Keyboard International4", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x008B, Name: "Keyboard International5", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x008C, Name: "Keyboard International6", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x008D, Name: "Keyboard International7", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x008E, Name: "Keyboard International8", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x008F, Name: "Keyboard International9", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0090, Name: "Keyboard LANG1", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0091, Name: "Keyboard LANG2", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0092, Name: "Keyboard LANG3", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0093, Name: "Keyboard LANG4", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0094, Name: "Keyboard LANG5", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0095, Name: "Keyboard LANG6", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0096, Name: "Keyboard LANG7", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0097, Name: "Keyboard LANG8", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0098, Name: "Keyboard LANG9", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x0099, Name: "Keyboard Alternate Erase", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x009A, Name: "Keyboard SysReq Attention", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x009B, Name: "Keyboard Cancel", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x009C, Name: "Keyboard Clear", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x009D, Name: "Keyboard Prior", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x009E, Name: "Keyboard Return", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x009F, Name: "Keyboard Separator", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00A0, Name: "Keyboard Out", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00A1, Name: "Keyboard Oper", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00A2, Name: "Keyboard Clear Again", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00A3, Name: "Keyboard CrSel Props", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00A4, Name: "Keyboard ExSel", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00B0, Name: "Keypad Double 0", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00B1, Name: "Keypad Triple 0", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00B2, Name: "Thousands Separator", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00B3, Name: "Decimal Separator", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00B4, Name: "Currency Unit", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00B5, Name: "Currency Sub-unit", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00B6, Name: "Keypad Left Bracket", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00B7, Name: "Keypad Right Bracket", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00B8, Name: "Keypad Left Brace", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00B9, Name: "Keypad Right Brace", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00BA, Name: "Keypad Tab", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00BB, Name: "Keypad Backspace", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00BC, Name: "Keypad A", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00BD, Name: "Keypad B", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00BE, Name: "Keypad C", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00BF, Name: "Keypad D", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00C0, Name: "Keypad E", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00C1, Name: "Keypad F", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00C2, Name: "Keypad XOR", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00C3, Name: "Keypad Caret", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00C4, Name: "Keypad Percentage", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00C5, Name: "Keypad Less", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00C6, Name: "Keypad Greater", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00C7, Name: "Keypad Ampersand", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00C8, Name: "Keypad Double Ampersand", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00C9, Name: "Keypad Bar", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00CA, Name: "Keypad Double Bar", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00CB, Name: "Keypad Colon", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00CC, Name: "Keypad Hash", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00CD, Name: "Keypad Space", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00CE, Name: "Keypad At", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00CF, Name: "Keypad Bang", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00D0, Name: "Keypad Memory Store", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00D1, Name: "Keypad Memory Recall", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00D2, Name: "Keypad Memory Clear", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00D3, Name: "Keypad Memory Add", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00D4, Name: "Keypad Memory Subtract", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00D5, Name: "Keypad Memory Multiply", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00D6, Name: "Keypad Memory Divide", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00D7, Name: "Keypad Plus Minus", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00D8, Name: "Keypad Clear", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00D9, Name: "Keypad Clear Entry", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00DA, Name: "Keypad Binary", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00DB, Name: "Keypad Octal", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00DC, Name: "Keypad Decimal", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00DD, Name: "Keypad Hexadecimal", Kinds: KIND_SEL},
+			{Page: 0x0007, ID: 0x00E0, Name: "Keyboard LeftControl", Kinds: KIND_DV},
+			{Page: 0x0007, ID: 0x00E1, Name: "Keyboard LeftShift", Kinds: KIND_DV},
+			{Page: 0x0007, ID: 0x00E2, Name: "Keyboard LeftAlt", Kinds: KIND_DV},
+			{Page: 0x0007, ID: 0x00E3, Name: "Keyboard Left GUI", Kinds: KIND_DV},
+			{Page: 0x0007, ID: 0x00E4, Name: "Keyboard RightControl", Kinds: KIND_DV},
+			{Page: 0x0007, ID: 0x00E5, Name: "Keyboard RightShift", Kinds: KIND_DV},
+			{Page: 0x0007, ID: 0x00E6, Name: "Keyboard RightAlt", Kinds: KIND_DV},
+			{Page: 0x0007, ID: 0x00E7, Name: "Keyboard Right GUI", Kinds: KIND_DV},
+		},
+	},
+	{
+		ID:   0x0008,
+		Name: "LED",
+		Usages: []Usage{
+			{Page: 0x0008, ID: 0x0001, Name: "Num Lock", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0002, Name: "Caps Lock", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0003, Name: "Scroll Lock", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0004, Name: "Compose", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0005, Name: "Kana", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0006, Name: "Power", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0007, Name: "Shift", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0008, Name: "Do Not Disturb", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0009, Name: "Mute", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x000A, Name: "Tone Enable", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x000B, Name: "High Cut Filter", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x000C, Name: "Low Cut Filter", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x000D, Name: "Equalizer Enable", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x000E, Name: "Sound Field On", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x000F, Name: "Surround On", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0010, Name: "Repeat", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0011, Name: "Stereo", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0012, Name: "Sampling Rate Detect", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0013, Name: "Spinning", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0014, Name: "CAV", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0015, Name: "CLV", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0016, Name: "Recording Format Detect", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0017, Name: "Off-Hook", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0018, Name: "Ring", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0019, Name: "Message Waiting", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x001A, Name: "Data Mode", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x001B, Name: "Battery Operation", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x001C, Name: "Battery OK", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x001D, Name: "Battery Low", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x001E, Name: "Speaker", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x001F, Name: "Headset", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0020, Name: "Hold", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0021, Name: "Microphone", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0022, Name: "Coverage", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0023, Name: "Night Mode", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0024, Name: "Send Calls", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0025, Name: "Call Pickup", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0026, Name: "Conference", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0027, Name: "Stand-by", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0028, Name: "Camera On", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0029, Name: "Camera Off", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x002A, Name: "On-Line", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x002B, Name: "Off-Line", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x002C, Name: "Busy", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x002D, Name: "Ready", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x002E, Name: "Paper-Out", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x002F, Name: "Paper-Jam", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0030, Name: "Remote", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0031, Name: "Forward", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0032, Name: "Reverse", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0033, Name: "Stop", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0034, Name: "Rewind", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0035, Name: "Fast Forward", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0036, Name: "Play", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0037, Name: "Pause", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0038, Name: "Record", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0039, Name: "Error", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x003A, Name: "Usage Selected Indicator", Kinds: KIND_US},
+			{Page: 0x0008, ID: 0x003B, Name: "Usage In Use Indicator", Kinds: KIND_US},
+			{Page: 0x0008, ID: 0x003C, Name: "Usage Multi Mode Indicator", Kinds: KIND_UM},
+			{Page: 0x0008, ID: 0x003D, Name: "Indicator On", Kinds: KIND_SEL},
+			{Page: 0x0008, ID: 0x003E, Name: "Indicator Flash", Kinds: KIND_SEL},
+			{Page: 0x0008, ID: 0x003F, Name: "Indicator Slow Blink", Kinds: KIND_SEL},
+			{Page: 0x0008, ID: 0x0040, Name: "Indicator Fast Blink", Kinds: KIND_SEL},
+			{Page: 0x0008, ID: 0x0041, Name: "Indicator Off", Kinds: KIND_SEL},
+			{Page: 0x0008, ID: 0x0042, Name: "Flash On Time", Kinds: KIND_DV},
+			{Page: 0x0008, ID: 0x0043, Name: "Slow Blink On Time", Kinds: KIND_DV},
+			{Page: 0x0008, ID: 0x0044, Name: "Slow Blink Off Time", Kinds: KIND_DV},
+			{Page: 0x0008, ID: 0x0045, Name: "Fast Blink On Time", Kinds: KIND_DV},
+			{Page: 0x0008, ID: 0x0046, Name: "Fast Blink Off Time", Kinds: KIND_DV},
+			{Page: 0x0008, ID: 0x0047, Name: "Usage Indicator Color", Kinds: KIND_UM},
+			{Page: 0x0008, ID: 0x0048, Name: "Indicator Red", Kinds: KIND_SEL},
+			{Page: 0x0008, ID: 0x0049, Name: "Indicator Green", Kinds: KIND_SEL},
+			{Page: 0x0008, ID: 0x004A, Name: "Indicator Amber", Kinds: KIND_SEL},
+			{Page: 0x0008, ID: 0x004B, Name: "Generic Indicator", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x004C, Name: "System Suspend", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x004D, Name: "External Power Connected", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x004E, Name: "Indicator Blue", Kinds: KIND_SEL},
+			{Page: 0x0008, ID: 0x004F, Name: "Indicator Orange", Kinds: KIND_SEL},
+			{Page: 0x0008, ID: 0x0050, Name: "Good Status", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0051, Name: "Warning Status", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0052, Name: "RGB LED", Kinds: KIND_CL},
+			{Page: 0x0008, ID: 0x0053, Name: "Red LED Channel", Kinds: KIND_DV},
+			{Page: 0x0008, ID: 0x0054, Name: "Blue LED Channel", Kinds: KIND_DV},
+			{Page: 0x0008, ID: 0x0055, Name: "Green LED Channel", Kinds: KIND_DV},
+			{Page: 0x0008, ID: 0x0056, Name: "LED Intensity", Kinds: KIND_DV},
+			{Page: 0x0008, ID: 0x0057, Name: "System Microphone Mute", Kinds: KIND_OOC},
+			{Page: 0x0008, ID: 0x0060, Name: "Player Indicator", Kinds: KIND_NARY},
+			{Page: 0x0008, ID: 0x0061, Name: "Player 1", Kinds: KIND_SEL},
+			{Page: 0x0008, ID: 0x0062, Name: "Player 2", Kinds: KIND_SEL},
+			{Page: 0x0008, ID: 0x0063, Name: "Player 3", Kinds: KIND_SEL},
+			{Page: 0x0008, ID: 0x0064, Name: "Player 4", Kinds: KIND_SEL},
+			{Page: 0x0008, ID: 0x0065, Name: "Player 5", Kinds: KIND_SEL},
+			{Page: 0x0008, ID: 0x0066, Name: "Player 6", Kinds: KIND_SEL},
+			{Page: 0x0008, ID: 0x0067, Name: "Player 7", Kinds: KIND_SEL},
+			{Page: 0x0008, ID: 0x0068, Name: "Player 8", Kinds: KIND_SEL},
+		},
+	},
+	{
+		ID:        0x0009,
+		Name:      "Button",
+		Generator: &Generator{Prefix: "Button", StartID: 0x0001, EndID: 0xFFFF, Kinds: KIND_SEL | KIND_OOC | KIND_MC | KIND_OSC},
+	},
+	{
+		ID:        0x000A,
+		Name:      "Ordinal",
+		Generator: &Generator{Prefix: "Instance", StartID: 0x0001, EndID: 0xFFFF, Kinds: KIND_UM},
+	},
+	{
+		ID:   0x000B,
+		Name: "Telephony Device",
+		Usages: []Usage{
+			{Page: 0x000B, ID: 0x0001, Name: "Phone", Kinds: KIND_CA},
+			{Page: 0x000B, ID: 0x0002, Name: "Answering Machine", Kinds: KIND_CA},
+			{Page: 0x000B, ID: 0x0003, Name: "Message Controls", Kinds: KIND_CL},
+			{Page: 0x000B, ID: 0x0004, Name: "Handset", Kinds: KIND_CL},
+			{Page: 0x000B, ID: 0x0005, Name: "Headset", Kinds: KIND_CL | KIND_CA},
+			{Page: 0x000B, ID: 0x0006, Name: "Telephony Key Pad", Kinds: KIND_NARY},
+			{Page: 0x000B, ID: 0x0007, Name: "Programmable Button", Kinds: KIND_NARY},
+			{Page: 0x000B, ID: 0x0020, Name: "Hook Switch", Kinds: KIND_OOC},
+			{Page: 0x000B, ID: 0x0021, Name: "Flash", Kinds: KIND_MC},
+			{Page: 0x000B, ID: 0x0022, Name: "Feature", Kinds: KIND_OSC},
+			{Page: 0x000B, ID: 0x0023, Name: "Hold", Kinds: KIND_OOC},
+			{Page: 0x000B, ID: 0x0024, Name: "Redial", Kinds: KIND_OSC},
+			{Page: 0x000B, ID: 0x0025, Name: "Transfer", Kinds: KIND_OSC},
+			{Page: 0x000B, ID: 0x0026, Name: "Drop", Kinds: KIND_OSC},
+			{Page: 0x000B, ID: 0x0027, Name: "Park", Kinds: KIND_OOC},
+			{Page: 0x000B, ID: 0x0028, Name: "Forward Calls", Kinds: KIND_OOC},
+			{Page: 0x000B, ID: 0x0029, Name: "Alternate Function", Kinds: KIND_MC},
+			{Page: 0x000B, ID: 0x002A, Name: "Line", Kinds: KIND_OSC | KIND_NARY},
+			{Page: 0x000B, ID: 0x002B, Name: "Speaker Phone", Kinds: KIND_OOC},
+			{Page: 0x000B, ID: 0x002C, Name: "Conference", Kinds: KIND_OOC},
+			{Page: 0x000B, ID: 0x002D, Name: "Ring Enable", Kinds: KIND_OOC},
+			{Page: 0x000B, ID: 0x002E, Name: "Ring Select", Kinds: KIND_OSC},
+			{Page: 0x000B, ID: 0x002F, Name: "Phone Mute", Kinds: KIND_OOC},
+			{Page: 0x000B, ID: 0x0030, Name: "Caller ID", Kinds: KIND_MC},
+			{Page: 0x000B, ID: 0x0031, Name: "Send", Kinds: KIND_OOC},
+			{Page: 0x000B, ID: 0x0050, Name: "Speed Dial", Kinds: KIND_OSC},
+			{Page: 0x000B, ID: 0x0051, Name: "Store Number", Kinds: KIND_OSC},
+			{Page: 0x000B, ID: 0x0052, Name: "Recall Number", Kinds: KIND_OSC},
+			{Page: 0x000B, ID: 0x0053, Name: "Phone Directory", Kinds: KIND_OOC},
+			{Page: 0x000B, ID: 0x0070, Name: "Voice Mail", Kinds: KIND_OOC},
+			{Page: 0x000B, ID: 0x0071, Name: "Screen Calls", Kinds: KIND_OOC},
+			{Page: 0x000B, ID: 0x0072, Name: "Do Not Disturb", Kinds: KIND_OOC},
+			{Page: 0x000B, ID: 0x0073, Name: "Message", Kinds: KIND_OSC},
+			{Page: 0x000B, ID: 0x0074, Name: "Answer On/Off", Kinds: KIND_OOC},
+			{Page: 0x000B, ID: 0x0090, Name: "Inside Dial Tone", Kinds: KIND_MC},
+			{Page: 0x000B, ID: 0x0091, Name: "Outside Dial Tone", Kinds: KIND_MC},
+			{Page: 0x000B, ID: 0x0092, Name: "Inside Ring Tone", Kinds: KIND_MC},
+			{Page: 0x000B, ID: 0x0093, Name: "Outside Ring Tone", Kinds: KIND_MC},
+			{Page: 0x000B, ID: 0x0094, Name: "Priority Ring Tone", Kinds: KIND_MC},
+			{Page: 0x000B, ID: 0x0095, Name: "Inside Ringback", Kinds: KIND_MC},
+			{Page: 0x000B, ID: 0x0096, Name: "Priority Ringback", Kinds: KIND_MC},
+			{Page: 0x000B, ID: 0x0097, Name: "Line Busy Tone", Kinds: KIND_MC},
+			{Page: 0x000B, ID: 0x0098, Name: "Reorder Tone", Kinds: KIND_MC},
+			{Page: 0x000B, ID: 0x0099, Name: "Call Waiting Tone", Kinds: KIND_MC},
+			{Page: 0x000B, ID: 0x009A, Name: "Confirmation Tone 1", Kinds: KIND_MC},
+			{Page: 0x000B, ID: 0x009B, Name: "Confirmation Tone 2", Kinds: KIND_MC},
+			{Page: 0x000B, ID: 0x009C, Name: "Tones Off", Kinds: KIND_OOC},
+			{Page: 0x000B, ID: 0x009D, Name: "Outside Ringback", Kinds: KIND_MC},
+			{Page: 0x000B, ID: 0x009E, Name: "Ringer", Kinds: KIND_OOC},
+			{Page: 0x000B, ID: 0x00B0, Name: "Phone Key 0", Kinds: KIND_SEL},
+			{Page: 0x000B, ID: 0x00B1, Name: "Phone Key 1", Kinds: KIND_SEL},
+			{Page: 0x000B, ID: 0x00B2, Name: "Phone Key 2", Kinds: KIND_SEL},
+			{Page: 0x000B, ID: 0x00B3, Name: "Phone Key 3", Kinds: KIND_SEL},
+			{Page: 0x000B, ID: 0x00B4, Name: "Phone Key 4", Kinds: KIND_SEL},
+			{Page: 0x000B, ID: 0x00B5, Name: "Phone Key 5", Kinds: KIND_SEL},
+			{Page: 0x000B, ID: 0x00B6, Name: "Phone Key 6", Kinds: KIND_SEL},
+			{Page: 0x000B, ID: 0x00B7, Name: "Phone Key 7", Kinds: KIND_SEL},
+			{Page: 0x000B, ID: 0x00B8, Name: "Phone Key 8", Kinds: KIND_SEL},
+			{Page: 0x000B, ID: 0x00B9, Name: "Phone Key 9", Kinds: KIND_SEL},
+			{Page: 0x000B, ID: 0x00BA, Name: "Phone Key Star", Kinds: KIND_SEL},
+			{Page: 0x000B, ID: 0x00BB, Name: "Phone Key Pound", Kinds: KIND_SEL},
+			{Page: 0x000B, ID: 0x00BC, Name: "Phone Key A", Kinds: KIND_SEL},
+			{Page: 0x000B, ID: 0x00BD, Name: "Phone Key B", Kinds: KIND_SEL},
+			{Page: 0x000B, ID: 0x00BE, Name: "Phone Key C", Kinds: KIND_SEL},
+			{Page: 0x000B, ID: 0x00BF, Name: "Phone Key D", Kinds: KIND_SEL},
+			{Page: 0x000B, ID: 0x00C0, Name: "Phone Call History Key", Kinds: KIND_SEL},
+			{Page: 0x000B, ID: 0x00C1, Name: "Phone Caller ID Key", Kinds: KIND_SEL},
+			{Page: 0x000B, ID: 0x00C2, Name: "Phone Settings Key", Kinds: KIND_SEL},
+			{Page: 0x000B, ID: 0x00F0, Name: "Host Control", Kinds: KIND_OOC},
+			{Page: 0x000B, ID: 0x00F1, Name: "Host Available", Kinds: KIND_OOC},
+			{Page: 0x000B, ID: 0x00F2, Name: "Host Call Active", Kinds: KIND_OOC},
+			{Page: 0x000B, ID: 0x00F3, Name: "Activate Handset Audio", Kinds: KIND_OOC},
+			{Page: 0x000B, ID: 0x00F4, Name: "Ring Type", Kinds: KIND_NARY},
+			{Page: 0x000B, ID: 0x00F5, Name: "Re-dialable Phone Number", Kinds: KIND_OOC},
+			{Page: 0x000B, ID: 0x00F8, Name: "Stop Ring Tone", Kinds: KIND_SEL},
+			{Page: 0x000B, ID: 0x00F9, Name: "PSTN Ring Tone", Kinds: KIND_SEL},
+			{Page: 0x000B, ID: 0x00FA, Name: "Host Ring Tone", Kinds: KIND_SEL},
+			{Page: 0x000B, ID: 0x00FB, Name: "Alert Sound Error", Kinds: KIND_SEL},
+			{Page: 0x000B, ID: 0x00FC, Name: "Alert Sound Confirm", Kinds: KIND_SEL},
+			{Page: 0x000B, ID: 0x00FD, Name: "Alert Sound Notification", Kinds: KIND_SEL},
+			{Page: 0x000B, ID: 0x00FE, Name: "Silent Ring", Kinds: KIND_SEL},
+			{Page: 0x000B, ID: 0x0108, Name: "Email Message Waiting", Kinds: KIND_OOC},
+			{Page: 0x000B, ID: 0x0109, Name: "Voicemail Message Waiting", Kinds: KIND_OOC},
+			{Page: 0x000B, ID: 0x010A, Name: "Host Hold", Kinds: KIND_OOC},
+			{Page: 0x000B, ID: 0x0110, Name: "Incoming Call History Count", Kinds: KIND_DV},
+			{Page: 0x000B, ID: 0x0111, Name: "Outgoing Call History Count", Kinds: KIND_DV},
+			{Page: 0x000B, ID: 0x0112, Name: "Incoming Call History", Kinds: KIND_CL},
+			{Page: 0x000B, ID: 0x0113, Name: "Outgoing Call History", Kinds: KIND_CL},
+			{Page: 0x000B, ID: 0x0114, Name: "Phone Locale", Kinds: KIND_DV},
+			{Page: 0x000B, ID: 0x0140, Name: "Phone Time Second", Kinds: KIND_DV},
+			{Page: 0x000B, ID: 0x0141, Name: "Phone Time Minute", Kinds: KIND_DV},
+			{Page: 0x000B, ID: 0x0142, Name: "Phone Time Hour", Kinds: KIND_DV},
+			{Page: 0x000B, ID: 0x0143, Name: "Phone Date Day", Kinds: KIND_DV},
+			{Page: 0x000B, ID: 0x0144, Name: "Phone Date Month", Kinds: KIND_DV},
+			{Page: 0x000B, ID: 0x0145, Name: "Phone Date Year", Kinds: KIND_DV},
+			{Page: 0x000B, ID: 0x0146, Name: "Handset Nickname", Kinds: KIND_DV},
+			{Page: 0x000B, ID: 0x0147, Name: "Address Book ID", Kinds: KIND_DV},
+			{Page: 0x000B, ID: 0x014A, Name: "Call Duration", Kinds: KIND_DV},
+			{Page: 0x000B, ID: 0x014B, Name: "Dual Mode Phone", Kinds: KIND_CA},
+		},
+	},
+	{
+		ID:   0x000C,
+		Name: "Consumer",
+		Usages: []Usage{
+			{Page: 0x000C, ID: 0x0001, Name: "Consumer Control", Kinds: KIND_CA},
+			{Page: 0x000C, ID: 0x0002, Name: "Numeric Key Pad", Kinds: KIND_NARY},
+			{Page: 0x000C, ID: 0x0003, Name: "Programmable Buttons", Kinds: KIND_NARY},
+			{Page: 0x000C, ID: 0x0004, Name: "Microphone", Kinds: KIND_CA},
+			{Page: 0x000C, ID: 0x0005, Name: "Headphone", Kinds: KIND_CA},
+			{Page: 0x000C, ID: 0x0006, Name: "Graphic Equalizer", Kinds: KIND_CA},
+			{Page: 0x000C, ID: 0x0020, Name: "+10", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x0021, Name: "+100", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x0022, Name: "AM/PM", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x0030, Name: "Power", Kinds: KIND_OOC},
+			{Page: 0x000C, ID: 0x0031, Name: "Reset", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x0032, Name: "Sleep", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x0033, Name: "Sleep After", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x0034, Name: "Sleep Mode", Kinds: KIND_RTC},
+			{Page: 0x000C, ID: 0x0035, Name: "Illumination", Kinds: KIND_OOC},
+			{Page: 0x000C, ID: 0x0036, Name: "Function Buttons", Kinds: KIND_NARY},
+			{Page: 0x000C, ID: 0x0040, Name: "Menu", Kinds: KIND_OOC},
+			{Page: 0x000C, ID: 0x0041, Name: "Menu Pick", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x0042, Name: "Menu Up", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x0043, Name: "Menu Down", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x0044, Name: "Menu Left", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x0045, Name: "Menu Right", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x0046, Name: "Menu Escape", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x0047, Name: "Menu Value Increase", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x0048, Name: "Menu Value Decrease", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x0060, Name: "Data On Screen", Kinds: KIND_OOC},
+			{Page: 0x000C, ID: 0x0061, Name: "Closed Caption", Kinds: KIND_OOC},
+			{Page: 0x000C, ID: 0x0062, Name: "Closed Caption Select", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x0063, Name: "VCR/TV", Kinds: KIND_OOC},
+			{Page: 0x000C, ID: 0x0064, Name: "Broadcast Mode", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x0065, Name: "Snapshot", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x0066, Name: "Still", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x0067, Name: "Picture-in-Picture Toggle", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x0068, Name: "Picture-in-Picture Swap", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x0069, Name: "Red Menu Button", Kinds: KIND_MC},
+			{Page: 0x000C, ID: 0x006A, Name: "Green Menu Button", Kinds: KIND_MC},
+			{Page: 0x000C, ID: 0x006B, Name: "Blue Menu Button", Kinds: KIND_MC},
+			{Page: 0x000C, ID: 0x006C, Name: "Yellow Menu Button", Kinds: KIND_MC},
+			{Page: 0x000C, ID: 0x006D, Name: "Aspect", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x006E, Name: "3D Mode Select", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x006F, Name: "Display Brightness Increment", Kinds: KIND_RTC},
+			{Page: 0x000C, ID: 0x0070, Name: "Display Brightness Decrement", Kinds: KIND_RTC},
+			{Page: 0x000C, ID: 0x0071, Name: "Display Brightness", Kinds: KIND_LC},
+			{Page: 0x000C, ID: 0x0072, Name: "Display Backlight Toggle", Kinds: KIND_OOC},
+			{Page: 0x000C, ID: 0x0073, Name: "Display Set Brightness to Minimum", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x0074, Name: "Display Set Brightness to Maximum", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x0075, Name: "Display Set Auto Brightness", Kinds: KIND_OOC},
+			{Page: 0x000C, ID: 0x0076, Name: "Camera Access Enabled", Kinds: KIND_OOC},
+			{Page: 0x000C, ID: 0x0077, Name: "Camera Access Disabled", Kinds: KIND_OOC},
+			{Page: 0x000C, ID: 0x0078, Name: "Camera Access Toggle", Kinds: KIND_OOC},
+			{Page: 0x000C, ID: 0x0079, Name: "Keyboard Brightness Increment", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x007A, Name: "Keyboard Brightness Decrement", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x007B, Name: "Keyboard Backlight Set Level", Kinds: KIND_LC},
+			{Page: 0x000C, ID: 0x007C, Name: "Keyboard Backlight OOC", Kinds: KIND_OOC},
+			{Page: 0x000C, ID: 0x007D, Name: "Keyboard Backlight Set Minimum", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x007E, Name: "Keyboard Backlight Set Maximum", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x007F, Name: "Keyboard Backlight Auto", Kinds: KIND_OOC},
+			{Page: 0x000C, ID: 0x0080, Name: "Selection", Kinds: KIND_NARY},
+			{Page: 0x000C, ID: 0x0081, Name: "Assign Selection", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x0082, Name: "Mode Step", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x0083, Name: "Recall Last", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x0084, Name: "Enter Channel", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x0085, Name: "Order Movie", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x0086, Name: "Channel", Kinds: KIND_LC},
+			{Page: 0x000C, ID: 0x0087, Name: "Media Selection", Kinds: KIND_NARY},
+			{Page: 0x000C, ID: 0x0088, Name: "Media Select Computer", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0089, Name: "Media Select TV", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x008A, Name: "Media Select WWW", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x008B, Name: "Media Select DVD", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x008C, Name: "Media Select Telephone", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x008D, Name: "Media Select Program Guide", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x008E, Name: "Media Select Video Phone", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x008F, Name: "Media Select Games", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0090, Name: "Media Select Messages", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0091, Name: "Media Select CD", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0092, Name: "Media Select VCR", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0093, Name: "Media Select Tuner", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0094, Name: "Quit", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x0095, Name: "Help", Kinds: KIND_OOC},
+			{Page: 0x000C, ID: 0x0096, Name: "Media Select Tape", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0097, Name: "Media Select Cable", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0098, Name: "Media Select Satellite", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0099, Name: "Media Select Security", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x009A, Name: "Media Select Home", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x009B, Name: "Media Select Call", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x009C, Name: "Channel Increment", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x009D, Name: "Channel Decrement", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x009E, Name: "Media Select SAP", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x00A0, Name: "VCR Plus", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x00A1, Name: "Once", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x00A2, Name: "Daily", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x00A3, Name: "Weekly", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x00A4, Name: "Monthly", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x00B0, Name: "Play", Kinds: KIND_OOC},
+			{Page: 0x000C, ID: 0x00B1, Name: "Pause", Kinds: KIND_OOC},
+			{Page: 0x000C, ID: 0x00B2, Name: "Record", Kinds: KIND_OOC},
+			{Page: 0x000C, ID: 0x00B3, Name: "Fast Forward", Kinds: KIND_OOC},
+			{Page: 0x000C, ID: 0x00B4, Name: "Rewind", Kinds: KIND_OOC},
+			{Page: 0x000C, ID: 0x00B5, Name: "Scan Next Track", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x00B6, Name: "Scan Previous Track", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x00B7, Name: "Stop", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x00B8, Name: "Eject", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x00B9, Name: "Random Play", Kinds: KIND_OOC},
+			{Page: 0x000C, ID: 0x00BA, Name: "Select Disc", Kinds: KIND_NARY},
+			{Page: 0x000C, ID: 0x00BB, Name: "Enter Disc", Kinds: KIND_MC},
+			{Page: 0x000C, ID: 0x00BC, Name: "Repeat", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x00BD, Name: "Tracking", Kinds: KIND_LC},
+			{Page: 0x000C, ID: 0x00BE, Name: "Track Normal", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x00BF, Name: "Slow Tracking", Kinds: KIND_LC},
+			{Page: 0x000C, ID: 0x00C0, Name: "Frame Forward", Kinds: KIND_RTC},
+			{Page: 0x000C, ID: 0x00C1, Name: "Frame Back", Kinds: KIND_RTC},
+			{Page: 0x000C, ID: 0x00C2, Name: "Mark", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x00C3, Name: "Clear Mark", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x00C4, Name: "Repeat From Mark", Kinds: KIND_OOC},
+			{Page: 0x000C, ID: 0x00C5, Name: "Return To Mark", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x00C6, Name: "Search Mark Forward", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x00C7, Name: "Search Mark Backwards", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x00C8, Name: "Counter Reset", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x00C9, Name: "Show Counter", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x00CA, Name: "Tracking Increment", Kinds: KIND_RTC},
+			{Page: 0x000C, ID: 0x00CB, Name: "Tracking Decrement", Kinds: KIND_RTC},
+			{Page: 0x000C, ID: 0x00CC, Name: "Stop/Eject", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x00CD, Name: "Play/Pause", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x00CE, Name: "Play/Skip", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x00CF, Name: "Voice Command", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x00D0, Name: "Invoke Capture Interface", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x00D1, Name: "Start or Stop Game Recording", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x00D2, Name: "Historical Game Capture", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x00D3, Name: "Capture Game Screenshot", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x00D4, Name: "Show or Hide Recording Indicator", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x00D5, Name: "Start or Stop Microphone Capture", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x00D6, Name: "Start or Stop Camera Capture", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x00D7, Name: "Start or Stop Game Broadcast", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x00D8, Name: "Start or Stop Voice Dictation Session", Kinds: KIND_OOC},
+			{Page: 0x000C, ID: 0x00D9, Name: "Invoke/Dismiss Emoji Picker", Kinds: KIND_OOC},
+			{Page: 0x000C, ID: 0x00E0, Name: "Volume", Kinds: KIND_LC},
+			{Page: 0x000C, ID: 0x00E1, Name: "Balance", Kinds: KIND_LC},
+			{Page: 0x000C, ID: 0x00E2, Name: "Mute", Kinds: KIND_OOC},
+			{Page: 0x000C, ID: 0x00E3, Name: "Bass", Kinds: KIND_LC},
+			{Page: 0x000C, ID: 0x00E4, Name: "Treble", Kinds: KIND_LC},
+			{Page: 0x000C, ID: 0x00E5, Name: "Bass Boost", Kinds: KIND_OOC},
+			{Page: 0x000C, ID: 0x00E6, Name: "Surround Mode", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x00E7, Name: "Loudness", Kinds: KIND_OOC},
+			{Page: 0x000C, ID: 0x00E8, Name: "MPX", Kinds: KIND_OOC},
+			{Page: 0x000C, ID: 0x00E9, Name: "Volume Increment", Kinds: KIND_RTC},
+			{Page: 0x000C, ID: 0x00EA, Name: "Volume Decrement", Kinds: KIND_RTC},
+			{Page: 0x000C, ID: 0x00F0, Name: "Speed Select", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x00F1, Name: "Playback Speed", Kinds: KIND_NARY},
+			{Page: 0x000C, ID: 0x00F2, Name: "Standard Play", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x00F3, Name: "Long Play", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x00F4, Name: "Extended Play", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x00F5, Name: "Slow", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x0100, Name: "Fan Enable", Kinds: KIND_OOC},
+			{Page: 0x000C, ID: 0x0101, Name: "Fan Speed", Kinds: KIND_LC},
+			{Page: 0x000C, ID: 0x0102, Name: "Light Enable", Kinds: KIND_OOC},
+			{Page: 0x000C, ID: 0x0103, Name: "Light Illumination Level", Kinds: KIND_LC},
+			{Page: 0x000C, ID: 0x0104, Name: "Climate Control Enable", Kinds: KIND_OOC},
+			{Page: 0x000C, ID: 0x0105, Name: "Room Temperature", Kinds: KIND_LC},
+			{Page: 0x000C, ID: 0x0106, Name: "Security Enable", Kinds: KIND_OOC},
+			{Page: 0x000C, ID: 0x0107, Name: "Fire Alarm", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x0108, Name: "Police Alarm", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x0109, Name: "Proximity", Kinds: KIND_LC},
+			{Page: 0x000C, ID: 0x010A, Name: "Motion", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x010B, Name: "Duress Alarm", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x010C, Name: "Holdup Alarm", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x010D, Name: "Medical Alarm", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x0150, Name: "Balance Right", Kinds: KIND_RTC},
+			{Page: 0x000C, ID: 0x0151, Name: "Balance Left", Kinds: KIND_RTC},
+			{Page: 0x000C, ID: 0x0152, Name: "Bass Increment", Kinds: KIND_RTC},
+			{Page: 0x000C, ID: 0x0153, Name: "Bass Decrement", Kinds: KIND_RTC},
+			{Page: 0x000C, ID: 0x0154, Name: "Treble Increment", Kinds: KIND_RTC},
+			{Page: 0x000C, ID: 0x0155, Name: "Treble Decrement", Kinds: KIND_RTC},
+			{Page: 0x000C, ID: 0x0160, Name: "Speaker System", Kinds: KIND_CL},
+			{Page: 0x000C, ID: 0x0161, Name: "Channel Left", Kinds: KIND_CL},
+			{Page: 0x000C, ID: 0x0162, Name: "Channel Right", Kinds: KIND_CL},
+			{Page: 0x000C, ID: 0x0163, Name: "Channel Center", Kinds: KIND_CL},
+			{Page: 0x000C, ID: 0x0164, Name: "Channel Front", Kinds: KIND_CL},
+			{Page: 0x000C, ID: 0x0165, Name: "Channel Center Front", Kinds: KIND_CL},
+			{Page: 0x000C, ID: 0x0166, Name: "Channel Side", Kinds: KIND_CL},
+			{Page: 0x000C, ID: 0x0167, Name: "Channel Surround", Kinds: KIND_CL},
+			{Page: 0x000C, ID: 0x0168, Name: "Channel Low Frequency Enhancement", Kinds: KIND_CL},
+			{Page: 0x000C, ID: 0x0169, Name: "Channel Top", Kinds: KIND_CL},
+			{Page: 0x000C, ID: 0x016A, Name: "Channel Unknown", Kinds: KIND_CL},
+			{Page: 0x000C, ID: 0x0170, Name: "Sub-channel", Kinds: KIND_LC},
+			{Page: 0x000C, ID: 0x0171, Name: "Sub-channel Increment", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x0172, Name: "Sub-channel Decrement", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x0173, Name: "Alternate Audio Increment", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x0174, Name: "Alternate Audio Decrement", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x0180, Name: "Application Launch Buttons", Kinds: KIND_NARY},
+			{Page: 0x000C, ID: 0x0181, Name: "AL Launch Button Configuration Tool", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0182, Name: "AL Programmable Button Configuration", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0183, Name: "AL Consumer Control Configuration", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0184, Name: "AL Word Processor", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0185, Name: "AL Text Editor", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0186, Name: "AL Spreadsheet", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0187, Name: "AL Graphics Editor", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0188, Name: "AL Presentation App", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0189, Name: "AL Database App", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x018A, Name: "AL Email Reader", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x018B, Name: "AL Newsreader", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x018C, Name: "AL Voicemail", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x018D, Name: "AL Contacts/Address Book", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x018E, Name: "AL Calendar/Schedule", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x018F, Name: "AL Task/Project Manager", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0190, Name: "AL Log/Journal/Timecard", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0191, Name: "AL Checkbook/Finance", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0192, Name: "AL Calculator", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0193, Name: "AL A/V Capture/Playback", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0194, Name: "AL Local Machine Browser", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0195, Name: "AL LAN/WAN Browser", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0196, Name: "AL Internet Browser", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0197, Name: "AL Remote Networking/ISP Connect", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0198, Name: "AL Network Conference", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0199, Name: "AL Network Chat", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x019A, Name: "AL Telephony/Dialer", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x019B, Name: "AL Logon", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x019C, Name: "AL Logoff", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x019D, Name: "AL Logon/Logoff", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x019E, Name: "AL Terminal Lock/Screensaver", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x019F, Name: "AL Control Panel", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01A0, Name: "AL Command Line Processor/Run", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01A1, Name: "AL Process/Task Manager", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01A2, Name: "AL Select Task/Application", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01A3, Name: "AL Next Task/Application", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01A4, Name: "AL Previous Task/Application", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01A5, Name: "AL Preemptive Halt Task/Application", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01A6, Name: "AL Integrated Help Center", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01A7, Name: "AL Documents", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01A8, Name: "AL Thesaurus", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01A9, Name: "AL Dictionary", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01AA, Name: "AL Desktop", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01AB, Name: "AL Spell Check", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01AC, Name: "AL Grammar Check", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01AD, Name: "AL Wireless Status", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01AE, Name: "AL Keyboard Layout", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01AF, Name: "AL Virus Protection", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01B0, Name: "AL Encryption", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01B1, Name: "AL Screen Saver", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01B2, Name: "AL Alarms", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01B3, Name: "AL Clock", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01B4, Name: "AL File Browser", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01B5, Name: "AL Power Status", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01B6, Name: "AL Image Browser", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01B7, Name: "AL Audio Browser", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01B8, Name: "AL Movie Browser", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01B9, Name: "AL Digital Rights Manager", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01BA, Name: "AL Digital Wallet", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01BC, Name: "AL Instant Messaging", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01BD, Name: "AL OEM Features/ Tips/Tutorial Browser", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01BE, Name: "AL OEM Help", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01BF, Name: "AL Online Community", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01C0, Name: "AL Entertainment Content Browser", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01C1, Name: "AL Online Shopping Browser", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01C2, Name: "AL SmartCard Information/Help", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01C3, Name: "AL Market Monitor/Finance Browser", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01C4, Name: "AL Customized Corporate News Browser", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01C5, Name: "AL Online Activity Browser", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01C6, Name: "AL Research/Search Browser", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01C7, Name: "AL Audio Player", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01C8, Name: "AL Message Status", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01C9, Name: "AL Contact Sync", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01CA, Name: "AL Navigation", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x01CB, Name: "AL Context‐aware Desktop Assistant", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0200, Name: "Generic GUI Application Controls", Kinds: KIND_NARY},
+			{Page: 0x000C, ID: 0x0201, Name: "AC New", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0202, Name: "AC Open", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0203, Name: "AC Close", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0204, Name: "AC Exit", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0205, Name: "AC Maximize", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0206, Name: "AC Minimize", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0207, Name: "AC Save", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0208, Name: "AC Print", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0209, Name: "AC Properties", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x021A, Name: "AC Undo", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x021B, Name: "AC Copy", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x021C, Name: "AC Cut", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x021D, Name: "AC Paste", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x021E, Name: "AC Select All", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x021F, Name: "AC Find", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0220, Name: "AC Find and Replace", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0221, Name: "AC Search", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0222, Name: "AC Go To", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0223, Name: "AC Home", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0224, Name: "AC Back", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0225, Name: "AC Forward", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0226, Name: "AC Stop", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0227, Name: "AC Refresh", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0228, Name: "AC Previous Link", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0229, Name: "AC Next Link", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x022A, Name: "AC Bookmarks", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x022B, Name: "AC History", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x022C, Name: "AC Subscriptions", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x022D, Name: "AC Zoom In", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x022E, Name: "AC Zoom Out", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x022F, Name: "AC Zoom", Kinds: KIND_LC},
+			{Page: 0x000C, ID: 0x0230, Name: "AC Full Screen View", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0231, Name: "AC Normal View", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0232, Name: "AC View Toggle", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0233, Name: "AC Scroll Up", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0234, Name: "AC Scroll Down", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0235, Name: "AC Scroll", Kinds: KIND_LC},
+			{Page: 0x000C, ID: 0x0236, Name: "AC Pan Left", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0237, Name: "AC Pan Right", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0238, Name: "AC Pan", Kinds: KIND_LC},
+			{Page: 0x000C, ID: 0x0239, Name: "AC New Window", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x023A, Name: "AC Tile Horizontally", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x023B, Name: "AC Tile Vertically", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x023C, Name: "AC Format", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x023D, Name: "AC Edit", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x023E, Name: "AC Bold", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x023F, Name: "AC Italics", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0240, Name: "AC Underline", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0241, Name: "AC Strikethrough", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0242, Name: "AC Subscript", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0243, Name: "AC Superscript", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0244, Name: "AC All Caps", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0245, Name: "AC Rotate", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0246, Name: "AC Resize", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0247, Name: "AC Flip Horizontal", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0248, Name: "AC Flip Vertical", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0249, Name: "AC Mirror Horizontal", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x024A, Name: "AC Mirror Vertical", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x024B, Name: "AC Font Select", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x024C, Name: "AC Font Color", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x024D, Name: "AC Font Size", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x024E, Name: "AC Justify Left", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x024F, Name: "AC Justify Center H", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0250, Name: "AC Justify Right", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0251, Name: "AC Justify Block H", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0252, Name: "AC Justify Top", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0253, Name: "AC Justify Center V", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0254, Name: "AC Justify Bottom", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0255, Name: "AC Justify Block V", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0256, Name: "AC Indent Decrease", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0257, Name: "AC Indent Increase", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0258, Name: "AC Numbered List", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0259, Name: "AC Restart Numbering", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x025A, Name: "AC Bulleted List", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x025B, Name: "AC Promote", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x025C, Name: "AC Demote", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x025D, Name: "AC Yes", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x025E, Name: "AC No", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x025F, Name: "AC Cancel", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0260, Name: "AC Catalog", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0261, Name: "AC Buy/Checkout", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0262, Name: "AC Add to Cart", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0263, Name: "AC Expand", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0264, Name: "AC Expand All", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0265, Name: "AC Collapse", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0266, Name: "AC Collapse All", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0267, Name: "AC Print Preview", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0268, Name: "AC Paste Special", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0269, Name: "AC Insert Mode", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x026A, Name: "AC Delete", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x026B, Name: "AC Lock", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x026C, Name: "AC Unlock", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x026D, Name: "AC Protect", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x026E, Name: "AC Unprotect", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x026F, Name: "AC Attach Comment", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0270, Name: "AC Delete Comment", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0271, Name: "AC View Comment", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0272, Name: "AC Select Word", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0273, Name: "AC Select Sentence", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0274, Name: "AC Select Paragraph", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0275, Name: "AC Select Column", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0276, Name: "AC Select Row", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0277, Name: "AC Select Table", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0278, Name: "AC Select Object", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0279, Name: "AC Redo/Repeat", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x027A, Name: "AC Sort", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x027B, Name: "AC Sort Ascending", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x027C, Name: "AC Sort Descending", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x027D, Name: "AC Filter", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x027E, Name: "AC Set Clock", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x027F, Name: "AC View Clock", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0280, Name: "AC Select Time Zone", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0281, Name: "AC Edit Time Zones", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0282, Name: "AC Set Alarm", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0283, Name: "AC Clear Alarm", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0284, Name: "AC Snooze Alarm", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0285, Name: "AC Reset Alarm", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0286, Name: "AC Synchronize", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0287, Name: "AC Send/Receive", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0288, Name: "AC Send To", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0289, Name: "AC Reply", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x028A, Name: "AC Reply All", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x028B, Name: "AC Forward Msg", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x028C, Name: "AC Send", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x028D, Name: "AC Attach File", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x028E, Name: "AC Upload", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x028F, Name: "AC Download (Save Target As)", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0290, Name: "AC Set Borders", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0291, Name: "AC Insert Row", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0292, Name: "AC Insert Column", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0293, Name: "AC Insert File", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0294, Name: "AC Insert Picture", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0295, Name: "AC Insert Object", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0296, Name: "AC Insert Symbol", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0297, Name: "AC Save and Close", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0298, Name: "AC Rename", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x0299, Name: "AC Merge", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x029A, Name: "AC Split", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x029B, Name: "AC Disribute Horizontally", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x029C, Name: "AC Distribute Vertically", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x029D, Name: "AC Next Keyboard Layout Select", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x029E, Name: "AC Navigation Guidance", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x029F, Name: "AC Desktop Show All Windows", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x02A0, Name: "AC Soft Key Left", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x02A1, Name: "AC Soft Key Right", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x02A2, Name: "AC Desktop Show All Applications", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x02B0, Name: "AC Idle Keep Alive", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x02C0, Name: "Extended Keyboard Attributes Collection", Kinds: KIND_CL},
+			{Page: 0x000C, ID: 0x02C1, Name: "Keyboard Form Factor", Kinds: KIND_SV},
+			{Page: 0x000C, ID: 0x02C2, Name: "Keyboard Key Type", Kinds: KIND_SV},
+			{Page: 0x000C, ID: 0x02C3, Name: "Keyboard Physical Layout", Kinds: KIND_SV},
+			{Page: 0x000C, ID: 0x02C4, Name: "Vendor‐Specific Keyboard Physical Layout", Kinds: KIND_SV},
+			{Page: 0x000C, ID: 0x02C5, Name: "Keyboard IETF Language Tag Index", Kinds: KIND_SV},
+			{Page: 0x000C, ID: 0x02C6, Name: "Implemented Keyboard Input Assist Controls", Kinds: KIND_SV},
+			{Page: 0x000C, ID: 0x02C7, Name: "Keyboard Input Assist Previous", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x02C8, Name: "Keyboard Input Assist Next", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x02C9, Name: "Keyboard Input Assist Previous Group", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x02CA, Name: "Keyboard Input Assist Next Group", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x02CB, Name: "Keyboard Input Assist Accept", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x02CC, Name: "Keyboard Input Assist Cancel", Kinds: KIND_SEL},
+			{Page: 0x000C, ID: 0x02D0, Name: "Privacy Screen Toggle", Kinds: KIND_OOC},
+			{Page: 0x000C, ID: 0x02D1, Name: "Privacy Screen Level Decrement", Kinds: KIND_RTC},
+			{Page: 0x000C, ID: 0x02D2, Name: "Privacy Screen Level Increment", Kinds: KIND_RTC},
+			{Page: 0x000C, ID: 0x02D3, Name: "Privacy Screen Level Minimum", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x02D4, Name: "Privacy Screen Level Maximum", Kinds: KIND_OSC},
+			{Page: 0x000C, ID: 0x0500, Name: "Contact Edited", Kinds: KIND_OOC},
+			{Page: 0x000C, ID: 0x0501, Name: "Contact Added", Kinds: KIND_OOC},
+			{Page: 0x000C, ID: 0x0502, Name: "Contact Record Active", Kinds: KIND_OOC},
+			{Page: 0x000C, ID: 0x0503, Name: "Contact Index", Kinds: KIND_DV},
+			{Page: 0x000C, ID: 0x0504, Name: "Contact Nickname", Kinds: KIND_DV},
+			{Page: 0x000C, ID: 0x0505, Name: "Contact First Name", Kinds: KIND_DV},
+			{Page: 0x000C, ID: 0x0506, Name: "Contact Last Name", Kinds: KIND_DV},
+			{Page: 0x000C, ID: 0x0507, Name: "Contact Full Name", Kinds: KIND_DV},
+			{Page: 0x000C, ID: 0x0508, Name: "Contact Phone Number Personal", Kinds: KIND_DV},
+			{Page: 0x000C, ID: 0x0509, Name: "Contact Phone Number Business", Kinds: KIND_DV},
+			{Page: 0x000C, ID: 0x050A, Name: "Contact Phone Number Mobile", Kinds: KIND_DV},
+			{Page: 0x000C, ID: 0x050B, Name: "Contact Phone Number Pager", Kinds: KIND_DV},
+			{Page: 0x000C, ID: 0x050C, Name: "Contact Phone Number Fax", Kinds: KIND_DV},
+			{Page: 0x000C, ID: 0x050D, Name: "Contact Phone Number Other", Kinds: KIND_DV},
+			{Page: 0x000C, ID: 0x050E, Name: "Contact Email Personal", Kinds: KIND_DV},
+			{Page: 0x000C, ID: 0x050F, Name: "Contact Email Business", Kinds: KIND_DV},
+			{Page: 0x000C, ID: 0x0510, Name: "Contact Email Other", Kinds: KIND_DV},
+			{Page: 0x000C, ID: 0x0511, Name: "Contact Email Main", Kinds: KIND_DV},
+			{Page: 0x000C, ID: 0x0512, Name: "Contact Speed Dial Number", Kinds: KIND_DV},
+			{Page: 0x000C, ID: 0x0513, Name: "Contact Status Flag", Kinds: KIND_DV},
+			{Page: 0x000C, ID: 0x0514, Name: "Contact Misc.", Kinds: KIND_DV},
+		},
+	},
+	{
+		ID:   0x000D,
+		Name: "Digitizers",
+		Usages: []Usage{
+			{Page: 0x000D, ID: 0x0001, Name: "Digitizer", Kinds: KIND_CA},
+			{Page: 0x000D, ID: 0x0002, Name: "Pen", Kinds: KIND_CA},
+			{Page: 0x000D, ID: 0x0003, Name: "Light Pen", Kinds: KIND_CA},
+			{Page: 0x000D, ID: 0x0004, Name: "Touch Screen", Kinds: KIND_CA},
+			{Page: 0x000D, ID: 0x0005, Name: "Touch Pad", Kinds: KIND_CA},
+			{Page: 0x000D, ID: 0x0006, Name: "Whiteboard", Kinds: KIND_CA},
+			{Page: 0x000D, ID: 0x0007, Name: "Coordinate Measuring Machine", Kinds: KIND_CA},
+			{Page: 0x000D, ID: 0x0008, Name: "3D Digitizer", Kinds: KIND_CA},
+			{Page: 0x000D, ID: 0x0009, Name: "Stereo Plotter", Kinds: KIND_CA},
+			{Page: 0x000D, ID: 0x000A, Name: "Articulated Arm", Kinds: KIND_CA},
+			{Page: 0x000D, ID: 0x000B, Name: "Armature", Kinds: KIND_CA},
+			{Page: 0x000D, ID: 0x000C, Name: "Multiple Point Digitizer", Kinds: KIND_CA},
+			{Page: 0x000D, ID: 0x000D, Name: "Free Space Wand", Kinds: KIND_CA},
+			{Page: 0x000D, ID: 0x000E, Name: "Device Configuration", Kinds: KIND_CA},
+			{Page: 0x000D, ID: 0x000F, Name: "Capacitive Heat Map Digitizer", Kinds: KIND_CA},
+			{Page: 0x000D, ID: 0x0020, Name: "Stylus", Kinds: KIND_CA | KIND_CL},
+			{Page: 0x000D, ID: 0x0021, Name: "Puck", Kinds: KIND_CL},
+			{Page: 0x000D, ID: 0x0022, Name: "Finger", Kinds: KIND_CL},
+			{Page: 0x000D, ID: 0x0023, Name: "Device settings", Kinds: KIND_CL},
+			{Page: 0x000D, ID: 0x0024, Name: "Character Gesture", Kinds: KIND_CL},
+			{Page: 0x000D, ID: 0x0030, Name: "Tip Pressure", Kinds: KIND_DV},
+			{Page: 0x000D, ID: 0x0031, Name: "Barrel Pressure", Kinds: KIND_DV},
+			{Page: 0x000D, ID: 0x0032, Name: "In Range", Kinds: KIND_MC},
+			{Page: 0x000D, ID: 0x0033, Name: "Touch", Kinds: KIND_MC},
+			{Page: 0x000D, ID: 0x0034, Name: "Untouch", Kinds: KIND_OSC},
+			{Page: 0x000D, ID: 0x0035, Name: "Tap", Kinds: KIND_OSC},
+			{Page: 0x000D, ID: 0x0036, Name: "Quality", Kinds: KIND_DV},
+			{Page: 0x000D, ID: 0x0037, Name: "Data Valid", Kinds: KIND_MC},
+			{Page: 0x000D, ID: 0x0038, Name: "Transducer Index", Kinds: KIND_DV},
+			{Page: 0x000D, ID: 0x0039, Name: "Tablet Function Keys", Kinds: KIND_CL},
+			{Page: 0x000D, ID: 0x003A, Name: "Program Change Keys", Kinds: KIND_CL},
+			{Page: 0x000D, ID: 0x003B, Name: "Battery Strength", Kinds: KIND_DV},
+			{Page: 0x000D, ID: 0x003C, Name: "Invert", Kinds: KIND_MC},
+			{Page: 0x000D, ID: 0x003D, Name: "X Tilt", Kinds: KIND_DV},
+			{Page: 0x000D, ID: 0x003E, Name: "Y Tilt", Kinds: KIND_DV},
+			{Page: 0x000D, ID: 0x003F, Name: "Azimuth", Kinds: KIND_DV},
+			{Page: 0x000D, ID: 0x0040, Name: "Altitude", Kinds: KIND_DV},
+			{Page: 0x000D, ID: 0x0041, Name: "Twist", Kinds: KIND_DV},
+			{Page: 0x000D, ID: 0x0042, Name: "Tip Switch", Kinds: KIND_MC},
+			{Page: 0x000D, ID: 0x0043, Name: "Secondary Tip Switch", Kinds: KIND_MC},
+			{Page: 0x000D, ID: 0x0044, Name: "Barrel Switch", Kinds: KIND_MC},
+			{Page: 0x000D, ID: 0x0045, Name: "Eraser", Kinds: KIND_MC},
+			{Page: 0x000D, ID: 0x0046, Name: "Tablet Pick", Kinds: KIND_MC},
+			{Page: 0x000D, ID: 0x0047, Name: "Touch Valid", Kinds: KIND_MC},
+			{Page: 0x000D, ID: 0x0048, Name: "Width", Kinds: KIND_DV},
+			{Page: 0x000D, ID: 0x0049, Name: "Height", Kinds: KIND_DV},
+			{Page: 0x000D, ID: 0x0051, Name: "Contact Identifier", Kinds: KIND_DV},
+			{Page: 0x000D, ID: 0x0052, Name: "Device Mode", Kinds: KIND_DV},
+			{Page: 0x000D, ID: 0x0053, Name: "Device Identifier", Kinds: KIND_DV | KIND_SV},
+			{Page: 0x000D, ID: 0x0054, Name: "Contact Count", Kinds: KIND_DV},
+			{Page: 0x000D, ID: 0x0055, Name: "Contact Count Maximum", Kinds: KIND_SV},
+			{Page: 0x000D, ID: 0x0056, Name: "Scan Time", Kinds: KIND_DV},
+			{Page: 0x000D, ID: 0x0057, Name: "Surface Switch", Kinds: KIND_DF},
+			{Page: 0x000D, ID: 0x0058, Name: "Button Switch", Kinds: KIND_DF},
+			{Page: 0x000D, ID: 0x0059, Name: "Pad Type", Kinds: KIND_SF},
+			{Page: 0x000D, ID: 0x005A, Name: "Secondary Barrel Switch", Kinds: KIND_MC},
+			{Page: 0x000D, ID: 0x005B, Name: "Transducer Serial Number", Kinds: KIND_SV},
+			{Page: 0x000D, ID: 0x005C, Name: "Preferred Color", Kinds: KIND_DV},
+			{Page: 0x000D, ID: 0x005D, Name: "Preferred Color is Locked", Kinds: KIND_MC},
+			{Page: 0x000D, ID: 0x005E, Name: "Preferred Line Width", Kinds: KIND_DV},
+			{Page: 0x000D, ID: 0x005F, Name: "Preferred Line Width is Locked", Kinds: KIND_MC},
+			{Page: 0x000D, ID: 0x0060, Name: "Latency Mode", Kinds: KIND_DF},
+			{Page: 0x000D, ID: 0x0061, Name: "Gesture Character Quality", Kinds: KIND_DV},
+			{Page: 0x000D, ID: 0x0062, Name: "Character Gesture Data Length", Kinds: KIND_DV},
+			{Page: 0x000D, ID: 0x0063, Name: "Character Gesture Data", Kinds: KIND_DV},
+			{Page: 0x000D, ID: 0x0064, Name: "Gesture Character Encoding", Kinds: KIND_NARY},
+			{Page: 0x000D, ID: 0x0065, Name: "UTF8 Character Gesture Encoding", Kinds: KIND_SEL},
+			{Page: 0x000D, ID: 0x0066, Name: "UTF16 Little Endian Character Gesture Encoding", Kinds: KIND_SEL},
+			{Page: 0x000D, ID: 0x0067, Name: "UTF16 Big Endian Character Gesture Encoding", Kinds: KIND_SEL},
+			{Page: 0x000D, ID: 0x0068, Name: "UTF32 Little Endian Character Gesture Encoding", Kinds: KIND_SEL},
+			{Page: 0x000D, ID: 0x0069, Name: "UTF32 Big Endian Character Gesture Encoding", Kinds: KIND_SEL},
+			{Page: 0x000D, ID: 0x006A, Name: "Capacitive Heat Map Protocol Vendor ID", Kinds: KIND_SV},
+			{Page: 0x000D, ID: 0x006B, Name: "Capacitive Heat Map Protocol Version", Kinds: KIND_SV},
+			{Page: 0x000D, ID: 0x006C, Name: "Capacitive Heat Map Frame Data", Kinds: KIND_DV},
+			{Page: 0x000D, ID: 0x006D, Name: "Gesture Character Enable", Kinds: KIND_DF},
+			{Page: 0x000D, ID: 0x006E, Name: "Transducer Serial Number Part 2", Kinds: KIND_SV},
+			{Page: 0x000D, ID: 0x006F, Name: "No Preferred Color", Kinds: KIND_DF},
+			{Page: 0x000D, ID: 0x0070, Name: "Preferred Line Style", Kinds: KIND_NARY},
+			{Page: 0x000D, ID: 0x0071, Name: "Preferred Line Style is Locked", Kinds: KIND_MC},
+			{Page: 0x000D, ID: 0x0072, Name: "Ink", Kinds: KIND_SEL},
+			{Page: 0x000D, ID: 0x0073, Name: "Pencil", Kinds: KIND_SEL},
+			{Page: 0x000D, ID: 0x0074, Name: "Highlighter", Kinds: KIND_SEL},
+			{Page: 0x000D, ID: 0x0075, Name: "Chisel Marker", Kinds: KIND_SEL},
+			{Page: 0x000D, ID: 0x0076, Name: "Brush", Kinds: KIND_SEL},
+			{Page: 0x000D, ID: 0x0077, Name: "No Preference", Kinds: KIND_SEL},
+			{Page: 0x000D, ID: 0x0080, Name: "Digitizer Diagnostic", Kinds: KIND_CL},
+			{Page: 0x000D, ID: 0x0081, Name: "Digitizer Error", Kinds: KIND_NARY},
+			{Page: 0x000D, ID: 0x0082, Name: "Err Normal Status", Kinds: KIND_SEL},
+			{Page: 0x000D, ID: 0x0083, Name: "Err Transducers Exceeded", Kinds: KIND_SEL},
+			{Page: 0x000D, ID: 0x0084, Name: "Err Full Trans Features Unavailable", Kinds: KIND_SEL},
+			{Page: 0x000D, ID: 0x0085, Name: "Err Charge Low", Kinds: KIND_SEL},
+			{Page: 0x000D, ID: 0x0090, Name: "Transducer Software Info", Kinds: KIND_CL},
+			{Page: 0x000D, ID: 0x0091, Name: "Transducer Vendor Id", Kinds: KIND_SV},
+			{Page: 0x000D, ID: 0x0092, Name: "Transducer Product Id", Kinds: KIND_SV},
+			{Page: 0x000D, ID: 0x0093, Name: "Device Supported Protocols", Kinds: KIND_NARY | KIND_CL},
+			{Page: 0x000D, ID: 0x0094, Name: "Transducer Supported Protocols", Kinds: KIND_NARY | KIND_CL},
+			{Page: 0x000D, ID: 0x0095, Name: "No Protocol", Kinds: KIND_SEL},
+			{Page: 0x000D, ID: 0x0096, Name: "Wacom AES Protocol", Kinds: KIND_SEL},
+			{Page: 0x000D, ID: 0x0097, Name: "USI Protocol", Kinds: KIND_SEL},
+			{Page: 0x000D, ID: 0x0098, Name: "Microsoft Pen Protocol", Kinds: KIND_SEL},
+			{Page: 0x000D, ID: 0x00A0, Name: "Supported Report Rates", Kinds: KIND_SV | KIND_CL},
+			{Page: 0x000D, ID: 0x00A1, Name: "Report Rate", Kinds: KIND_DV},
+			{Page: 0x000D, ID: 0x00A2, Name: "Transducer Connected", Kinds: KIND_SF},
+			{Page: 0x000D, ID: 0x00A3, Name: "Switch Disabled", Kinds: KIND_SEL},
+			{Page: 0x000D, ID: 0x00A4, Name: "Switch Unimplemented", Kinds: KIND_SEL},
+			{Page: 0x000D, ID: 0x00A5, Name: "Transducer Switches", Kinds: KIND_CL},
+			{Page: 0x000D, ID: 0x00A6, Name: "Transducer Index Selector", Kinds: KIND_DV},
+			{Page: 0x000D, ID: 0x00B0, Name: "Button Press Threshold", Kinds: KIND_DV},
+		},
+	},
+	{
+		ID:   0x000E,
+		Name: "Haptics",
+		Usages: []Usage{
+			{Page: 0x000E, ID: 0x0001, Name: "Simple Haptic Controller", Kinds: KIND_CA | KIND_CL},
+			{Page: 0x000E, ID: 0x0010, Name: "Waveform List", Kinds: KIND_NARY},
+			{Page: 0x000E, ID: 0x0011, Name: "Duration List", Kinds: KIND_NARY},
+			{Page: 0x000E, ID: 0x0020, Name: "Auto Trigger", Kinds: KIND_DV},
+			{Page: 0x000E, ID: 0x0021, Name: "Manual Trigger", Kinds: KIND_DV},
+			{Page: 0x000E, ID: 0x0022, Name: "Auto Trigger Associated Control", Kinds: KIND_SV},
+			{Page: 0x000E, ID: 0x0023, Name: "Intensity", Kinds: KIND_DV},
+			{Page: 0x000E, ID: 0x0024, Name: "Repeat Count", Kinds: KIND_DV},
+			{Page: 0x000E, ID: 0x0025, Name: "Retrigger Period", Kinds: KIND_DV},
+			{Page: 0x000E, ID: 0x0026, Name: "Waveform Vendor Page", Kinds: KIND_SV},
+			{Page: 0x000E, ID: 0x0027, Name: "Waveform Vendor ID", Kinds: KIND_SV},
+			{Page: 0x000E, ID: 0x0028, Name: "Waveform Cutoff Time", Kinds: KIND_SV},
+			{Page: 0x000E, ID: 0x1001, Name: "Waveform None", Kinds: KIND_SV},
+			{Page: 0x000E, ID: 0x1002, Name: "Waveform Stop", Kinds: KIND_SV},
+			{Page: 0x000E, ID: 0x1003, Name: "Waveform Click", Kinds: KIND_SV},
+			{Page: 0x000E, ID: 0x1004, Name: "Waveform Buzz Continuous", Kinds: KIND_SV},
+			{Page: 0x000E, ID: 0x1005, Name: "Waveform Rumble Continuous", Kinds: KIND_SV},
+			{Page: 0x000E, ID: 0x1006, Name: "Waveform Press", Kinds: KIND_SV},
+			{Page: 0x000E, ID: 0x1007, Name: "Waveform Release", Kinds: KIND_SV},
+			{Page: 0x000E, ID: 0x1008, Name: "Waveform Hover", Kinds: KIND_SV},
+			{Page: 0x000E, ID: 0x1009, Name: "Waveform Success", Kinds: KIND_SV},
+			{Page: 0x000E, ID: 0x100A, Name: "Waveform Error", Kinds: KIND_SV},
+			{Page: 0x000E, ID: 0x100B, Name: "Waveform Ink Continuous", Kinds: KIND_SV},
+			{Page: 0x000E, ID: 0x100C, Name: "Waveform Pencil Continuous", Kinds: KIND_SV},
+			{Page: 0x000E, ID: 0x100D, Name: "Waveform Marker Continuous", Kinds: KIND_SV},
+			{Page: 0x000E, ID: 0x100E, Name: "Waveform Chisel Marker Continuous", Kinds: KIND_SV},
+			{Page: 0x000E, ID: 0x100F, Name: "Waveform Brush Continuous", Kinds: KIND_SV},
+			{Page: 0x000E, ID: 0x1010, Name: "Waveform Eraser Continuous", Kinds: KIND_SV},
+			{Page: 0x000E, ID: 0x1011, Name: "Waveform Sparkle Continuous", Kinds: KIND_SV},
+		},
+	},
+	{
+		ID:   0x000F,
+		Name: "Physical Input Device",
+		Usages: []Usage{
+			{Page: 0x000F, ID: 0x0001, Name: "Physical Input Device", Kinds: KIND_CA},
+			{Page: 0x000F, ID: 0x0020, Name: "Normal", Kinds: KIND_DV},
+			{Page: 0x000F, ID: 0x0021, Name: "Set Effect Report", Kinds: KIND_CL},
+			{Page: 0x000F, ID: 0x0022, Name: "Effect Parameter Block Index", Kinds: KIND_DV},
+			{Page: 0x000F, ID: 0x0023, Name: "Parameter Block Offset", Kinds: KIND_DV},
+			{Page: 0x000F, ID: 0x0024, Name: "ROM Flag", Kinds: KIND_DF},
+			{Page: 0x000F, ID: 0x0025, Name: "Effect Type", Kinds: KIND_NARY},
+			{Page: 0x000F, ID: 0x0026, Name: "ET Constant-Force", Kinds: KIND_SEL},
+			{Page: 0x000F, ID: 0x0027, Name: "ET Ramp", Kinds: KIND_SEL},
+			{Page: 0x000F, ID: 0x0028, Name: "ET Custom-Force", Kinds: KIND_SEL},
+			{Page: 0x000F, ID: 0x0030, Name: "ET Square", Kinds: KIND_SEL},
+			{Page: 0x000F, ID: 0x0031, Name: "ET Sine", Kinds: KIND_SEL},
+			{Page: 0x000F, ID: 0x0032, Name: "ET Triangle", Kinds: KIND_SEL},
+			{Page: 0x000F, ID: 0x0033, Name: "ET Sawtooth Up", Kinds: KIND_SEL},
+			{Page: 0x000F, ID: 0x0034, Name: "ET Sawtooth Down", Kinds: KIND_SEL},
+			{Page: 0x000F, ID: 0x0040, Name: "ET Spring", Kinds: KIND_SEL},
+			{Page: 0x000F, ID: 0x0041, Name: "ET Damper", Kinds: KIND_SEL},
+			{Page: 0x000F, ID: 0x0042, Name: "ET Inertia", Kinds: KIND_SEL},
+			{Page: 0x000F, ID: 0x0043, Name: "ET Friction", Kinds: KIND_SEL},
+			{Page: 0x000F, ID: 0x0050, Name: "Duration", Kinds: KIND_DV},
+			{Page: 0x000F, ID: 0x0051, Name: "Sample Period", Kinds: KIND_DV},
+			{Page: 0x000F, ID: 0x0052, Name: "Gain", Kinds: KIND_DV},
+			{Page: 0x000F, ID: 0x0053, Name: "Trigger Button", Kinds: KIND_DV},
+			{Page: 0x000F, ID: 0x0054, Name: "Trigger Repeat Interval", Kinds: KIND_DV},
+			{Page: 0x000F, ID: 0x0055, Name: "Axes Enable", Kinds: KIND_US},
+			{Page: 0x000F, ID: 0x0056, Name: "Direction Enable", Kinds: KIND_DF},
+			{Page: 0x000F, ID: 0x0057, Name: "Direction", Kinds: KIND_CL},
+			{Page: 0x000F, ID: 0x0058, Name: "Type Specific Block Offset", Kinds: KIND_CL},
+			{Page: 0x000F, ID: 0x0059, Name: "Block Type", Kinds: KIND_NARY},
+			{Page: 0x000F, ID: 0x005A, Name: "Set Envelope Report", Kinds: KIND_CL | KIND_SV},
+			{Page: 0x000F, ID: 0x005B, Name: "Attack Level", Kinds: KIND_DV},
+			{Page: 0x000F, ID: 0x005C, Name: "Attack Time", Kinds: KIND_DV},
+			{Page: 0x000F, ID: 0x005D, Name: "Fade Level", Kinds: KIND_DV},
+			{Page: 0x000F, ID: 0x005E, Name: "Fade Time", Kinds: KIND_DV},
+			{Page: 0x000F, ID: 0x005F, Name: "Set Condition Report", Kinds: KIND_CL | KIND_SV},
+			{Page: 0x000F, ID: 0x0060, Name: "Center-Point Offset", Kinds: KIND_DV},
+			{Page: 0x000F, ID: 0x0061, Name: "Positive Coefficient", Kinds: KIND_DV},
+			{Page: 0x000F, ID: 0x0062, Name: "Negative Coefficient", Kinds: KIND_DV},
+			{Page: 0x000F, ID: 0x0063, Name: "Positive Saturation", Kinds: KIND_DV},
+			{Page: 0x000F, ID: 0x0064, Name: "Negative Saturation", Kinds: KIND_DV},
+			{Page: 0x000F, ID: 0x0065, Name: "Dead Band", Kinds: KIND_DV},
+			{Page: 0x000F, ID: 0x0066, Name: "Download Force Sample", Kinds: KIND_CL},
+			{Page: 0x000F, ID: 0x0067, Name: "Isoch Custom-Force Enable", Kinds: KIND_DF},
+			{Page: 0x000F, ID: 0x0068, Name: "Custom-Force Data Report", Kinds: KIND_CL},
+			{Page: 0x000F, ID: 0x0069, Name: "Custom-Force Data", Kinds: KIND_DV},
+			{Page: 0x000F, ID: 0x006A, Name: "Custom-Force Vendor Defined Data", Kinds: KIND_DV},
+			{Page: 0x000F, ID: 0x006B, Name: "Set Custom-Force Report", Kinds: KIND_CL | KIND_SV},
+			{Page: 0x000F, ID: 0x006C, Name: "Custom-Force Data Offset", Kinds: KIND_DV},
+			{Page: 0x000F, ID: 0x006D, Name: "Sample Count", Kinds: KIND_DV},
+			{Page: 0x000F, ID: 0x006E, Name: "Set Periodic Report", Kinds: KIND_CL | KIND_SV},
+			{Page: 0x000F, ID: 0x006F, Name: "Offset", Kinds: KIND_DV},
+			{Page: 0x000F, ID: 0x0070, Name: "Magnitude", Kinds: KIND_DV},
+			{Page: 0x000F, ID: 0x0071, Name: "Phase", Kinds: KIND_DV},
+			{Page: 0x000F, ID: 0x0072, Name: "Period", Kinds: KIND_DV},
+			{Page: 0x000F, ID: 0x0073, Name: "Set Constant-Force Report", Kinds: KIND_CL | KIND_SV},
+			{Page: 0x000F, ID: 0x0074, Name: "Set Ramp-Force Report", Kinds: KIND_CL | KIND_SV},
+			{Page: 0x000F, ID: 0x0075, Name: "Ramp Start", Kinds: KIND_DV},
+			{Page: 0x000F, ID: 0x0076, Name: "Ramp End", Kinds: KIND_DV},
+			{Page: 0x000F, ID: 0x0077, Name: "Effect Operation Report", Kinds: KIND_CL},
+			{Page: 0x000F, ID: 0x0078, Name: "Effect Operation", Kinds: KIND_NARY},
+			{Page: 0x000F, ID: 0x0079, Name: "Op Effect Start", Kinds: KIND_SEL},
+			{Page: 0x000F, ID: 0x007A, Name: "Op Effect Start Solo", Kinds: KIND_SEL},
+			{Page: 0x000F, ID: 0x007B, Name: "Op Effect Stop", Kinds: KIND_SEL},
+			{Page: 0x000F, ID: 0x007C, Name: "Loop Count", Kinds: KIND_DV},
+			{Page: 0x000F, ID: 0x007D, Name: "Device Gain Report", Kinds: KIND_CL},
+			{Page: 0x000F, ID: 0x007E, Name: "Device Gain", Kinds: KIND_DV},
+			{Page: 0x000F, ID: 0x007F, Name: "Parameter Block Pools Report", Kinds: KIND_CL},
+			{Page: 0x000F, ID: 0x0080, Name: "RAM Pool Size", Kinds: KIND_DV},
+			{Page: 0x000F, ID: 0x0081, Name: "ROM Pool Size", Kinds: KIND_SV},
+			{Page: 0x000F, ID: 0x0082, Name: "ROM Effect Block Count", Kinds: KIND_SV},
+			{Page: 0x000F, ID: 0x0083, Name: "Simultaneous Effects Max", Kinds: KIND_SV},
+			{Page: 0x000F, ID: 0x0084, Name: "Pool Alignment", Kinds: KIND_SV},
+			{Page: 0x000F, ID: 0x0085, Name: "Parameter Block Move Report", Kinds: KIND_CL},
+			{Page: 0x000F, ID: 0x0086, Name: "Move Source", Kinds: KIND_DV},
+			{Page: 0x000F, ID: 0x0087, Name: "Move Destination", Kinds: KIND_DV},
+			{Page: 0x000F, ID: 0x0088, Name: "Move Length", Kinds: KIND_DV},
+			{Page: 0x000F, ID: 0x0089, Name: "Effect Parameter Block Load Report", Kinds: KIND_CL},
+			{Page: 0x000F, ID: 0x008B, Name: "Effect Parameter Block Load Status", Kinds: KIND_NARY},
+			{Page: 0x000F, ID: 0x008C, Name: "Block Load Success", Kinds: KIND_SEL},
+			{Page: 0x000F, ID: 0x008D, Name: "Block Load Full", Kinds: KIND_SEL},
+			{Page: 0x000F, ID: 0x008E, Name: "Block Load Error", Kinds: KIND_SEL},
+			{Page: 0x000F, ID: 0x008F, Name: "Block Handle", Kinds: KIND_DV},
+			{Page: 0x000F, ID: 0x0090, Name: "Effect Parameter Block Free Report", Kinds: KIND_CL},
+			{Page: 0x000F, ID: 0x0091, Name: "Type Specific Block Handle", Kinds: KIND_CL},
+			{Page: 0x000F, ID: 0x0092, Name: "PID State Report", Kinds: KIND_CL},
+			{Page: 0x000F, ID: 0x0094, Name: "Effect Playing", Kinds: KIND_DF},
+			{Page: 0x000F, ID: 0x0095, Name: "PID Device Control Report", Kinds: KIND_CL},
+			{Page: 0x000F, ID: 0x0096, Name: "PID Device Control", Kinds: KIND_NARY},
+			{Page: 0x000F, ID: 0x0097, Name: "DC Enable Actuators", Kinds: KIND_SEL},
+			{Page: 0x000F, ID: 0x0098, Name: "DC Disable Actuators", Kinds: KIND_SEL},
+			{Page: 0x000F, ID: 0x0099, Name: "DC Stop All Effects", Kinds: KIND_SEL},
+			{Page: 0x000F, ID: 0x009A, Name: "DC Reset", Kinds: KIND_SEL},
+			{Page: 0x000F, ID: 0x009B, Name: "DC Pause", Kinds: KIND_SEL},
+			{Page: 0x000F, ID: 0x009C, Name: "DC Continue", Kinds: KIND_SEL},
+			{Page: 0x000F, ID: 0x009F, Name: "Device Paused", Kinds: KIND_DF},
+			{Page: 0x000F, ID: 0x00A0, Name: "Actuators Enabled", Kinds: KIND_DF},
+			{Page: 0x000F, ID: 0x00A4, Name: "Safety Switch", Kinds: KIND_DF},
+			{Page: 0x000F, ID: 0x00A5, Name: "Actuator Override Switch", Kinds: KIND_DF},
+			{Page: 0x000F, ID: 0x00A6, Name: "Actuator Power", Kinds: KIND_OOC},
+			{Page: 0x000F, ID: 0x00A7, Name: "Start Delay", Kinds: KIND_DV},
+			{Page: 0x000F, ID: 0x00A8, Name: "Parameter Block Size", Kinds: KIND_CL},
+			{Page: 0x000F, ID: 0x00A9, Name: "Device-Managed Pool", Kinds: KIND_SF},
+			{Page: 0x000F, ID: 0x00AA, Name: "Shared Parameter Blocks", Kinds: KIND_SF},
+			{Page: 0x000F, ID: 0x00AB, Name: "Create New Effect Parameter Block Report", Kinds: KIND_CL},
+			{Page: 0x000F, ID: 0x00AC, Name: "RAM Pool Available", Kinds: KIND_DV},
+		},
+	},
+	{
+		ID:   0x0011,
+		Name: "SoC",
+		Usages: []Usage{
+			{Page: 0x0011, ID: 0x0001, Name: "SocControl", Kinds: KIND_CA},
+			{Page: 0x0011, ID: 0x0002, Name: "FirmwareTransfer", Kinds: KIND_CL},
+			{Page: 0x0011, ID: 0x0003, Name: "FirmwareFileId", Kinds: KIND_DV},
+			{Page: 0x0011, ID: 0x0004, Name: "FileOffsetInBytes", Kinds: KIND_DV},
+			{Page: 0x0011, ID: 0x0005, Name: "FileTransferSizeMaxInBytes", Kinds: KIND_DV},
+			{Page: 0x0011, ID: 0x0006, Name: "FilePayload", Kinds: KIND_DV},
+			{Page: 0x0011, ID: 0x0007, Name: "FilePayloadSizeInBytes", Kinds: KIND_DV},
+			{Page: 0x0011, ID: 0x0008, Name: "FilePayloadContainsLastBytes", Kinds: KIND_DF},
+			{Page: 0x0011, ID: 0x0009, Name: "FileTransferStop", Kinds: KIND_DF},
+			{Page: 0x0011, ID: 0x000A, Name: "FileTransferTillEnd", Kinds: KIND_DF},
+		},
+	},
+	{
+		ID:   0x0012,
+		Name: "Eye and Head Trackers",
+		Usages: []Usage{
+			{Page: 0x0012, ID: 0x0001, Name: "Eye Tracker", Kinds: KIND_CA},
+			{Page: 0x0012, ID: 0x0002, Name: "Head Tracker", Kinds: KIND_CA},
+			{Page: 0x0012, ID: 0x0010, Name: "Tracking Data", Kinds: KIND_CP},
+			{Page: 0x0012, ID: 0x0011, Name: "Capabilities", Kinds: KIND_CL},
+			{Page: 0x0012, ID: 0x0012, Name: "Configuration", Kinds: KIND_CL},
+			{Page: 0x0012, ID: 0x0013, Name: "Status", Kinds: KIND_CL},
+			{Page: 0x0012, ID: 0x0014, Name: "Control", Kinds: KIND_CL},
+			{Page: 0x0012, ID: 0x0020, Name: "Sensor Timestamp", Kinds: KIND_DV},
+			{Page: 0x0012, ID: 0x0021, Name: "Position X", Kinds: KIND_DV},
+			{Page: 0x0012, ID: 0x0022, Name: "Position Y", Kinds: KIND_DV},
+			{Page: 0x0012, ID: 0x0023, Name: "Position Z", Kinds: KIND_DV},
+			{Page: 0x0012, ID: 0x0024, Name: "Gaze Point", Kinds: KIND_CP},
+			{Page: 0x0012, ID: 0x0025, Name: "Left Eye Position", Kinds: KIND_CP},
+			{Page: 0x0012, ID: 0x0026, Name: "Right Eye Position", Kinds: KIND_CP},
+			{Page: 0x0012, ID: 0x0027, Name: "Head Position", Kinds: KIND_CP},
+			{Page: 0x0012, ID: 0x0028, Name: "Head Direction Point", Kinds: KIND_CP},
+			{Page: 0x0012, ID: 0x0029, Name: "Rotation about X axis", Kinds: KIND_DV},
+			{Page: 0x0012, ID: 0x002A, Name: "Rotation about Y axis", Kinds: KIND_DV},
+			{Page: 0x0012, ID: 0x002B, Name: "Rotation about Z axis", Kinds: KIND_DV},
+			{Page: 0x0012, ID: 0x0100, Name: "Tracker Quality", Kinds: KIND_SV},
+			{Page: 0x0012, ID: 0x0101, Name: "Minimum Tracking Distance", Kinds: KIND_SV},
+			{Page: 0x0012, ID: 0x0102, Name: "Optimum Tracking Distance", Kinds: KIND_SV},
+			{Page: 0x0012, ID: 0x0103, Name: "Maximum Tracking Distance", Kinds: KIND_SV},
+			{Page: 0x0012, ID: 0x0104, Name: "Maximum Screen Plane Width", Kinds: KIND_SV},
+			{Page: 0x0012, ID: 0x0105, Name: "Maximum Screen Plane Height", Kinds: KIND_SV},
+			{Page: 0x0012, ID: 0x0200, Name: "Display Manufacturer ID", Kinds: KIND_SV},
+			{Page: 0x0012, ID: 0x0201, Name: "Display Product ID", Kinds: KIND_SV},
+			{Page: 0x0012, ID: 0x0202, Name: "Display Serial Number", Kinds: KIND_SV},
+			{Page: 0x0012, ID: 0x0203, Name: "Display Manufacturer Date", Kinds: KIND_SV},
+			{Page: 0x0012, ID: 0x0204, Name: "Calibrated Screen Width", Kinds: KIND_SV},
+			{Page: 0x0012, ID: 0x0205, Name: "Calibrated Screen Height", Kinds: KIND_SV},
+			{Page: 0x0012, ID: 0x0300, Name: "Sampling Frequency", Kinds: KIND_DV},
+			{Page: 0x0012, ID: 0x0301, Name: "Configuration Status", Kinds: KIND_DV},
+			{Page: 0x0012, ID: 0x0400, Name: "Device Mode Request", Kinds: KIND_DV},
+		},
+	},
+	{
+		ID:   0x0014,
+		Name: "Auxiliary Display",
+		Usages: []Usage{
+			{Page: 0x0014, ID: 0x0001, Name: "Alphanumeric Display", Kinds: KIND_CA},
+			{Page: 0x0014, ID: 0x0002, Name: "Auxiliary Display", Kinds: KIND_CA},
+			{Page: 0x0014, ID: 0x0020, Name: "Display Attributes Report", Kinds: KIND_CL},
+			{Page: 0x0014, ID: 0x0021, Name: "ASCII Character Set", Kinds: KIND_SF},
+			{Page: 0x0014, ID: 0x0022, Name: "Data Read Back", Kinds: KIND_SF},
+			{Page: 0x0014, ID: 0x0023, Name: "Font Read Back", Kinds: KIND_SF},
+			{Page: 0x0014, ID: 0x0024, Name: "Display Control Report", Kinds: KIND_CL},
+			{Page: 0x0014, ID: 0x0025, Name: "Clear Display", Kinds: KIND_DF},
+			{Page: 0x0014, ID: 0x0026, Name: "Display Enable", Kinds: KIND_DF},
+			{Page: 0x0014, ID: 0x0027, Name: "Screen Saver Delay", Kinds: KIND_SV | KIND_DV},
+			{Page: 0x0014, ID: 0x0028, Name: "Screen Saver Enable", Kinds: KIND_DF},
+			{Page: 0x0014, ID: 0x0029, Name: "Vertical Scroll", Kinds: KIND_SF | KIND_DF},
+			{Page: 0x0014, ID: 0x002A, Name: "Horizontal Scroll", Kinds: KIND_SF | KIND_DF},
+			{Page: 0x0014, ID: 0x002B, Name: "Character Report", Kinds: KIND_CL},
+			{Page: 0x0014, ID: 0x002C, Name: "Display Data", Kinds: KIND_DV},
+			{Page: 0x0014, ID: 0x002D, Name: "Display Status", Kinds: KIND_CL},
+			{Page: 0x0014, ID: 0x002E, Name: "Stat Not Ready", Kinds: KIND_SEL},
+			{Page: 0x0014, ID: 0x002F, Name: "Stat Ready", Kinds: KIND_SEL},
+			{Page: 0x0014, ID: 0x0030, Name: "Err Not a loadable character", Kinds: KIND_SEL},
+			{Page: 0x0014, ID: 0x0031, Name: "Err Font data cannot be read", Kinds: KIND_SEL},
+			{Page: 0x0014, ID: 0x0032, Name: "Cursor Position Report", Kinds: KIND_SEL},
+			{Page: 0x0014, ID: 0x0033, Name: "Row", Kinds: KIND_DV},
+			{Page: 0x0014, ID: 0x0034, Name: "Column", Kinds: KIND_DV},
+			{Page: 0x0014, ID: 0x0035, Name: "Rows", Kinds: KIND_SV},
+			{Page: 0x0014, ID: 0x0036, Name: "Columns", Kinds: KIND_SV},
+			{Page: 0x0014, ID: 0x0037, Name: "Cursor Pixel Positioning", Kinds: KIND_SF},
+			{Page: 0x0014, ID: 0x0038, Name: "Cursor Mode", Kinds: KIND_DF},
+			{Page: 0x0014, ID: 0x0039, Name: "Cursor Enable", Kinds: KIND_DF},
+			{Page: 0x0014, ID: 0x003A, Name: "Cursor Blink", Kinds: KIND_DF},
+			{Page: 0x0014, ID: 0x003B, Name: "Font Report", Kinds: KIND_CL},
+			{Page: 0x0014, ID: 0x003C, Name: "Font Data", Kinds: KIND_BUFFERED_BYTES},
+			{Page: 0x0014, ID: 0x003D, Name: "Character Width", Kinds: KIND_SV},
+			{Page: 0x0014, ID: 0x003E, Name: "Character Height", Kinds: KIND_SV},
+			{Page: 0x0014, ID: 0x003F, Name: "Character Spacing Horizontal", Kinds: KIND_SV},
+			{Page: 0x0014, ID: 0x0040, Name: "Character Spacing Vertical", Kinds: KIND_SV},
+			{Page: 0x0014, ID: 0x0041, Name: "Unicode Character Set", Kinds: KIND_SF},
+			{Page: 0x0014, ID: 0x0042, Name: "Font 7-Segment", Kinds: KIND_SF},
+			{Page: 0x0014, ID: 0x0043, Name: "7-Segment Direct Map", Kinds: KIND_SF},
+			{Page: 0x0014, ID: 0x0044, Name: "Font 14-Segment", Kinds: KIND_SF},
+			{Page: 0x0014, ID: 0x0045, Name: "14-Segment Direct Map", Kinds: KIND_SF},
+			{Page: 0x0014, ID: 0x0046, Name: "Display Brightness", Kinds: KIND_DV},
+			{Page: 0x0014, ID: 0x0047, Name: "Display Contrast", Kinds: KIND_DV},
+			{Page: 0x0014, ID: 0x0048, Name: "Character Attribute", Kinds: KIND_CL},
+			{Page: 0x0014, ID: 0x0049, Name: "Attribute Readback", Kinds: KIND_SF},
+			{Page: 0x0014, ID: 0x004A, Name: "Attribute Data", Kinds: KIND_DV},
+			{Page: 0x0014, ID: 0x004B, Name: "Char Attr Enhance", Kinds: KIND_OOC},
+			{Page: 0x0014, ID: 0x004C, Name: "Char Attr Underline", Kinds: KIND_OOC},
+			{Page: 0x0014, ID: 0x004D, Name: "Char Attr Blink", Kinds: KIND_OOC},
+			{Page: 0x0014, ID: 0x0080, Name: "Bitmap Size X", Kinds: KIND_SV},
+			{Page: 0x0014, ID: 0x0081, Name: "Bitmap Size Y", Kinds: KIND_SV},
+			{Page: 0x0014, ID: 0x0082, Name: "Max Blit Size", Kinds: KIND_SV},
+			{Page: 0x0014, ID: 0x0083, Name: "Bit Depth Format", Kinds: KIND_SV},
+			{Page: 0x0014, ID: 0x0084, Name: "Display Orientation", Kinds: KIND_DV},
+			{Page: 0x0014, ID: 0x0085, Name: "Palette Report", Kinds: KIND_CL},
+			{Page: 0x0014, ID: 0x0086, Name: "Palette Data Size", Kinds: KIND_SV},
+			{Page: 0x0014, ID: 0x0087, Name: "Palette Data Offset", Kinds: KIND_SV},
+			{Page: 0x0014, ID: 0x0088, Name: "Palette Data", Kinds: KIND_BUFFERED_BYTES},
+			{Page: 0x0014, ID: 0x008A, Name: "Blit Report", Kinds: KIND_CL},
+			{Page: 0x0014, ID: 0x008B, Name: "Blit Rectangle X1", Kinds: KIND_SV},
+			{Page: 0x0014, ID: 0x008C, Name: "Blit Rectangle Y1", Kinds: KIND_SV},
+			{Page: 0x0014, ID: 0x008D, Name: "Blit Rectangle X2", Kinds: KIND_SV},
+			{Page: 0x0014, ID: 0x008E, Name: "Blit Rectangle Y2", Kinds: KIND_SV},
+			{Page: 0x0014, ID: 0x008F, Name: "Blit Data", Kinds: KIND_BUFFERED_BYTES},
+			{Page: 0x0014, ID: 0x0090, Name: "Soft Button", Kinds: KIND_CL},
+			{Page: 0x0014, ID: 0x0091, Name: "Soft Button ID", Kinds: KIND_SV},
+			{Page: 0x0014, ID: 0x0092, Name: "Soft Button Side", Kinds: KIND_SV},
+			{Page: 0x0014, ID: 0x0093, Name: "Soft Button Offset 1", Kinds: KIND_SV},
+			{Page: 0x0014, ID: 0x0094, Name: "Soft Button Offset 2", Kinds: KIND_SV},
+			{Page: 0x0014, ID: 0x0095, Name: "Soft Button Report", Kinds: KIND_SV},
+			{Page: 0x0014, ID: 0x00C2, Name: "Soft Keys", Kinds: KIND_SV},
+			{Page: 0x0014, ID: 0x00CC, Name: "Display Data Extensions", Kinds: KIND_SF},
+			{Page: 0x0014, ID: 0x00CF, Name: "Character Mapping", Kinds: KIND_SV},
+			{Page: 0x0014, ID: 0x00DD, Name: "Unicode Equivalent", Kinds: KIND_SV},
+			{Page: 0x0014, ID: 0x00DF, Name: "Character Page Mapping", Kinds: KIND_SV},
+			{Page: 0x0014, ID: 0x00FF, Name: "Request Report", Kinds: KIND_DV},
+		},
+	},
+	{
+		ID:   0x0020,
+		Name: "Sensors",
+		Usages: []Usage{
+			{Page: 0x0020, ID: 0x0001, Name: "Sensor", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0010, Name: "Biometric", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0011, Name: "Biometric: Human Presence", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0012, Name: "Biometric: Human Proximity", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0013, Name: "Biometric: Human Touch", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0014, Name: "Biometric: Blood Pressure", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0015, Name: "Biometric: Body Temperature", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0016, Name: "Biometric: Heart Rate", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0017, Name: "Biometric: Heart Rate Variability", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0018, Name: "Biometric: Peripheral Oxygen Saturation", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0019, Name: "Biometric: Respiratory Rate", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0020, Name: "Electrical", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0021, Name: "Electrical: Capacitance", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0022, Name: "Electrical: Current", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0023, Name: "Electrical: Power", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0024, Name: "Electrical: Inductance", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0025, Name: "Electrical: Resistance", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0026, Name: "Electrical: Voltage", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0027, Name: "Electrical: Potentiometer", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0028, Name: "Electrical: Frequency", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0029, Name: "Electrical: Period", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0030, Name: "Environmental", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0031, Name: "Environmental: Atmospheric Pressure", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0032, Name: "Environmental: Humidity", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0033, Name: "Environmental: Temperature", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0034, Name: "Environmental: Wind Direction", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0035, Name: "Environmental: Wind Speed", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0036, Name: "Environmental: Air Quality", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0037, Name: "Environmental: Heat Index", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0038, Name: "Environmental: Surface Temperature", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0039, Name: "Environmental: Volatile Organic Compounds", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x003A, Name: "Environmental: Object Presence", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x003B, Name: "Environmental: Object Proximity", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0040, Name: "Light", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0041, Name: "Light: Ambient Light", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0042, Name: "Light: Consumer Infrared", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0043, Name: "Light: Infrared Light", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0044, Name: "Light: Visible Light", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0045, Name: "Light: Ultraviolet Light", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0050, Name: "Location", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0051, Name: "Location: Broadcast", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0052, Name: "Location: Dead Reckoning", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0053, Name: "Location: GPS (Global Positioning System)", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0054, Name: "Location: Lookup", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0055, Name: "Location: Other", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0056, Name: "Location: Static", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0057, Name: "Location: Triangulation", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0060, Name: "Mechanical", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0061, Name: "Mechanical: Boolean Switch", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0062, Name: "Mechanical: Boolean Switch Array", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0063, Name: "Mechanical: Multivalue Switch", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0064, Name: "Mechanical: Force", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0065, Name: "Mechanical: Pressure", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0066, Name: "Mechanical: Strain", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0067, Name: "Mechanical: Weight", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0068, Name: "Mechanical: Haptic Vibrator", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0069, Name: "Mechanical: Hall Effect Switch", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0070, Name: "Motion", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0071, Name: "Motion: Accelerometer 1D", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0072, Name: "Motion: Accelerometer 2D", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0073, Name: "Motion: Accelerometer 3D", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0074, Name: "Motion: Gyrometer 1D", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0075, Name: "Motion: Gyrometer 2D", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0076, Name: "Motion: Gyrometer 3D", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0077, Name: "Motion: Motion Detector", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0078, Name: "Motion: Speedometer", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0079, Name: "Motion: Accelerometer", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x007A, Name: "Motion: Gyrometer", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x007B, Name: "Motion: Gravity Vector", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x007C, Name: "Motion: Linear Accelerometer", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0080, Name: "Orientation", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0081, Name: "Orientation: Compass 1D", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0082, Name: "Orientation: Compass 2D", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0083, Name: "Orientation: Compass 3D", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0084, Name: "Orientation: Inclinometer 1D", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0085, Name: "Orientation: Inclinometer 2D", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0086, Name: "Orientation: Inclinometer 3D", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0087, Name: "Orientation: Distance 1D", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0088, Name: "Orientation: Distance 2D", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0089, Name: "Orientation: Distance 3D", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x008A, Name: "Orientation: Device Orientation", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x008B, Name: "Orientation: Compass", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x008C, Name: "Orientation: Inclinometer", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x008D, Name: "Orientation: Distance", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x008E, Name: "Orientation: Relative Orientation", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x008F, Name: "Orientation: Simple Orientation", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0090, Name: "Scanner", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0091, Name: "Scanner: Barcode", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0092, Name: "Scanner: RFID", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0093, Name: "Scanner: NFC", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x00A0, Name: "Time", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x00A1, Name: "Time: Alarm Timer", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x00A2, Name: "Time: Real Time Clock", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x00B0, Name: "Personal Activity", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x00B1, Name: "Personal Activity: Activity Detection", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x00B2, Name: "Personal Activity: Device Position", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x00B3, Name: "Personal Activity: Floor Tracker", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x00B4, Name: "Personal Activity: Pedometer", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x00B5, Name: "Personal Activity: Step Detection", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x00C0, Name: "Orientation Extended", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x00C1, Name: "Orientation Extended: Geomagnetic Orientation", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x00C2, Name: "Orientation Extended: Magnetometer", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x00D0, Name: "Gesture", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x00D1, Name: "Gesture: Chassis Flip Gesture", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x00D2, Name: "Gesture: Hinge Fold Gesture", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x00E0, Name: "Other", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x00E1, Name: "Other: Custom", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x00E2, Name: "Other: Generic", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x00E3, Name: "Other: Generic Enumerator", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x00E4, Name: "Other: Hinge Angle", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x00F0, Name: "Vendor Reserved 1", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x00F1, Name: "Vendor Reserved 2", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x00F2, Name: "Vendor Reserved 3", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x00F3, Name: "Vendor Reserved 4", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x00F4, Name: "Vendor Reserved 5", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x00F5, Name: "Vendor Reserved 6", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x00F6, Name: "Vendor Reserved 7", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x00F7, Name: "Vendor Reserved 8", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x00F8, Name: "Vendor Reserved 9", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x00F9, Name: "Vendor Reserved 10", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x00FA, Name: "Vendor Reserved 11", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x00FB, Name: "Vendor Reserved 12", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x00FC, Name: "Vendor Reserved 13", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x00FD, Name: "Vendor Reserved 14", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x00FE, Name: "Vendor Reserved 15", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x00FF, Name: "Vendor Reserved 16", Kinds: KIND_CA | KIND_CP},
+			{Page: 0x0020, ID: 0x0200, Name: "Event", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x0201, Name: "Event: Sensor State", Kinds: KIND_NARY},
+			{Page: 0x0020, ID: 0x0202, Name: "Event: Sensor Event", Kinds: KIND_NARY},
+			{Page: 0x0020, ID: 0x0300, Name: "Property", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x0301, Name: "Property: Friendly Name", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0302, Name: "Property: Persistent Unique ID", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x0303, Name: "Property: Sensor Status", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x0304, Name: "Property: Minimum Report Interval", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0305, Name: "Property: Sensor Manufacturer", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0306, Name: "Property: Sensor Model", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0307, Name: "Property: Sensor Serial Number", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0308, Name: "Property: Sensor Description", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0309, Name: "Property: Sensor Connection Type", Kinds: KIND_NARY},
+			{Page: 0x0020, ID: 0x030A, Name: "Property: Sensor Device Path", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x030B, Name: "Property: Hardware Revision", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x030C, Name: "Property: Firmware Version", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x030D, Name: "Property: Release Date", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x030E, Name: "Property: Report Interval", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x030F, Name: "Property: Change Sensitivity Absolute", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x0310, Name: "Property: Change Sensitivity Percent of Range", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x0311, Name: "Property: Change Sensitivity Percent Relative", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x0312, Name: "Property: Accuracy", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x0313, Name: "Property: Resolution", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x0314, Name: "Property: Maximum", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x0315, Name: "Property: Minimum", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x0316, Name: "Property: Reporting State", Kinds: KIND_NARY},
+			{Page: 0x0020, ID: 0x0317, Name: "Property: Sampling Rate", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x0318, Name: "Property: Response Curve", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x0319, Name: "Property: Power State", Kinds: KIND_NARY},
+			{Page: 0x0020, ID: 0x031A, Name: "Property: Maximum FIFO Events", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x031B, Name: "Property: Report Latency", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x031C, Name: "Property: Flush FIFO Events", Kinds: KIND_DF},
+			{Page: 0x0020, ID: 0x031D, Name: "Property: Maximum Power Consumption", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x031E, Name: "Property: Is Primary", Kinds: KIND_DF},
+			{Page: 0x0020, ID: 0x031F, Name: "Property: Human Presence Detection Type", Kinds: KIND_NARY},
+			{Page: 0x0020, ID: 0x0400, Name: "Data Field: Location", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x0402, Name: "Data Field: Altitude Antenna Sea Level", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0403, Name: "Data Field: Differential Reference Station ID", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0404, Name: "Data Field: Altitude Ellipsoid Error", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0405, Name: "Data Field: Altitude Ellipsoid", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0406, Name: "Data Field: Altitude Sea Level Error", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0407, Name: "Data Field: Altitude Sea Level", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0408, Name: "Data Field: Differential GPS Data Age", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0409, Name: "Data Field: Error Radius", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x040A, Name: "Data Field: Fix Quality", Kinds: KIND_NARY},
+			{Page: 0x0020, ID: 0x040B, Name: "Data Field: Fix Type", Kinds: KIND_NARY},
+			{Page: 0x0020, ID: 0x040C, Name: "Data Field: Geoidal Separation", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x040D, Name: "Data Field: GPS Operation Mode", Kinds: KIND_NARY},
+			{Page: 0x0020, ID: 0x040E, Name: "Data Field: GPS Selection Mode", Kinds: KIND_NARY},
+			{Page: 0x0020, ID: 0x040F, Name: "Data Field: GPS Status", Kinds: KIND_NARY},
+			{Page: 0x0020, ID: 0x0410, Name: "Data Field: Position Dilution of Precision", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0411, Name: "Data Field: Horizontal Dilution of Precision", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0412, Name: "Data Field: Vertical Dilution of Precision", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0413, Name: "Data Field: Latitude", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0414, Name: "Data Field: Longitude", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0415, Name: "Data Field: True Heading", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0416, Name: "Data Field: Magnetic Heading", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0417, Name: "Data Field: Magnetic Variation", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0418, Name: "Data Field: Speed", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0419, Name: "Data Field: Satellites in View", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x041A, Name: "Data Field: Satellites in View Azimuth", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x041B, Name: "Data Field: Satellites in View Elevation", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x041C, Name: "Data Field: Satellites in View IDs", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x041D, Name: "Data Field: Satellites in View PRNs", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x041E, Name: "Data Field: Satellites in View S/N Ratios", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x041F, Name: "Data Field: Satellites Used Count", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0420, Name: "Data Field: Satellites Used PRNs", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0421, Name: "Data Field: NMEA Sentence", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0422, Name: "Data Field: Address Line 1", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0423, Name: "Data Field: Address Line 2", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0424, Name: "Data Field: City", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0425, Name: "Data Field: State or Province", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0426, Name: "Data Field: Country or Region", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0427, Name: "Data Field: Postal Code", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x042A, Name: "Property: Location", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x042B, Name: "Property: Location Desired Accuracy", Kinds: KIND_NARY},
+			{Page: 0x0020, ID: 0x0430, Name: "Data Field: Environmental", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0431, Name: "Data Field: Atmospheric Pressure", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0433, Name: "Data Field: Relative Humidity", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0434, Name: "Data Field: Temperature", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0435, Name: "Data Field: Wind Direction", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0436, Name: "Data Field: Wind Speed", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0437, Name: "Data Field: Air Quality Index", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0438, Name: "Data Field: Equivalent CO2", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0439, Name: "Data Field: Volatile Organic Compound Concentration", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x043A, Name: "Data Field: Object Presence", Kinds: KIND_SF},
+			{Page: 0x0020, ID: 0x043B, Name: "Data Field: Object Proximity Range", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x043C, Name: "Data Field: Object Proximity Out of Range", Kinds: KIND_SF},
+			{Page: 0x0020, ID: 0x0440, Name: "Property: Environmental", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0441, Name: "Property: Reference Pressure", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0450, Name: "Data Field: Motion", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x0451, Name: "Data Field: Motion State", Kinds: KIND_SF},
+			{Page: 0x0020, ID: 0x0452, Name: "Data Field: Acceleration", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0453, Name: "Data Field: Acceleration Axis X", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0454, Name: "Data Field: Acceleration Axis Y", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0455, Name: "Data Field: Acceleration Axis Z", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0456, Name: "Data Field: Angular Velocity", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0457, Name: "Data Field: Angular Velocity about X Axis", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0458, Name: "Data Field: Angular Velocity about Y Axis", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0459, Name: "Data Field: Angular Velocity about Z Axis", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x045A, Name: "Data Field: Angular Position", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x045B, Name: "Data Field: Angular Position about X Axis", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x045C, Name: "Data Field: Angular Position about Y Axis", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x045D, Name: "Data Field: Angular Position about Z Axis", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x045E, Name: "Data Field: Motion Speed", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x045F, Name: "Data Field: Motion Intensity", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0470, Name: "Data Field: Orientation", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x0471, Name: "Data Field: Heading", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0472, Name: "Data Field: Heading X Axis", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0473, Name: "Data Field: Heading Y Axis", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0474, Name: "Data Field: Heading Z Axis", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0475, Name: "Data Field: Heading Compensated Magnetic North", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0476, Name: "Data Field: Heading Compensated True North", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0477, Name: "Data Field: Heading Magnetic North", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0478, Name: "Data Field: Heading True North", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0479, Name: "Data Field: Distance", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x047A, Name: "Data Field: Distance X Axis", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x047B, Name: "Data Field: Distance Y Axis", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x047C, Name: "Data Field: Distance Z Axis", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x047D, Name: "Data Field: Distance Out-of-Range", Kinds: KIND_SF},
+			{Page: 0x0020, ID: 0x047E, Name: "Data Field: Tilt", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x047F, Name: "Data Field: Tilt X Axis", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0480, Name: "Data Field: Tilt Y Axis", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0481, Name: "Data Field: Tilt Z Axis", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0482, Name: "Data Field: Rotation Matrix", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0483, Name: "Data Field: Quaternion", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0484, Name: "Data Field: Magnetic Flux", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0485, Name: "Data Field: Magnetic Flux X Axis", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0486, Name: "Data Field: Magnetic Flux Y Axis", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0487, Name: "Data Field: Magnetic Flux Z Axis", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0488, Name: "Data Field: Magnetometer Accuracy", Kinds: KIND_NARY},
+			{Page: 0x0020, ID: 0x0489, Name: "Data Field: Simple Orientation Direction", Kinds: KIND_NARY},
+			{Page: 0x0020, ID: 0x0490, Name: "Data Field: Mechanical", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x0491, Name: "Data Field: Boolean Switch State", Kinds: KIND_SF},
+			{Page: 0x0020, ID: 0x0492, Name: "Data Field: Boolean Switch Array States", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0493, Name: "Data Field: Multivalue Switch Value", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0494, Name: "Data Field: Force", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0495, Name: "Data Field: Absolute Pressure", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0496, Name: "Data Field: Gauge Pressure", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0497, Name: "Data Field: Strain", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0498, Name: "Data Field: Weight", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x04A0, Name: "Property: Mechanical", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x04A1, Name: "Property: Vibration State", Kinds: KIND_DF},
+			{Page: 0x0020, ID: 0x04A2, Name: "Property: Forward Vibration Speed", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x04A3, Name: "Property: Backward Vibration Speed", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x04B0, Name: "Data Field: Biometric", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x04B1, Name: "Data Field: Human Presence", Kinds: KIND_SF},
+			{Page: 0x0020, ID: 0x04B2, Name: "Data Field: Human Proximity Range", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x04B3, Name: "Data Field: Human Proximity Out of Range", Kinds: KIND_SF},
+			{Page: 0x0020, ID: 0x04B4, Name: "Data Field: Human Touch State", Kinds: KIND_SF},
+			{Page: 0x0020, ID: 0x04B5, Name: "Data Field: Blood Pressure", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x04B6, Name: "Data Field: Blood Pressure Diastolic", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x04B7, Name: "Data Field: Blood Pressure Systolic", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x04B8, Name: "Data Field: Heart Rate", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x04B9, Name: "Data Field: Resting Heart Rate", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x04BA, Name: "Data Field: Heartbeat Interval", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x04BB, Name: "Data Field: Respiratory Rate", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x04BC, Name: "Data Field: SpO2", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x04BD, Name: "Data Field: Human Attention Detected", Kinds: KIND_MC},
+			{Page: 0x0020, ID: 0x04BE, Name: "Data Field: Human Head Azimuth", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x04BF, Name: "Data Field: Human Head Altitude", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x04C0, Name: "Data Field: Human Head Roll", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x04C1, Name: "Data Field: Human Head Pitch", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x04C2, Name: "Data Field: Human Head Yaw", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x04C3, Name: "Data Field: Human Correlation Id", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x04D0, Name: "Data Field: Light", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x04D1, Name: "Data Field: Illuminance", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x04D2, Name: "Data Field: Color Temperature", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x04D3, Name: "Data Field: Chromaticity", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x04D4, Name: "Data Field: Chromaticity X", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x04D5, Name: "Data Field: Chromaticity Y", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x04D6, Name: "Data Field: Consumer IR Sentence Receive", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x04D7, Name: "Data Field: Infrared Light", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x04D8, Name: "Data Field: Red Light", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x04D9, Name: "Data Field: Green Light", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x04DA, Name: "Data Field: Blue Light", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x04DB, Name: "Data Field: Ultraviolet A Light", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x04DC, Name: "Data Field: Ultraviolet B Light", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x04DD, Name: "Data Field: Ultraviolet Index", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x04DE, Name: "Data Field: Near Infrared Light", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x04DF, Name: "Property: Light", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x04E0, Name: "Property: Consumer IR Sentence Send", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x04E2, Name: "Property: Auto Brightness Preferred", Kinds: KIND_DF},
+			{Page: 0x0020, ID: 0x04E3, Name: "Property: Auto Color Preferred", Kinds: KIND_DF},
+			{Page: 0x0020, ID: 0x04F0, Name: "Data Field: Scanner", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x04F1, Name: "Data Field: RFID Tag 40 Bit", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x04F2, Name: "Data Field: NFC Sentence Receive", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x04F8, Name: "Property: Scanner", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x04F9, Name: "Property: NFC Sentence Send", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0500, Name: "Data Field: Electrical", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0501, Name: "Data Field: Capacitance", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0502, Name: "Data Field: Current", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0503, Name: "Data Field: Electrical Power", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0504, Name: "Data Field: Inductance", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0505, Name: "Data Field: Resistance", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0506, Name: "Data Field: Voltage", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0507, Name: "Data Field: Frequency", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0508, Name: "Data Field: Period", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0509, Name: "Data Field: Percent of Range", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0520, Name: "Data Field: Time", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x0521, Name: "Data Field: Year", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0522, Name: "Data Field: Month", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0523, Name: "Data Field: Day", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0524, Name: "Data Field: Day of Week", Kinds: KIND_NARY},
+			{Page: 0x0020, ID: 0x0525, Name: "Data Field: Hour", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0526, Name: "Data Field: Minute", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0527, Name: "Data Field: Second", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0528, Name: "Data Field: Millisecond", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0529, Name: "Data Field: Timestamp", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x052A, Name: "Data Field: Julian Day of Year", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x052B, Name: "Data Field: Time Since System Boot", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0530, Name: "Property: Time", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x0531, Name: "Property: Time Zone Offset from UTC", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x0532, Name: "Property: Time Zone Name", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x0533, Name: "Property: Daylight Savings Time Observed", Kinds: KIND_DF},
+			{Page: 0x0020, ID: 0x0534, Name: "Property: Time Trim Adjustment", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x0535, Name: "Property: Arm Alarm", Kinds: KIND_DF},
+			{Page: 0x0020, ID: 0x0540, Name: "Data Field: Custom", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x0541, Name: "Data Field: Custom Usage", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0542, Name: "Data Field: Custom Boolean Array", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0543, Name: "Data Field: Custom Value", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0544, Name: "Data Field: Custom Value 1", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0545, Name: "Data Field: Custom Value 2", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0546, Name: "Data Field: Custom Value 3", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0547, Name: "Data Field: Custom Value 4", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0548, Name: "Data Field: Custom Value 5", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0549, Name: "Data Field: Custom Value 6", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x054A, Name: "Data Field: Custom Value 7", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x054B, Name: "Data Field: Custom Value 8", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x054C, Name: "Data Field: Custom Value 9", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x054D, Name: "Data Field: Custom Value 10", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x054E, Name: "Data Field: Custom Value 11", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x054F, Name: "Data Field: Custom Value 12", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0550, Name: "Data Field: Custom Value 13", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0551, Name: "Data Field: Custom Value 14", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0552, Name: "Data Field: Custom Value 15", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0553, Name: "Data Field: Custom Value 16", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0554, Name: "Data Field: Custom Value 17", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0555, Name: "Data Field: Custom Value 18", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0556, Name: "Data Field: Custom Value 19", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0557, Name: "Data Field: Custom Value 20", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0558, Name: "Data Field: Custom Value 21", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0559, Name: "Data Field: Custom Value 22", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x055A, Name: "Data Field: Custom Value 23", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x055B, Name: "Data Field: Custom Value 24", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x055C, Name: "Data Field: Custom Value 25", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x055D, Name: "Data Field: Custom Value 26", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x055E, Name: "Data Field: Custom Value 27", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x055F, Name: "Data Field: Custom Value 28", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0560, Name: "Data Field: Generic", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x0561, Name: "Data Field: Generic GUID or PROPERTYKEY", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0562, Name: "Data Field: Generic Category GUID", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0563, Name: "Data Field: Generic Type GUID", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0564, Name: "Data Field: Generic Event PROPERTYKEY", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0565, Name: "Data Field: Generic Property PROPERTYKEY", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0566, Name: "Data Field: Generic Data Field PROPERTYKEY", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0567, Name: "Data Field: Generic Event", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0568, Name: "Data Field: Generic Property", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0569, Name: "Data Field: Generic Data Field", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x056A, Name: "Data Field: Enumerator Table Row Index", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x056B, Name: "Data Field: Enumerator Table Row Count", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x056C, Name: "Data Field: Generic GUID or PROPERTYKEY kind", Kinds: KIND_NARY},
+			{Page: 0x0020, ID: 0x056D, Name: "Data Field: Generic GUID", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x056E, Name: "Data Field: Generic PROPERTYKEY", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x056F, Name: "Data Field: Generic Top Level Collection ID", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0570, Name: "Data Field: Generic Report ID", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0571, Name: "Data Field: Generic Report Item Position Index", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0572, Name: "Data Field: Generic Firmware VARTYPE", Kinds: KIND_NARY},
+			{Page: 0x0020, ID: 0x0573, Name: "Data Field: Generic Unit of Measure", Kinds: KIND_NARY},
+			{Page: 0x0020, ID: 0x0574, Name: "Data Field: Generic Unit Exponent", Kinds: KIND_NARY},
+			{Page: 0x0020, ID: 0x0575, Name: "Data Field: Generic Report Size", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0576, Name: "Data Field: Generic Report Count", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0580, Name: "Property: Generic", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x0581, Name: "Property: Enumerator Table Row Index", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x0582, Name: "Property: Enumerator Table Row Count", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0590, Name: "Data Field: Personal Activity", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x0591, Name: "Data Field: Activity Type", Kinds: KIND_NARY},
+			{Page: 0x0020, ID: 0x0592, Name: "Data Field: Activity State", Kinds: KIND_NARY},
+			{Page: 0x0020, ID: 0x0593, Name: "Data Field: Device Position", Kinds: KIND_NARY},
+			{Page: 0x0020, ID: 0x0594, Name: "Data Field: Step Count", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0595, Name: "Data Field: Step Count Reset", Kinds: KIND_DF},
+			{Page: 0x0020, ID: 0x0596, Name: "Data Field: Step Duration", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x0597, Name: "Data Field: Step Type", Kinds: KIND_NARY},
+			{Page: 0x0020, ID: 0x05A0, Name: "Property: Minimum Activity Detection Interval", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x05A1, Name: "Property: Supported Activity Types", Kinds: KIND_NARY},
+			{Page: 0x0020, ID: 0x05A2, Name: "Property: Subscribed Activity Types", Kinds: KIND_NARY},
+			{Page: 0x0020, ID: 0x05A3, Name: "Property: Supported Step Types", Kinds: KIND_NARY},
+			{Page: 0x0020, ID: 0x05A4, Name: "Property: Subscribed Step Types", Kinds: KIND_NARY},
+			{Page: 0x0020, ID: 0x05A5, Name: "Property: Floor Height", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x05B0, Name: "Data Field: Custom Type ID", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x05C0, Name: "Property: Custom", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x05C1, Name: "Property: Custom Value 1", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x05C2, Name: "Property: Custom Value 2", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x05C3, Name: "Property: Custom Value 3", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x05C4, Name: "Property: Custom Value 4", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x05C5, Name: "Property: Custom Value 5", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x05C6, Name: "Property: Custom Value 6", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x05C7, Name: "Property: Custom Value 7", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x05C8, Name: "Property: Custom Value 8", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x05C9, Name: "Property: Custom Value 9", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x05CA, Name: "Property: Custom Value 10", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x05CB, Name: "Property: Custom Value 11", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x05CC, Name: "Property: Custom Value 12", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x05CD, Name: "Property: Custom Value 13", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x05CE, Name: "Property: Custom Value 14", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x05CF, Name: "Property: Custom Value 15", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x05D0, Name: "Property: Custom Value 16", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x05E0, Name: "Data Field: Hinge", Kinds: KIND_SV | KIND_DV},
+			{Page: 0x0020, ID: 0x05E1, Name: "Data Field: Hinge Angle", Kinds: KIND_SV | KIND_DV},
+			{Page: 0x0020, ID: 0x05F0, Name: "Data Field: Gesture Sensor", Kinds: KIND_DV},
+			{Page: 0x0020, ID: 0x05F1, Name: "Data Field: Gesture State", Kinds: KIND_NARY},
+			{Page: 0x0020, ID: 0x05F2, Name: "Data Field: Hinge Fold Initial Angle", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x05F3, Name: "Data Field: Hinge Fold Final Angle", Kinds: KIND_SV},
+			{Page: 0x0020, ID: 0x05F4, Name: "Data Field: Hinge Fold Contributing Panel", Kinds: KIND_NARY},
+			{Page: 0x0020, ID: 0x05F5, Name: "Data Field: Hinge Fold Type", Kinds: KIND_NARY},
+			{Page: 0x0020, ID: 0x0800, Name: "Sensor State: Undefined", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0801, Name: "Sensor State: Ready", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0802, Name: "Sensor State: Not Available", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0803, Name: "Sensor State: No Data", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0804, Name: "Sensor State: Initializing", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0805, Name: "Sensor State: Access Denied", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0806, Name: "Sensor State: Error", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0810, Name: "Sensor Event: Unknown", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0811, Name: "Sensor Event: State Changed", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0812, Name: "Sensor Event: Property Changed", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0813, Name: "Sensor Event: Data Updated", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0814, Name: "Sensor Event: Poll Response", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0815, Name: "Sensor Event: Change Sensitivity", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0816, Name: "Sensor Event: Range Maximum Reached", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0817, Name: "Sensor Event: Range Minimum Reached", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0818, Name: "Sensor Event: High Threshold Cross Upward", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0819, Name: "Sensor Event: High Threshold Cross Downward", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x081A, Name: "Sensor Event: Low Threshold Cross Upward", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x081B, Name: "Sensor Event: Low Threshold Cross Downward", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x081C, Name: "Sensor Event: Zero Threshold Cross Upward", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x081D, Name: "Sensor Event: Zero Threshold Cross Downward", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x081E, Name: "Sensor Event: Period Exceeded", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x081F, Name: "Sensor Event: Frequency Exceeded", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0820, Name: "Sensor Event: Complex Trigger", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0830, Name: "Connection Type: PC Integrated", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0831, Name: "Connection Type: PC Attached", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0832, Name: "Connection Type: PC External", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0840, Name: "Reporting State: Report No Events", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0841, Name: "Reporting State: Report All Events", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0842, Name: "Reporting State: Report Threshold Events", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0843, Name: "Reporting State: Wake On No Events", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0844, Name: "Reporting State: Wake On All Events", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0845, Name: "Reporting State: Wake On Threshold Events", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0846, Name: "Reporting State: Anytime", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0850, Name: "Power State: Undefined", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0851, Name: "Power State: D0 Full Power", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0852, Name: "Power State: D1 Low Power", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0853, Name: "Power State: D2 Standby Power with Wakeup", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0854, Name: "Power State: D3 Sleep with Wakeup", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0855, Name: "Power State: D4 Power Off", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0860, Name: "Accuracy: Default", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0861, Name: "Accuracy: High", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0862, Name: "Accuracy: Medium", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0863, Name: "Accuracy: Low", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0870, Name: "Fix Quality: No Fix", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0871, Name: "Fix Quality: GPS", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0872, Name: "Fix Quality: DGPS", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0880, Name: "Fix Type: No Fix", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0881, Name: "Fix Type: GPS SPS Mode, Fix Valid", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0882, Name: "Fix Type: DGPS SPS Mode, Fix Valid", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0883, Name: "Fix Type: GPS PPS Mode, Fix Valid", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0884, Name: "Fix Type: Real Time Kinematic", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0885, Name: "Fix Type: Float RTK", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0886, Name: "Fix Type: Estimated (dead reckoned)", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0887, Name: "Fix Type: Manual Input Mode", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0888, Name: "Fix Type: Simulator Mode", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0890, Name: "GPS Operation Mode: Manual", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0891, Name: "GPS Operation Mode: Automatic", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x08A0, Name: "GPS Selection Mode: Autonomous", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x08A1, Name: "GPS Selection Mode: DGPS", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x08A2, Name: "GPS Selection Mode: Estimated (dead reckoned)", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x08A3, Name: "GPS Selection Mode: Manual Input", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x08A4, Name: "GPS Selection Mode: Simulator", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x08A5, Name: "GPS Selection Mode: Data Not Valid", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x08B0, Name: "GPS Status Data: Valid", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x08B1, Name: "GPS Status Data: Not Valid", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x08C0, Name: "Day of Week: Sunday", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x08C1, Name: "Day of Week: Monday", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x08C2, Name: "Day of Week: Tuesday", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x08C3, Name: "Day of Week: Wednesday", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x08C4, Name: "Day of Week: Thursday", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x08C5, Name: "Day of Week: Friday", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x08C6, Name: "Day of Week: Saturday", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x08D0, Name: "Kind: Category", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x08D1, Name: "Kind: Type", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x08D2, Name: "Kind: Event", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x08D3, Name: "Kind: Property", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x08D4, Name: "Kind: Data Field", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x08E0, Name: "Magnetometer Accuracy: Low", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x08E1, Name: "Magnetometer Accuracy: Medium", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x08E2, Name: "Magnetometer Accuracy: High", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x08F0, Name: "Simple Orientation Direction: Not Rotated", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x08F1, Name: "Simple Orientation Direction: Rotated 90 Degrees CCW", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x08F2, Name: "Simple Orientation Direction: Rotated 180 Degrees CCW", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x08F3, Name: "Simple Orientation Direction: Rotated 270 Degrees CCW", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x08F4, Name: "Simple Orientation Direction: Face Up", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x08F5, Name: "Simple Orientation Direction: Face Down", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0900, Name: "VT\\_NULL", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0901, Name: "VT\\_BOOL", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0902, Name: "VT\\_UI1", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0903, Name: "VT\\_I1", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0904, Name: "VT\\_UI2", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0905, Name: "VT\\_I2", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0906, Name: "VT\\_UI4", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0907, Name: "VT\\_I4", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0908, Name: "VT\\_UI8", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0909, Name: "VT\\_I8", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x090A, Name: "VT\\_R4", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x090B, Name: "VT\\_R8", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x090C, Name: "VT\\_WSTR", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x090D, Name: "VT\\_STR", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x090E, Name: "VT\\_CLSID", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x090F, Name: "VT\\_VECTOR VT\\_UI1", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0910, Name: "VT\\_F16E0", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0911, Name: "VT\\_F16E1", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0912, Name: "VT\\_F16E2", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0913, Name: "VT\\_F16E3", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0914, Name: "VT\\_F16E4", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0915, Name: "VT\\_F16E5", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0916, Name: "VT\\_F16E6", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0917, Name: "VT\\_F16E7", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0918, Name: "VT\\_F16E8", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0919, Name: "VT\\_F16E9", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x091A, Name: "VT\\_F16EA", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x091B, Name: "VT\\_F16EB", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x091C, Name: "VT\\_F16EC", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x091D, Name: "VT\\_F16ED", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x091E, Name: "VT\\_F16EE", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x091F, Name: "VT\\_F16EF", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0920, Name: "VT\\_F32E0", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0921, Name: "VT\\_F32E1", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0922, Name: "VT\\_F32E2", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0923, Name: "VT\\_F32E3", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0924, Name: "VT\\_F32E4", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0925, Name: "VT\\_F32E5", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0926, Name: "VT\\_F32E6", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0927, Name: "VT\\_F32E7", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0928, Name: "VT\\_F32E8", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0929, Name: "VT\\_F32E9", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x092A, Name: "VT\\_F32EA", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x092B, Name: "VT\\_F32EB", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x092C, Name: "VT\\_F32EC", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x092D, Name: "VT\\_F32ED", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x092E, Name: "VT\\_F32EE", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x092F, Name: "VT\\_F32EF", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0930, Name: "Activity Type: Unknown", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0931, Name: "Activity Type: Stationary", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0932, Name: "Activity Type: Fidgeting", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0933, Name: "Activity Type: Walking", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0934, Name: "Activity Type: Running", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0935, Name: "Activity Type: In Vehicle", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0936, Name: "Activity Type: Biking", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0937, Name: "Activity Type: Idle", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0940, Name: "Unit: Not Specified", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0941, Name: "Unit: Lux", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0942, Name: "Unit: Degrees Kelvin", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0943, Name: "Unit: Degrees Celsius", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0944, Name: "Unit: Pascal", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0945, Name: "Unit: Newton", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0946, Name: "Unit: Meters/Second", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0947, Name: "Unit: Kilogram", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0948, Name: "Unit: Meter", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0949, Name: "Unit: Meters/Second/Second", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x094A, Name: "Unit: Farad", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x094B, Name: "Unit: Ampere", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x094C, Name: "Unit: Watt", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x094D, Name: "Unit: Henry", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x094E, Name: "Unit: Ohm", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x094F, Name: "Unit: Volt", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0950, Name: "Unit: Hertz", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0951, Name: "Unit: Bar", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0952, Name: "Unit: Degrees Anti-clockwise", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0953, Name: "Unit: Degrees Clockwise", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0954, Name: "Unit: Degrees", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0955, Name: "Unit: Degrees/Second", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0956, Name: "Unit: Degrees/Second/Second", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0957, Name: "Unit: Knot", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0958, Name: "Unit: Percent", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0959, Name: "Unit: Second", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x095A, Name: "Unit: Millisecond", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x095B, Name: "Unit: G", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x095C, Name: "Unit: Bytes", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x095D, Name: "Unit: Milligauss", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x095E, Name: "Unit: Bits", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0960, Name: "Activity State: No State Change", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0961, Name: "Activity State: Start Activity", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0962, Name: "Activity State: End Activity", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0970, Name: "Exponent 0", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0971, Name: "Exponent 1", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0972, Name: "Exponent 2", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0973, Name: "Exponent 3", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0974, Name: "Exponent 4", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0975, Name: "Exponent 5", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0976, Name: "Exponent 6", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0977, Name: "Exponent 7", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0978, Name: "Exponent 8", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0979, Name: "Exponent 9", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x097A, Name: "Exponent A", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x097B, Name: "Exponent B", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x097C, Name: "Exponent C", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x097D, Name: "Exponent D", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x097E, Name: "Exponent E", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x097F, Name: "Exponent F", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0980, Name: "Device Position: Unknown", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0981, Name: "Device Position: Unchanged", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0982, Name: "Device Position: On Desk", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0983, Name: "Device Position: In Hand", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0984, Name: "Device Position: Moving in Bag", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0985, Name: "Device Position: Stationary in Bag", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0990, Name: "Step Type: Unknown", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0991, Name: "Step Type: Walking", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x0992, Name: "Step Type: Running", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x09A0, Name: "Gesture State: Unknown", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x09A1, Name: "Gesture State: Started", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x09A2, Name: "Gesture State: Completed", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x09A3, Name: "Gesture State: Cancelled", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x09B0, Name: "Hinge Fold Contributing Panel: Unknown", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x09B1, Name: "Hinge Fold Contributing Panel: Panel 1", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x09B2, Name: "Hinge Fold Contributing Panel: Panel 2", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x09B3, Name: "Hinge Fold Contributing Panel: Both", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x09B4, Name: "Hinge Fold Type: Unknown", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x09B5, Name: "Hinge Fold Type: Increasing", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x09B6, Name: "Hinge Fold Type: Decreasing", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x09C0, Name: "Human Presence Detection Type: Vendor-Defined Non-Biometric", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x09C1, Name: "Human Presence Detection Type: Vendor-Defined Biometric", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x09C2, Name: "Human Presence Detection Type: Facial Biometric", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x09C3, Name: "Human Presence Detection Type: Audio Biometric", Kinds: KIND_SEL},
+			{Page: 0x0020, ID: 0x1000, Name: "Modifier: Change Sensitivity Absolute", Kinds: KIND_US},
+			{Page: 0x0020, ID: 0x2000, Name: "Modifier: Maximum", Kinds: KIND_US},
+			{Page: 0x0020, ID: 0x3000, Name: "Modifier: Minimum", Kinds: KIND_US},
+			{Page: 0x0020, ID: 0x4000, Name: "Modifier: Accuracy", Kinds: KIND_US},
+			{Page: 0x0020, ID: 0x5000, Name: "Modifier: Resolution", Kinds: KIND_US},
+			{Page: 0x0020, ID: 0x6000, Name: "Modifier: Threshold High", Kinds: KIND_US},
+			{Page: 0x0020, ID: 0x7000, Name: "Modifier: Threshold Low", Kinds: KIND_US},
+			{Page: 0x0020, ID: 0x8000, Name: "Modifier: Calibration Offset", Kinds: KIND_US},
+			{Page: 0x0020, ID: 0x9000, Name: "Modifier: Calibration Multiplier", Kinds: KIND_US},
+			{Page: 0x0020, ID: 0xA000, Name: "Modifier: Report Interval", Kinds: KIND_US},
+			{Page: 0x0020, ID: 0xB000, Name: "Modifier: Frequency Max", Kinds: KIND_US},
+			{Page: 0x0020, ID: 0xC000, Name: "Modifier: Period Max", Kinds: KIND_US},
+			{Page: 0x0020, ID: 0xD000, Name: "Modifier: Change Sensitivity Percent of Range", Kinds: KIND_US},
+			{Page: 0x0020, ID: 0xE000, Name: "Modifier: Change Sensitivity Percent Relative", Kinds: KIND_US},
+			{Page: 0x0020, ID: 0xF000, Name: "Modifier: Vendor Reserved", Kinds: KIND_US},
+		},
+	},
+	{
+		ID:   0x0040,
+		Name: "Medical Instrument",
+		Usages: []Usage{
+			{Page: 0x0040, ID: 0x0001, Name: "Medical Ultrasound", Kinds: KIND_CA},
+			{Page: 0x0040, ID: 0x0020, Name: "VCR/Acquisition", Kinds: KIND_OOC},
+			{Page: 0x0040, ID: 0x0021, Name: "Freeze/Thaw", Kinds: KIND_OOC},
+			{Page: 0x0040, ID: 0x0022, Name: "Clip Store", Kinds: KIND_OSC},
+			{Page: 0x0040, ID: 0x0023, Name: "Update", Kinds: KIND_OSC},
+			{Page: 0x0040, ID: 0x0024, Name: "Next", Kinds: KIND_OSC},
+			{Page: 0x0040, ID: 0x0025, Name: "Save", Kinds: KIND_OSC},
+			{Page: 0x0040, ID: 0x0026, Name: "Print", Kinds: KIND_OSC},
+			{Page: 0x0040, ID: 0x0027, Name: "Microphone Enable", Kinds: KIND_OSC},
+			{Page: 0x0040, ID: 0x0040, Name: "Cine", Kinds: KIND_LC},
+			{Page: 0x0040, ID: 0x0041, Name: "Transmit Power", Kinds: KIND_LC},
+			{Page: 0x0040, ID: 0x0042, Name: "Volume", Kinds: KIND_LC},
+			{Page: 0x0040, ID: 0x0043, Name: "Focus", Kinds: KIND_LC},
+			{Page: 0x0040, ID: 0x0044, Name: "Depth", Kinds: KIND_LC},
+			{Page: 0x0040, ID: 0x0060, Name: "Soft Step - Primary", Kinds: KIND_LC},
+			{Page: 0x0040, ID: 0x0061, Name: "Soft Step - Secondary", Kinds: KIND_LC},
+			{Page: 0x0040, ID: 0x0070, Name: "Depth Gain Compensation", Kinds: KIND_LC},
+			{Page: 0x0040, ID: 0x0080, Name: "Zoom Select", Kinds: KIND_OSC},
+			{Page: 0x0040, ID: 0x0081, Name: "Zoom Adjust", Kinds: KIND_LC},
+			{Page: 0x0040, ID: 0x0082, Name: "Spectral Doppler Mode Select", Kinds: KIND_OSC},
+			{Page: 0x0040, ID: 0x0083, Name: "Spectral Doppler Adjust", Kinds: KIND_LC},
+			{Page: 0x0040, ID: 0x0084, Name: "Color Doppler Mode Select", Kinds: KIND_OSC},
+			{Page: 0x0040, ID: 0x0085, Name: "Color Doppler Adjust", Kinds: KIND_LC},
+			{Page: 0x0040, ID: 0x0086, Name: "Motion Mode Select", Kinds: KIND_OSC},
+			{Page: 0x0040, ID: 0x0087, Name: "Motion Mode Adjust", Kinds: KIND_LC},
+			{Page: 0x0040, ID: 0x0088, Name: "2-D Mode Select", Kinds: KIND_OSC},
+			{Page: 0x0040, ID: 0x0089, Name: "2-D Mode Adjust", Kinds: KIND_LC},
+			{Page: 0x0040, ID: 0x00A0, Name: "Soft Control Select", Kinds: KIND_OSC},
+			{Page: 0x0040, ID: 0x00A1, Name: "Soft Control Adjust", Kinds: KIND_LC},
+		},
+	},
+	{
+		ID:   0x0041,
+		Name: "Braille Display",
+		Usages: []Usage{
+			{Page: 0x0041, ID: 0x0001, Name: "Braille Display", Kinds: KIND_CA},
+			{Page: 0x0041, ID: 0x0002, Name: "Braille Row", Kinds: KIND_NARY},
+			{Page: 0x0041, ID: 0x0003, Name: "8 Dot Braille Cell", Kinds: KIND_DV},
+			{Page: 0x0041, ID: 0x0004, Name: "6 Dot Braille Cell", Kinds: KIND_DV},
+			{Page: 0x0041, ID: 0x0005, Name: "Number of Braille Cells", Kinds: KIND_DV},
+			{Page: 0x0041, ID: 0x0006, Name: "Screen Reader Control", Kinds: KIND_NARY},
+			{Page: 0x0041, ID: 0x0007, Name: "Screen Reader Identifier", Kinds: KIND_DV},
+			{Page: 0x0041, ID: 0x00FA, Name: "Router Set 1", Kinds: KIND_NARY},
+			{Page: 0x0041, ID: 0x00FB, Name: "Router Set 2", Kinds: KIND_NARY},
+			{Page: 0x0041, ID: 0x00FC, Name: "Router Set 3", Kinds: KIND_NARY},
+			{Page: 0x0041, ID: 0x0100, Name: "Router Key", Kinds: KIND_SEL},
+			{Page: 0x0041, ID: 0x0101, Name: "Row Router Key", Kinds: KIND_SEL},
+			{Page: 0x0041, ID: 0x0200, Name: "Braille Buttons", Kinds: KIND_NARY},
+			{Page: 0x0041, ID: 0x0201, Name: "Braille Keyboard Dot 1", Kinds: KIND_SEL},
+			{Page: 0x0041, ID: 0x0202, Name: "Braille Keyboard Dot 2", Kinds: KIND_SEL},
+			{Page: 0x0041, ID: 0x0203, Name: "Braille Keyboard Dot 3", Kinds: KIND_SEL},
+			{Page: 0x0041, ID: 0x0204, Name: "Braille Keyboard Dot 4", Kinds: KIND_SEL},
+			{Page: 0x0041, ID: 0x0205, Name: "Braille Keyboard Dot 5", Kinds: KIND_SEL},
+			{Page: 0x0041, ID: 0x0206, Name: "Braille Keyboard Dot 6", Kinds: KIND_SEL},
+			{Page: 0x0041, ID: 0x0207, Name: "Braille Keyboard Dot 7", Kinds: KIND_SEL},
+			{Page: 0x0041, ID: 0x0208, Name: "Braille Keyboard Dot 8", Kinds: KIND_SEL},
+			{Page: 0x0041, ID: 0x0209, Name: "Braille Keyboard Space", Kinds: KIND_SEL},
+			{Page: 0x0041, ID: 0x020A, Name: "Braille Keyboard Left Space", Kinds: KIND_SEL},
+			{Page: 0x0041, ID: 0x020B, Name: "Braille Keyboard Right Space", Kinds: KIND_SEL},
+			{Page: 0x0041, ID: 0x020C, Name: "Braille Face Controls", Kinds: KIND_NARY},
+			{Page: 0x0041, ID: 0x020D, Name: "Braille Left Controls", Kinds: KIND_NARY},
+			{Page: 0x0041, ID: 0x020E, Name: "Braille Right Controls", Kinds: KIND_NARY},
+			{Page: 0x0041, ID: 0x020F, Name: "Braille Top Controls", Kinds: KIND_NARY},
+			{Page: 0x0041, ID: 0x0210, Name: "Braille Joystick Center", Kinds: KIND_SEL},
+			{Page: 0x0041, ID: 0x0211, Name: "Braille Joystick Up", Kinds: KIND_SEL},
+			{Page: 0x0041, ID: 0x0212, Name: "Braille Joystick Down", Kinds: KIND_SEL},
+			{Page: 0x0041, ID: 0x0213, Name: "Braille Joystick Left", Kinds: KIND_SEL},
+			{Page: 0x0041, ID: 0x0214, Name: "Braille Joystick Right", Kinds: KIND_SEL},
+			{Page: 0x0041, ID: 0x0215, Name: "Braille D-Pad Center", Kinds: KIND_SEL},
+			{Page: 0x0041, ID: 0x0216, Name: "Braille D-Pad Up", Kinds: KIND_SEL},
+			{Page: 0x0041, ID: 0x0217, Name: "Braille D-Pad Down", Kinds: KIND_SEL},
+			{Page: 0x0041, ID: 0x0218, Name: "Braille D-Pad Left", Kinds: KIND_SEL},
+			{Page: 0x0041, ID: 0x0219, Name: "Braille D-Pad Right", Kinds: KIND_SEL},
+			{Page: 0x0041, ID: 0x021A, Name: "Braille Pan Left", Kinds: KIND_SEL},
+			{Page: 0x0041, ID: 0x021B, Name: "Braille Pan Right", Kinds: KIND_SEL},
+			{Page: 0x0041, ID: 0x021C, Name: "Braille Rocker Up", Kinds: KIND_SEL},
+			{Page: 0x0041, ID: 0x021D, Name: "Braille Rocker Down", Kinds: KIND_SEL},
+			{Page: 0x0041, ID: 0x021E, Name: "Braille Rocker Press", Kinds: KIND_SEL},
+		},
+	},
+	{
+		ID:   0x0059,
+		Name: "Lighting And Illumination",
+		Usages: []Usage{
+			{Page: 0x0059, ID: 0x0001, Name: "LampArray", Kinds: KIND_CA},
+			{Page: 0x0059, ID: 0x0002, Name: "LampArrayAttributesReport", Kinds: KIND_CL},
+			{Page: 0x0059, ID: 0x0003, Name: "LampCount", Kinds: KIND_SV | KIND_DV},
+			{Page: 0x0059, ID: 0x0004, Name: "BoundingBoxWidthInMicrometers", Kinds: KIND_SV},
+			{Page: 0x0059, ID: 0x0005, Name: "BoundingBoxHeightInMicrometers", Kinds: KIND_SV},
+			{Page: 0x0059, ID: 0x0006, Name: "BoundingBoxDepthInMicrometers", Kinds: KIND_SV},
+			{Page: 0x0059, ID: 0x0007, Name: "LampArrayKind", Kinds: KIND_SV},
+			{Page: 0x0059, ID: 0x0008, Name: "MinUpdateIntervalInMicroseconds", Kinds: KIND_SV},
+			{Page: 0x0059, ID: 0x0020, Name: "LampAttributesRequestReport", Kinds: KIND_CL},
+			{Page: 0x0059, ID: 0x0021, Name: "LampId", Kinds: KIND_SV | KIND_DV},
+			{Page: 0x0059, ID: 0x0022, Name: "LampAttributesResponseReport", Kinds: KIND_CL},
+			{Page: 0x0059, ID: 0x0023, Name: "PositionXInMicrometers", Kinds: KIND_DV},
+			{Page: 0x0059, ID: 0x0024, Name: "PositionYInMicrometers", Kinds: KIND_DV},
+			{Page: 0x0059, ID: 0x0025, Name: "PositionZInMicrometers", Kinds: KIND_DV},
+			{Page: 0x0059, ID: 0x0026, Name: "LampPurposes", Kinds: KIND_DV},
+			{Page: 0x0059, ID: 0x0027, Name: "UpdateLatencyInMicroseconds", Kinds: KIND_DV},
+			{Page: 0x0059, ID: 0x0028, Name: "RedLevelCount", Kinds: KIND_DV},
+			{Page: 0x0059, ID: 0x0029, Name: "GreenLevelCount", Kinds: KIND_DV},
+			{Page: 0x0059, ID: 0x002A, Name: "BlueLevelCount", Kinds: KIND_DV},
+			{Page: 0x0059, ID: 0x002B, Name: "IntensityLevelCount", Kinds: KIND_DV},
+			{Page: 0x0059, ID: 0x002C, Name: "IsProgrammable", Kinds: KIND_DV},
+			{Page: 0x0059, ID: 0x002D, Name: "InputBinding", Kinds: KIND_DV},
+			{Page: 0x0059, ID: 0x0050, Name: "LampMultiUpdateReport", Kinds: KIND_CL},
+			{Page: 0x0059, ID: 0x0051, Name: "RedUpdateChannel", Kinds: KIND_DV},
+			{Page: 0x0059, ID: 0x0052, Name: "GreenUpdateChannel", Kinds: KIND_DV},
+			{Page: 0x0059, ID: 0x0053, Name: "BlueUpdateChannel", Kinds: KIND_DV},
+			{Page: 0x0059, ID: 0x0054, Name: "IntensityUpdateChannel", Kinds: KIND_DV},
+			{Page: 0x0059, ID: 0x0055, Name: "LampUpdateFlags", Kinds: KIND_DV},
+			{Page: 0x0059, ID: 0x0060, Name: "LampRangeUpdateReport", Kinds: KIND_CL},
+			{Page: 0x0059, ID: 0x0061, Name: "LampIdStart", Kinds: KIND_DV},
+			{Page: 0x0059, ID: 0x0062, Name: "LampIdEnd", Kinds: KIND_DV},
+			{Page: 0x0059, ID: 0x0070, Name: "LampArrayControlReport", Kinds: KIND_CL},
+			{Page: 0x0059, ID: 0x0071, Name: "AutonomousMode", Kinds: KIND_DV},
+		},
+	},
+	{
+		ID:   0x0080,
+		Name: "Monitor",
+		Usages: []Usage{
+			{Page: 0x0080, ID: 0x0001, Name: "Monitor Control", Kinds: KIND_CA},
+			{Page: 0x0080, ID: 0x0002, Name: "EDID Information", Kinds: KIND_SV},
+			{Page: 0x0080, ID: 0x0003, Name: "VDIF Information", Kinds: KIND_SV},
+			{Page: 0x0080, ID: 0x0004, Name: "VESA Version", Kinds: KIND_SV},
+		},
+	},
+	{
+		ID:        0x0081,
+		Name:      "Monitor Enumerated",
+		Generator: &Generator{Prefix: "Enum", StartID: 0x0001, EndID: 0xFFFF, Kinds: KIND_SEL},
+	},
+	{
+		ID:   0x0082,
+		Name: "VESA Virtual Controls",
+		Usages: []Usage{
+			{Page: 0x0082, ID: 0x0001, Name: "Degauss", Kinds: KIND_DV},
+			{Page: 0x0082, ID: 0x0010, Name: "Brightness", Kinds: KIND_DV},
+			{Page: 0x0082, ID: 0x0012, Name: "Contrast", Kinds: KIND_DV},
+			{Page: 0x0082, ID: 0x0016, Name: "Red Video Gain", Kinds: KIND_DV},
+			{Page: 0x0082, ID: 0x0018, Name: "Green Video Gain", Kinds: KIND_DV},
+			{Page: 0x0082, ID: 0x001A, Name: "Blue Video Gain", Kinds: KIND_DV},
+			{Page: 0x0082, ID: 0x001C, Name: "Focus", Kinds: KIND_DV},
+			{Page: 0x0082, ID: 0x0020, Name: "Horizontal Position", Kinds: KIND_DV},
+			{Page: 0x0082, ID: 0x0022, Name: "Horizontal Size", Kinds: KIND_DV},
+			{Page: 0x0082, ID: 0x0024, Name: "Horizontal Pincushion", Kinds: KIND_DV},
+			{Page: 0x0082, ID: 0x0026, Name: "Horizontal Pincushion Balance", Kinds: KIND_DV},
+			{Page: 0x0082, ID: 0x0028, Name: "Horizontal Misconvergence", Kinds: KIND_DV},
+			{Page: 0x0082, ID: 0x002A, Name: "Horizontal Linearity", Kinds: KIND_DV},
+			{Page: 0x0082, ID: 0x002C, Name: "Horizontal Linearity Balance", Kinds: KIND_DV},
+			{Page: 0x0082, ID: 0x0030, Name: "Vertical Position", Kinds: KIND_DV},
+			{Page: 0x0082, ID: 0x0032, Name: "Vertical Size", Kinds: KIND_DV},
+			{Page: 0x0082, ID: 0x0034, Name: "Vertical Pincushion", Kinds: KIND_DV},
+			{Page: 0x0082, ID: 0x0036, Name: "Vertical Pincushion Balance", Kinds: KIND_DV},
+			{Page: 0x0082, ID: 0x0038, Name: "Vertical Misconvergence", Kinds: KIND_DV},
+			{Page: 0x0082, ID: 0x003A, Name: "Vertical Linearity", Kinds: KIND_DV},
+			{Page: 0x0082, ID: 0x003C, Name: "Vertical Linearity Balance", Kinds: KIND_DV},
+			{Page: 0x0082, ID: 0x0040, Name: "Parallelogram Distortion (Key Balance)", Kinds: KIND_DV},
+			{Page: 0x0082, ID: 0x0042, Name: "Trapezoidal Distortion (Key)", Kinds: KIND_DV},
+			{Page: 0x0082, ID: 0x0044, Name: "Tilt (Rotation)", Kinds: KIND_DV},
+			{Page: 0x0082, ID: 0x0046, Name: "Top Corner Distortion Control", Kinds: KIND_DV},
+			{Page: 0x0082, ID: 0x0048, Name: "Top Corner Distortion Balance", Kinds: KIND_DV},
+			{Page: 0x0082, ID: 0x004A, Name: "Bottom Corner Distortion Control", Kinds: KIND_DV},
+			{Page: 0x0082, ID: 0x004C, Name: "Bottom Corner Distortion Balance", Kinds: KIND_DV},
+			{Page: 0x0082, ID: 0x0056, Name: "Horizontal Moiré", Kinds: KIND_DV},
+			{Page: 0x0082, ID: 0x0058, Name: "Vertical Moiré", Kinds: KIND_DV},
+			{Page: 0x0082, ID: 0x005E, Name: "Input Level Select", Kinds: KIND_NARY},
+			{Page: 0x0082, ID: 0x0060, Name: "Input Source Select", Kinds: KIND_NARY},
+			{Page: 0x0082, ID: 0x006C, Name: "Red Video Black Level", Kinds: KIND_DV},
+			{Page: 0x0082, ID: 0x006E, Name: "Green Video Black Level", Kinds: KIND_DV},
+			{Page: 0x0082, ID: 0x0070, Name: "Blue Video Black Level", Kinds: KIND_DV},
+			{Page: 0x0082, ID: 0x00A2, Name: "Auto Size Center", Kinds: KIND_NARY},
+			{Page: 0x0082, ID: 0x00A4, Name: "Polarity Horizontal Synchronization", Kinds: KIND_NARY},
+			{Page: 0x0082, ID: 0x00A6, Name: "Polarity Vertical Synchronization", Kinds: KIND_NARY},
+			{Page: 0x0082, ID: 0x00A8, Name: "Synchronization Type", Kinds: KIND_NARY},
+			{Page: 0x0082, ID: 0x00AA, Name: "Screen Orientation", Kinds: KIND_NARY},
+			{Page: 0x0082, ID: 0x00AC, Name: "Horizontal Frequency", Kinds: KIND_DV},
+			{Page: 0x0082, ID: 0x00AE, Name: "Vertical Frequency", Kinds: KIND_DV},
+			{Page: 0x0082, ID: 0x00B0, Name: "Settings", Kinds: KIND_NARY},
+			{Page: 0x0082, ID: 0x00CA, Name: "On Screen Display", Kinds: KIND_NARY},
+			{Page: 0x0082, ID: 0x00D4, Name: "Stereo Mode", Kinds: KIND_NARY},
+		},
+	},
+	{
+		ID:   0x0084,
+		Name: "Power",
+		Usages: []Usage{
+			{Page: 0x0084, ID: 0x0001, Name: "iName", Kinds: KIND_SV},
+			{Page: 0x0084, ID: 0x0002, Name: "Present Status", Kinds: KIND_CL},
+			{Page: 0x0084, ID: 0x0003, Name: "Changed Status", Kinds: KIND_CL},
+			{Page: 0x0084, ID: 0x0004, Name: "UPS", Kinds: KIND_CA},
+			{Page: 0x0084, ID: 0x0005, Name: "Power Supply", Kinds: KIND_CA},
+			{Page: 0x0084, ID: 0x0010, Name: "Battery System", Kinds: KIND_CP},
+			{Page: 0x0084, ID: 0x0011, Name: "Battery System Id", Kinds: KIND_SV},
+			{Page: 0x0084, ID: 0x0012, Name: "Battery", Kinds: KIND_CP},
+			{Page: 0x0084, ID: 0x0013, Name: "Battery Id", Kinds: KIND_SV},
+			{Page: 0x0084, ID: 0x0014, Name: "Charger", Kinds: KIND_CP},
+			{Page: 0x0084, ID: 0x0015, Name: "Charger Id", Kinds: KIND_SV},
+			{Page: 0x0084, ID: 0x0016, Name: "Power Converter", Kinds: KIND_CP},
+			{Page: 0x0084, ID: 0x0017, Name: "Power Converter Id", Kinds: KIND_SV},
+			{Page: 0x0084, ID: 0x0018, Name: "Outlet System", Kinds: KIND_CP},
+			{Page: 0x0084, ID: 0x0019, Name: "Outlet System Id", Kinds: KIND_SV},
+			{Page: 0x0084, ID: 0x001A, Name: "Input", Kinds: KIND_CP},
+			{Page: 0x0084, ID: 0x001B, Name: "Input Id", Kinds: KIND_SV},
+			{Page: 0x0084, ID: 0x001C, Name: "Output", Kinds: KIND_CP},
+			{Page: 0x0084, ID: 0x001D, Name: "Output Id", Kinds: KIND_SV},
+			{Page: 0x0084, ID: 0x001E, Name: "Flow", Kinds: KIND_CP},
+			{Page: 0x0084, ID: 0x001F, Name: "Flow Id", Kinds: KIND_SV},
+			{Page: 0x0084, ID: 0x0020, Name: "Outlet", Kinds: KIND_CP},
+			{Page: 0x0084, ID: 0x0021, Name: "Outlet Id", Kinds: KIND_SV},
+			{Page: 0x0084, ID: 0x0022, Name: "Gang", Kinds: KIND_CL | KIND_CP},
+			{Page: 0x0084, ID: 0x0023, Name: "Gang Id", Kinds: KIND_SV},
+			{Page: 0x0084, ID: 0x0024, Name: "Power Summary", Kinds: KIND_CL | KIND_CP},
+			{Page: 0x0084, ID: 0x0025, Name: "Power Summary Id", Kinds: KIND_SV},
+			{Page: 0x0084, ID: 0x0030, Name: "Voltage", Kinds: KIND_DV},
+			{Page: 0x0084, ID: 0x0031, Name: "Current", Kinds: KIND_DV},
+			{Page: 0x0084, ID: 0x0032, Name: "Frequency", Kinds: KIND_DV},
+			{Page: 0x0084, ID: 0x0033, Name: "Apparent Power", Kinds: KIND_DV},
+			{Page: 0x0084, ID: 0x0034, Name: "Active Power", Kinds: KIND_DV},
+			{Page: 0x0084, ID: 0x0035, Name: "Percent Load", Kinds: KIND_DV},
+			{Page: 0x0084, ID: 0x0036, Name: "Temperature", Kinds: KIND_DV},
+			{Page: 0x0084, ID: 0x0037, Name: "Humidity", Kinds: KIND_DV},
+			{Page: 0x0084, ID: 0x0038, Name: "Bad Count", Kinds: KIND_DV},
+			{Page: 0x0084, ID: 0x0040, Name: "Config Voltage", Kinds: KIND_SV | KIND_DV},
+			{Page: 0x0084, ID: 0x0041, Name: "Config Current", Kinds: KIND_SV | KIND_DV},
+			{Page: 0x0084, ID: 0x0042, Name: "Config Frequency", Kinds: KIND_SV | KIND_DV},
+			{Page: 0x0084, ID: 0x0043, Name: "Config Apparent Power", Kinds: KIND_SV | KIND_DV},
+			{Page: 0x0084, ID: 0x0044, Name: "Config Active Power", Kinds: KIND_SV | KIND_DV},
+			{Page: 0x0084, ID: 0x0045, Name: "Config Percent Load", Kinds: KIND_SV | KIND_DV},
+			{Page: 0x0084, ID: 0x0046, Name: "Config Temperature", Kinds: KIND_SV | KIND_DV},
+			{Page: 0x0084, ID: 0x0047, Name: "Config Humidity", Kinds: KIND_SV | KIND_DV},
+			{Page: 0x0084, ID: 0x0050, Name: "Switch On Control", Kinds: KIND_DV},
+			{Page: 0x0084, ID: 0x0051, Name: "Switch Off Control", Kinds: KIND_DV},
+			{Page: 0x0084, ID: 0x0052, Name: "Toggle Control", Kinds: KIND_DV},
+			{Page: 0x0084, ID: 0x0053, Name: "Low Voltage Transfer", Kinds: KIND_DV},
+			{Page: 0x0084, ID: 0x0054, Name: "High Voltage Transfer", Kinds: KIND_DV},
+			{Page: 0x0084, ID: 0x0055, Name: "Delay Before Reboot", Kinds: KIND_DV},
+			{Page: 0x0084, ID: 0x0056, Name: "Delay Before Startup", Kinds: KIND_DV},
+			{Page: 0x0084, ID: 0x0057, Name: "Delay Before Shutdown", Kinds: KIND_DV},
+			{Page: 0x0084, ID: 0x0058, Name: "Test", Kinds: KIND_DV},
+			{Page: 0x0084, ID: 0x0059, Name: "Module Reset", Kinds: KIND_DV},
+			{Page: 0x0084, ID: 0x005A, Name: "Audible Alarm Control", Kinds: KIND_DV},
+			{Page: 0x0084, ID: 0x0060, Name: "Present", Kinds: KIND_DF},
+			{Page: 0x0084, ID: 0x0061, Name: "Good", Kinds: KIND_DF},
+			{Page: 0x0084, ID: 0x0062, Name: "Internal Failure", Kinds: KIND_DF},
+			{Page: 0x0084, ID: 0x0063, Name: "Voltag Out Of Range", Kinds: KIND_DF},
+			{Page: 0x0084, ID: 0x0064, Name: "Frequency Out Of Range", Kinds: KIND_DF},
+			{Page: 0x0084, ID: 0x0065, Name: "Overload", Kinds: KIND_DF},
+			{Page: 0x0084, ID: 0x0066, Name: "Over Charged", Kinds: KIND_DF},
+			{Page: 0x0084, ID: 0x0067, Name: "Over Temperature", Kinds: KIND_DF},
+			{Page: 0x0084, ID: 0x0068, Name: "Shutdown Requested", Kinds: KIND_DF},
+			{Page: 0x0084, ID: 0x0069, Name: "Shutdown Imminent", Kinds: KIND_DF},
+			{Page: 0x0084, ID: 0x006B, Name: "Switch On/Off", Kinds: KIND_DF},
+			{Page: 0x0084, ID: 0x006C, Name: "Switchable", Kinds: KIND_DF},
+			{Page: 0x0084, ID: 0x006D, Name: "Used", Kinds: KIND_DF},
+			{Page: 0x0084, ID: 0x006E, Name: "Boost", Kinds: KIND_DF},
+			{Page: 0x0084, ID: 0x006F, Name: "Buck", Kinds: KIND_DF},
+			{Page: 0x0084, ID: 0x0070, Name: "Initialized", Kinds: KIND_DF},
+			{Page: 0x0084, ID: 0x0071, Name: "Tested", Kinds: KIND_DF},
+			{Page: 0x0084, ID: 0x0072, Name: "Awaiting Power", Kinds: KIND_DF},
+			{Page: 0x0084, ID: 0x0073, Name: "Communication Lost", Kinds: KIND_DF},
+			{Page: 0x0084, ID: 0x00FD, Name: "iManufacturer", Kinds: KIND_SV},
+			{Page: 0x0084, ID: 0x00FE, Name: "iProduct", Kinds: KIND_SV},
+			{Page: 0x0084, ID: 0x00FF, Name: "iSerialNumber", Kinds: KIND_SV},
+		},
+	},
+	{
+		ID:   0x0085,
+		Name: "Battery System",
+		Usages: []Usage{
+			{Page: 0x0085, ID: 0x0001, Name: "Smart Battery Battery Mode", Kinds: KIND_CL},
+			{Page: 0x0085, ID: 0x0002, Name: "Smart Battery Battery Status", Kinds: KIND_NARY},
+			{Page: 0x0085, ID: 0x0003, Name: "Smart Battery Alarm Warning", Kinds: KIND_NARY},
+			{Page: 0x0085, ID: 0x0004, Name: "Smart Battery Charger Mode", Kinds: KIND_CL},
+			{Page: 0x0085, ID: 0x0005, Name: "Smart Battery Charger Status", Kinds: KIND_CL},
+			{Page: 0x0085, ID: 0x0006, Name: "Smart Battery Charger Spec Info", Kinds: KIND_CL},
+			{Page: 0x0085, ID: 0x0007, Name: "Smart Battery Selector State", Kinds: KIND_CL},
+			{Page: 0x0085, ID: 0x0008, Name: "Smart Battery Selector Presets", Kinds: KIND_CL},
+			{Page: 0x0085, ID: 0x0009, Name: "Smart Battery Selector Info", Kinds: KIND_CL},
+			{Page: 0x0085, ID: 0x0010, Name: "Optional Mfg Function 1", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x0011, Name: "Optional Mfg Function 2", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x0012, Name: "Optional Mfg Function 3", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x0013, Name: "Optional Mfg Function 4", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x0014, Name: "Optional Mfg Function 5", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x0015, Name: "Connection To SM Bus", Kinds: KIND_DF},
+			{Page: 0x0085, ID: 0x0016, Name: "Output Connection", Kinds: KIND_DF},
+			{Page: 0x0085, ID: 0x0017, Name: "Charger Connection", Kinds: KIND_DF},
+			{Page: 0x0085, ID: 0x0018, Name: "Battery Insertion", Kinds: KIND_DF},
+			{Page: 0x0085, ID: 0x0019, Name: "Use Next", Kinds: KIND_DF},
+			{Page: 0x0085, ID: 0x001A, Name: "OK To Use", Kinds: KIND_DF},
+			{Page: 0x0085, ID: 0x001B, Name: "Battery Supported", Kinds: KIND_DF},
+			{Page: 0x0085, ID: 0x001C, Name: "Selector Revision", Kinds: KIND_DF},
+			{Page: 0x0085, ID: 0x001D, Name: "Charging Indicator", Kinds: KIND_DF},
+			{Page: 0x0085, ID: 0x0028, Name: "Manufacturer Access", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x0029, Name: "Remaining Capacity Limit", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x002A, Name: "Remaining Time Limit", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x002B, Name: "At Rate", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x002C, Name: "Capacity Mode", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x002D, Name: "Broadcast To Charger", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x002E, Name: "Primary Battery", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x002F, Name: "Charge Controller", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x0040, Name: "Terminate Charge", Kinds: KIND_SEL},
+			{Page: 0x0085, ID: 0x0041, Name: "Terminate Discharge", Kinds: KIND_SEL},
+			{Page: 0x0085, ID: 0x0042, Name: "Below Remaining Capacity Limit", Kinds: KIND_SEL},
+			{Page: 0x0085, ID: 0x0043, Name: "Remaining Time Limit Expired", Kinds: KIND_SEL},
+			{Page: 0x0085, ID: 0x0044, Name: "Charging", Kinds: KIND_SEL},
+			{Page: 0x0085, ID: 0x0045, Name: "Discharging", Kinds: KIND_SEL},
+			{Page: 0x0085, ID: 0x0046, Name: "Fully Charged", Kinds: KIND_SEL},
+			{Page: 0x0085, ID: 0x0047, Name: "Fully Discharged", Kinds: KIND_SEL},
+			{Page: 0x0085, ID: 0x0048, Name: "Conditioning Flag", Kinds: KIND_DF},
+			{Page: 0x0085, ID: 0x0049, Name: "At Rate OK", Kinds: KIND_DF},
+			{Page: 0x0085, ID: 0x004A, Name: "Smart Battery Error Code", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x004B, Name: "Need Replacement", Kinds: KIND_DF},
+			{Page: 0x0085, ID: 0x0060, Name: "At Rate Time To Full", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x0061, Name: "At Rate Time To Empty", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x0062, Name: "Average Current", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x0063, Name: "Max Error", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x0064, Name: "Relative State Of Charge", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x0065, Name: "Absolute State Of Charge", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x0066, Name: "Remaining Capacity", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x0067, Name: "Full Charge Capacity", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x0068, Name: "Run Time To Empty", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x0069, Name: "Average Time To Empty", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x006A, Name: "Average Time To Full", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x006B, Name: "Cycle Count", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x0080, Name: "Battery Pack Model Level", Kinds: KIND_SV},
+			{Page: 0x0085, ID: 0x0081, Name: "Internal Charge Controller", Kinds: KIND_SF},
+			{Page: 0x0085, ID: 0x0082, Name: "Primary Battery Support", Kinds: KIND_SF},
+			{Page: 0x0085, ID: 0x0083, Name: "Design Capacity", Kinds: KIND_SV},
+			{Page: 0x0085, ID: 0x0084, Name: "Specification Info", Kinds: KIND_SV},
+			{Page: 0x0085, ID: 0x0085, Name: "Manufacture Date", Kinds: KIND_SV},
+			{Page: 0x0085, ID: 0x0086, Name: "Serial Number", Kinds: KIND_SV},
+			{Page: 0x0085, ID: 0x0087, Name: "iManufacturer Name", Kinds: KIND_SV},
+			{Page: 0x0085, ID: 0x0088, Name: "iDevice Name", Kinds: KIND_SV},
+			{Page: 0x0085, ID: 0x0089, Name: "iDevice Chemistry", Kinds: KIND_SV},
+			{Page: 0x0085, ID: 0x008A, Name: "Manufacturer Data", Kinds: KIND_SV},
+			{Page: 0x0085, ID: 0x008B, Name: "Rechargable", Kinds: KIND_SV},
+			{Page: 0x0085, ID: 0x008C, Name: "Warning Capacity Limit", Kinds: KIND_SV},
+			{Page: 0x0085, ID: 0x008D, Name: "Capacity Granularity 1", Kinds: KIND_SV},
+			{Page: 0x0085, ID: 0x008E, Name: "Capacity Granularity 2", Kinds: KIND_SV},
+			{Page: 0x0085, ID: 0x008F, Name: "iOEM Information", Kinds: KIND_SV},
+			{Page: 0x0085, ID: 0x00C0, Name: "Inhibit Charge", Kinds: KIND_DF},
+			{Page: 0x0085, ID: 0x00C1, Name: "Enable Polling", Kinds: KIND_DF},
+			{Page: 0x0085, ID: 0x00C2, Name: "Reset To Zero", Kinds: KIND_DF},
+			{Page: 0x0085, ID: 0x00D0, Name: "AC Present", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x00D1, Name: "Battery Present", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x00D2, Name: "Power Fail", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x00D3, Name: "Alarm Inhibited", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x00D4, Name: "Thermistor Under Range", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x00D5, Name: "Thermistor Hot", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x00D6, Name: "Thermistor Cold", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x00D7, Name: "Thermistor Over Range", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x00D8, Name: "Voltage Out Of Range", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x00D9, Name: "Current Out Of Range", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x00DA, Name: "Current Not Regulated", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x00DB, Name: "Voltage Not Regulated", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x00DC, Name: "Master Mode", Kinds: KIND_DV},
+			{Page: 0x0085, ID: 0x00F0, Name: "Charger Selector Support", Kinds: KIND_SF},
+			{Page: 0x0085, ID: 0x00F1, Name: "Charger Spec", Kinds: KIND_SV},
+			{Page: 0x0085, ID: 0x00F2, Name: "Level 2", Kinds: KIND_SF},
+			{Page: 0x0085, ID: 0x00F3, Name: "Level 3", Kinds: KIND_SF},
+		},
+	},
+	{
+		ID:   0x008C,
+		Name: "Barcode Scanner",
+		Usages: []Usage{
+			{Page: 0x008C, ID: 0x0001, Name: "Barcode Badge Reader", Kinds: KIND_CA},
+			{Page: 0x008C, ID: 0x0002, Name: "Barcode Scanner", Kinds: KIND_CA},
+			{Page: 0x008C, ID: 0x0003, Name: "Dumb Bar Code Scanner", Kinds: KIND_CA},
+			{Page: 0x008C, ID: 0x0004, Name: "Cordless Scanner Base", Kinds: KIND_CA},
+			{Page: 0x008C, ID: 0x0005, Name: "Bar Code Scanner Cradle", Kinds: KIND_CA},
+			{Page: 0x008C, ID: 0x0010, Name: "Attribute Report", Kinds: KIND_CL},
+			{Page: 0x008C, ID: 0x0011, Name: "Settings Report", Kinds: KIND_CL},
+			{Page: 0x008C, ID: 0x0012, Name: "Scanned Data Report", Kinds: KIND_CL},
+			{Page: 0x008C, ID: 0x0013, Name: "Raw Scanned Data Report", Kinds: KIND_CL},
+			{Page: 0x008C, ID: 0x0014, Name: "Trigger Report", Kinds: KIND_CL},
+			{Page: 0x008C, ID: 0x0015, Name: "Status Report", Kinds: KIND_CL},
+			{Page: 0x008C, ID: 0x0016, Name: "UPC/EAN Control Report", Kinds: KIND_CL},
+			{Page: 0x008C, ID: 0x0017, Name: "EAN 2/3 Label Control Report", Kinds: KIND_CL},
+			{Page: 0x008C, ID: 0x0018, Name: "Code 39 Control Report", Kinds: KIND_CL},
+			{Page: 0x008C, ID: 0x0019, Name: "Interleaved 2 of 5 Control Report", Kinds: KIND_CL},
+			{Page: 0x008C, ID: 0x001A, Name: "Standard 2 of 5 Control Report", Kinds: KIND_CL},
+			{Page: 0x008C, ID: 0x001B, Name: "MSI Plessey Control Report", Kinds: KIND_CL},
+			{Page: 0x008C, ID: 0x001C, Name: "Codabar Control Report", Kinds: KIND_CL},
+			{Page: 0x008C, ID: 0x001D, Name: "Code 128 Control Report", Kinds: KIND_CL},
+			{Page: 0x008C, ID: 0x001E, Name: "Misc 1D Control Report", Kinds: KIND_CL},
+			{Page: 0x008C, ID: 0x001F, Name: "2D Control Report", Kinds: KIND_CL},
+			{Page: 0x008C, ID: 0x0030, Name: "Aiming/Pointer Mode", Kinds: KIND_SF},
+			{Page: 0x008C, ID: 0x0031, Name: "Bar Code Present Sensor", Kinds: KIND_SF},
+			{Page: 0x008C, ID: 0x0032, Name: "Class 1A Laser", Kinds: KIND_SF},
+			{Page: 0x008C, ID: 0x0033, Name: "Class 2 Laser", Kinds: KIND_SF},
+			{Page: 0x008C, ID: 0x0034, Name: "Heater Present", Kinds: KIND_SF},
+			{Page: 0x008C, ID: 0x0035, Name: "Contact Scanner", Kinds: KIND_SF},
+			{Page: 0x008C, ID: 0x0036, Name: "Electronic Article Surveillance Notification", Kinds: KIND_SF},
+			{Page: 0x008C, ID: 0x0037, Name: "Constant Electronic Article Surveillance", Kinds: KIND_SF},
+			{Page: 0x008C, ID: 0x0038, Name: "Error Indication", Kinds: KIND_SF},
+			{Page: 0x008C, ID: 0x0039, Name: "Fixed Beeper", Kinds: KIND_SF},
+			{Page: 0x008C, ID: 0x003A, Name: "Good Decode Indication", Kinds: KIND_SF},
+			{Page: 0x008C, ID: 0x003B, Name: "Hands Free Scanning", Kinds: KIND_SF},
+			{Page: 0x008C, ID: 0x003C, Name: "Intrinsically Safe", Kinds: KIND_SF},
+			{Page: 0x008C, ID: 0x003D, Name: "Klasse Eins Laser", Kinds: KIND_SF},
+			{Page: 0x008C, ID: 0x003E, Name: "Long Range Scanner", Kinds: KIND_SF},
+			{Page: 0x008C, ID: 0x003F, Name: "Mirror Speed Control", Kinds: KIND_SF},
+			{Page: 0x008C, ID: 0x0040, Name: "Not On File Indication", Kinds: KIND_SF},
+			{Page: 0x008C, ID: 0x0041, Name: "Programmable Beeper", Kinds: KIND_SF},
+			{Page: 0x008C, ID: 0x0042, Name: "Triggerless", Kinds: KIND_SF},
+			{Page: 0x008C, ID: 0x0043, Name: "Wand", Kinds: KIND_SF},
+			{Page: 0x008C, ID: 0x0044, Name: "Water Resistant", Kinds: KIND_SF},
+			{Page: 0x008C, ID: 0x0045, Name: "Multi-Range Scanner", Kinds: KIND_SF},
+			{Page: 0x008C, ID: 0x0046, Name: "Proximity Sensor", Kinds: KIND_SF},
+			{Page: 0x008C, ID: 0x004D, Name: "Fragment Decoding", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x004E, Name: "Scanner Read Confidence", Kinds: KIND_DV},
+			{Page: 0x008C, ID: 0x004F, Name: "Data Prefix", Kinds: KIND_NARY},
+			{Page: 0x008C, ID: 0x0050, Name: "Prefix AIMI", Kinds: KIND_SEL},
+			{Page: 0x008C, ID: 0x0051, Name: "Prefix None", Kinds: KIND_SEL},
+			{Page: 0x008C, ID: 0x0052, Name: "Prefix Proprietary", Kinds: KIND_SEL},
+			{Page: 0x008C, ID: 0x0055, Name: "Active Time", Kinds: KIND_DV},
+			{Page: 0x008C, ID: 0x0056, Name: "Aiming Laser Pattern", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x0057, Name: "Bar Code Present", Kinds: KIND_OOC},
+			{Page: 0x008C, ID: 0x0058, Name: "Beeper State", Kinds: KIND_OOC},
+			{Page: 0x008C, ID: 0x0059, Name: "Laser On Time", Kinds: KIND_DV},
+			{Page: 0x008C, ID: 0x005A, Name: "Laser State", Kinds: KIND_OOC},
+			{Page: 0x008C, ID: 0x005B, Name: "Lockout Time", Kinds: KIND_DV},
+			{Page: 0x008C, ID: 0x005C, Name: "Motor State", Kinds: KIND_OOC},
+			{Page: 0x008C, ID: 0x005D, Name: "Motor Timeout", Kinds: KIND_DV},
+			{Page: 0x008C, ID: 0x005E, Name: "Power On Reset Scanner", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x005F, Name: "Prevent Read of Barcodes", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x0060, Name: "Initiate Barcode Read", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x0061, Name: "Trigger State", Kinds: KIND_OOC},
+			{Page: 0x008C, ID: 0x0062, Name: "Trigger Mode", Kinds: KIND_NARY},
+			{Page: 0x008C, ID: 0x0063, Name: "Trigger Mode Blinking Laser On", Kinds: KIND_SEL},
+			{Page: 0x008C, ID: 0x0064, Name: "Trigger Mode Continuous Laser On", Kinds: KIND_SEL},
+			{Page: 0x008C, ID: 0x0065, Name: "Trigger Mode Laser on while Pulled", Kinds: KIND_SEL},
+			{Page: 0x008C, ID: 0x0066, Name: "Trigger Mode Laser stays on after release", Kinds: KIND_SEL},
+			{Page: 0x008C, ID: 0x006D, Name: "Commit Parameters to NVM", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x006E, Name: "Parameter Scanning", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x006F, Name: "Parameters Changed", Kinds: KIND_OOC},
+			{Page: 0x008C, ID: 0x0070, Name: "Set parameter default values", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x0075, Name: "Scanner In Cradle", Kinds: KIND_OOC},
+			{Page: 0x008C, ID: 0x0076, Name: "Scanner In Range", Kinds: KIND_OOC},
+			{Page: 0x008C, ID: 0x007A, Name: "Aim Duration", Kinds: KIND_DV},
+			{Page: 0x008C, ID: 0x007B, Name: "Good Read Lamp Duration", Kinds: KIND_DV},
+			{Page: 0x008C, ID: 0x007C, Name: "Good Read Lamp Intensity", Kinds: KIND_DV},
+			{Page: 0x008C, ID: 0x007D, Name: "Good Read LED", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x007E, Name: "Good Read Tone Frequency", Kinds: KIND_DV},
+			{Page: 0x008C, ID: 0x007F, Name: "Good Read Tone Length", Kinds: KIND_DV},
+			{Page: 0x008C, ID: 0x0080, Name: "Good Read Tone Volume", Kinds: KIND_DV},
+			{Page: 0x008C, ID: 0x0082, Name: "No Read Message", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x0083, Name: "Not on File Volume", Kinds: KIND_DV},
+			{Page: 0x008C, ID: 0x0084, Name: "Powerup Beep", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x0085, Name: "Sound Error Beep", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x0086, Name: "Sound Good Read Beep", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x0087, Name: "Sound Not On File Beep", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x0088, Name: "Good Read When to Write", Kinds: KIND_NARY},
+			{Page: 0x008C, ID: 0x0089, Name: "GRWTI After Decode", Kinds: KIND_SEL},
+			{Page: 0x008C, ID: 0x008A, Name: "GRWTI Beep/Lamp after transmit", Kinds: KIND_SEL},
+			{Page: 0x008C, ID: 0x008B, Name: "GRWTI No Beep/Lamp use at all", Kinds: KIND_SEL},
+			{Page: 0x008C, ID: 0x0091, Name: "Bookland EAN", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x0092, Name: "Convert EAN 8 to 13 Type", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x0093, Name: "Convert UPC A to EAN-13", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x0094, Name: "Convert UPC-E to A", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x0095, Name: "EAN-13", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x0096, Name: "EAN-8", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x0097, Name: "EAN-99 128 Mandatory", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x0098, Name: "EAN-99 P5/128 Optional", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x0099, Name: "Enable EAN Two Label", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x009A, Name: "UPC/EAN", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x009B, Name: "UPC/EAN Coupon Code", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x009C, Name: "UPC/EAN Periodicals", Kinds: KIND_DV},
+			{Page: 0x008C, ID: 0x009D, Name: "UPC-A", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x009E, Name: "UPC-A with 128 Mandatory", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x009F, Name: "UPC-A with 128 Optional", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x00A0, Name: "UPC-A with P5 Optional", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x00A1, Name: "UPC-E", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x00A2, Name: "UPC-E1", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x00A9, Name: "Periodical", Kinds: KIND_NARY},
+			{Page: 0x008C, ID: 0x00AA, Name: "Periodical Auto-Discriminate +2", Kinds: KIND_SEL},
+			{Page: 0x008C, ID: 0x00AB, Name: "Periodical Only Decode with +2", Kinds: KIND_SEL},
+			{Page: 0x008C, ID: 0x00AC, Name: "Periodical Ignore +2", Kinds: KIND_SEL},
+			{Page: 0x008C, ID: 0x00AD, Name: "Periodical Auto-Discriminate +5", Kinds: KIND_SEL},
+			{Page: 0x008C, ID: 0x00AE, Name: "Periodical Only Decode with +5", Kinds: KIND_SEL},
+			{Page: 0x008C, ID: 0x00AF, Name: "Periodical Ignore +5", Kinds: KIND_SEL},
+			{Page: 0x008C, ID: 0x00B0, Name: "Check", Kinds: KIND_NARY},
+			{Page: 0x008C, ID: 0x00B1, Name: "Check Disable Price", Kinds: KIND_SEL},
+			{Page: 0x008C, ID: 0x00B2, Name: "Check Enable 4 digit Price", Kinds: KIND_SEL},
+			{Page: 0x008C, ID: 0x00B3, Name: "Check Enable 5 digit Price", Kinds: KIND_SEL},
+			{Page: 0x008C, ID: 0x00B4, Name: "Check Enable European 4 digit Price", Kinds: KIND_SEL},
+			{Page: 0x008C, ID: 0x00B5, Name: "Check Enable European 5 digit Price", Kinds: KIND_SEL},
+			{Page: 0x008C, ID: 0x00B7, Name: "EAN Two Label", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x00B8, Name: "EAN Three Label", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x00B9, Name: "EAN 8 Flag Digit 1", Kinds: KIND_DV},
+			{Page: 0x008C, ID: 0x00BA, Name: "EAN 8 Flag Digit 2", Kinds: KIND_DV},
+			{Page: 0x008C, ID: 0x00BB, Name: "EAN 8 Flag Digit 3", Kinds: KIND_DV},
+			{Page: 0x008C, ID: 0x00BC, Name: "EAN 13 Flag Digit 1", Kinds: KIND_DV},
+			{Page: 0x008C, ID: 0x00BD, Name: "EAN 13 Flag Digit 2", Kinds: KIND_DV},
+			{Page: 0x008C, ID: 0x00BE, Name: "EAN 13 Flag Digit 3", Kinds: KIND_DV},
+			{Page: 0x008C, ID: 0x00BF, Name: "Add EAN 2/3 Label Definition", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x00C0, Name: "Clear all EAN 2/3 Label Definitions", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x00C3, Name: "Codabar", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x00C4, Name: "Code 128", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x00C7, Name: "Code 39", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x00C8, Name: "Code 93", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x00C9, Name: "Full ASCII Conversion", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x00CA, Name: "Interleaved 2 of 5", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x00CB, Name: "Italian Pharmacy Code", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x00CC, Name: "MSI/Plessey", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x00CD, Name: "Standard 2 of 5 IATA", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x00CE, Name: "Standard 2 of 5", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x00D3, Name: "Transmit Start/Stop", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x00D4, Name: "Tri-Optic", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x00D5, Name: "UCC/EAN-128", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x00D6, Name: "Check Digit", Kinds: KIND_NARY},
+			{Page: 0x008C, ID: 0x00D7, Name: "Check Digit Disable", Kinds: KIND_SEL},
+			{Page: 0x008C, ID: 0x00D8, Name: "Check Digit Enable Interleaved 2 of 5 OPCC", Kinds: KIND_SEL},
+			{Page: 0x008C, ID: 0x00D9, Name: "Check Digit Enable Interleaved 2 of 5 USS", Kinds: KIND_SEL},
+			{Page: 0x008C, ID: 0x00DA, Name: "Check Digit Enable Standard 2 of 5 OPCC", Kinds: KIND_SEL},
+			{Page: 0x008C, ID: 0x00DB, Name: "Check Digit Enable Standard 2 of 5 USS", Kinds: KIND_SEL},
+			{Page: 0x008C, ID: 0x00DC, Name: "Check Digit Enable One MSI Plessey", Kinds: KIND_SEL},
+			{Page: 0x008C, ID: 0x00DD, Name: "Check Digit Enable Two MSI Plessey", Kinds: KIND_SEL},
+			{Page: 0x008C, ID: 0x00DE, Name: "Check Digit Codabar Enable", Kinds: KIND_SEL},
+			{Page: 0x008C, ID: 0x00DF, Name: "Check Digit Code 39 Enable", Kinds: KIND_SEL},
+			{Page: 0x008C, ID: 0x00F0, Name: "Transmit Check Digit", Kinds: KIND_NARY},
+			{Page: 0x008C, ID: 0x00F1, Name: "Disable Check Digit Transmit", Kinds: KIND_SEL},
+			{Page: 0x008C, ID: 0x00F2, Name: "Enable Check Digit Transmit", Kinds: KIND_SEL},
+			{Page: 0x008C, ID: 0x00FB, Name: "Symbology Identifier 1", Kinds: KIND_DV},
+			{Page: 0x008C, ID: 0x00FC, Name: "Symbology Identifier 2", Kinds: KIND_DV},
+			{Page: 0x008C, ID: 0x00FD, Name: "Symbology Identifier 3", Kinds: KIND_DV},
+			{Page: 0x008C, ID: 0x00FE, Name: "Decoded Data", Kinds: KIND_DV},
+			{Page: 0x008C, ID: 0x00FF, Name: "Decode Data Continued", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x0100, Name: "Bar Space Data", Kinds: KIND_DV},
+			{Page: 0x008C, ID: 0x0101, Name: "Scanner Data Accuracy", Kinds: KIND_DV},
+			{Page: 0x008C, ID: 0x0102, Name: "Raw Data Polarity", Kinds: KIND_NARY},
+			{Page: 0x008C, ID: 0x0103, Name: "Polarity Inverted Bar Code", Kinds: KIND_SEL},
+			{Page: 0x008C, ID: 0x0104, Name: "Polarity Normal Bar Code", Kinds: KIND_SEL},
+			{Page: 0x008C, ID: 0x0106, Name: "Minimum Length to Decode", Kinds: KIND_DV},
+			{Page: 0x008C, ID: 0x0107, Name: "Maximum Length to Decode", Kinds: KIND_DV},
+			{Page: 0x008C, ID: 0x0108, Name: "Discrete Length to Decode 1", Kinds: KIND_DV},
+			{Page: 0x008C, ID: 0x0109, Name: "Discrete Length to Decode 2", Kinds: KIND_DV},
+			{Page: 0x008C, ID: 0x010A, Name: "Data Length Method", Kinds: KIND_NARY},
+			{Page: 0x008C, ID: 0x010B, Name: "DL Method Read any", Kinds: KIND_SEL},
+			{Page: 0x008C, ID: 0x010C, Name: "DL Method Check in Range", Kinds: KIND_SEL},
+			{Page: 0x008C, ID: 0x010D, Name: "DL Method Check for Discrete", Kinds: KIND_SEL},
+			{Page: 0x008C, ID: 0x0110, Name: "Aztec Code", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x0111, Name: "BC412", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x0112, Name: "Channel Code", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x0113, Name: "Code 16", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x0114, Name: "Code 32", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x0115, Name: "Code 49", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x0116, Name: "Code One", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x0117, Name: "Colorcode", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x0118, Name: "Data Matrix", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x0119, Name: "MaxiCode", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x011A, Name: "MicroPDF", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x011B, Name: "PDF-417", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x011C, Name: "PosiCode", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x011D, Name: "QR Code", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x011E, Name: "SuperCode", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x011F, Name: "UltraCode", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x0120, Name: "USD-5 (Slug Code)", Kinds: KIND_DF},
+			{Page: 0x008C, ID: 0x0121, Name: "VeriCode", Kinds: KIND_DF},
+		},
+	},
+	{
+		ID:   0x008D,
+		Name: "Scales",
+		Usages: []Usage{
+			{Page: 0x008D, ID: 0x0001, Name: "Scales", Kinds: KIND_CA},
+			{Page: 0x008D, ID: 0x0020, Name: "Scale Device", Kinds: KIND_CL},
+			{Page: 0x008D, ID: 0x0021, Name: "Scale Class", Kinds: KIND_NARY},
+			{Page: 0x008D, ID: 0x0022, Name: "Scale Class I Metric", Kinds: KIND_SEL},
+			{Page: 0x008D, ID: 0x0023, Name: "Scale Class II Metric", Kinds: KIND_SEL},
+			{Page: 0x008D, ID: 0x0024, Name: "Scale Class III Metric", Kinds: KIND_SEL},
+			{Page: 0x008D, ID: 0x0025, Name: "Scale Class IIIL Metric", Kinds: KIND_SEL},
+			{Page: 0x008D, ID: 0x0026, Name: "Scale Class IV Metric", Kinds: KIND_SEL},
+			{Page: 0x008D, ID: 0x0027, Name: "Scale Class III English", Kinds: KIND_SEL},
+			{Page: 0x008D, ID: 0x0028, Name: "Scale Class IIIL English", Kinds: KIND_SEL},
+			{Page: 0x008D, ID: 0x0029, Name: "Scale Class IV English", Kinds: KIND_SEL},
+			{Page: 0x008D, ID: 0x002A, Name: "Scale Class Generic", Kinds: KIND_SEL},
+			{Page: 0x008D, ID: 0x0030, Name: "Scale Attribute Report", Kinds: KIND_CL},
+			{Page: 0x008D, ID: 0x0031, Name: "Scale Control Report", Kinds: KIND_CL},
+			{Page: 0x008D, ID: 0x0032, Name: "Scale Data Report", Kinds: KIND_CL},
+			{Page: 0x008D, ID: 0x0033, Name: "Scale Status Report", Kinds: KIND_CL},
+			{Page: 0x008D, ID: 0x0034, Name: "Scale Weight Limit Report", Kinds: KIND_CL},
+			{Page: 0x008D, ID: 0x0035, Name: "Scale Statistics Report", Kinds: KIND_CL},
+			{Page: 0x008D, ID: 0x0040, Name: "Data Weight", Kinds: KIND_DV},
+			{Page: 0x008D, ID: 0x0041, Name: "Data Scaling", Kinds: KIND_DV},
+			{Page: 0x008D, ID: 0x0050, Name: "Weight Unit", Kinds: KIND_CL},
+			{Page: 0x008D, ID: 0x0051, Name: "Weight Unit Milligram", Kinds: KIND_SEL},
+			{Page: 0x008D, ID: 0x0052, Name: "Weight Unit Gram", Kinds: KIND_SEL},
+			{Page: 0x008D, ID: 0x0053, Name: "Weight Unit Kilogram", Kinds: KIND_SEL},
+			{Page: 0x008D, ID: 0x0054, Name: "Weight Unit Carats", Kinds: KIND_SEL},
+			{Page: 0x008D, ID: 0x0055, Name: "Weight Unit Taels", Kinds: KIND_SEL},
+			{Page: 0x008D, ID: 0x0056, Name: "Weight Unit Grains", Kinds: KIND_SEL},
+			{Page: 0x008D, ID: 0x0057, Name: "Weight Unit Pennyweights", Kinds: KIND_SEL},
+			{Page: 0x008D, ID: 0x0058, Name: "Weight Unit Metric Ton", Kinds: KIND_SEL},
+			{Page: 0x008D, ID: 0x0059, Name: "Weight Unit Avoir Ton", Kinds: KIND_SEL},
+			{Page: 0x008D, ID: 0x005A, Name: "Weight Unit Troy Ounce", Kinds: KIND_SEL},
+			{Page: 0x008D, ID: 0x005B, Name: "Weight Unit Ounce", Kinds: KIND_SEL},
+			{Page: 0x008D, ID: 0x005C, Name: "Weight Unit Pound", Kinds: KIND_SEL},
+			{Page: 0x008D, ID: 0x0060, Name: "Calibration Count", Kinds: KIND_DV},
+			{Page: 0x008D, ID: 0x0061, Name: "Re-Zero Count", Kinds: KIND_DV},
+			{Page: 0x008D, ID: 0x0070, Name: "Scale Status", Kinds: KIND_NARY},
+			{Page: 0x008D, ID: 0x0071, Name: "Scale Status Fault", Kinds: KIND_SEL},
+			{Page: 0x008D, ID: 0x0072, Name: "Scale Status Stable at Center of Zero", Kinds: KIND_SEL},
+			{Page: 0x008D, ID: 0x0073, Name: "Scale Status In Motion", Kinds: KIND_SEL},
+			{Page: 0x008D, ID: 0x0074, Name: "Scale Status Weight Stable", Kinds: KIND_SEL},
+			{Page: 0x008D, ID: 0x0075, Name: "Scale Status Under Zero", Kinds: KIND_SEL},
+			{Page: 0x008D, ID: 0x0076, Name: "Scale Status Over Weight Limit", Kinds: KIND_SEL},
+			{Page: 0x008D, ID: 0x0077, Name: "Scale Status Requires Calibration", Kinds: KIND_SEL},
+			{Page: 0x008D, ID: 0x0078, Name: "Scale Status Requires Rezeroing", Kinds: KIND_SEL},
+			{Page: 0x008D, ID: 0x0080, Name: "Zero Scale", Kinds: KIND_OOC},
+			{Page: 0x008D, ID: 0x0081, Name: "Enforced Zero Return", Kinds: KIND_OOC},
+		},
+	},
+	{
+		ID:   0x008E,
+		Name: "Magnetic Stripe Reader",
+		Usages: []Usage{
+			{Page: 0x008E, ID: 0x0001, Name: "MSR Device Read-Only", Kinds: KIND_CA},
+			{Page: 0x008E, ID: 0x0011, Name: "Track 1 Length", Kinds: KIND_DV},
+			{Page: 0x008E, ID: 0x0012, Name: "Track 2 Length", Kinds: KIND_DV},
+			{Page: 0x008E, ID: 0x0013, Name: "Track 3 Length", Kinds: KIND_DV},
+			{Page: 0x008E, ID: 0x0014, Name: "Track JIS Length", Kinds: KIND_DV},
+			{Page: 0x008E, ID: 0x0020, Name: "Track Data", Kinds: KIND_SF | KIND_DF | KIND_DV},
+			{Page: 0x008E, ID: 0x0021, Name: "Track 1 Data", Kinds: KIND_SF | KIND_DF | KIND_DV},
+			{Page: 0x008E, ID: 0x0022, Name: "Track 2 Data", Kinds: KIND_SF | KIND_DF | KIND_DV},
+			{Page: 0x008E, ID: 0x0023, Name: "Track 3 Data", Kinds: KIND_SF | KIND_DF | KIND_DV},
+			{Page: 0x008E, ID: 0x0024, Name: "Track JIS Data", Kinds: KIND_SF | KIND_DF | KIND_DV},
+		},
+	},
+	{
+		ID:   0x0090,
+		Name: "Camera Control",
+		Usages: []Usage{
+			{Page: 0x0090, ID: 0x0020, Name: "Camera Auto-focus", Kinds: KIND_OSC},
+			{Page: 0x0090, ID: 0x0021, Name: "Camera Shutter", Kinds: KIND_OSC},
+		},
+	},
+	{
+		ID:   0x0091,
+		Name: "Arcade",
+		Usages: []Usage{
+			{Page: 0x0091, ID: 0x0001, Name: "General Purpose IO Card", Kinds: KIND_CA},
+			{Page: 0x0091, ID: 0x0002, Name: "Coin Door", Kinds: KIND_CA},
+			{Page: 0x0091, ID: 0x0003, Name: "Watchdog Timer", Kinds: KIND_CA},
+			{Page: 0x0091, ID: 0x0030, Name: "General Purpose Analog Input State", Kinds: KIND_DV},
+			{Page: 0x0091, ID: 0x0031, Name: "General Purpose Digital Input State", Kinds: KIND_DV},
+			{Page: 0x0091, ID: 0x0032, Name: "General Purpose Optical Input State", Kinds: KIND_DV},
+			{Page: 0x0091, ID: 0x0033, Name: "General Purpose Digital Output State", Kinds: KIND_DV},
+			{Page: 0x0091, ID: 0x0034, Name: "Number of Coin Doors", Kinds: KIND_DV},
+			{Page: 0x0091, ID: 0x0035, Name: "Coin Drawer Drop Count", Kinds: KIND_DV},
+			{Page: 0x0091, ID: 0x0036, Name: "Coin Drawer Start", Kinds: KIND_OOC},
+			{Page: 0x0091, ID: 0x0037, Name: "Coin Drawer Service", Kinds: KIND_OOC},
+			{Page: 0x0091, ID: 0x0038, Name: "Coin Drawer Tilt", Kinds: KIND_OOC},
+			{Page: 0x0091, ID: 0x0039, Name: "Coin Door Test", Kinds: KIND_OOC},
+			{Page: 0x0091, ID: 0x0040, Name: "Coin Door Lockout", Kinds: KIND_OOC},
+			{Page: 0x0091, ID: 0x0041, Name: "Watchdog Timeout", Kinds: KIND_DV},
+			{Page: 0x0091, ID: 0x0042, Name: "Watchdog Action", Kinds: KIND_NARY},
+			{Page: 0x0091, ID: 0x0043, Name: "Watchdog Reboot", Kinds: KIND_SEL},
+			{Page: 0x0091, ID: 0x0044, Name: "Watchdog Restart", Kinds: KIND_SEL},
+			{Page: 0x0091, ID: 0x0045, Name: "Alarm Input", Kinds: KIND_DV},
+			{Page: 0x0091, ID: 0x0046, Name: "Coin Door Counter", Kinds: KIND_OOC},
+			{Page: 0x0091, ID: 0x0047, Name: "I/O Direction Mapping", Kinds: KIND_DV},
+			{Page: 0x0091, ID: 0x0048, Name: "Set I/O Direction Mapping", Kinds: KIND_DV},
+			{Page: 0x0091, ID: 0x0049, Name: "Extended Optical Input State", Kinds: KIND_DV},
+			{Page: 0x0091, ID: 0x004A, Name: "Pin Pad Input State", Kinds: KIND_DV},
+			{Page: 0x0091, ID: 0x004B, Name: "Pin Pad Status", Kinds: KIND_DV},
+			{Page: 0x0091, ID: 0x004C, Name: "Pin Pad Output", Kinds: KIND_OOC},
+			{Page: 0x0091, ID: 0x004D, Name: "Pin Pad Command", Kinds: KIND_DV},
+		},
+	},
+	{
+		ID:   0xF1D0,
+		Name: "FIDO Alliance",
+		Usages: []Usage{
+			{Page: 0xF1D0, ID: 0x0001, Name: "U2F Authenticator Device", Kinds: KIND_CA},
+			{Page: 0xF1D0, ID: 0x0020, Name: "Input Report Data", Kinds: KIND_DV},
+			{Page: 0xF1D0, ID: 0x0021, Name: "Output Report Data", Kinds: KIND_DV},
+		},
+	},
+}
@@ -0,0 +1,241 @@
+// Package usages contains HID Usage Tables, including names and types of usage pages and usages.
+// The tables are generated from the JSON attachment of HID Usage Tables specification.
+package usages
+
+//go:generate go run ./internal/gen -o tables.go
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	USAGE_RESERVED_NAME = "Reserved"
+	USAGE_VENDOR_NAME   = "Vendor"
+
+	USAGE_PAGE_VENDOR_MIN uint16 = 0xFF00
+)
+
+// Kind is a set of usage types, e.g. Selector (Sel) or Dynamic Value (DV), defined in HID Usage Tables
+type Kind uint32
+
+const (
+	// Linear Control
+	KIND_LC Kind = 1 << iota
+	// On/Off Control
+	KIND_OOC
+	// Momentary Control
+	KIND_MC
+	// One Shot Control
+	KIND_OSC
+	// Re-trigger Control
+	KIND_RTC
+	// Selector
+	KIND_SEL
+	// Static Value
+	KIND_SV
+	// Static Flag
+	KIND_SF
+	// Dynamic Value
+	KIND_DV
+	// Dynamic Flag
+	KIND_DF
+	// Named Array
+	KIND_NARY
+	// Application Collection
+	KIND_CA
+	// Logical Collection
+	KIND_CL
+	// Physical Collection
+	KIND_CP
+	// Usage Switch
+	KIND_US
+	// Usage Modifier
+	KIND_UM
+	// Buffered Bytes
+	KIND_BUFFERED_BYTES
+)
+
+var kindNames = []string{
+	"LC", "OOC", "MC", "OSC", "RTC", "Sel", "SV", "SF", "DV", "DF", "NAry", "CA", "CL", "CP", "US", "UM", "BufferedBytes",
+}
+
+// String returns abbreviations of usage types separated by "|", e.g. "Sel|DV"
+func (k Kind) String() string {
+	var names []string
+	for i, name := range kindNames {
+		if k&(1<<i) != 0 {
+			names = append(names, name)
+		}
+	}
+
+	return strings.Join(names, "|")
+}
+
+// ParseKind parses an abbreviation of a usage type, e.g. "Sel" or "DV", as used in HID Usage Tables
+func ParseKind(name string) (Kind, bool) {
+	for i, kindName := range kindNames {
+		if strings.EqualFold(kindName, name) {
+			return 1 << i, true
+		}
+	}
+
+	return 0, false
+}
+
+// Usage is a usage defined in HID Usage Tables
+type Usage struct {
+	Page  uint16
+	ID    uint16
+	Name  string
+	Kinds Kind
+}
+
+// Generator describes usages of a page whose names are generated from their IDs, e.g. "Button 1"
+type Generator struct {
+	Prefix  string
+	StartID uint16
+	EndID   uint16
+	Kinds   Kind
+}
+
+// Page is a usage page defined in HID Usage Tables
+type Page struct {
+	ID   uint16
+	Name string
+	// Defined usages sorted by usage ID
+	Usages []Usage
+	// Generator of usages, if usages of this page are generated instead of defined
+	Generator *Generator
+
+	usageNames map[string]int
+}
+
+var (
+	pageIDs   = make(map[uint16]*Page)
+	pageNames = make(map[string]*Page)
+)
+
+func init() {
+	for _, page := range pages {
+		pageIDs[page.ID] = page
+		pageNames[strings.ToLower(page.Name)] = page
+		page.usageNames = make(map[string]int)
+		for i, usage := range page.Usages {
+			page.usageNames[strings.ToLower(usage.Name)] = i
+		}
+	}
+}
+
+// Usage returns a usage of this page by its ID
+func (p *Page) Usage(id uint16) (Usage, bool) {
+	if p.Generator != nil {
+		if id < p.Generator.StartID || id > p.Generator.EndID {
+			return Usage{}, false
+		}
+		return Usage{
+			Page:  p.ID,
+			ID:    id,
+			Name:  p.Generator.Prefix + " " + strconv.FormatUint(uint64(id), 10),
+			Kinds: p.Generator.Kinds,
+		}, true
+	}
+
+	// Usages are sorted by ID
+	low, high := 0, len(p.Usages)
+	for low < high {
+		mid := (low + high) / 2
+		if p.Usages[mid].ID < id {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+	if low < len(p.Usages) && p.Usages[low].ID == id {
+		return p.Usages[low], true
+	}
+
+	return Usage{}, false
+}
+
+// UsageByName returns a usage of this page by its name, case-insensitively
+func (p *Page) UsageByName(name string) (Usage, bool) {
+	if p.Generator != nil {
+		prefix, number, ok := strings.Cut(name, " ")
+		if !ok || !strings.EqualFold(prefix, p.Generator.Prefix) {
+			return Usage{}, false
+		}
+		id, err := strconv.ParseUint(number, 10, 16)
+		if err != nil {
+			return Usage{}, false
+		}
+		return p.Usage(uint16(id))
+	}
+
+	if i, ok := p.usageNames[strings.ToLower(name)]; ok {
+		return p.Usages[i], true
+	}
+
+	return Usage{}, false
+}
+
+// Pages returns all usage pages sorted by page ID
+func Pages() []*Page {
+	return pages
+}
+
+// LookupPage returns a usage page by its ID
+func LookupPage(page uint16) (*Page, bool) {
+	p, ok := pageIDs[page]
+	return p, ok
+}
+
+// LookupPageByName returns a usage page by its name, case-insensitively, e.g. "Generic Desktop"
+func LookupPageByName(name string) (*Page, bool) {
+	p, ok := pageNames[strings.ToLower(name)]
+	return p, ok
+}
+
+// Lookup returns a usage by its page ID and usage ID
+func Lookup(page, id uint16) (Usage, bool) {
+	p, ok := pageIDs[page]
+	if !ok {
+		return Usage{}, false
+	}
+
+	return p.Usage(id)
+}
+
+// LookupByName returns a usage by names of its page and itself, case-insensitively, e.g. "Generic Desktop" and "X"
+func LookupByName(pageName, name string) (Usage, bool) {
+	p, ok := LookupPageByName(pageName)
+	if !ok {
+		return Usage{}, false
+	}
+
+	return p.UsageByName(name)
+}
+
+// PageName returns name of a usage page, or "Vendor" or "Reserved" if it is not defined
+func PageName(page uint16) string {
+	if p, ok := pageIDs[page]; ok {
+		return p.Name
+	}
+	if page >= USAGE_PAGE_VENDOR_MIN {
+		return USAGE_VENDOR_NAME
+	}
+
+	return USAGE_RESERVED_NAME
+}
+
+// Name returns name of a usage, or "Vendor" or "Reserved" if it is not defined
+func Name(page, id uint16) string {
+	if usage, ok := Lookup(page, id); ok {
+		return usage.Name
+	}
+	if page >= USAGE_PAGE_VENDOR_MIN {
+		return USAGE_VENDOR_NAME
+	}
+
+	return USAGE_RESERVED_NAME
+}
@@ -0,0 +1,160 @@
+package usages_test
+
+import (
+	"testing"
+
+	"github.com/ntchjb/gohid/usages"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		name  string
+		page  uint16
+		id    uint16
+		usage usages.Usage
+		ok    bool
+	}{
+		{
+			name:  "Defined",
+			page:  0x01,
+			id:    0x30,
+			usage: usages.Usage{Page: 0x01, ID: 0x30, Name: "X", Kinds: usages.KIND_DV},
+			ok:    true,
+		},
+		{
+			name:  "MultipleKinds",
+			page:  0x0C,
+			id:    0xE0,
+			usage: usages.Usage{Page: 0x0C, ID: 0xE0, Name: "Volume", Kinds: usages.KIND_LC},
+			ok:    true,
+		},
+		{
+			name:  "Generated",
+			page:  0x09,
+			id:    3,
+			usage: usages.Usage{Page: 0x09, ID: 3, Name: "Button 3", Kinds: usages.KIND_SEL | usages.KIND_OOC | usages.KIND_MC | usages.KIND_OSC},
+			ok:    true,
+		},
+		{
+			name: "GeneratedOutOfRange",
+			page: 0x09,
+			id:   0,
+		},
+		{
+			name: "ReservedUsage",
+			page: 0x01,
+			id:   0x03,
+		},
+		{
+			name: "ReservedPage",
+			page: 0x13,
+			id:   0x01,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			usage, ok := usages.Lookup(test.page, test.id)
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.usage, usage)
+		})
+	}
+}
+
+func TestLookupByName(t *testing.T) {
+	tests := []struct {
+		name      string
+		pageName  string
+		usageName string
+		page      uint16
+		id        uint16
+		ok        bool
+	}{
+		{
+			name:      "Defined",
+			pageName:  "Generic Desktop",
+			usageName: "Gamepad",
+			page:      0x01,
+			id:        0x05,
+			ok:        true,
+		},
+		{
+			name:      "CaseInsensitive",
+			pageName:  "consumer",
+			usageName: "play/pause",
+			page:      0x0C,
+			id:        0xCD,
+			ok:        true,
+		},
+		{
+			name:      "Generated",
+			pageName:  "Button",
+			usageName: "Button 12",
+			page:      0x09,
+			id:        12,
+			ok:        true,
+		},
+		{
+			name:      "GeneratedInvalidNumber",
+			pageName:  "Button",
+			usageName: "Button A",
+		},
+		{
+			name:      "UnknownUsage",
+			pageName:  "Generic Desktop",
+			usageName: "Steering Wheel",
+		},
+		{
+			name:      "UnknownPage",
+			pageName:  "Foo",
+			usageName: "X",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			usage, ok := usages.LookupByName(test.pageName, test.usageName)
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.page, usage.Page)
+			assert.Equal(t, test.id, usage.ID)
+		})
+	}
+}
+
+func TestName(t *testing.T) {
+	assert.Equal(t, "Generic Desktop", usages.PageName(0x01))
+	assert.Equal(t, "Vendor", usages.PageName(0xFF00))
+	assert.Equal(t, "Reserved", usages.PageName(0x13))
+	assert.Equal(t, "Keyboard LeftControl", usages.Name(0x07, 0xE0))
+	assert.Equal(t, "Vendor", usages.Name(0xFFA0, 0x01))
+	assert.Equal(t, "Reserved", usages.Name(0x01, 0x03))
+}
+
+func TestPages(t *testing.T) {
+	pages := usages.Pages()
+	assert.NotEmpty(t, pages)
+	for i := 1; i < len(pages); i++ {
+		assert.Less(t, pages[i-1].ID, pages[i].ID)
+	}
+	for _, page := range pages {
+		for i := 1; i < len(page.Usages); i++ {
+			assert.Less(t, page.Usages[i-1].ID, page.Usages[i].ID, page.Name)
+		}
+	}
+}
+
+func TestKind(t *testing.T) {
+	assert.Equal(t, "Sel|DV", (usages.KIND_SEL | usages.KIND_DV).String())
+	assert.Equal(t, "", usages.Kind(0).String())
+
+	kind, ok := usages.ParseKind("nary")
+	assert.True(t, ok)
+	assert.Equal(t, usages.KIND_NARY, kind)
+	_, ok = usages.ParseKind("XYZ")
+	assert.False(t, ok)
+}
@@ -0,0 +1,355 @@
+// Package capture records USB traffic of devices opened via usb.Context, in the form captured by Linux usbmon.
+// Captures written by pcapng writer can be opened by Wireshark with its USB and USB HID dissectors, e.g.
+//
+//	file, _ := os.Create("capture.pcapng")
+//	writer, _ := capture.NewPcapngWriter(file, "gohid", 0)
+//	man := manager.NewDeviceManager(capture.NewContext(usb.NewGOUSBContext(), writer, logger), logger)
+package capture
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/gousb"
+	"github.com/ntchjb/gohid/usb"
+)
+
+// Writer writes captured packets
+type Writer interface {
+	WritePacket(packet Packet) error
+}
+
+// tap creates packets of URBs and writes them to a writer
+type tap struct {
+	writer Writer
+	logger *slog.Logger
+	nextID atomic.Uint64
+	now    func() time.Time
+}
+
+func (t *tap) write(packet Packet) {
+	if err := t.writer.WritePacket(packet); err != nil {
+		t.logger.Error("unable to write captured packet", "err", err)
+	}
+}
+
+// submit writes submission of a URB, and returns packet of the URB for writing its completion
+func (t *tap) submit(desc *gousb.DeviceDesc, transferType TransferType, endpoint uint8, setup []byte, length int, data []byte) Packet {
+	packet := Packet{
+		ID:           t.nextID.Add(1),
+		Event:        EVENT_SUBMIT,
+		TransferType: transferType,
+		Endpoint:     endpoint,
+		Device:       uint8(desc.Address),
+		Bus:          uint16(desc.Bus),
+		Setup:        setup,
+		Timestamp:    t.now(),
+		Status:       usb.URB_STATUS_EINPROGRESS,
+		Length:       uint32(length),
+		Data:         data,
+	}
+	t.write(packet)
+
+	return packet
+}
+
+// complete writes completion of a URB submitted earlier
+func (t *tap) complete(packet Packet, length int, data []byte, err error) {
+	packet.Event = EVENT_COMPLETE
+	packet.Setup = nil
+	packet.Timestamp = t.now()
+	packet.Status = usb.URBStatus(err)
+	packet.Length = uint32(max(length, 0))
+	packet.Data = data
+	t.write(packet)
+}
+
+// control writes submission and completion of a control transfer whose result is already known
+func (t *tap) control(desc *gousb.DeviceDesc, bmRequestType, bRequest uint8, wValue, wIndex uint16, data []byte) {
+	setup := setupPacket(bmRequestType, bRequest, wValue, wIndex, uint16(len(data)))
+	packet := t.submit(desc, TRANSFER_TYPE_CONTROL, ENDPOINT_DIRECTION_IN, setup, len(data), nil)
+	t.complete(packet, len(data), data, nil)
+}
+
+// clearHalt clears halt condition of an endpoint, and writes it as CLEAR_FEATURE(ENDPOINT_HALT) control transfer
+func (t *tap) clearHalt(desc *gousb.DeviceDesc, address gousb.EndpointAddress, clearHalt func() error) error {
+	setup := setupPacket(usb.REQUEST_TYPE_ENDPOINT_OUT, usb.REQUEST_CLEAR_FEATURE, usb.FEATURE_ENDPOINT_HALT, uint16(address), 0)
+	packet := t.submit(desc, TRANSFER_TYPE_CONTROL, 0, setup, 0, nil)
+	err := clearHalt()
+	t.complete(packet, 0, nil, err)
+
+	return err
+}
+
+func setupPacket(bmRequestType, bRequest uint8, wValue, wIndex, wLength uint16) []byte {
+	return []byte{
+		bmRequestType, bRequest,
+		byte(wValue), byte(wValue >> 8),
+		byte(wIndex), byte(wIndex >> 8),
+		byte(wLength), byte(wLength >> 8),
+	}
+}
+
+type captureContext struct {
+	ctx usb.Context
+	tap *tap
+}
+
+// NewContext wraps a USB context, so that traffic of devices opened from it is written to a writer
+func NewContext(ctx usb.Context, writer Writer, logger *slog.Logger) usb.Context {
+	return &captureContext{
+		ctx: ctx,
+		tap: &tap{
+			writer: writer,
+			logger: logger,
+			now:    time.Now,
+		},
+	}
+}
+
+func (c *captureContext) IterateDevices(reader func(desc *gousb.DeviceDesc)) error {
+	return c.ctx.IterateDevices(reader)
+}
+
+func (c *captureContext) OpenDevice(vid, pid gousb.ID) (usb.Device, error) {
+	device, err := c.ctx.OpenDevice(vid, pid)
+	if err != nil {
+		return nil, err
+	}
+
+	return newCaptureDevice(device, c.tap), nil
+}
+
+func (c *captureContext) Close() error {
+	return c.ctx.Close()
+}
+
+type captureDevice struct {
+	usb.Device
+	tap *tap
+}
+
+// NewDevice wraps an opened USB device, so that its traffic is written to a writer
+func NewDevice(device usb.Device, writer Writer, logger *slog.Logger) usb.Device {
+	return newCaptureDevice(device, &tap{
+		writer: writer,
+		logger: logger,
+		now:    time.Now,
+	})
+}
+
+// newCaptureDevice wraps a device, and writes its device and configuration descriptors as if they were read by the host
+func newCaptureDevice(device usb.Device, t *tap) usb.Device {
+	desc := device.Descriptor()
+	deviceDesc := usb.EncodeDeviceDescriptor(desc)
+	t.control(desc, 0x80, 0x06, 0x0100, 0, deviceDesc)
+	// Configuration descriptors are indexed in order of their numbers
+	numbers := make([]int, 0, len(desc.Configs))
+	for number := range desc.Configs {
+		numbers = append(numbers, number)
+	}
+	sort.Ints(numbers)
+	for i, number := range numbers {
+		t.control(desc, 0x80, 0x06, 0x0200|uint16(i), 0, usb.EncodeConfigDescriptor(desc, desc.Configs[number], nil))
+	}
+
+	return &captureDevice{
+		Device: device,
+		tap:    t,
+	}
+}
+
+func (d *captureDevice) Control(bmRequestType, bRequest uint8, wValue, wIndex uint16, data []byte) (int, error) {
+	desc := d.Device.Descriptor()
+	setup := setupPacket(bmRequestType, bRequest, wValue, wIndex, uint16(len(data)))
+	isIn := bmRequestType&ENDPOINT_DIRECTION_IN != 0
+	endpoint := bmRequestType & ENDPOINT_DIRECTION_IN
+
+	var packet Packet
+	if isIn {
+		packet = d.tap.submit(desc, TRANSFER_TYPE_CONTROL, endpoint, setup, len(data), nil)
+	} else {
+		packet = d.tap.submit(desc, TRANSFER_TYPE_CONTROL, endpoint, setup, len(data), append([]byte{}, data...))
+	}
+	n, err := d.Device.Control(bmRequestType, bRequest, wValue, wIndex, data)
+	if isIn && n > 0 {
+		d.tap.complete(packet, n, append([]byte{}, data[:n]...), err)
+	} else {
+		d.tap.complete(packet, n, nil, err)
+	}
+
+	return n, err
+}
+
+func (d *captureDevice) GetStringDescriptor(index int) (string, error) {
+	str, err := d.Device.GetStringDescriptor(index)
+	if err == nil {
+		// English (United States)
+		d.tap.control(d.Device.Descriptor(), 0x80, 0x06, 0x0300|uint16(index), 0x0409, usb.EncodeStringDescriptor(str))
+	}
+
+	return str, err
+}
+
+func (d *captureDevice) Config(configNumber int) (usb.Config, error) {
+	config, err := d.Device.Config(configNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	return &captureConfig{
+		Config: config,
+		desc:   d.Device.Descriptor(),
+		tap:    d.tap,
+	}, nil
+}
+
+type captureConfig struct {
+	usb.Config
+	desc *gousb.DeviceDesc
+	tap  *tap
+}
+
+func (c *captureConfig) Interface(num, alt int) (usb.Interface, error) {
+	intf, err := c.Config.Interface(num, alt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &captureInterface{
+		Interface: intf,
+		desc:      c.desc,
+		tap:       c.tap,
+	}, nil
+}
+
+type captureInterface struct {
+	usb.Interface
+	desc *gousb.DeviceDesc
+	tap  *tap
+}
+
+func (i *captureInterface) InEndpoint(num int) (usb.InEndpoint, error) {
+	ep, err := i.Interface.InEndpoint(num)
+	if err != nil {
+		return nil, err
+	}
+
+	return &captureInEndpoint{
+		InEndpoint: ep,
+		desc:       i.desc,
+		tap:        i.tap,
+	}, nil
+}
+
+func (i *captureInterface) OutEndpoint(num int) (usb.OutEndpoint, error) {
+	ep, err := i.Interface.OutEndpoint(num)
+	if err != nil {
+		return nil, err
+	}
+
+	return &captureOutEndpoint{
+		OutEndpoint: ep,
+		desc:        i.desc,
+		tap:         i.tap,
+	}, nil
+}
+
+// transferType converts transfer type of an endpoint into transfer type of usbmon
+func transferType(endpoint gousb.EndpointDesc) TransferType {
+	switch endpoint.TransferType {
+	case gousb.TransferTypeIsochronous:
+		return TRANSFER_TYPE_ISOCHRONOUS
+	case gousb.TransferTypeBulk:
+		return TRANSFER_TYPE_BULK
+	case gousb.TransferTypeControl:
+		return TRANSFER_TYPE_CONTROL
+	}
+
+	return TRANSFER_TYPE_INTERRUPT
+}
+
+type captureInEndpoint struct {
+	usb.InEndpoint
+	desc *gousb.DeviceDesc
+	tap  *tap
+}
+
+func (e *captureInEndpoint) NewStream(count int) (usb.StreamReader, error) {
+	stream, err := e.InEndpoint.NewStream(count)
+	if err != nil {
+		return nil, err
+	}
+
+	return &captureStreamReader{
+		StreamReader: stream,
+		endpoint:     e.InEndpoint.Descriptor(),
+		desc:         e.desc,
+		tap:          e.tap,
+	}, nil
+}
+
+func (e *captureInEndpoint) ClearHalt() error {
+	return e.tap.clearHalt(e.desc, e.InEndpoint.Descriptor().Address, e.InEndpoint.ClearHalt)
+}
+
+type captureOutEndpoint struct {
+	usb.OutEndpoint
+	desc *gousb.DeviceDesc
+	tap  *tap
+}
+
+func (e *captureOutEndpoint) NewStream(count int) (usb.StreamWriter, error) {
+	stream, err := e.OutEndpoint.NewStream(count)
+	if err != nil {
+		return nil, err
+	}
+
+	return &captureStreamWriter{
+		StreamWriter: stream,
+		endpoint:     e.OutEndpoint.Descriptor(),
+		desc:         e.desc,
+		tap:          e.tap,
+	}, nil
+}
+
+func (e *captureOutEndpoint) ClearHalt() error {
+	return e.tap.clearHalt(e.desc, e.OutEndpoint.Descriptor().Address, e.OutEndpoint.ClearHalt)
+}
+
+type captureStreamReader struct {
+	usb.StreamReader
+	endpoint gousb.EndpointDesc
+	desc     *gousb.DeviceDesc
+	tap      *tap
+}
+
+func (s *captureStreamReader) ReadContext(ctx context.Context, data []byte) (int, error) {
+	packet := s.tap.submit(s.desc, transferType(s.endpoint), uint8(s.endpoint.Address), nil, len(data), nil)
+	n, err := s.StreamReader.ReadContext(ctx, data)
+	var captured []byte
+	if n > 0 {
+		captured = append(captured, data[:n]...)
+	}
+	s.tap.complete(packet, n, captured, err)
+
+	return n, err
+}
+
+type captureStreamWriter struct {
+	usb.StreamWriter
+	endpoint gousb.EndpointDesc
+	desc     *gousb.DeviceDesc
+	tap      *tap
+}
+
+func (s *captureStreamWriter) WriteContext(ctx context.Context, data []byte) (int, error) {
+	packet := s.tap.submit(s.desc, transferType(s.endpoint), uint8(s.endpoint.Address), nil, len(data), append([]byte{}, data...))
+	n, err := s.StreamWriter.WriteContext(ctx, data)
+	s.tap.complete(packet, n, nil, err)
+
+	return n, err
+}
@@ -0,0 +1,231 @@
+package capture_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/gousb"
+	"github.com/ntchjb/gohid/usb"
+	"github.com/ntchjb/gohid/usb/capture"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var deviceDesc = &gousb.DeviceDesc{
+	Bus:                  1,
+	Address:              21,
+	Speed:                gousb.SpeedFull,
+	Spec:                 0x0200,
+	Device:               0x0100,
+	Vendor:               0xFF01,
+	Product:              0x0001,
+	MaxControlPacketSize: 64,
+	Configs: map[int]gousb.ConfigDesc{
+		1: {
+			Number:   1,
+			MaxPower: 100,
+			Interfaces: []gousb.InterfaceDesc{
+				{
+					Number: 0,
+					AltSettings: []gousb.InterfaceSetting{
+						{
+							Alternate: 0,
+							Class:     gousb.ClassHID,
+							Endpoints: map[gousb.EndpointAddress]gousb.EndpointDesc{
+								0x81: {
+									Address:       0x81,
+									Number:        1,
+									Direction:     gousb.EndpointDirectionIn,
+									MaxPacketSize: 64,
+									TransferType:  gousb.TransferTypeInterrupt,
+									PollInterval:  10 * time.Millisecond,
+								},
+								0x01: {
+									Address:       0x01,
+									Number:        1,
+									Direction:     gousb.EndpointDirectionOut,
+									MaxPacketSize: 64,
+									TransferType:  gousb.TransferTypeInterrupt,
+									PollInterval:  10 * time.Millisecond,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+// packetRecorder keeps written packets without timestamps
+type packetRecorder struct {
+	packets []capture.Packet
+	mu      sync.Mutex
+}
+
+func (r *packetRecorder) WritePacket(packet capture.Packet) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	packet.Timestamp = time.Time{}
+	r.packets = append(r.packets, packet)
+
+	return nil
+}
+
+func TestNewDevice_Descriptors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	device := usb.NewMockDevice(ctrl)
+	device.EXPECT().Descriptor().Return(deviceDesc).AnyTimes()
+	recorder := &packetRecorder{}
+
+	capture.NewDevice(device, recorder, slog.Default())
+
+	require.Len(t, recorder.packets, 4)
+	assert.Equal(t, capture.Packet{
+		ID: 1, Event: capture.EVENT_SUBMIT, TransferType: capture.TRANSFER_TYPE_CONTROL, Endpoint: 0x80, Device: 21, Bus: 1,
+		Setup:  []byte{0x80, 0x06, 0x00, 0x01, 0x00, 0x00, 18, 0x00},
+		Status: usb.URB_STATUS_EINPROGRESS, Length: 18,
+	}, recorder.packets[0])
+	assert.Equal(t, capture.Packet{
+		ID: 1, Event: capture.EVENT_COMPLETE, TransferType: capture.TRANSFER_TYPE_CONTROL, Endpoint: 0x80, Device: 21, Bus: 1,
+		Length: 18,
+		Data:   []byte{18, 0x01, 0x00, 0x02, 0, 0, 0, 64, 0x01, 0xFF, 0x01, 0x00, 0x00, 0x01, 0, 0, 0, 1},
+	}, recorder.packets[1])
+	assert.Equal(t, []byte{0x80, 0x06, 0x00, 0x02, 0x00, 0x00, 32, 0x00}, recorder.packets[2].Setup)
+	assert.Equal(t, []byte{
+		9, 0x02, 32, 0, 1, 1, 0, 0x80, 50,
+		9, 0x04, 0, 0, 2, 0x03, 0, 0, 0,
+		7, 0x05, 0x01, 0x03, 64, 0, 10,
+		7, 0x05, 0x81, 0x03, 64, 0, 10,
+	}, recorder.packets[3].Data)
+}
+
+func TestCaptureDevice_Control(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	device := usb.NewMockDevice(ctrl)
+	device.EXPECT().Descriptor().Return(deviceDesc).AnyTimes()
+	recorder := &packetRecorder{}
+	captured := capture.NewDevice(device, recorder, slog.Default())
+	recorder.packets = nil
+
+	device.EXPECT().Control(uint8(0x81), uint8(0x06), uint16(0x2200), uint16(0), gomock.Len(64)).DoAndReturn(
+		func(bmRequestType, bRequest uint8, wValue, wIndex uint16, data []byte) (int, error) {
+			return copy(data, []byte{0x05, 0x01, 0xC0}), nil
+		},
+	)
+	device.EXPECT().Control(uint8(0x21), uint8(0x09), uint16(0x0301), uint16(0), []byte{0x01, 0xFF}).Return(0, gousb.ErrorPipe)
+
+	n, err := captured.Control(0x81, 0x06, 0x2200, 0, make([]byte, 64))
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+	_, err = captured.Control(0x21, 0x09, 0x0301, 0, []byte{0x01, 0xFF})
+	assert.ErrorIs(t, err, gousb.ErrorPipe)
+
+	require.Len(t, recorder.packets, 4)
+	assert.Equal(t, capture.Packet{
+		ID: 3, Event: capture.EVENT_SUBMIT, TransferType: capture.TRANSFER_TYPE_CONTROL, Endpoint: 0x80, Device: 21, Bus: 1,
+		Setup:  []byte{0x81, 0x06, 0x00, 0x22, 0x00, 0x00, 64, 0x00},
+		Status: usb.URB_STATUS_EINPROGRESS, Length: 64,
+	}, recorder.packets[0])
+	assert.Equal(t, capture.Packet{
+		ID: 3, Event: capture.EVENT_COMPLETE, TransferType: capture.TRANSFER_TYPE_CONTROL, Endpoint: 0x80, Device: 21, Bus: 1,
+		Length: 3, Data: []byte{0x05, 0x01, 0xC0},
+	}, recorder.packets[1])
+	assert.Equal(t, capture.Packet{
+		ID: 4, Event: capture.EVENT_SUBMIT, TransferType: capture.TRANSFER_TYPE_CONTROL, Endpoint: 0x00, Device: 21, Bus: 1,
+		Setup:  []byte{0x21, 0x09, 0x01, 0x03, 0x00, 0x00, 2, 0x00},
+		Status: usb.URB_STATUS_EINPROGRESS, Length: 2, Data: []byte{0x01, 0xFF},
+	}, recorder.packets[2])
+	assert.Equal(t, capture.Packet{
+		ID: 4, Event: capture.EVENT_COMPLETE, TransferType: capture.TRANSFER_TYPE_CONTROL, Endpoint: 0x00, Device: 21, Bus: 1,
+		Status: usb.URB_STATUS_EPIPE,
+	}, recorder.packets[3])
+}
+
+func TestCaptureContext_Streams(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	usbCtx := usb.NewMockContext(ctrl)
+	device := usb.NewMockDevice(ctrl)
+	config := usb.NewMockConfig(ctrl)
+	intf := usb.NewMockInterface(ctrl)
+	epIn := usb.NewMockInEndpoint(ctrl)
+	epOut := usb.NewMockOutEndpoint(ctrl)
+	reader := usb.NewMockStreamReader(ctrl)
+	writer := usb.NewMockStreamWriter(ctrl)
+	recorder := &packetRecorder{}
+
+	usbCtx.EXPECT().OpenDevice(gousb.ID(0xFF01), gousb.ID(0x0001)).Return(device, nil)
+	device.EXPECT().Descriptor().Return(deviceDesc).AnyTimes()
+	device.EXPECT().Config(1).Return(config, nil)
+	config.EXPECT().Interface(0, 0).Return(intf, nil)
+	intf.EXPECT().InEndpoint(1).Return(epIn, nil)
+	intf.EXPECT().OutEndpoint(1).Return(epOut, nil)
+	epIn.EXPECT().NewStream(16).Return(reader, nil)
+	epIn.EXPECT().Descriptor().Return(deviceDesc.Configs[1].Interfaces[0].AltSettings[0].Endpoints[0x81])
+	epOut.EXPECT().NewStream(16).Return(writer, nil)
+	epOut.EXPECT().Descriptor().Return(deviceDesc.Configs[1].Interfaces[0].AltSettings[0].Endpoints[0x01])
+
+	ctx := context.Background()
+	reader.EXPECT().ReadContext(ctx, gomock.Len(64)).DoAndReturn(func(ctx context.Context, data []byte) (int, error) {
+		return copy(data, []byte{0x01, 0x02}), nil
+	})
+	reader.EXPECT().ReadContext(ctx, gomock.Len(64)).Return(0, gousb.TransferStall)
+	writer.EXPECT().WriteContext(ctx, []byte{0x03, 0x04}).Return(2, nil)
+
+	capturedCtx := capture.NewContext(usbCtx, recorder, slog.Default())
+	captured, err := capturedCtx.OpenDevice(0xFF01, 0x0001)
+	require.NoError(t, err)
+	capturedConfig, err := captured.Config(1)
+	require.NoError(t, err)
+	capturedIntf, err := capturedConfig.Interface(0, 0)
+	require.NoError(t, err)
+	capturedIn, err := capturedIntf.InEndpoint(1)
+	require.NoError(t, err)
+	capturedOut, err := capturedIntf.OutEndpoint(1)
+	require.NoError(t, err)
+	capturedReader, err := capturedIn.NewStream(16)
+	require.NoError(t, err)
+	capturedWriter, err := capturedOut.NewStream(16)
+	require.NoError(t, err)
+	recorder.packets = nil
+
+	data := make([]byte, 64)
+	n, err := capturedReader.ReadContext(ctx, data)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+	_, err = capturedReader.ReadContext(ctx, data)
+	assert.True(t, errors.Is(err, gousb.TransferStall))
+	n, err = capturedWriter.WriteContext(ctx, []byte{0x03, 0x04})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	interrupt := capture.TRANSFER_TYPE_INTERRUPT
+	assert.Equal(t, []capture.Packet{
+		{ID: 3, Event: capture.EVENT_SUBMIT, TransferType: interrupt, Endpoint: 0x81, Device: 21, Bus: 1, Status: usb.URB_STATUS_EINPROGRESS, Length: 64},
+		{ID: 3, Event: capture.EVENT_COMPLETE, TransferType: interrupt, Endpoint: 0x81, Device: 21, Bus: 1, Length: 2, Data: []byte{0x01, 0x02}},
+		{ID: 4, Event: capture.EVENT_SUBMIT, TransferType: interrupt, Endpoint: 0x81, Device: 21, Bus: 1, Status: usb.URB_STATUS_EINPROGRESS, Length: 64},
+		{ID: 4, Event: capture.EVENT_COMPLETE, TransferType: interrupt, Endpoint: 0x81, Device: 21, Bus: 1, Status: usb.URB_STATUS_EPIPE},
+		{ID: 5, Event: capture.EVENT_SUBMIT, TransferType: interrupt, Endpoint: 0x01, Device: 21, Bus: 1, Status: usb.URB_STATUS_EINPROGRESS, Length: 2, Data: []byte{0x03, 0x04}},
+		{ID: 5, Event: capture.EVENT_COMPLETE, TransferType: interrupt, Endpoint: 0x01, Device: 21, Bus: 1, Length: 2},
+	}, recorder.packets)
+}
+
+func TestCaptureDevice_WriterError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	device := usb.NewMockDevice(ctrl)
+	writer := capture.NewMockWriter(ctrl)
+	device.EXPECT().Descriptor().Return(deviceDesc).AnyTimes()
+	writer.EXPECT().WritePacket(gomock.Any()).Return(errors.New("disk full")).AnyTimes()
+	device.EXPECT().GetStringDescriptor(1).Return("gohid", nil)
+
+	// Errors of capture do not affect the device
+	str, err := capture.NewDevice(device, writer, slog.Default()).GetStringDescriptor(1)
+	assert.NoError(t, err)
+	assert.Equal(t, "gohid", str)
+}
@@ -0,0 +1,53 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./usb/capture/capture.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./usb/capture/capture.go -destination=./usb/capture/mock_capture.go -package=capture
+//
+
+// Package capture is a generated GoMock package.
+package capture
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWriter is a mock of Writer interface.
+type MockWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockWriterMockRecorder
+}
+
+// MockWriterMockRecorder is the mock recorder for MockWriter.
+type MockWriterMockRecorder struct {
+	mock *MockWriter
+}
+
+// NewMockWriter creates a new mock instance.
+func NewMockWriter(ctrl *gomock.Controller) *MockWriter {
+	mock := &MockWriter{ctrl: ctrl}
+	mock.recorder = &MockWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWriter) EXPECT() *MockWriterMockRecorder {
+	return m.recorder
+}
+
+// WritePacket mocks base method.
+func (m *MockWriter) WritePacket(packet Packet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WritePacket", packet)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WritePacket indicates an expected call of WritePacket.
+func (mr *MockWriterMockRecorder) WritePacket(packet any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WritePacket", reflect.TypeOf((*MockWriter)(nil).WritePacket), packet)
+}
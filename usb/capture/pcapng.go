@@ -0,0 +1,122 @@
+package capture
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sync"
+)
+
+const (
+	PCAPNG_BLOCK_TYPE_SECTION_HEADER   uint32 = 0x0A0D0D0A
+	PCAPNG_BLOCK_TYPE_INTERFACE        uint32 = 0x00000001
+	PCAPNG_BLOCK_TYPE_ENHANCED_PACKET  uint32 = 0x00000006
+	PCAPNG_BYTE_ORDER_MAGIC            uint32 = 0x1A2B3C4D
+	PCAPNG_OPTION_END                  uint16 = 0
+	PCAPNG_OPTION_SHB_USER_APPLICATION uint16 = 4
+	PCAPNG_OPTION_IF_NAME              uint16 = 2
+
+	// Link type of packets with 48-byte usbmon header
+	LINKTYPE_USB_LINUX uint16 = 189
+
+	DEFAULT_SNAP_LENGTH = 0x40000
+)
+
+// pcapngWriter writes packets into a pcapng file with a single section and a single interface
+type pcapngWriter struct {
+	w          io.Writer
+	snapLength int
+	mu         sync.Mutex
+}
+
+// NewPcapngWriter writes pcapng headers, and returns a writer of packets captured from a USB bus.
+// Data of packets longer than snap length is truncated. Packets can be written concurrently.
+func NewPcapngWriter(w io.Writer, interfaceName string, snapLength int) (Writer, error) {
+	if snapLength <= 0 {
+		snapLength = DEFAULT_SNAP_LENGTH
+	}
+	p := &pcapngWriter{
+		w:          w,
+		snapLength: snapLength,
+	}
+
+	shb := binary.LittleEndian.AppendUint32(nil, PCAPNG_BYTE_ORDER_MAGIC)
+	shb = binary.LittleEndian.AppendUint16(shb, 1)
+	shb = binary.LittleEndian.AppendUint16(shb, 0)
+	// Section length is unknown
+	shb = binary.LittleEndian.AppendUint64(shb, ^uint64(0))
+	shb = appendOption(shb, PCAPNG_OPTION_SHB_USER_APPLICATION, []byte("gohid"))
+	shb = appendOption(shb, PCAPNG_OPTION_END, nil)
+	if err := p.writeBlock(PCAPNG_BLOCK_TYPE_SECTION_HEADER, shb); err != nil {
+		return nil, err
+	}
+
+	idb := binary.LittleEndian.AppendUint16(nil, LINKTYPE_USB_LINUX)
+	idb = binary.LittleEndian.AppendUint16(idb, 0)
+	idb = binary.LittleEndian.AppendUint32(idb, uint32(snapLength))
+	if interfaceName != "" {
+		idb = appendOption(idb, PCAPNG_OPTION_IF_NAME, []byte(interfaceName))
+	}
+	idb = appendOption(idb, PCAPNG_OPTION_END, nil)
+	if err := p.writeBlock(PCAPNG_BLOCK_TYPE_INTERFACE, idb); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// appendOption appends an option padded to 32 bits
+func appendOption(buf []byte, code uint16, value []byte) []byte {
+	buf = binary.LittleEndian.AppendUint16(buf, code)
+	buf = binary.LittleEndian.AppendUint16(buf, uint16(len(value)))
+	buf = append(buf, value...)
+
+	return append(buf, make([]byte, padding(len(value)))...)
+}
+
+func padding(length int) int {
+	return (4 - length%4) % 4
+}
+
+// writeBlock writes a block with its body padded to 32 bits
+func (p *pcapngWriter) writeBlock(blockType uint32, body []byte) error {
+	length := uint32(12 + len(body) + padding(len(body)))
+	block := make([]byte, 0, length)
+	block = binary.LittleEndian.AppendUint32(block, blockType)
+	block = binary.LittleEndian.AppendUint32(block, length)
+	block = append(block, body...)
+	block = append(block, make([]byte, padding(len(body)))...)
+	block = binary.LittleEndian.AppendUint32(block, length)
+
+	if _, err := p.w.Write(block); err != nil {
+		return fmt.Errorf("unable to write pcapng block 0x%08X: %w", blockType, err)
+	}
+
+	return nil
+}
+
+func (p *pcapngWriter) WritePacket(packet Packet) error {
+	originalLength := USBMON_HEADER_LENGTH + len(packet.Data)
+	// Captured data length of usbmon header matches data truncated by snap length
+	packet.Data = packet.Data[:min(len(packet.Data), max(p.snapLength-USBMON_HEADER_LENGTH, 0))]
+	data, err := packet.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	captured := data[:min(len(data), p.snapLength)]
+	// Timestamps are in microseconds by default
+	timestamp := uint64(packet.Timestamp.UnixMicro())
+
+	body := make([]byte, 0, 20+len(captured))
+	body = binary.LittleEndian.AppendUint32(body, 0)
+	body = binary.LittleEndian.AppendUint32(body, uint32(timestamp>>32))
+	body = binary.LittleEndian.AppendUint32(body, uint32(timestamp))
+	body = binary.LittleEndian.AppendUint32(body, uint32(len(captured)))
+	body = binary.LittleEndian.AppendUint32(body, uint32(originalLength))
+	body = append(body, captured...)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.writeBlock(PCAPNG_BLOCK_TYPE_ENHANCED_PACKET, body)
+}
@@ -0,0 +1,99 @@
+package capture_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/ntchjb/gohid/usb"
+	"github.com/ntchjb/gohid/usb/capture"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// readBlocks splits a pcapng file into block types and bodies
+func readBlocks(t *testing.T, data []byte) ([]uint32, [][]byte) {
+	var types []uint32
+	var bodies [][]byte
+	for len(data) > 0 {
+		require.GreaterOrEqual(t, len(data), 12)
+		blockType := binary.LittleEndian.Uint32(data[0:4])
+		length := binary.LittleEndian.Uint32(data[4:8])
+		require.Zero(t, length%4)
+		require.LessOrEqual(t, int(length), len(data))
+		require.Equal(t, length, binary.LittleEndian.Uint32(data[length-4:length]))
+		types = append(types, blockType)
+		bodies = append(bodies, data[8:length-4])
+		data = data[length:]
+	}
+
+	return types, bodies
+}
+
+func TestPcapngWriter(t *testing.T) {
+	var buf bytes.Buffer
+	writer, err := capture.NewPcapngWriter(&buf, "usbmon1", 50)
+	require.NoError(t, err)
+
+	timestamp := time.Unix(1700000000, 123456000)
+	err = writer.WritePacket(capture.Packet{
+		ID:           7,
+		Event:        capture.EVENT_SUBMIT,
+		TransferType: capture.TRANSFER_TYPE_CONTROL,
+		Endpoint:     0x80,
+		Device:       21,
+		Bus:          1,
+		Setup:        []byte{0x81, 0x06, 0x00, 0x22, 0x01, 0x00, 0x40, 0x00},
+		Timestamp:    timestamp,
+		Status:       usb.URB_STATUS_EINPROGRESS,
+		Length:       64,
+	})
+	require.NoError(t, err)
+	err = writer.WritePacket(capture.Packet{
+		ID:           7,
+		Event:        capture.EVENT_COMPLETE,
+		TransferType: capture.TRANSFER_TYPE_CONTROL,
+		Endpoint:     0x80,
+		Device:       21,
+		Bus:          1,
+		Timestamp:    timestamp.Add(time.Millisecond),
+		Length:       5,
+		Data:         []byte{0x05, 0x01, 0x09, 0x02, 0xA1},
+	})
+	require.NoError(t, err)
+
+	types, bodies := readBlocks(t, buf.Bytes())
+	require.Equal(t, []uint32{
+		capture.PCAPNG_BLOCK_TYPE_SECTION_HEADER,
+		capture.PCAPNG_BLOCK_TYPE_INTERFACE,
+		capture.PCAPNG_BLOCK_TYPE_ENHANCED_PACKET,
+		capture.PCAPNG_BLOCK_TYPE_ENHANCED_PACKET,
+	}, types)
+	assert.Equal(t, capture.PCAPNG_BYTE_ORDER_MAGIC, binary.LittleEndian.Uint32(bodies[0][0:4]))
+	assert.Equal(t, capture.LINKTYPE_USB_LINUX, binary.LittleEndian.Uint16(bodies[1][0:2]))
+	assert.Equal(t, uint32(50), binary.LittleEndian.Uint32(bodies[1][4:8]))
+
+	submit := bodies[2]
+	timestampMicro := uint64(binary.LittleEndian.Uint32(submit[4:8]))<<32 | uint64(binary.LittleEndian.Uint32(submit[8:12]))
+	assert.Equal(t, uint64(timestamp.UnixMicro()), timestampMicro)
+	assert.Equal(t, uint32(capture.USBMON_HEADER_LENGTH), binary.LittleEndian.Uint32(submit[12:16]))
+	header := submit[20 : 20+capture.USBMON_HEADER_LENGTH]
+	assert.Equal(t, []byte{7, 0, 0, 0, 0, 0, 0, 0, 'S', 2, 0x80, 21, 1, 0, 0, '<'}, header[0:16])
+	assert.Equal(t, int64(1700000000), int64(binary.LittleEndian.Uint64(header[16:24])))
+	assert.Equal(t, uint32(123456), binary.LittleEndian.Uint32(header[24:28]))
+	assert.Equal(t, usb.URB_STATUS_EINPROGRESS, int32(binary.LittleEndian.Uint32(header[28:32])))
+	assert.Equal(t, uint32(64), binary.LittleEndian.Uint32(header[32:36]))
+	assert.Equal(t, uint32(0), binary.LittleEndian.Uint32(header[36:40]))
+	assert.Equal(t, []byte{0x81, 0x06, 0x00, 0x22, 0x01, 0x00, 0x40, 0x00}, header[40:48])
+
+	// Captured length is limited by snap length
+	complete := bodies[3]
+	assert.Equal(t, uint32(50), binary.LittleEndian.Uint32(complete[12:16]))
+	assert.Equal(t, uint32(capture.USBMON_HEADER_LENGTH+5), binary.LittleEndian.Uint32(complete[16:20]))
+	header = complete[20 : 20+capture.USBMON_HEADER_LENGTH]
+	assert.Equal(t, []byte{'C', 2, 0x80, 21, 1, 0, '-', 0}, header[8:16])
+	assert.Equal(t, uint32(5), binary.LittleEndian.Uint32(header[32:36]))
+	assert.Equal(t, uint32(2), binary.LittleEndian.Uint32(header[36:40]))
+	assert.Equal(t, []byte{0x05, 0x01}, complete[20+capture.USBMON_HEADER_LENGTH:20+50])
+}
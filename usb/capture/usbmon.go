@@ -0,0 +1,87 @@
+package capture
+
+import (
+	"encoding/binary"
+	"time"
+)
+
+type EventType uint8
+
+const (
+	EVENT_SUBMIT   EventType = 'S'
+	EVENT_COMPLETE EventType = 'C'
+	EVENT_ERROR    EventType = 'E'
+)
+
+// TransferType is transfer type of URB, as defined by usbmon
+type TransferType uint8
+
+const (
+	TRANSFER_TYPE_ISOCHRONOUS TransferType = 0
+	TRANSFER_TYPE_INTERRUPT   TransferType = 1
+	TRANSFER_TYPE_CONTROL     TransferType = 2
+	TRANSFER_TYPE_BULK        TransferType = 3
+)
+
+const (
+	// Size of usbmon packet header of LINKTYPE_USB_LINUX
+	USBMON_HEADER_LENGTH = 48
+	SETUP_PACKET_LENGTH  = 8
+
+	// Flags of usbmon header telling that setup packet or data is not captured
+	FLAG_SETUP_ABSENT = '-'
+	FLAG_DATA_IN      = '<'
+	FLAG_DATA_OUT     = '>'
+
+	ENDPOINT_DIRECTION_IN = 0x80
+)
+
+// Packet is a submission or completion of a URB, in the same form as captured by Linux usbmon
+type Packet struct {
+	// Tag of URB, shared by its submission and completion
+	ID           uint64
+	Event        EventType
+	TransferType TransferType
+	// Endpoint address, in which bit 7 is set for IN direction
+	Endpoint uint8
+	Device   uint8
+	Bus      uint16
+	// Setup packet of control transfer submission, or nil
+	Setup     []byte
+	Timestamp time.Time
+	Status    int32
+	// Length of URB data, which may be more than length of captured data
+	Length uint32
+	Data   []byte
+}
+
+// MarshalBinary encodes this packet as usbmon header followed by captured data
+func (p Packet) MarshalBinary() ([]byte, error) {
+	buf := make([]byte, USBMON_HEADER_LENGTH, USBMON_HEADER_LENGTH+len(p.Data))
+	binary.LittleEndian.PutUint64(buf[0:8], p.ID)
+	buf[8] = byte(p.Event)
+	buf[9] = byte(p.TransferType)
+	buf[10] = p.Endpoint
+	buf[11] = p.Device
+	binary.LittleEndian.PutUint16(buf[12:14], p.Bus)
+	buf[14] = FLAG_SETUP_ABSENT
+	if len(p.Setup) == SETUP_PACKET_LENGTH {
+		buf[14] = 0
+		copy(buf[40:48], p.Setup)
+	}
+	switch {
+	case len(p.Data) > 0:
+		buf[15] = 0
+	case p.Endpoint&ENDPOINT_DIRECTION_IN != 0:
+		buf[15] = FLAG_DATA_IN
+	default:
+		buf[15] = FLAG_DATA_OUT
+	}
+	binary.LittleEndian.PutUint64(buf[16:24], uint64(p.Timestamp.Unix()))
+	binary.LittleEndian.PutUint32(buf[24:28], uint32(p.Timestamp.Nanosecond()/1000))
+	binary.LittleEndian.PutUint32(buf[28:32], uint32(p.Status))
+	binary.LittleEndian.PutUint32(buf[32:36], p.Length)
+	binary.LittleEndian.PutUint32(buf[36:40], uint32(len(p.Data)))
+
+	return append(buf, p.Data...), nil
+}
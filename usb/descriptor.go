@@ -0,0 +1,317 @@
+package usb
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+	"time"
+
+	"github.com/google/gousb"
+)
+
+var (
+	ErrInvalidDescriptor = errors.New("invalid descriptor")
+)
+
+// Standard descriptors are read by libusb from sysfs instead of control transfers, so they are not available as bytes.
+// Functions below encode them back from parsed descriptors, for USB traffic captures and devices served to other hosts.
+
+const (
+	DEVICE_DESCRIPTOR_LENGTH = 18
+	// Offsets of string descriptor indices in device descriptor
+	DEVICE_DESCRIPTOR_MANUFACTURER_OFFSET  = 14
+	DEVICE_DESCRIPTOR_PRODUCT_OFFSET       = 15
+	DEVICE_DESCRIPTOR_SERIAL_NUMBER_OFFSET = 16
+	// Offset of bNumConfigurations in device descriptor
+	DEVICE_DESCRIPTOR_NUM_CONFIGURATIONS_OFFSET = 17
+
+	CONFIG_DESCRIPTOR_LENGTH    = 9
+	INTERFACE_DESCRIPTOR_LENGTH = 9
+	ENDPOINT_DESCRIPTOR_LENGTH  = 7
+
+	DESCRIPTOR_TYPE_DEVICE    = 0x01
+	DESCRIPTOR_TYPE_CONFIG    = 0x02
+	DESCRIPTOR_TYPE_STRING    = 0x03
+	DESCRIPTOR_TYPE_INTERFACE = 0x04
+	DESCRIPTOR_TYPE_ENDPOINT  = 0x05
+
+	// String descriptor indices of manufacturer, product and serial number strings of emulated and exported devices
+	STRING_INDEX_MANUFACTURER  = 1
+	STRING_INDEX_PRODUCT       = 2
+	STRING_INDEX_SERIAL_NUMBER = 3
+	// Language ID of string descriptors, which is English (United States)
+	STRING_LANGUAGE_ID = 0x0409
+)
+
+// EncodeDeviceDescriptor encodes a device descriptor. String descriptor indices are zero,
+// as they are not exposed by gousb.
+func EncodeDeviceDescriptor(desc *gousb.DeviceDesc) []byte {
+	buf := make([]byte, DEVICE_DESCRIPTOR_LENGTH)
+	buf[0] = DEVICE_DESCRIPTOR_LENGTH
+	buf[1] = DESCRIPTOR_TYPE_DEVICE
+	binary.LittleEndian.PutUint16(buf[2:4], uint16(desc.Spec))
+	buf[4] = uint8(desc.Class)
+	buf[5] = uint8(desc.SubClass)
+	buf[6] = uint8(desc.Protocol)
+	buf[7] = uint8(desc.MaxControlPacketSize)
+	binary.LittleEndian.PutUint16(buf[8:10], uint16(desc.Vendor))
+	binary.LittleEndian.PutUint16(buf[10:12], uint16(desc.Product))
+	binary.LittleEndian.PutUint16(buf[12:14], uint16(desc.Device))
+	buf[DEVICE_DESCRIPTOR_NUM_CONFIGURATIONS_OFFSET] = uint8(len(desc.Configs))
+
+	return buf
+}
+
+// pollInterval converts poll interval into bInterval of endpoint descriptor
+func pollInterval(interval time.Duration, speed gousb.Speed) uint8 {
+	if speed < gousb.SpeedHigh {
+		return uint8(interval / time.Millisecond)
+	}
+	// Interval is 2^(bInterval-1) microframes of 125us
+	microframes := uint(interval / (125 * time.Microsecond))
+	if microframes == 0 {
+		return 1
+	}
+
+	return uint8(bits.Len(microframes))
+}
+
+// decodePollInterval converts bInterval of endpoint descriptor into poll interval
+func decodePollInterval(interval uint8, speed gousb.Speed) time.Duration {
+	if speed < gousb.SpeedHigh {
+		return time.Duration(interval) * time.Millisecond
+	}
+	if interval == 0 {
+		return 0
+	}
+
+	return time.Duration(1<<(min(interval, 16)-1)) * 125 * time.Microsecond
+}
+
+// gousb keeps sync type as bits of bmAttributes, but usage type as its own enum
+const (
+	endpointSyncTypeMask  = 0x0C
+	endpointUsageTypeMask = 0x30
+)
+
+// endpointAttributes encodes bmAttributes of endpoint descriptor
+func endpointAttributes(endpoint gousb.EndpointDesc) uint8 {
+	attributes := uint8(endpoint.TransferType)
+	switch endpoint.TransferType {
+	case gousb.TransferTypeIsochronous:
+		attributes |= uint8(endpoint.IsoSyncType) & endpointSyncTypeMask
+		switch endpoint.UsageType {
+		case gousb.IsoUsageTypeFeedback:
+			attributes |= 0x10
+		case gousb.IsoUsageTypeImplicit:
+			attributes |= 0x20
+		}
+	case gousb.TransferTypeInterrupt:
+		if endpoint.UsageType == gousb.InterruptUsageTypeNotification {
+			attributes |= 0x10
+		}
+	}
+
+	return attributes
+}
+
+// decodeEndpointAttributes sets transfer, sync and usage types of an endpoint from bmAttributes
+func decodeEndpointAttributes(endpoint *gousb.EndpointDesc, attributes uint8) {
+	endpoint.TransferType = gousb.TransferType(attributes & 0x03)
+	usage := attributes & endpointUsageTypeMask
+	switch endpoint.TransferType {
+	case gousb.TransferTypeIsochronous:
+		endpoint.IsoSyncType = gousb.IsoSyncType(attributes & endpointSyncTypeMask)
+		switch usage {
+		case 0x00:
+			endpoint.UsageType = gousb.IsoUsageTypeData
+		case 0x10:
+			endpoint.UsageType = gousb.IsoUsageTypeFeedback
+		case 0x20:
+			endpoint.UsageType = gousb.IsoUsageTypeImplicit
+		}
+	case gousb.TransferTypeInterrupt:
+		switch usage {
+		case 0x00:
+			endpoint.UsageType = gousb.InterruptUsageTypePeriodic
+		case 0x10:
+			endpoint.UsageType = gousb.InterruptUsageTypeNotification
+		}
+	}
+}
+
+// EncodeConfigDescriptor encodes a configuration descriptor with its interface and endpoint descriptors.
+// Class-specific descriptors, e.g. HID descriptors, are placed after descriptors of interfaces by interface number.
+func EncodeConfigDescriptor(desc *gousb.DeviceDesc, config gousb.ConfigDesc, classDescriptors map[int][]byte) []byte {
+	buf := make([]byte, CONFIG_DESCRIPTOR_LENGTH)
+	buf[0] = CONFIG_DESCRIPTOR_LENGTH
+	buf[1] = DESCRIPTOR_TYPE_CONFIG
+	buf[5] = uint8(config.Number)
+	buf[7] = 0x80
+	if config.SelfPowered {
+		buf[7] |= 0x40
+	}
+	if config.RemoteWakeup {
+		buf[7] |= 0x20
+	}
+	// Max power is in units of 2mA, or 8mA for SuperSpeed
+	if desc.Speed >= gousb.SpeedSuper {
+		buf[8] = uint8(config.MaxPower / 8)
+	} else {
+		buf[8] = uint8(config.MaxPower / 2)
+	}
+	buf[4] = uint8(len(config.Interfaces))
+
+	for _, inf := range config.Interfaces {
+		for _, setting := range inf.AltSettings {
+			buf = append(buf, INTERFACE_DESCRIPTOR_LENGTH, DESCRIPTOR_TYPE_INTERFACE, uint8(inf.Number), uint8(setting.Alternate), uint8(len(setting.Endpoints)),
+				uint8(setting.Class), uint8(setting.SubClass), uint8(setting.Protocol), 0)
+			buf = append(buf, classDescriptors[inf.Number]...)
+			for _, endpoint := range SortedEndpoints(setting.Endpoints) {
+				buf = append(buf, ENDPOINT_DESCRIPTOR_LENGTH, DESCRIPTOR_TYPE_ENDPOINT, uint8(endpoint.Address), endpointAttributes(endpoint),
+					0, 0, pollInterval(endpoint.PollInterval, desc.Speed))
+				binary.LittleEndian.PutUint16(buf[len(buf)-3:len(buf)-1], uint16(endpoint.MaxPacketSize))
+			}
+		}
+	}
+	binary.LittleEndian.PutUint16(buf[2:4], uint16(len(buf)))
+
+	return buf
+}
+
+// SortedEndpoints lists endpoints of an interface setting in order of endpoint address
+func SortedEndpoints(endpoints map[gousb.EndpointAddress]gousb.EndpointDesc) []gousb.EndpointDesc {
+	res := make([]gousb.EndpointDesc, 0, len(endpoints))
+	for address := 0; address <= 0xFF; address++ {
+		if endpoint, ok := endpoints[gousb.EndpointAddress(address)]; ok {
+			res = append(res, endpoint)
+		}
+	}
+
+	return res
+}
+
+// EncodeStringDescriptor encodes a string as string descriptor in UTF-16LE
+func EncodeStringDescriptor(str string) []byte {
+	buf := []byte{2, DESCRIPTOR_TYPE_STRING}
+	for _, r := range str {
+		if r > 0xFFFF {
+			r = 0xFFFD
+		}
+		buf = binary.LittleEndian.AppendUint16(buf, uint16(r))
+	}
+	// Length of descriptor is at most 255 bytes
+	buf = buf[:min(len(buf), 0xFE)]
+	buf[0] = uint8(len(buf))
+
+	return buf
+}
+
+// DecodeDeviceDescriptor decodes a device descriptor, with bus, address and speed left for the caller to fill.
+// String descriptor indices are returned separately, as gousb.DeviceDesc does not have them.
+func DecodeDeviceDescriptor(buf []byte) (desc *gousb.DeviceDesc, strings [3]uint8, err error) {
+	if len(buf) < DEVICE_DESCRIPTOR_LENGTH || buf[1] != DESCRIPTOR_TYPE_DEVICE {
+		return nil, strings, fmt.Errorf("device descriptor of %d bytes: %w", len(buf), ErrInvalidDescriptor)
+	}
+
+	desc = &gousb.DeviceDesc{
+		Spec:                 gousb.BCD(binary.LittleEndian.Uint16(buf[2:4])),
+		Class:                gousb.Class(buf[4]),
+		SubClass:             gousb.Class(buf[5]),
+		Protocol:             gousb.Protocol(buf[6]),
+		MaxControlPacketSize: int(buf[7]),
+		Vendor:               gousb.ID(binary.LittleEndian.Uint16(buf[8:10])),
+		Product:              gousb.ID(binary.LittleEndian.Uint16(buf[10:12])),
+		Device:               gousb.BCD(binary.LittleEndian.Uint16(buf[12:14])),
+		Configs:              make(map[int]gousb.ConfigDesc),
+	}
+	strings = [3]uint8{
+		buf[DEVICE_DESCRIPTOR_MANUFACTURER_OFFSET],
+		buf[DEVICE_DESCRIPTOR_PRODUCT_OFFSET],
+		buf[DEVICE_DESCRIPTOR_SERIAL_NUMBER_OFFSET],
+	}
+
+	return desc, strings, nil
+}
+
+// DecodeConfigDescriptor decodes a configuration descriptor with its interface and endpoint descriptors,
+// as returned by GET_DESCRIPTOR request. Class-specific and unknown descriptors are skipped.
+func DecodeConfigDescriptor(buf []byte, speed gousb.Speed) (gousb.ConfigDesc, error) {
+	if len(buf) < CONFIG_DESCRIPTOR_LENGTH || buf[1] != DESCRIPTOR_TYPE_CONFIG {
+		return gousb.ConfigDesc{}, fmt.Errorf("config descriptor of %d bytes: %w", len(buf), ErrInvalidDescriptor)
+	}
+	totalLength := int(binary.LittleEndian.Uint16(buf[2:4]))
+	if totalLength > len(buf) {
+		return gousb.ConfigDesc{}, fmt.Errorf("config descriptor of %d bytes is truncated to %d bytes: %w", totalLength, len(buf), ErrInvalidDescriptor)
+	}
+
+	config := gousb.ConfigDesc{
+		Number:       int(buf[5]),
+		SelfPowered:  buf[7]&0x40 != 0,
+		RemoteWakeup: buf[7]&0x20 != 0,
+	}
+	if speed >= gousb.SpeedSuper {
+		config.MaxPower = gousb.Milliamperes(buf[8]) * 8
+	} else {
+		config.MaxPower = gousb.Milliamperes(buf[8]) * 2
+	}
+
+	var setting *gousb.InterfaceSetting
+	for offset := int(buf[0]); offset < totalLength; {
+		length := int(buf[offset])
+		if length < 2 || offset+length > totalLength {
+			return gousb.ConfigDesc{}, fmt.Errorf("descriptor of %d bytes at offset %d: %w", length, offset, ErrInvalidDescriptor)
+		}
+		desc := buf[offset : offset+length]
+		offset += length
+
+		switch desc[1] {
+		case DESCRIPTOR_TYPE_INTERFACE:
+			if length < INTERFACE_DESCRIPTOR_LENGTH {
+				return gousb.ConfigDesc{}, fmt.Errorf("interface descriptor of %d bytes: %w", length, ErrInvalidDescriptor)
+			}
+			setting = addInterfaceSetting(&config, gousb.InterfaceSetting{
+				Number:    int(desc[2]),
+				Alternate: int(desc[3]),
+				Class:     gousb.Class(desc[5]),
+				SubClass:  gousb.Class(desc[6]),
+				Protocol:  gousb.Protocol(desc[7]),
+				Endpoints: make(map[gousb.EndpointAddress]gousb.EndpointDesc),
+			})
+		case DESCRIPTOR_TYPE_ENDPOINT:
+			if length < ENDPOINT_DESCRIPTOR_LENGTH || setting == nil {
+				return gousb.ConfigDesc{}, fmt.Errorf("endpoint descriptor of %d bytes: %w", length, ErrInvalidDescriptor)
+			}
+			address := gousb.EndpointAddress(desc[2])
+			endpoint := gousb.EndpointDesc{
+				Address:       address,
+				Number:        int(address & 0x0F),
+				Direction:     gousb.EndpointDirection(address&0x80 != 0),
+				MaxPacketSize: int(binary.LittleEndian.Uint16(desc[4:6]) & 0x07FF),
+				PollInterval:  decodePollInterval(desc[6], speed),
+			}
+			decodeEndpointAttributes(&endpoint, desc[3])
+			setting.Endpoints[address] = endpoint
+		}
+	}
+
+	return config, nil
+}
+
+// addInterfaceSetting adds an alternate setting to its interface, and returns pointer to the added setting
+func addInterfaceSetting(config *gousb.ConfigDesc, setting gousb.InterfaceSetting) *gousb.InterfaceSetting {
+	for i := range config.Interfaces {
+		if config.Interfaces[i].Number == setting.Number {
+			intf := &config.Interfaces[i]
+			intf.AltSettings = append(intf.AltSettings, setting)
+			return &intf.AltSettings[len(intf.AltSettings)-1]
+		}
+	}
+	config.Interfaces = append(config.Interfaces, gousb.InterfaceDesc{
+		Number:      setting.Number,
+		AltSettings: []gousb.InterfaceSetting{setting},
+	})
+
+	return &config.Interfaces[len(config.Interfaces)-1].AltSettings[0]
+}
@@ -0,0 +1,116 @@
+package usb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/gousb"
+	"github.com/ntchjb/gohid/usb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeDeviceDescriptor(t *testing.T) {
+	buf := []byte{18, 0x01, 0x00, 0x02, 0, 0, 0, 64, 0x01, 0xFF, 0x02, 0x00, 0x10, 0x01, 1, 2, 3, 1}
+
+	desc, strings, err := usb.DecodeDeviceDescriptor(buf)
+	require.NoError(t, err)
+	assert.Equal(t, &gousb.DeviceDesc{
+		Spec:                 0x0200,
+		MaxControlPacketSize: 64,
+		Vendor:               0xFF01,
+		Product:              0x0002,
+		Device:               0x0110,
+		Configs:              map[int]gousb.ConfigDesc{},
+	}, desc)
+	assert.Equal(t, [3]uint8{1, 2, 3}, strings)
+
+	_, _, err = usb.DecodeDeviceDescriptor(buf[:17])
+	assert.ErrorIs(t, err, usb.ErrInvalidDescriptor)
+}
+
+func TestDecodeConfigDescriptor(t *testing.T) {
+	tests := []struct {
+		name   string
+		speed  gousb.Speed
+		config gousb.ConfigDesc
+	}{
+		{
+			name:  "full speed HID",
+			speed: gousb.SpeedFull,
+			config: gousb.ConfigDesc{
+				Number:       1,
+				RemoteWakeup: true,
+				MaxPower:     100,
+				Interfaces: []gousb.InterfaceDesc{{
+					Number: 0,
+					AltSettings: []gousb.InterfaceSetting{{
+						Class: gousb.ClassHID,
+						Endpoints: map[gousb.EndpointAddress]gousb.EndpointDesc{
+							0x01: {Address: 0x01, Number: 1, Direction: gousb.EndpointDirectionOut, MaxPacketSize: 64,
+								TransferType: gousb.TransferTypeInterrupt, UsageType: gousb.InterruptUsageTypePeriodic, PollInterval: 10 * time.Millisecond},
+							0x81: {Address: 0x81, Number: 1, Direction: gousb.EndpointDirectionIn, MaxPacketSize: 64,
+								TransferType: gousb.TransferTypeInterrupt, UsageType: gousb.InterruptUsageTypeNotification, PollInterval: 10 * time.Millisecond},
+						},
+					}},
+				}},
+			},
+		},
+		{
+			name:  "high speed with alternate settings",
+			speed: gousb.SpeedHigh,
+			config: gousb.ConfigDesc{
+				Number:      2,
+				SelfPowered: true,
+				Interfaces: []gousb.InterfaceDesc{
+					{
+						Number:      0,
+						AltSettings: []gousb.InterfaceSetting{{Class: gousb.ClassVendorSpec, Endpoints: map[gousb.EndpointAddress]gousb.EndpointDesc{}}},
+					},
+					{
+						Number: 1,
+						AltSettings: []gousb.InterfaceSetting{
+							{Number: 1, Class: gousb.ClassAudio, Endpoints: map[gousb.EndpointAddress]gousb.EndpointDesc{}},
+							{Number: 1, Alternate: 1, Class: gousb.ClassAudio, Endpoints: map[gousb.EndpointAddress]gousb.EndpointDesc{
+								0x82: {Address: 0x82, Number: 2, Direction: gousb.EndpointDirectionIn, MaxPacketSize: 192,
+									TransferType: gousb.TransferTypeIsochronous, IsoSyncType: gousb.IsoSyncTypeAsync,
+									UsageType: gousb.IsoUsageTypeData, PollInterval: time.Millisecond},
+							}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			desc := &gousb.DeviceDesc{Speed: test.speed}
+			buf := usb.EncodeConfigDescriptor(desc, test.config, map[int][]byte{0: {9, 0x21, 0x11, 0x01, 0, 1, 0x22, 0x20, 0}})
+
+			config, err := usb.DecodeConfigDescriptor(buf, test.speed)
+			require.NoError(t, err)
+			assert.Equal(t, test.config, config)
+		})
+	}
+}
+
+func TestDecodeConfigDescriptor_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		buf  []byte
+	}{
+		{name: "short header", buf: []byte{9, 0x02, 9, 0}},
+		{name: "not config", buf: []byte{9, 0x04, 9, 0, 0, 0, 0, 0, 0}},
+		{name: "truncated", buf: []byte{9, 0x02, 18, 0, 1, 1, 0, 0x80, 50}},
+		{name: "zero length descriptor", buf: []byte{9, 0x02, 11, 0, 1, 1, 0, 0x80, 50, 0, 0x04}},
+		{name: "endpoint without interface", buf: []byte{9, 0x02, 16, 0, 1, 1, 0, 0x80, 50, 7, 0x05, 0x81, 0x03, 64, 0, 10}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := usb.DecodeConfigDescriptor(test.buf, gousb.SpeedFull)
+			assert.ErrorIs(t, err, usb.ErrInvalidDescriptor)
+		})
+	}
+}
@@ -0,0 +1,436 @@
+package emulator
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/google/gousb"
+	"github.com/ntchjb/gohid/hid"
+	"github.com/ntchjb/gohid/usb"
+	"github.com/ntchjb/usbip-virtual-device/usb/protocol/descriptor"
+)
+
+// inputQueue is a queue of Input reports of an interface, whose buffers are reused after reports are read
+type inputQueue struct {
+	reports chan []byte
+	free    chan []byte
+}
+
+func newInputQueue() *inputQueue {
+	return &inputQueue{
+		reports: make(chan []byte, INPUT_QUEUE_LENGTH),
+		free:    make(chan []byte, INPUT_QUEUE_LENGTH),
+	}
+}
+
+// buffer returns a copy of report data in a reused buffer
+func (q *inputQueue) buffer(data []byte) []byte {
+	var buf []byte
+	select {
+	case buf = <-q.free:
+	default:
+	}
+
+	return append(buf[:0], data...)
+}
+
+// release returns buffer of a report to be reused
+func (q *inputQueue) release(buf []byte) {
+	select {
+	case q.free <- buf:
+	default:
+	}
+}
+
+// connection is the state of a device from when it is plugged until it is unplugged
+type connection struct {
+	// Queues of Input reports by interface number
+	inputs   map[int]*inputQueue
+	halted   map[gousb.EndpointAddress]bool
+	idle     map[int]uint8
+	protocol map[int]uint8
+	// Closed when the device is unplugged
+	disconnected chan struct{}
+}
+
+func (c *connection) isDisconnected() bool {
+	select {
+	case <-c.disconnected:
+		return true
+	default:
+		return false
+	}
+}
+
+type deviceImpl struct {
+	config DeviceConfig
+	// Current connection, or nil if the device is unplugged
+	conn *connection
+	mu   sync.Mutex
+}
+
+func (d *deviceImpl) currentConnection() *connection {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.conn
+}
+
+func (d *deviceImpl) SendInput(ctx context.Context, interfaceNumber int, data []byte) error {
+	conn := d.currentConnection()
+	if conn == nil {
+		return gousb.ErrorNoDevice
+	}
+	queue, ok := conn.inputs[interfaceNumber]
+	if !ok {
+		return fmt.Errorf("interface %d: %w", interfaceNumber, ErrInterfaceNotFound)
+	}
+
+	buf := queue.buffer(data)
+	select {
+	case queue.reports <- buf:
+		return nil
+	case <-conn.disconnected:
+		queue.release(buf)
+		return gousb.ErrorNoDevice
+	case <-ctx.Done():
+		queue.release(buf)
+		return ctx.Err()
+	}
+}
+
+func (d *deviceImpl) SetHalt(address gousb.EndpointAddress, halted bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.conn != nil {
+		d.conn.halted[address] = halted
+	}
+}
+
+func (d *deviceImpl) isHalted(conn *connection, address gousb.EndpointAddress) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return conn.halted[address]
+}
+
+func (d *deviceImpl) Disconnect() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.conn != nil {
+		close(d.conn.disconnected)
+		d.conn = nil
+	}
+}
+
+func (d *deviceImpl) Reconnect() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.conn != nil {
+		return
+	}
+	d.conn = &connection{
+		inputs:       make(map[int]*inputQueue),
+		halted:       make(map[gousb.EndpointAddress]bool),
+		idle:         make(map[int]uint8),
+		protocol:     make(map[int]uint8),
+		disconnected: make(chan struct{}),
+	}
+	for number := range d.config.Interfaces {
+		d.conn.inputs[number] = newInputQueue()
+		d.conn.protocol[number] = hid.PROTOCOL_REPORT
+	}
+}
+
+// stringTable gets string descriptors by index
+func (d *deviceImpl) stringTable() map[int]string {
+	res := make(map[int]string)
+	for index, str := range map[int]string{
+		usb.STRING_INDEX_MANUFACTURER:  d.config.Manufacturer,
+		usb.STRING_INDEX_PRODUCT:       d.config.Product,
+		usb.STRING_INDEX_SERIAL_NUMBER: d.config.SerialNumber,
+	} {
+		if str != "" {
+			res[index] = str
+		}
+	}
+	for index, str := range d.config.Strings {
+		res[index] = str
+	}
+
+	return res
+}
+
+// deviceDescriptor encodes device descriptor, with indices of strings which are defined
+func (d *deviceImpl) deviceDescriptor() []byte {
+	buf := usb.EncodeDeviceDescriptor(d.config.Desc)
+	if d.config.Manufacturer != "" {
+		buf[usb.DEVICE_DESCRIPTOR_MANUFACTURER_OFFSET] = usb.STRING_INDEX_MANUFACTURER
+	}
+	if d.config.Product != "" {
+		buf[usb.DEVICE_DESCRIPTOR_PRODUCT_OFFSET] = usb.STRING_INDEX_PRODUCT
+	}
+	if d.config.SerialNumber != "" {
+		buf[usb.DEVICE_DESCRIPTOR_SERIAL_NUMBER_OFFSET] = usb.STRING_INDEX_SERIAL_NUMBER
+	}
+
+	return buf
+}
+
+// configDescriptor encodes configuration descriptor by index, with HID descriptors of HID interfaces
+func (d *deviceImpl) configDescriptor(index int) ([]byte, bool) {
+	numbers := make([]int, 0, len(d.config.Desc.Configs))
+	for number := range d.config.Desc.Configs {
+		numbers = append(numbers, number)
+	}
+	slices.Sort(numbers)
+	if index >= len(numbers) {
+		return nil, false
+	}
+
+	hidDescriptors := make(map[int][]byte)
+	for number, intf := range d.config.Interfaces {
+		hidDescriptors[number] = hidDescriptor(intf.ReportDescriptor)
+	}
+
+	return usb.EncodeConfigDescriptor(d.config.Desc, d.config.Desc.Configs[numbers[index]], hidDescriptors), true
+}
+
+// hidDescriptor encodes HID descriptor of an interface having a report descriptor
+func hidDescriptor(reportDescriptor []byte) []byte {
+	buf := []byte{9, byte(hid.DESCRIPTOR_TYPE_HID), 0, 0, 0, 1, byte(hid.DESCRIPTOR_TYPE_REPORT), 0, 0}
+	binary.LittleEndian.PutUint16(buf[2:4], HID_VERSION)
+	binary.LittleEndian.PutUint16(buf[7:9], uint16(len(reportDescriptor)))
+
+	return buf
+}
+
+// handlerError converts an error of handler into an error of control transfer.
+// Errors of gousb are kept, so that handlers can emulate errors like timeout.
+func handlerError(err error) error {
+	var usbErr gousb.Error
+	if errors.As(err, &usbErr) {
+		return usbErr
+	}
+
+	return gousb.ErrorPipe
+}
+
+// streamError converts an error of handler into an error of interrupt transfer
+func streamError(err error) error {
+	var transferStatus gousb.TransferStatus
+	if errors.As(err, &transferStatus) {
+		return transferStatus
+	}
+
+	return gousb.TransferStall
+}
+
+// usbDevice is an opened connection of an emulated device
+type usbDevice struct {
+	device *deviceImpl
+	conn   *connection
+}
+
+func (d *usbDevice) SetAutoDetach(autoDetach bool) error {
+	if d.conn.isDisconnected() {
+		return gousb.ErrorNoDevice
+	}
+
+	return nil
+}
+
+func (d *usbDevice) Config(configNumber int) (usb.Config, error) {
+	if d.conn.isDisconnected() {
+		return nil, gousb.ErrorNoDevice
+	}
+	desc, ok := d.device.config.Desc.Configs[configNumber]
+	if !ok {
+		return nil, fmt.Errorf("config %d: %w", configNumber, gousb.ErrorNotFound)
+	}
+
+	return &usbConfig{desc: desc, device: d}, nil
+}
+
+func (d *usbDevice) Descriptor() *gousb.DeviceDesc {
+	return d.device.config.Desc
+}
+
+func (d *usbDevice) Control(bmRequestType, bRequest uint8, wValue, wIndex uint16, data []byte) (int, error) {
+	if d.conn.isDisconnected() {
+		return 0, gousb.ErrorNoDevice
+	}
+
+	standardIn := uint8(hid.SETUP_REQUEST_TYPE_STANDARD) | uint8(hid.SETUP_EP_DIR_IN)
+	switch {
+	case bmRequestType == standardIn|uint8(hid.SETUP_RECIPIENT_DEVICE) && bRequest == uint8(hid.SETUP_REQUEST_GET_DESCRIPTOR):
+		return d.getDeviceDescriptor(wValue, data)
+	case bmRequestType == standardIn|uint8(hid.SETUP_RECIPIENT_INTERFACE) && bRequest == uint8(hid.SETUP_REQUEST_GET_DESCRIPTOR):
+		return d.getInterfaceDescriptor(wValue, wIndex, data)
+	case bmRequestType&^uint8(hid.SETUP_EP_DIR_IN) == uint8(hid.SETUP_REQUEST_TYPE_CLASS)|uint8(hid.SETUP_RECIPIENT_INTERFACE):
+		return d.hidRequest(bmRequestType, bRequest, wValue, wIndex, data)
+	case bmRequestType == usb.REQUEST_TYPE_ENDPOINT_OUT && bRequest == usb.REQUEST_CLEAR_FEATURE && wValue == usb.FEATURE_ENDPOINT_HALT:
+		d.device.mu.Lock()
+		defer d.device.mu.Unlock()
+		delete(d.conn.halted, gousb.EndpointAddress(wIndex))
+		return 0, nil
+	}
+
+	return 0, gousb.ErrorPipe
+}
+
+func (d *usbDevice) getDeviceDescriptor(wValue uint16, data []byte) (int, error) {
+	descType, index := descriptor.GetDescriptorTypeAndIndex(wValue)
+	switch descType {
+	case descriptor.DESCRIPTOR_TYPE_DEVICE:
+		return copy(data, d.device.deviceDescriptor()), nil
+	case descriptor.DESCRIPTOR_TYPE_CONFIGURATION:
+		if buf, ok := d.device.configDescriptor(int(index)); ok {
+			return copy(data, buf), nil
+		}
+	case descriptor.DESCRIPTOR_TYPE_STRING:
+		if index == 0 {
+			return copy(data, binary.LittleEndian.AppendUint16([]byte{4, usb.DESCRIPTOR_TYPE_STRING}, usb.STRING_LANGUAGE_ID)), nil
+		}
+		if str, ok := d.device.stringTable()[int(index)]; ok {
+			return copy(data, usb.EncodeStringDescriptor(str)), nil
+		}
+	}
+
+	return 0, gousb.ErrorPipe
+}
+
+func (d *usbDevice) getInterfaceDescriptor(wValue, wIndex uint16, data []byte) (int, error) {
+	intf, ok := d.device.config.Interfaces[int(wIndex&0xFF)]
+	if !ok {
+		return 0, gousb.ErrorPipe
+	}
+
+	switch hid.ClassDescriptorType(wValue >> 8) {
+	case hid.DESCRIPTOR_TYPE_HID:
+		return copy(data, hidDescriptor(intf.ReportDescriptor)), nil
+	case hid.DESCRIPTOR_TYPE_REPORT:
+		return copy(data, intf.ReportDescriptor), nil
+	}
+
+	return 0, gousb.ErrorPipe
+}
+
+func (d *usbDevice) hidRequest(bmRequestType, bRequest uint8, wValue, wIndex uint16, data []byte) (int, error) {
+	number := int(wIndex & 0xFF)
+	intf, ok := d.device.config.Interfaces[number]
+	if !ok {
+		return 0, gousb.ErrorPipe
+	}
+	isIn := bmRequestType&uint8(hid.SETUP_EP_DIR_IN) != 0
+	reportType := hid.ReportType(wValue >> 8)
+	reportID := uint8(wValue)
+
+	switch hid.SetupRequest(bRequest) {
+	case hid.SETUP_REQUEST_HID_GET_REPORT:
+		if !isIn || intf.Handler == nil {
+			break
+		}
+		n, err := intf.Handler.GetReport(reportType, reportID, data)
+		if err != nil {
+			return 0, handlerError(err)
+		}
+		return n, nil
+	case hid.SETUP_REQUEST_HID_SET_REPORT:
+		if isIn || intf.Handler == nil {
+			break
+		}
+		if err := intf.Handler.SetReport(reportType, reportID, slices.Clone(data)); err != nil {
+			return 0, handlerError(err)
+		}
+		return len(data), nil
+	case hid.SETUP_REQUEST_HID_GET_IDLE, hid.SETUP_REQUEST_HID_GET_PROTOCOL:
+		if !isIn || len(data) == 0 {
+			break
+		}
+		d.device.mu.Lock()
+		defer d.device.mu.Unlock()
+		if hid.SetupRequest(bRequest) == hid.SETUP_REQUEST_HID_GET_IDLE {
+			data[0] = d.conn.idle[number]
+		} else {
+			data[0] = d.conn.protocol[number]
+		}
+		return 1, nil
+	case hid.SETUP_REQUEST_HID_SET_IDLE, hid.SETUP_REQUEST_HID_SET_PROTOCOL:
+		if isIn {
+			break
+		}
+		d.device.mu.Lock()
+		defer d.device.mu.Unlock()
+		if hid.SetupRequest(bRequest) == hid.SETUP_REQUEST_HID_SET_IDLE {
+			d.conn.idle[number] = uint8(wValue >> 8)
+		} else {
+			d.conn.protocol[number] = uint8(wValue)
+		}
+		return 0, nil
+	}
+
+	return 0, gousb.ErrorPipe
+}
+
+func (d *usbDevice) GetStringDescriptor(index int) (string, error) {
+	if d.conn.isDisconnected() {
+		return "", gousb.ErrorNoDevice
+	}
+	str, ok := d.device.stringTable()[index]
+	if !ok {
+		return "", gousb.ErrorPipe
+	}
+
+	return str, nil
+}
+
+// deviceString gets manufacturer, product or serial number string, which is empty if it is not defined
+func (d *usbDevice) deviceString(str string) (string, error) {
+	if d.conn.isDisconnected() {
+		return "", gousb.ErrorNoDevice
+	}
+
+	return str, nil
+}
+
+func (d *usbDevice) Manufacturer() (string, error) {
+	return d.deviceString(d.device.config.Manufacturer)
+}
+
+func (d *usbDevice) Product() (string, error) {
+	return d.deviceString(d.device.config.Product)
+}
+
+func (d *usbDevice) SerialNumber() (string, error) {
+	return d.deviceString(d.device.config.SerialNumber)
+}
+
+// Reset clears halt conditions of endpoints, and resets idle rates and protocols of HID interfaces.
+// Queued Input reports are kept, as the device does not re-enumerate.
+func (d *usbDevice) Reset() error {
+	if d.conn.isDisconnected() {
+		return gousb.ErrorNoDevice
+	}
+	d.device.mu.Lock()
+	defer d.device.mu.Unlock()
+
+	clear(d.conn.halted)
+	for number := range d.conn.protocol {
+		d.conn.idle[number] = 0
+		d.conn.protocol[number] = hid.PROTOCOL_REPORT
+	}
+
+	return nil
+}
+
+func (d *usbDevice) Close() error {
+	return nil
+}
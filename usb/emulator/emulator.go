@@ -0,0 +1,159 @@
+// Package emulator provides an in-process usb.Context of software-emulated HID devices, so that code using
+// this library can be tested without hardware and without writing expectations of every USB transfer.
+package emulator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/google/gousb"
+	"github.com/ntchjb/gohid/hid"
+	"github.com/ntchjb/gohid/usb"
+)
+
+var (
+	ErrInvalidDevice     = errors.New("invalid emulated device")
+	ErrInterfaceNotFound = errors.New("emulated HID interface not found")
+)
+
+const (
+	// Number of Input reports queued on interrupt IN endpoint before SendInput blocks
+	INPUT_QUEUE_LENGTH = 64
+	// bcdHID of HID descriptors of emulated interfaces
+	HID_VERSION = 0x0111
+)
+
+// Handler handles requests sent to an emulated HID interface, like the firmware of a device.
+// An error returned by a handler stalls the request, except gousb.Error of control requests and gousb.TransferStatus
+// of Output reports, which are returned as they are, e.g. gousb.ErrorTimeout to emulate an unresponsive device.
+type Handler interface {
+	// Handle GET_REPORT request by writing the report into data, without report ID byte if reportID is zero
+	GetReport(reportType hid.ReportType, reportID uint8, data []byte) (int, error)
+	// Handle SET_REPORT request, where data has no report ID byte if reportID is zero
+	SetReport(reportType hid.ReportType, reportID uint8, data []byte) error
+	// Handle an Output report written to interrupt OUT endpoint
+	Output(data []byte) error
+}
+
+// InterfaceConfig defines an emulated HID interface
+type InterfaceConfig struct {
+	ReportDescriptor []byte
+	// Handler of requests, or nil to stall all report requests
+	Handler Handler
+}
+
+// DeviceConfig defines an emulated device
+type DeviceConfig struct {
+	Desc         *gousb.DeviceDesc
+	Manufacturer string
+	Product      string
+	SerialNumber string
+	// String descriptors by index
+	Strings map[int]string
+	// HID interfaces by interface number. Interfaces must be defined in Desc.
+	Interfaces map[int]InterfaceConfig
+}
+
+// Device controls an emulated device from the device side
+type Device interface {
+	// Queue an Input report to interrupt IN endpoint of an interface, which is read in packets of max packet size
+	// of the endpoint. It blocks while the queue is full.
+	SendInput(ctx context.Context, interfaceNumber int, data []byte) error
+	// Set or clear halt condition of an endpoint, where transfers to a halted endpoint are stalled
+	// until the host clears it, or resets the device
+	SetHalt(address gousb.EndpointAddress, halted bool)
+	// Unplug the device. Opened connections of the device fail with no device error.
+	Disconnect()
+	// Plug the device back, with empty Input report queues
+	Reconnect()
+}
+
+// Context is a usb.Context of emulated devices
+type Context interface {
+	usb.Context
+	// Plug an emulated device, which is then listed by IterateDevices and can be opened by OpenDevice
+	Connect(config DeviceConfig) (Device, error)
+}
+
+type contextImpl struct {
+	devices []*deviceImpl
+	mu      sync.Mutex
+}
+
+func NewContext() Context {
+	return &contextImpl{}
+}
+
+func (c *contextImpl) Connect(config DeviceConfig) (Device, error) {
+	if config.Desc == nil {
+		return nil, fmt.Errorf("device descriptor is nil: %w", ErrInvalidDevice)
+	}
+	for number := range config.Interfaces {
+		if !hasInterface(config.Desc, number) {
+			return nil, fmt.Errorf("interface %d is not in device descriptor: %w", number, ErrInvalidDevice)
+		}
+	}
+
+	device := &deviceImpl{config: config}
+	device.Reconnect()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.devices = append(c.devices, device)
+
+	return device, nil
+}
+
+func hasInterface(desc *gousb.DeviceDesc, number int) bool {
+	for _, config := range desc.Configs {
+		for _, intf := range config.Interfaces {
+			if intf.Number == number {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// connected returns devices which are plugged
+func (c *contextImpl) connected() []*deviceImpl {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var res []*deviceImpl
+	for _, device := range c.devices {
+		if device.currentConnection() != nil {
+			res = append(res, device)
+		}
+	}
+
+	return res
+}
+
+func (c *contextImpl) IterateDevices(reader func(desc *gousb.DeviceDesc)) error {
+	for _, device := range c.connected() {
+		reader(device.config.Desc)
+	}
+
+	return nil
+}
+
+func (c *contextImpl) OpenDevice(vid, pid gousb.ID) (usb.Device, error) {
+	for _, device := range c.connected() {
+		if device.config.Desc.Vendor != vid || device.config.Desc.Product != pid {
+			continue
+		}
+		if conn := device.currentConnection(); conn != nil {
+			return &usbDevice{device: device, conn: conn}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("device %s:%s: %w", vid, pid, gousb.ErrorNotFound)
+}
+
+func (c *contextImpl) Close() error {
+	return nil
+}
@@ -0,0 +1,460 @@
+package emulator_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/gousb"
+	"github.com/ntchjb/gohid/hid"
+	"github.com/ntchjb/gohid/manager"
+	"github.com/ntchjb/gohid/usb/emulator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var deviceDesc = &gousb.DeviceDesc{
+	Bus:                  1,
+	Address:              21,
+	Speed:                gousb.SpeedFull,
+	Spec:                 0x0200,
+	Device:               0x0100,
+	Vendor:               0xFF01,
+	Product:              0x0001,
+	MaxControlPacketSize: 64,
+	Configs: map[int]gousb.ConfigDesc{
+		1: {
+			Number:   1,
+			MaxPower: 100,
+			Interfaces: []gousb.InterfaceDesc{
+				{
+					Number: 0,
+					AltSettings: []gousb.InterfaceSetting{
+						{
+							Alternate: 0,
+							Class:     gousb.ClassHID,
+							Endpoints: map[gousb.EndpointAddress]gousb.EndpointDesc{
+								0x81: {
+									Address:       0x81,
+									Number:        1,
+									Direction:     gousb.EndpointDirectionIn,
+									MaxPacketSize: 64,
+									TransferType:  gousb.TransferTypeInterrupt,
+									PollInterval:  10 * time.Millisecond,
+								},
+								0x01: {
+									Address:       0x01,
+									Number:        1,
+									Direction:     gousb.EndpointDirectionOut,
+									MaxPacketSize: 64,
+									TransferType:  gousb.TransferTypeInterrupt,
+									PollInterval:  10 * time.Millisecond,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+// Vendor-defined device with 2-byte Input, Output and Feature reports
+var reportDescriptor = []byte{
+	0x06, 0x00, 0xFF, 0x09, 0x01, 0xA1, 0x01,
+	0x15, 0x00, 0x26, 0xFF, 0x00, 0x75, 0x08, 0x95, 0x02,
+	0x09, 0x01, 0x81, 0x02,
+	0x09, 0x02, 0x91, 0x02,
+	0x09, 0x03, 0xB1, 0x02,
+	0xC0,
+}
+
+// connect plugs an emulated device into a new context, and opens its HID interface via device manager
+func connect(t *testing.T, handler emulator.Handler) (emulator.Device, manager.DeviceManager, hid.Device) {
+	usbCtx := emulator.NewContext()
+	device, err := usbCtx.Connect(emulator.DeviceConfig{
+		Desc:         deviceDesc,
+		Manufacturer: "gohid",
+		Product:      "Emulated device",
+		Strings:      map[int]string{4: "Extra"},
+		Interfaces: map[int]emulator.InterfaceConfig{
+			0: {ReportDescriptor: reportDescriptor, Handler: handler},
+		},
+	})
+	require.NoError(t, err)
+
+	man := manager.NewDeviceManager(usbCtx, slog.Default())
+	hidDevice, err := man.Open(0xFF01, 0x0001, hid.DeviceConfig{StreamLaneCount: hid.DEFAULT_ENDPOINT_STREAM_COUNT})
+	require.NoError(t, err)
+	require.NoError(t, hidDevice.SetAutoDetach(true))
+	require.NoError(t, hidDevice.SetTarget(1, 0, 0))
+
+	return device, man, hidDevice
+}
+
+func TestContext_Reports(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	handler := emulator.NewMockHandler(ctrl)
+	device, man, hidDevice := connect(t, handler)
+
+	deviceInfos, err := man.Enumerate(0, 0)
+	require.NoError(t, err)
+	require.Len(t, deviceInfos, 1)
+	assert.Equal(t, deviceDesc, deviceInfos[0].DeviceDesc)
+
+	desc, err := hidDevice.GetReportDescriptor()
+	require.NoError(t, err)
+	assert.Equal(t, reportDescriptor, []byte(desc))
+	hidDesc, err := hidDevice.GetHIDDescriptor()
+	require.NoError(t, err)
+	assert.Equal(t, uint16(emulator.HID_VERSION), hidDesc.BCDHID)
+	assert.Equal(t, uint16(len(reportDescriptor)), hidDesc.WDescriptorLength)
+	str, err := hidDevice.GetManufacturer()
+	assert.NoError(t, err)
+	assert.Equal(t, "gohid", str)
+	str, err = hidDevice.GetStringDescriptor(4)
+	assert.NoError(t, err)
+	assert.Equal(t, "Extra", str)
+
+	handler.EXPECT().GetReport(hid.REPORT_TYPE_FEATURE, uint8(0), gomock.Len(2)).DoAndReturn(
+		func(reportType hid.ReportType, reportID uint8, data []byte) (int, error) {
+			return copy(data, []byte{0x12, 0x34}), nil
+		},
+	)
+	handler.EXPECT().SetReport(hid.REPORT_TYPE_FEATURE, uint8(0), []byte{0x56, 0x78}).Return(nil)
+	handler.EXPECT().Output([]byte{0x9A, 0xBC}).Return(nil)
+
+	data := []byte{0x00, 0x00, 0x00}
+	n, err := hidDevice.GetFeatureReport(data)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x00, 0x12, 0x34}, data[:n])
+	n, err = hidDevice.SendFeatureReport([]byte{0x00, 0x56, 0x78})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+	n, err = hidDevice.WriteOutput(context.Background(), []byte{0x00, 0x9A, 0xBC})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	require.NoError(t, device.SendInput(context.Background(), 0, []byte{0x01, 0x02}))
+	data = make([]byte, 64)
+	n, err = hidDevice.ReadInput(context.Background(), data)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x01, 0x02}, data[:n])
+
+	// No Input report is sent
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = hidDevice.ReadInput(ctx, data)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	assert.ErrorIs(t, device.SendInput(context.Background(), 1, []byte{0x01}), emulator.ErrInterfaceNotFound)
+}
+
+func TestContext_Errors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	handler := emulator.NewMockHandler(ctrl)
+	device, man, hidDevice := connect(t, handler)
+
+	handler.EXPECT().GetReport(hid.REPORT_TYPE_FEATURE, uint8(0), gomock.Any()).Return(0, errors.New("unsupported"))
+	handler.EXPECT().GetReport(hid.REPORT_TYPE_INPUT, uint8(0), gomock.Any()).Return(0, gousb.ErrorTimeout)
+	handler.EXPECT().Output(gomock.Any()).Return(errors.New("busy"))
+
+	_, err := hidDevice.GetFeatureReport([]byte{0x00, 0x00, 0x00})
+	assert.ErrorIs(t, err, gousb.ErrorPipe)
+	_, err = hidDevice.GetInputReport([]byte{0x00, 0x00, 0x00})
+	assert.ErrorIs(t, err, gousb.ErrorTimeout)
+	_, err = hidDevice.WriteOutput(context.Background(), []byte{0x00, 0x01, 0x02})
+	assert.ErrorIs(t, err, gousb.TransferStall)
+
+	device.SetHalt(0x81, true)
+	_, err = hidDevice.ReadInput(context.Background(), make([]byte, 64))
+	assert.ErrorIs(t, err, gousb.TransferStall)
+	device.SetHalt(0x81, false)
+
+	// Streams failed by the stall are reopened
+	handler.EXPECT().Output([]byte{0x03, 0x04}).Return(nil)
+	n, err := hidDevice.WriteOutput(context.Background(), []byte{0x00, 0x03, 0x04})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+	require.NoError(t, device.SendInput(context.Background(), 0, []byte{0x01, 0x02}))
+	data := make([]byte, 1)
+	n, err = hidDevice.ReadInput(context.Background(), data)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x01}, data[:n])
+	// Rest of the transfer is read by the next read
+	n, err = hidDevice.ReadInput(context.Background(), data)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x02}, data[:n])
+
+	// Pending read is stopped when the device is unplugged
+	readErr := make(chan error)
+	go func() {
+		_, err := hidDevice.ReadInput(context.Background(), make([]byte, 64))
+		readErr <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	device.Disconnect()
+	assert.ErrorIs(t, <-readErr, gousb.TransferNoDevice)
+	_, err = hidDevice.GetFeatureReport([]byte{0x00, 0x00, 0x00})
+	assert.ErrorIs(t, err, gousb.ErrorNoDevice)
+	assert.ErrorIs(t, device.SendInput(context.Background(), 0, []byte{0x01}), gousb.ErrorNoDevice)
+	deviceInfos, err := man.Enumerate(0, 0)
+	require.NoError(t, err)
+	assert.Empty(t, deviceInfos)
+	_, err = man.Open(0xFF01, 0x0001, hid.DeviceConfig{})
+	assert.ErrorIs(t, err, gousb.ErrorNotFound)
+
+	device.Reconnect()
+	deviceInfos, err = man.Enumerate(0, 0)
+	require.NoError(t, err)
+	assert.Len(t, deviceInfos, 1)
+	// Connections opened before unplugging stay disconnected
+	_, err = hidDevice.GetFeatureReport([]byte{0x00, 0x00, 0x00})
+	assert.ErrorIs(t, err, gousb.ErrorNoDevice)
+}
+
+func TestContext_Streams(t *testing.T) {
+	usbCtx := emulator.NewContext()
+	device, err := usbCtx.Connect(emulator.DeviceConfig{
+		Desc: deviceDesc,
+		Interfaces: map[int]emulator.InterfaceConfig{
+			0: {ReportDescriptor: reportDescriptor},
+		},
+	})
+	require.NoError(t, err)
+	usbDevice, err := usbCtx.OpenDevice(0xFF01, 0x0001)
+	require.NoError(t, err)
+	config, err := usbDevice.Config(1)
+	require.NoError(t, err)
+	intf, err := config.Interface(0, 0)
+	require.NoError(t, err)
+	epIn, err := intf.InEndpoint(1)
+	require.NoError(t, err)
+	epOut, err := intf.OutEndpoint(1)
+	require.NoError(t, err)
+	reader, err := epIn.NewStream(hid.DEFAULT_ENDPOINT_STREAM_COUNT)
+	require.NoError(t, err)
+	writer, err := epOut.NewStream(hid.DEFAULT_ENDPOINT_STREAM_COUNT)
+	require.NoError(t, err)
+
+	// Reports are transferred in packets of max packet size
+	report := make([]byte, 70)
+	report[64] = 0x01
+	require.NoError(t, device.SendInput(context.Background(), 0, report))
+	data := make([]byte, 128)
+	n, err := reader.ReadContext(context.Background(), data)
+	require.NoError(t, err)
+	assert.Equal(t, 64, n)
+	n, err = reader.ReadContext(context.Background(), data)
+	require.NoError(t, err)
+	assert.Equal(t, report[64:], data[:n])
+
+	// Streams cannot be used after a failed transfer, even if the failure is gone
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = reader.ReadContext(ctx, data)
+	assert.ErrorIs(t, err, context.Canceled)
+	require.NoError(t, device.SendInput(context.Background(), 0, []byte{0x01, 0x02}))
+	_, err = reader.ReadContext(context.Background(), data)
+	assert.ErrorIs(t, err, io.ErrClosedPipe)
+	device.SetHalt(0x01, true)
+	_, err = writer.WriteContext(context.Background(), []byte{0x01, 0x02})
+	assert.ErrorIs(t, err, gousb.TransferStall)
+	device.SetHalt(0x01, false)
+	_, err = writer.WriteContext(context.Background(), []byte{0x01, 0x02})
+	assert.ErrorIs(t, err, io.ErrClosedPipe)
+
+	// Pending read is stopped when the stream is closed
+	reader, err = epIn.NewStream(hid.DEFAULT_ENDPOINT_STREAM_COUNT)
+	require.NoError(t, err)
+	n, err = reader.ReadContext(context.Background(), data)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x01, 0x02}, data[:n])
+	readErr := make(chan error)
+	go func() {
+		_, err := reader.ReadContext(context.Background(), data)
+		readErr <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(t, reader.Close())
+	assert.ErrorIs(t, <-readErr, io.ErrClosedPipe)
+}
+
+func TestContext_Recovery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	handler := emulator.NewMockHandler(ctrl)
+	usbCtx := emulator.NewContext()
+	device, err := usbCtx.Connect(emulator.DeviceConfig{
+		Desc: deviceDesc,
+		Interfaces: map[int]emulator.InterfaceConfig{
+			0: {ReportDescriptor: reportDescriptor, Handler: handler},
+		},
+	})
+	require.NoError(t, err)
+	man := manager.NewDeviceManager(usbCtx, slog.Default())
+	hidDevice, err := man.Open(0xFF01, 0x0001, hid.DeviceConfig{
+		StreamLaneCount: hid.DEFAULT_ENDPOINT_STREAM_COUNT,
+		RetryPolicy:     hid.RetryPolicy{WriteRetries: 1, Backoff: time.Millisecond},
+	})
+	require.NoError(t, err)
+	require.NoError(t, hidDevice.SetTarget(1, 0, 0))
+
+	// Halted endpoint is cleared by CLEAR_FEATURE before the write is retried
+	handler.EXPECT().Output([]byte{0x01, 0x02}).Return(nil)
+	device.SetHalt(0x01, true)
+	n, err := hidDevice.WriteOutput(context.Background(), []byte{0x00, 0x01, 0x02})
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	// Reset clears halts and restores Report protocol
+	usbDevice, err := usbCtx.OpenDevice(0xFF01, 0x0001)
+	require.NoError(t, err)
+	_, err = usbDevice.Control(0x21, 0x0B, 0, 0, nil)
+	require.NoError(t, err)
+	device.SetHalt(0x81, true)
+	require.NoError(t, usbDevice.Reset())
+	data := make([]byte, 1)
+	n, err = usbDevice.Control(0xA1, 0x03, 0, 0, data)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{1}, data[:n])
+	require.NoError(t, device.SendInput(context.Background(), 0, []byte{0x01, 0x02}))
+	data = make([]byte, 64)
+	n, err = hidDevice.ReadInput(context.Background(), data)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x01, 0x02}, data[:n])
+
+	device.Disconnect()
+	assert.ErrorIs(t, usbDevice.Reset(), gousb.ErrorNoDevice)
+}
+
+func TestContext_StandardDescriptors(t *testing.T) {
+	usbCtx := emulator.NewContext()
+	_, err := usbCtx.Connect(emulator.DeviceConfig{
+		Desc:         deviceDesc,
+		Manufacturer: "gohid",
+		Interfaces: map[int]emulator.InterfaceConfig{
+			0: {ReportDescriptor: reportDescriptor},
+		},
+	})
+	require.NoError(t, err)
+	device, err := usbCtx.OpenDevice(0xFF01, 0x0001)
+	require.NoError(t, err)
+
+	data := make([]byte, 255)
+	n, err := device.Control(0x80, 0x06, 0x0100, 0, data)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{18, 0x01, 0x00, 0x02, 0, 0, 0, 64, 0x01, 0xFF, 0x01, 0x00, 0x00, 0x01, 1, 0, 0, 1}, data[:n])
+	n, err = device.Control(0x80, 0x06, 0x0200, 0, data)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{
+		9, 0x02, 41, 0, 1, 1, 0, 0x80, 50,
+		9, 0x04, 0, 0, 2, 0x03, 0, 0, 0,
+		9, 0x21, 0x11, 0x01, 0, 1, 0x22, byte(len(reportDescriptor)), 0,
+		7, 0x05, 0x01, 0x03, 64, 0, 10,
+		7, 0x05, 0x81, 0x03, 64, 0, 10,
+	}, data[:n])
+	n, err = device.Control(0x80, 0x06, 0x0300, 0, data)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{4, 0x03, 0x09, 0x04}, data[:n])
+	n, err = device.Control(0x80, 0x06, 0x0301, 0x0409, data)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{12, 0x03, 'g', 0, 'o', 0, 'h', 0, 'i', 0, 'd', 0}, data[:n])
+
+	// Undefined string, and report request to interface without handler are stalled
+	_, err = device.Control(0x80, 0x06, 0x0302, 0x0409, data)
+	assert.ErrorIs(t, err, gousb.ErrorPipe)
+	_, err = device.Control(0xA1, 0x01, 0x0300, 0, data)
+	assert.ErrorIs(t, err, gousb.ErrorPipe)
+
+	// HID protocol is Report protocol until it is set
+	n, err = device.Control(0xA1, 0x03, 0, 0, data)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{1}, data[:n])
+	_, err = device.Control(0x21, 0x0B, 0, 0, nil)
+	require.NoError(t, err)
+	n, err = device.Control(0xA1, 0x03, 0, 0, data)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0}, data[:n])
+}
+
+func TestContext_Connect_Invalid(t *testing.T) {
+	usbCtx := emulator.NewContext()
+
+	_, err := usbCtx.Connect(emulator.DeviceConfig{})
+	assert.ErrorIs(t, err, emulator.ErrInvalidDevice)
+	_, err = usbCtx.Connect(emulator.DeviceConfig{
+		Desc:       deviceDesc,
+		Interfaces: map[int]emulator.InterfaceConfig{1: {}},
+	})
+	assert.ErrorIs(t, err, emulator.ErrInvalidDevice)
+}
+
+// newReportReader connects an emulated device, and reads its Input reports by a report reader
+// after the first report, which allocates buffers of Input queue of the device
+func newReportReader(tb testing.TB) (emulator.Device, hid.ReportReader) {
+	usbCtx := emulator.NewContext()
+	device, err := usbCtx.Connect(emulator.DeviceConfig{
+		Desc: deviceDesc,
+		Interfaces: map[int]emulator.InterfaceConfig{
+			0: {ReportDescriptor: reportDescriptor},
+		},
+	})
+	require.NoError(tb, err)
+	usbDevice, err := usbCtx.OpenDevice(0xFF01, 0x0001)
+	require.NoError(tb, err)
+	hidDevice, err := hid.NewDevice(usbDevice, hid.DeviceConfig{StreamLaneCount: hid.DEFAULT_ENDPOINT_STREAM_COUNT}, slog.Default())
+	require.NoError(tb, err)
+	require.NoError(tb, hidDevice.SetTarget(1, 0, 0))
+	reader := hid.NewReportReader(hidDevice, hid.ReportReaderConfig{ReportSize: 64})
+
+	ctx := context.Background()
+	require.NoError(tb, device.SendInput(ctx, 0, []byte{0x01, 0x02}))
+	report, err := reader.Read(ctx)
+	require.NoError(tb, err)
+	report.Release()
+
+	return device, reader
+}
+
+func TestContext_ReportReader_Allocs(t *testing.T) {
+	device, reader := newReportReader(t)
+	defer reader.Close()
+
+	ctx := context.Background()
+	data := []byte{0x01, 0x02}
+	allocs := testing.AllocsPerRun(1000, func() {
+		if err := device.SendInput(ctx, 0, data); err != nil {
+			t.Fatal(err)
+		}
+		report, err := reader.Read(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		report.Release()
+	})
+	assert.Zero(t, allocs)
+}
+
+func BenchmarkContext_ReportReader(b *testing.B) {
+	device, reader := newReportReader(b)
+	defer reader.Close()
+
+	ctx := context.Background()
+	data := []byte{0x01, 0x02}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		if err := device.SendInput(ctx, 0, data); err != nil {
+			b.Fatal(err)
+		}
+		report, err := reader.Read(ctx)
+		if err != nil {
+			b.Fatal(err)
+		}
+		report.Release()
+	}
+}
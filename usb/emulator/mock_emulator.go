@@ -0,0 +1,240 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./usb/emulator/emulator.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./usb/emulator/emulator.go -destination=./usb/emulator/mock_emulator.go -package=emulator
+//
+
+// Package emulator is a generated GoMock package.
+package emulator
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gousb "github.com/google/gousb"
+	hid "github.com/ntchjb/gohid/hid"
+	usb "github.com/ntchjb/gohid/usb"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockHandler is a mock of Handler interface.
+type MockHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockHandlerMockRecorder
+}
+
+// MockHandlerMockRecorder is the mock recorder for MockHandler.
+type MockHandlerMockRecorder struct {
+	mock *MockHandler
+}
+
+// NewMockHandler creates a new mock instance.
+func NewMockHandler(ctrl *gomock.Controller) *MockHandler {
+	mock := &MockHandler{ctrl: ctrl}
+	mock.recorder = &MockHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHandler) EXPECT() *MockHandlerMockRecorder {
+	return m.recorder
+}
+
+// GetReport mocks base method.
+func (m *MockHandler) GetReport(reportType hid.ReportType, reportID uint8, data []byte) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReport", reportType, reportID, data)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReport indicates an expected call of GetReport.
+func (mr *MockHandlerMockRecorder) GetReport(reportType, reportID, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReport", reflect.TypeOf((*MockHandler)(nil).GetReport), reportType, reportID, data)
+}
+
+// Output mocks base method.
+func (m *MockHandler) Output(data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Output", data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Output indicates an expected call of Output.
+func (mr *MockHandlerMockRecorder) Output(data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Output", reflect.TypeOf((*MockHandler)(nil).Output), data)
+}
+
+// SetReport mocks base method.
+func (m *MockHandler) SetReport(reportType hid.ReportType, reportID uint8, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReport", reportType, reportID, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetReport indicates an expected call of SetReport.
+func (mr *MockHandlerMockRecorder) SetReport(reportType, reportID, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReport", reflect.TypeOf((*MockHandler)(nil).SetReport), reportType, reportID, data)
+}
+
+// MockDevice is a mock of Device interface.
+type MockDevice struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeviceMockRecorder
+}
+
+// MockDeviceMockRecorder is the mock recorder for MockDevice.
+type MockDeviceMockRecorder struct {
+	mock *MockDevice
+}
+
+// NewMockDevice creates a new mock instance.
+func NewMockDevice(ctrl *gomock.Controller) *MockDevice {
+	mock := &MockDevice{ctrl: ctrl}
+	mock.recorder = &MockDeviceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDevice) EXPECT() *MockDeviceMockRecorder {
+	return m.recorder
+}
+
+// Disconnect mocks base method.
+func (m *MockDevice) Disconnect() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Disconnect")
+}
+
+// Disconnect indicates an expected call of Disconnect.
+func (mr *MockDeviceMockRecorder) Disconnect() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disconnect", reflect.TypeOf((*MockDevice)(nil).Disconnect))
+}
+
+// Reconnect mocks base method.
+func (m *MockDevice) Reconnect() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Reconnect")
+}
+
+// Reconnect indicates an expected call of Reconnect.
+func (mr *MockDeviceMockRecorder) Reconnect() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconnect", reflect.TypeOf((*MockDevice)(nil).Reconnect))
+}
+
+// SendInput mocks base method.
+func (m *MockDevice) SendInput(ctx context.Context, interfaceNumber int, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendInput", ctx, interfaceNumber, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendInput indicates an expected call of SendInput.
+func (mr *MockDeviceMockRecorder) SendInput(ctx, interfaceNumber, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendInput", reflect.TypeOf((*MockDevice)(nil).SendInput), ctx, interfaceNumber, data)
+}
+
+// SetHalt mocks base method.
+func (m *MockDevice) SetHalt(address gousb.EndpointAddress, halted bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetHalt", address, halted)
+}
+
+// SetHalt indicates an expected call of SetHalt.
+func (mr *MockDeviceMockRecorder) SetHalt(address, halted any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHalt", reflect.TypeOf((*MockDevice)(nil).SetHalt), address, halted)
+}
+
+// MockContext is a mock of Context interface.
+type MockContext struct {
+	ctrl     *gomock.Controller
+	recorder *MockContextMockRecorder
+}
+
+// MockContextMockRecorder is the mock recorder for MockContext.
+type MockContextMockRecorder struct {
+	mock *MockContext
+}
+
+// NewMockContext creates a new mock instance.
+func NewMockContext(ctrl *gomock.Controller) *MockContext {
+	mock := &MockContext{ctrl: ctrl}
+	mock.recorder = &MockContextMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContext) EXPECT() *MockContextMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockContext) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockContextMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockContext)(nil).Close))
+}
+
+// Connect mocks base method.
+func (m *MockContext) Connect(config DeviceConfig) (Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Connect", config)
+	ret0, _ := ret[0].(Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Connect indicates an expected call of Connect.
+func (mr *MockContextMockRecorder) Connect(config any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Connect", reflect.TypeOf((*MockContext)(nil).Connect), config)
+}
+
+// IterateDevices mocks base method.
+func (m *MockContext) IterateDevices(reader func(*gousb.DeviceDesc)) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IterateDevices", reader)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IterateDevices indicates an expected call of IterateDevices.
+func (mr *MockContextMockRecorder) IterateDevices(reader any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateDevices", reflect.TypeOf((*MockContext)(nil).IterateDevices), reader)
+}
+
+// OpenDevice mocks base method.
+func (m *MockContext) OpenDevice(vid, pid gousb.ID) (usb.Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenDevice", vid, pid)
+	ret0, _ := ret[0].(usb.Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenDevice indicates an expected call of OpenDevice.
+func (mr *MockContextMockRecorder) OpenDevice(vid, pid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenDevice", reflect.TypeOf((*MockContext)(nil).OpenDevice), vid, pid)
+}
@@ -0,0 +1,263 @@
+package emulator
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+	"sync/atomic"
+
+	"github.com/google/gousb"
+	"github.com/ntchjb/gohid/usb"
+)
+
+type usbConfig struct {
+	desc   gousb.ConfigDesc
+	device *usbDevice
+}
+
+func (c *usbConfig) Interface(num, alt int) (usb.Interface, error) {
+	if c.device.conn.isDisconnected() {
+		return nil, gousb.ErrorNoDevice
+	}
+	for _, intf := range c.desc.Interfaces {
+		if intf.Number != num {
+			continue
+		}
+		for _, setting := range intf.AltSettings {
+			if setting.Alternate == alt {
+				return &usbInterface{setting: setting, device: c.device}, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("interface %d alt %d: %w", num, alt, gousb.ErrorNotFound)
+}
+
+func (c *usbConfig) Close() error {
+	return nil
+}
+
+type usbInterface struct {
+	setting gousb.InterfaceSetting
+	device  *usbDevice
+}
+
+func (i *usbInterface) endpoint(num int, direction gousb.EndpointDirection) (gousb.EndpointDesc, error) {
+	for _, desc := range i.setting.Endpoints {
+		if desc.Number == num && desc.Direction == direction {
+			return desc, nil
+		}
+	}
+
+	return gousb.EndpointDesc{}, fmt.Errorf("endpoint %d %s: %w", num, direction, gousb.ErrorNotFound)
+}
+
+func (i *usbInterface) InEndpoint(num int) (usb.InEndpoint, error) {
+	desc, err := i.endpoint(num, gousb.EndpointDirectionIn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &inEndpoint{desc: desc, intf: i}, nil
+}
+
+func (i *usbInterface) OutEndpoint(num int) (usb.OutEndpoint, error) {
+	desc, err := i.endpoint(num, gousb.EndpointDirectionOut)
+	if err != nil {
+		return nil, err
+	}
+
+	return &outEndpoint{desc: desc, intf: i}, nil
+}
+
+func (i *usbInterface) Close() error {
+	return nil
+}
+
+type inEndpoint struct {
+	desc gousb.EndpointDesc
+	intf *usbInterface
+}
+
+func (e *inEndpoint) Descriptor() gousb.EndpointDesc {
+	return e.desc
+}
+
+func (e *inEndpoint) NewStream(count int) (usb.StreamReader, error) {
+	if e.intf.device.conn.isDisconnected() {
+		return nil, gousb.ErrorNoDevice
+	}
+
+	return &streamReader{
+		address:    e.desc.Address,
+		packetSize: e.desc.MaxPacketSize,
+		intf:       e.intf,
+		closed:     make(chan struct{}),
+	}, nil
+}
+
+func (e *inEndpoint) ClearHalt() error {
+	return usb.ClearHalt(e.intf.device, e.desc.Address)
+}
+
+type outEndpoint struct {
+	desc gousb.EndpointDesc
+	intf *usbInterface
+}
+
+func (e *outEndpoint) Descriptor() gousb.EndpointDesc {
+	return e.desc
+}
+
+func (e *outEndpoint) NewStream(count int) (usb.StreamWriter, error) {
+	if e.intf.device.conn.isDisconnected() {
+		return nil, gousb.ErrorNoDevice
+	}
+
+	return &streamWriter{address: e.desc.Address, intf: e.intf}, nil
+}
+
+func (e *outEndpoint) ClearHalt() error {
+	return usb.ClearHalt(e.intf.device, e.desc.Address)
+}
+
+// streamReader reads Input reports like streams of gousb, whose transfers are packets of max packet size.
+// A read returns the rest of the current transfer, which is only partly read if the buffer is smaller than it.
+// The stream cannot be read after a failed transfer, including a read stopped by ctx.
+type streamReader struct {
+	address    gousb.EndpointAddress
+	packetSize int
+	intf       *usbInterface
+	// Report being transferred, whose buffer is released to its queue after its last packet is read
+	queue  *inputQueue
+	report []byte
+	// Offset of the next packet of the report
+	next int
+	// Unread data of the current transfer
+	pending []byte
+	failed  bool
+	// Closed by Close, which stops a pending read
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+// ReadContext reads the current transfer, or waits for the next packet of Input reports when it is read entirely.
+// It fails with context error when ctx is done, as a device having no data to send.
+func (s *streamReader) ReadContext(ctx context.Context, data []byte) (int, error) {
+	if s.failed {
+		return 0, io.ErrClosedPipe
+	}
+	if len(s.pending) == 0 {
+		if err := s.transfer(ctx); err != nil {
+			s.failed = true
+			return 0, err
+		}
+	}
+	n := copy(data, s.pending)
+	s.pending = s.pending[n:]
+
+	return n, nil
+}
+
+// transfer receives the next packet of the current report, or of the next queued report
+func (s *streamReader) transfer(ctx context.Context) error {
+	conn := s.intf.device.conn
+	if conn.isDisconnected() {
+		return gousb.TransferNoDevice
+	}
+	if s.intf.device.device.isHalted(conn, s.address) {
+		return gousb.TransferStall
+	}
+	if s.report != nil && s.next >= len(s.report) {
+		s.queue.release(s.report)
+		s.report = nil
+	}
+	if s.report == nil {
+		// Queue of interface without HID configuration is nil, which never has any report
+		var reports chan []byte
+		s.queue = conn.inputs[s.intf.setting.Number]
+		if s.queue != nil {
+			reports = s.queue.reports
+		}
+		select {
+		case s.report = <-reports:
+			s.next = 0
+		case <-conn.disconnected:
+			return gousb.TransferNoDevice
+		case <-s.closed:
+			return io.ErrClosedPipe
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	end := len(s.report)
+	if s.packetSize > 0 {
+		end = min(s.next+s.packetSize, end)
+	}
+	s.pending = s.report[s.next:end]
+	s.next = end
+
+	return nil
+}
+
+func (s *streamReader) Close() error {
+	s.closeOnce.Do(func() {
+		close(s.closed)
+	})
+
+	return nil
+}
+
+// streamWriter writes Output reports like streams of gousb, which cannot be written after a failed transfer
+type streamWriter struct {
+	address gousb.EndpointAddress
+	intf    *usbInterface
+	failed  atomic.Bool
+}
+
+// WriteContext passes an Output report to handler of the interface. A zero-length packet, which only ends a report,
+// is not passed.
+func (s *streamWriter) WriteContext(ctx context.Context, data []byte) (int, error) {
+	if s.failed.Load() {
+		return 0, io.ErrClosedPipe
+	}
+	n, err := s.write(ctx, data)
+	if err != nil {
+		s.failed.Store(true)
+	}
+
+	return n, err
+}
+
+func (s *streamWriter) write(ctx context.Context, data []byte) (int, error) {
+	conn := s.intf.device.conn
+	if conn.isDisconnected() {
+		return 0, gousb.TransferNoDevice
+	}
+	if s.intf.device.device.isHalted(conn, s.address) {
+		return 0, gousb.TransferStall
+	}
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	if len(data) == 0 {
+		return 0, nil
+	}
+	intf, ok := s.intf.device.device.config.Interfaces[s.intf.setting.Number]
+	if !ok || intf.Handler == nil {
+		return 0, gousb.TransferStall
+	}
+	if err := intf.Handler.Output(slices.Clone(data)); err != nil {
+		return 0, streamError(err)
+	}
+
+	return len(data), nil
+}
+
+func (s *streamWriter) Close() error {
+	s.failed.Store(true)
+
+	return nil
+}
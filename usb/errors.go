@@ -0,0 +1,167 @@
+package usb
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/gousb"
+)
+
+// Kinds of USB errors, matched by Error with errors.Is regardless of which backend returned them
+var (
+	ErrTimeout      = errors.New("timeout")
+	ErrDisconnected = errors.New("device disconnected")
+	ErrStall        = errors.New("endpoint stalled")
+	ErrAccessDenied = errors.New("access denied")
+	ErrBusy         = errors.New("resource busy")
+)
+
+// Operation is a USB operation which failed
+type Operation string
+
+const (
+	OPERATION_OPEN              Operation = "open"
+	OPERATION_SET_AUTO_DETACH   Operation = "set auto detach"
+	OPERATION_CLAIM_CONFIG      Operation = "claim config"
+	OPERATION_CLAIM_INTERFACE   Operation = "claim interface"
+	OPERATION_OPEN_ENDPOINT     Operation = "open endpoint"
+	OPERATION_OPEN_STREAM       Operation = "open stream"
+	OPERATION_CONTROL           Operation = "control transfer"
+	OPERATION_READ              Operation = "read"
+	OPERATION_WRITE             Operation = "write"
+	OPERATION_STRING_DESCRIPTOR Operation = "get string descriptor"
+	OPERATION_RESET             Operation = "reset"
+	OPERATION_CLOSE             Operation = "close"
+)
+
+// NO_INTERFACE is interface number of Error whose operation is not on an interface
+const NO_INTERFACE = -1
+
+// Error is a failed USB operation of a device. It matches its kind, e.g. ErrStall, and its cause, e.g. gousb.ErrorPipe,
+// with errors.Is.
+type Error struct {
+	Op      Operation
+	Vendor  gousb.ID
+	Product gousb.ID
+	// Interface number, or NO_INTERFACE
+	Interface int
+	// One of ErrTimeout, ErrDisconnected, ErrStall, ErrAccessDenied or ErrBusy, or nil if the cause is of other kind
+	Kind error
+	Err  error
+}
+
+func (e *Error) Error() string {
+	if e.Interface == NO_INTERFACE {
+		return fmt.Sprintf("usb %s of device %04x:%04x: %v", e.Op, uint16(e.Vendor), uint16(e.Product), e.Err)
+	}
+
+	return fmt.Sprintf("usb %s of device %04x:%04x interface #%d: %v", e.Op, uint16(e.Vendor), uint16(e.Product), e.Interface, e.Err)
+}
+
+func (e *Error) Unwrap() []error {
+	if e.Kind == nil {
+		return []error{e.Err}
+	}
+
+	return []error{e.Kind, e.Err}
+}
+
+// ErrorKind returns kind of an error returned by gousb or other backends, or nil if it is of other kind
+func ErrorKind(err error) error {
+	switch {
+	case errors.Is(err, ErrTimeout), errors.Is(err, gousb.ErrorTimeout), errors.Is(err, gousb.TransferTimedOut):
+		return ErrTimeout
+	case errors.Is(err, ErrDisconnected), errors.Is(err, gousb.ErrorNoDevice), errors.Is(err, gousb.TransferNoDevice):
+		return ErrDisconnected
+	case errors.Is(err, ErrStall), errors.Is(err, gousb.ErrorPipe), errors.Is(err, gousb.TransferStall):
+		return ErrStall
+	case errors.Is(err, ErrAccessDenied), errors.Is(err, gousb.ErrorAccess):
+		return ErrAccessDenied
+	case errors.Is(err, ErrBusy), errors.Is(err, gousb.ErrorBusy):
+		return ErrBusy
+	}
+
+	return nil
+}
+
+// NewError wraps an error of a USB operation into Error, or returns nil if there is no error.
+// An error which already wraps Error is returned as it is, so that the operation closest to the device is kept.
+func NewError(op Operation, vendor, product gousb.ID, intf int, err error) error {
+	if err == nil {
+		return nil
+	}
+	var usbErr *Error
+	if errors.As(err, &usbErr) {
+		return err
+	}
+
+	return &Error{
+		Op:        op,
+		Vendor:    vendor,
+		Product:   product,
+		Interface: intf,
+		Kind:      ErrorKind(err),
+		Err:       err,
+	}
+}
+
+// newDeviceError wraps an error of a USB operation of a device, whose descriptor may be nil
+func newDeviceError(op Operation, desc *gousb.DeviceDesc, intf int, err error) error {
+	if desc == nil {
+		return NewError(op, 0, 0, intf, err)
+	}
+
+	return NewError(op, desc.Vendor, desc.Product, intf, err)
+}
+
+// EncodedError is an error encoded as JSON, e.g. to be sent to another process or recorded, where errors of gousb
+// and sentinel errors known by both sides are decoded as the same error values
+type EncodedError struct {
+	Message string `json:"message"`
+	// Code of gousb.Error, or zero if it is not a gousb.Error
+	Code int `json:"code,omitempty"`
+	// Value of gousb.TransferStatus, or zero if it is not a gousb.TransferStatus
+	TransferStatus uint8 `json:"transferStatus,omitempty"`
+	// Message of the first sentinel error which the error matches, or empty if there is none
+	Sentinel string `json:"sentinel,omitempty"`
+}
+
+// EncodeError encodes an error, whose sentinel error is the first of sentinels which it matches
+func EncodeError(err error, sentinels []error) EncodedError {
+	res := EncodedError{Message: err.Error()}
+	var usbErr gousb.Error
+	var transferStatus gousb.TransferStatus
+	switch {
+	case errors.As(err, &usbErr):
+		res.Code = int(usbErr)
+	case errors.As(err, &transferStatus):
+		res.TransferStatus = uint8(transferStatus)
+	}
+	for _, sentinel := range sentinels {
+		if errors.Is(err, sentinel) {
+			res.Sentinel = sentinel.Error()
+			break
+		}
+	}
+
+	return res
+}
+
+// Errors returns the decoded error of gousb and the decoded sentinel error of sentinels, which are set
+func (e EncodedError) Errors(sentinels []error) []error {
+	var errs []error
+	switch {
+	case e.Code != 0:
+		errs = append(errs, gousb.Error(e.Code))
+	case e.TransferStatus != 0:
+		errs = append(errs, gousb.TransferStatus(e.TransferStatus))
+	}
+	for _, sentinel := range sentinels {
+		if e.Sentinel != "" && e.Sentinel == sentinel.Error() {
+			errs = append(errs, sentinel)
+			break
+		}
+	}
+
+	return errs
+}
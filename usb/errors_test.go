@@ -0,0 +1,180 @@
+package usb_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/gousb"
+	"github.com/ntchjb/gohid/usb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewError(t *testing.T) {
+	errOther := errors.New("other error")
+
+	tests := []struct {
+		name    string
+		op      usb.Operation
+		intf    int
+		err     error
+		kind    error
+		message string
+	}{
+		{
+			name:    "Timeout",
+			op:      usb.OPERATION_READ,
+			intf:    1,
+			err:     gousb.TransferTimedOut,
+			kind:    usb.ErrTimeout,
+			message: "usb read of device ff01:0001 interface #1: transfer timed out",
+		},
+		{
+			name:    "Disconnected",
+			op:      usb.OPERATION_CONTROL,
+			intf:    usb.NO_INTERFACE,
+			err:     gousb.ErrorNoDevice,
+			kind:    usb.ErrDisconnected,
+			message: "usb control transfer of device ff01:0001: libusb: no device [code -4]",
+		},
+		{
+			name:    "Disconnected_Transfer",
+			op:      usb.OPERATION_WRITE,
+			intf:    0,
+			err:     fmt.Errorf("write: %w", gousb.TransferNoDevice),
+			kind:    usb.ErrDisconnected,
+			message: "usb write of device ff01:0001 interface #0: write: device was disconnected",
+		},
+		{
+			name:    "Stall",
+			op:      usb.OPERATION_CONTROL,
+			intf:    2,
+			err:     gousb.ErrorPipe,
+			kind:    usb.ErrStall,
+			message: "usb control transfer of device ff01:0001 interface #2: libusb: pipe error [code -9]",
+		},
+		{
+			name:    "AccessDenied",
+			op:      usb.OPERATION_OPEN,
+			intf:    usb.NO_INTERFACE,
+			err:     gousb.ErrorAccess,
+			kind:    usb.ErrAccessDenied,
+			message: "usb open of device ff01:0001: libusb: bad access [code -3]",
+		},
+		{
+			name:    "Busy",
+			op:      usb.OPERATION_CLAIM_INTERFACE,
+			intf:    0,
+			err:     gousb.ErrorBusy,
+			kind:    usb.ErrBusy,
+			message: "usb claim interface of device ff01:0001 interface #0: libusb: device or resource busy [code -6]",
+		},
+		{
+			name:    "Other",
+			op:      usb.OPERATION_READ,
+			intf:    0,
+			err:     errOther,
+			kind:    nil,
+			message: "usb read of device ff01:0001 interface #0: other error",
+		},
+		{
+			name:    "Canceled",
+			op:      usb.OPERATION_READ,
+			intf:    0,
+			err:     context.Canceled,
+			kind:    nil,
+			message: "usb read of device ff01:0001 interface #0: context canceled",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := usb.NewError(test.op, 0xFF01, 0x0001, test.intf, test.err)
+			assert.ErrorIs(t, err, test.err)
+			if test.kind != nil {
+				assert.ErrorIs(t, err, test.kind)
+			}
+			assert.Equal(t, test.message, err.Error())
+
+			var usbErr *usb.Error
+			require.ErrorAs(t, fmt.Errorf("wrapped: %w", err), &usbErr)
+			assert.Equal(t, test.op, usbErr.Op)
+			assert.Equal(t, gousb.ID(0xFF01), usbErr.Vendor)
+			assert.Equal(t, gousb.ID(0x0001), usbErr.Product)
+			assert.Equal(t, test.intf, usbErr.Interface)
+			assert.Equal(t, test.kind, usbErr.Kind)
+		})
+	}
+}
+
+func TestNewError_Nil(t *testing.T) {
+	assert.NoError(t, usb.NewError(usb.OPERATION_READ, 0xFF01, 0x0001, 0, nil))
+}
+
+func TestNewError_Wrapped(t *testing.T) {
+	// The innermost Error is kept, as it is the closest to the device
+	inner := usb.NewError(usb.OPERATION_READ, 0xFF01, 0x0001, 1, gousb.ErrorTimeout)
+	wrapped := fmt.Errorf("stream: %w", inner)
+	err := usb.NewError(usb.OPERATION_CONTROL, 0xFF02, 0x0002, usb.NO_INTERFACE, wrapped)
+	assert.Equal(t, wrapped, err)
+
+	var usbErr *usb.Error
+	require.ErrorAs(t, err, &usbErr)
+	assert.Equal(t, usb.OPERATION_READ, usbErr.Op)
+	assert.Equal(t, gousb.ID(0xFF01), usbErr.Vendor)
+}
+
+func TestErrorKind(t *testing.T) {
+	assert.Equal(t, usb.ErrStall, usb.ErrorKind(gousb.TransferStall))
+	assert.Equal(t, usb.ErrTimeout, usb.ErrorKind(gousb.ErrorTimeout))
+	assert.Equal(t, usb.ErrDisconnected, usb.ErrorKind(fmt.Errorf("remote: %w", usb.ErrDisconnected)))
+	assert.Nil(t, usb.ErrorKind(gousb.ErrorOverflow))
+	assert.Nil(t, usb.ErrorKind(nil))
+}
+
+func TestEncodeError(t *testing.T) {
+	errOther := errors.New("other error")
+	sentinels := []error{usb.ErrStall, context.Canceled}
+
+	tests := []struct {
+		name    string
+		err     error
+		encoded usb.EncodedError
+		errs    []error
+	}{
+		{
+			name:    "GOUSBError",
+			err:     usb.NewError(usb.OPERATION_CONTROL, 0xFF01, 0x0001, 0, gousb.ErrorPipe),
+			encoded: usb.EncodedError{Message: "usb control transfer of device ff01:0001 interface #0: libusb: pipe error [code -9]", Code: int(gousb.ErrorPipe), Sentinel: usb.ErrStall.Error()},
+			errs:    []error{gousb.ErrorPipe, usb.ErrStall},
+		},
+		{
+			name:    "TransferStatus",
+			err:     gousb.TransferNoDevice,
+			encoded: usb.EncodedError{Message: "device was disconnected", TransferStatus: uint8(gousb.TransferNoDevice)},
+			errs:    []error{gousb.TransferNoDevice},
+		},
+		{
+			name:    "Sentinel",
+			err:     fmt.Errorf("read: %w", context.Canceled),
+			encoded: usb.EncodedError{Message: "read: context canceled", Sentinel: context.Canceled.Error()},
+			errs:    []error{context.Canceled},
+		},
+		{
+			name:    "Other",
+			err:     errOther,
+			encoded: usb.EncodedError{Message: "other error"},
+			errs:    nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			encoded := usb.EncodeError(test.err, sentinels)
+			assert.Equal(t, test.encoded, encoded)
+			assert.Equal(t, test.errs, encoded.Errors(sentinels))
+		})
+	}
+}
@@ -9,48 +9,75 @@ import (
 
 var (
 	ErrGOUSBDeviceIsNil = errors.New("gousb device is nil")
+	// Zero-length packets are sent by endpoint of a stream, which is unknown to streams wrapped by NewGOUSBStreamWriter
+	ErrZeroLengthPacketUnsupported = errors.New("zero-length packet is not supported without endpoint of stream")
 )
 
 type gousbStreamWriter struct {
 	stream *gousb.WriteStream
+	// Endpoint of the stream, used to send zero-length packets which the stream does not send
+	ep *gousb.OutEndpoint
+	// Device and interface of the stream, used in errors
+	desc *gousb.DeviceDesc
+	intf int
 }
 
 func NewGOUSBStreamWriter(stream *gousb.WriteStream) StreamWriter {
 	return &gousbStreamWriter{
 		stream: stream,
+		intf:   NO_INTERFACE,
 	}
 }
 
 func (g *gousbStreamWriter) WriteContext(ctx context.Context, data []byte) (int, error) {
-	return g.stream.WriteContext(ctx, data)
+	if len(data) == 0 {
+		if g.ep == nil {
+			return 0, newDeviceError(OPERATION_WRITE, g.desc, g.intf, ErrZeroLengthPacketUnsupported)
+		}
+		_, err := g.ep.WriteContext(ctx, nil)
+
+		return 0, newDeviceError(OPERATION_WRITE, g.desc, g.intf, err)
+	}
+	n, err := g.stream.WriteContext(ctx, data)
+
+	return n, newDeviceError(OPERATION_WRITE, g.desc, g.intf, err)
 }
 
 func (g *gousbStreamWriter) Close() error {
-	return g.stream.Close()
+	return newDeviceError(OPERATION_CLOSE, g.desc, g.intf, g.stream.Close())
 }
 
 type gousbStreamReader struct {
 	stream *gousb.ReadStream
+	// Device and interface of the stream, used in errors
+	desc *gousb.DeviceDesc
+	intf int
 }
 
 func NewGOUSBStreamReader(stream *gousb.ReadStream) StreamReader {
 	return &gousbStreamReader{
 		stream: stream,
+		intf:   NO_INTERFACE,
 	}
 }
 
 func (g *gousbStreamReader) ReadContext(ctx context.Context, data []byte) (int, error) {
-	return g.stream.ReadContext(ctx, data)
+	n, err := g.stream.ReadContext(ctx, data)
+
+	return n, newDeviceError(OPERATION_READ, g.desc, g.intf, err)
 }
 
 func (g *gousbStreamReader) Close() error {
-	return g.stream.Close()
+	return newDeviceError(OPERATION_CLOSE, g.desc, g.intf, g.stream.Close())
 }
 
 type gousbOutEndpoint struct {
-	ep *gousb.OutEndpoint
+	ep     *gousb.OutEndpoint
+	desc   *gousb.DeviceDesc
+	device *gousbDevice
 }
 
+// NewGOUSBOutEndpoint wraps an OUT endpoint, whose halt cannot be cleared as its device is unknown
 func NewGOUSBOutEndpoint(ep *gousb.OutEndpoint) OutEndpoint {
 	return &gousbOutEndpoint{
 		ep: ep,
@@ -60,20 +87,31 @@ func NewGOUSBOutEndpoint(ep *gousb.OutEndpoint) OutEndpoint {
 func (g *gousbOutEndpoint) NewStream(count int) (StreamWriter, error) {
 	writeStream, err := g.ep.NewStream(g.ep.Desc.MaxPacketSize, count)
 	if err != nil {
-		return nil, err
+		return nil, newDeviceError(OPERATION_OPEN_STREAM, g.desc, g.ep.InterfaceSetting.Number, err)
 	}
 
-	return NewGOUSBStreamWriter(writeStream), nil
+	return &gousbStreamWriter{stream: writeStream, ep: g.ep, desc: g.desc, intf: g.ep.InterfaceSetting.Number}, nil
 }
 
 func (g *gousbOutEndpoint) Descriptor() gousb.EndpointDesc {
 	return g.ep.Desc
 }
 
+func (g *gousbOutEndpoint) ClearHalt() error {
+	if g.device == nil {
+		return ErrGOUSBDeviceIsNil
+	}
+
+	return ClearHalt(g.device, g.ep.Desc.Address)
+}
+
 type gousbInEndpoint struct {
-	ep *gousb.InEndpoint
+	ep     *gousb.InEndpoint
+	desc   *gousb.DeviceDesc
+	device *gousbDevice
 }
 
+// NewGOUSBInEndpoint wraps an IN endpoint, whose halt cannot be cleared as its device is unknown
 func NewGOUSBInEndpoint(ep *gousb.InEndpoint) InEndpoint {
 	return &gousbInEndpoint{
 		ep: ep,
@@ -83,20 +121,32 @@ func NewGOUSBInEndpoint(ep *gousb.InEndpoint) InEndpoint {
 func (g *gousbInEndpoint) NewStream(count int) (StreamReader, error) {
 	readStream, err := g.ep.NewStream(g.ep.Desc.MaxPacketSize, count)
 	if err != nil {
-		return nil, err
+		return nil, newDeviceError(OPERATION_OPEN_STREAM, g.desc, g.ep.InterfaceSetting.Number, err)
 	}
 
-	return NewGOUSBStreamReader(readStream), nil
+	return &gousbStreamReader{stream: readStream, desc: g.desc, intf: g.ep.InterfaceSetting.Number}, nil
 }
 
 func (g *gousbInEndpoint) Descriptor() gousb.EndpointDesc {
 	return g.ep.Desc
 }
 
+func (g *gousbInEndpoint) ClearHalt() error {
+	if g.device == nil {
+		return ErrGOUSBDeviceIsNil
+	}
+
+	return ClearHalt(g.device, g.ep.Desc.Address)
+}
+
 type gousbInterface struct {
-	intf *gousb.Interface
+	intf   *gousb.Interface
+	desc   *gousb.DeviceDesc
+	device *gousbDevice
 }
 
+// NewGOUSBInterface wraps an interface, whose endpoints cannot clear halt as its device is unknown.
+// Interfaces claimed from a configuration of a device returned by NewGOUSBDevice can clear halt.
 func NewGOUSBInterface(intf *gousb.Interface) Interface {
 	return &gousbInterface{
 		intf: intf,
@@ -111,24 +161,27 @@ func (g *gousbInterface) Close() error {
 func (g *gousbInterface) InEndpoint(num int) (InEndpoint, error) {
 	inEndpoint, err := g.intf.InEndpoint(num)
 	if err != nil {
-		return nil, err
+		return nil, newDeviceError(OPERATION_OPEN_ENDPOINT, g.desc, g.intf.Setting.Number, err)
 	}
 
-	return NewGOUSBInEndpoint(inEndpoint), nil
+	return &gousbInEndpoint{ep: inEndpoint, desc: g.desc, device: g.device}, nil
 }
 func (g *gousbInterface) OutEndpoint(num int) (OutEndpoint, error) {
 	outEndpoint, err := g.intf.OutEndpoint(num)
 	if err != nil {
-		return nil, err
+		return nil, newDeviceError(OPERATION_OPEN_ENDPOINT, g.desc, g.intf.Setting.Number, err)
 	}
 
-	return NewGOUSBOutEndpoint(outEndpoint), nil
+	return &gousbOutEndpoint{ep: outEndpoint, desc: g.desc, device: g.device}, nil
 }
 
 type gousbConfig struct {
 	config *gousb.Config
+	desc   *gousb.DeviceDesc
+	device *gousbDevice
 }
 
+// NewGOUSBConfiguration wraps a configuration, whose endpoints cannot clear halt as its device is unknown
 func NewGOUSBConfiguration(config *gousb.Config) Config {
 	return &gousbConfig{
 		config: config,
@@ -138,14 +191,14 @@ func NewGOUSBConfiguration(config *gousb.Config) Config {
 func (g *gousbConfig) Interface(num, alt int) (Interface, error) {
 	intf, err := g.config.Interface(num, alt)
 	if err != nil {
-		return nil, err
+		return nil, newDeviceError(OPERATION_CLAIM_INTERFACE, g.desc, num, err)
 	}
 
-	return NewGOUSBInterface(intf), nil
+	return &gousbInterface{intf: intf, desc: g.desc, device: g.device}, nil
 }
 
 func (g *gousbConfig) Close() error {
-	return g.config.Close()
+	return newDeviceError(OPERATION_CLOSE, g.desc, NO_INTERFACE, g.config.Close())
 }
 
 type gousbDevice struct {
@@ -160,15 +213,15 @@ func NewGOUSBDevice(device *gousb.Device) (Device, error) {
 }
 
 func (g *gousbDevice) SetAutoDetach(autodetach bool) error {
-	return g.device.SetAutoDetach(autodetach)
+	return newDeviceError(OPERATION_SET_AUTO_DETACH, g.device.Desc, NO_INTERFACE, g.device.SetAutoDetach(autodetach))
 }
 func (g *gousbDevice) Config(cfgNum int) (Config, error) {
 	config, err := g.device.Config(cfgNum)
 	if err != nil {
-		return nil, err
+		return nil, newDeviceError(OPERATION_CLAIM_CONFIG, g.device.Desc, NO_INTERFACE, err)
 	}
 
-	return NewGOUSBConfiguration(config), nil
+	return &gousbConfig{config: config, desc: g.device.Desc, device: g}, nil
 }
 
 func (g *gousbDevice) Descriptor() *gousb.DeviceDesc {
@@ -176,27 +229,46 @@ func (g *gousbDevice) Descriptor() *gousb.DeviceDesc {
 }
 
 func (g *gousbDevice) Control(bmRequestType, bRequest uint8, wValue, wIndex uint16, data []byte) (int, error) {
-	return g.device.Control(bmRequestType, bRequest, wValue, wIndex, data)
+	n, err := g.device.Control(bmRequestType, bRequest, wValue, wIndex, data)
+	intf := NO_INTERFACE
+	// Requests to an interface recipient have interface number at the low byte of wIndex
+	if bmRequestType&RECIPIENT_MASK == RECIPIENT_INTERFACE {
+		intf = int(wIndex & 0xFF)
+	}
+
+	return n, newDeviceError(OPERATION_CONTROL, g.device.Desc, intf, err)
+}
+
+func (g *gousbDevice) Reset() error {
+	return newDeviceError(OPERATION_RESET, g.device.Desc, NO_INTERFACE, g.device.Reset())
 }
 
 func (g *gousbDevice) Close() error {
-	return g.device.Close()
+	return newDeviceError(OPERATION_CLOSE, g.device.Desc, NO_INTERFACE, g.device.Close())
 }
 
 func (g *gousbDevice) SerialNumber() (string, error) {
-	return g.device.SerialNumber()
+	str, err := g.device.SerialNumber()
+
+	return str, newDeviceError(OPERATION_STRING_DESCRIPTOR, g.device.Desc, NO_INTERFACE, err)
 }
 
 func (g *gousbDevice) Product() (string, error) {
-	return g.device.Product()
+	str, err := g.device.Product()
+
+	return str, newDeviceError(OPERATION_STRING_DESCRIPTOR, g.device.Desc, NO_INTERFACE, err)
 }
 
 func (g *gousbDevice) Manufacturer() (string, error) {
-	return g.device.Manufacturer()
+	str, err := g.device.Manufacturer()
+
+	return str, newDeviceError(OPERATION_STRING_DESCRIPTOR, g.device.Desc, NO_INTERFACE, err)
 }
 
 func (g *gousbDevice) GetStringDescriptor(index int) (string, error) {
-	return g.device.GetStringDescriptor(index)
+	str, err := g.device.GetStringDescriptor(index)
+
+	return str, newDeviceError(OPERATION_STRING_DESCRIPTOR, g.device.Desc, NO_INTERFACE, err)
 }
 
 type gousbContext struct {
@@ -224,7 +296,7 @@ func (g *gousbContext) IterateDevices(reader func(desc *gousb.DeviceDesc)) error
 func (g *gousbContext) OpenDevice(vid, pid gousb.ID) (Device, error) {
 	device, err := g.usbCtx.OpenDeviceWithVIDPID(vid, pid)
 	if err != nil {
-		return nil, err
+		return nil, NewError(OPERATION_OPEN, vid, pid, NO_INTERFACE, err)
 	}
 
 	return NewGOUSBDevice(device)
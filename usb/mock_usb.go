@@ -144,6 +144,20 @@ func (m *MockOutEndpoint) EXPECT() *MockOutEndpointMockRecorder {
 	return m.recorder
 }
 
+// ClearHalt mocks base method.
+func (m *MockOutEndpoint) ClearHalt() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearHalt")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearHalt indicates an expected call of ClearHalt.
+func (mr *MockOutEndpointMockRecorder) ClearHalt() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearHalt", reflect.TypeOf((*MockOutEndpoint)(nil).ClearHalt))
+}
+
 // Descriptor mocks base method.
 func (m *MockOutEndpoint) Descriptor() gousb.EndpointDesc {
 	m.ctrl.T.Helper()
@@ -196,6 +210,20 @@ func (m *MockInEndpoint) EXPECT() *MockInEndpointMockRecorder {
 	return m.recorder
 }
 
+// ClearHalt mocks base method.
+func (m *MockInEndpoint) ClearHalt() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearHalt")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearHalt indicates an expected call of ClearHalt.
+func (mr *MockInEndpointMockRecorder) ClearHalt() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearHalt", reflect.TypeOf((*MockInEndpoint)(nil).ClearHalt))
+}
+
 // Descriptor mocks base method.
 func (m *MockInEndpoint) Descriptor() gousb.EndpointDesc {
 	m.ctrl.T.Helper()
@@ -470,6 +498,20 @@ func (mr *MockDeviceMockRecorder) Product() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Product", reflect.TypeOf((*MockDevice)(nil).Product))
 }
 
+// Reset mocks base method.
+func (m *MockDevice) Reset() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reset")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reset indicates an expected call of Reset.
+func (mr *MockDeviceMockRecorder) Reset() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockDevice)(nil).Reset))
+}
+
 // SerialNumber mocks base method.
 func (m *MockDevice) SerialNumber() (string, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,111 @@
+// Package record records sessions of USB devices opened via usb.Context into JSON lines,
+// and replays recorded sessions as a usb.Context, so that tests can run against recorded hardware, e.g.
+//
+//	file, _ := os.Create("session.jsonl")
+//	man := manager.NewDeviceManager(record.NewRecorder(usb.NewGOUSBContext(), file, logger), logger)
+//
+// and later in tests
+//
+//	usbCtx, _ := record.LoadReplayFile("testdata/session.jsonl")
+//	man := manager.NewDeviceManager(usbCtx, logger)
+package record
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/gousb"
+	"github.com/ntchjb/gohid/hid"
+	"github.com/ntchjb/gohid/usb"
+)
+
+var (
+	ErrRecordedError  = errors.New("recorded error")
+	ErrReplayMismatch = errors.New("request does not match recorded session")
+	ErrReplayEnded    = errors.New("recorded session has no more events")
+	ErrInvalidSession = errors.New("invalid recorded session")
+)
+
+type EventType string
+
+const (
+	// Descriptors of connected devices, found by IterateDevices
+	EVENT_DEVICES EventType = "devices"
+	// A device is opened by OpenDevice
+	EVENT_OPEN EventType = "open"
+	// A control transfer
+	EVENT_CONTROL EventType = "control"
+	// A string descriptor by index, or manufacturer, product or serial number string
+	EVENT_STRING EventType = "string"
+	// Data read from an IN endpoint stream
+	EVENT_READ EventType = "read"
+	// Data written to an OUT endpoint stream
+	EVENT_WRITE EventType = "write"
+	// Halt condition of an endpoint is cleared
+	EVENT_CLEAR_HALT EventType = "clearHalt"
+	// USB port of a device is reset
+	EVENT_RESET EventType = "reset"
+)
+
+const (
+	STRING_MANUFACTURER  = "manufacturer"
+	STRING_PRODUCT       = "product"
+	STRING_SERIAL_NUMBER = "serialNumber"
+)
+
+// Event is a line of a recorded session
+type Event struct {
+	Type EventType `json:"type"`
+	// Handle of the opened device this event belongs to, numbered from 1 in order of opening
+	Device int `json:"device,omitempty"`
+
+	// Found or opened devices
+	Devices   []*gousb.DeviceDesc `json:"devices,omitempty"`
+	VendorID  gousb.ID            `json:"vendorId,omitempty"`
+	ProductID gousb.ID            `json:"productId,omitempty"`
+
+	// Setup packet of control transfer. Length is wLength, and Data is data transferred.
+	RequestType uint8  `json:"requestType,omitempty"`
+	Request     uint8  `json:"request,omitempty"`
+	Value       uint16 `json:"value,omitempty"`
+	Index       uint16 `json:"index,omitempty"`
+	Length      int    `json:"length,omitempty"`
+
+	// Index of string descriptor, or name of a device string, e.g. "manufacturer"
+	StringIndex int    `json:"stringIndex,omitempty"`
+	StringName  string `json:"stringName,omitempty"`
+	String      string `json:"string,omitempty"`
+
+	// Endpoint address of read, write or clearing halt
+	Endpoint uint8        `json:"endpoint,omitempty"`
+	Data     hid.HexBytes `json:"data,omitempty"`
+	// Number of bytes transferred
+	N int `json:"n,omitempty"`
+
+	Error *Error `json:"error,omitempty"`
+}
+
+// Error is a recorded error. Errors of gousb are replayed as the same error values.
+type Error struct {
+	usb.EncodedError
+}
+
+func newError(err error) *Error {
+	if err == nil {
+		return nil
+	}
+
+	return &Error{EncodedError: usb.EncodeError(err, nil)}
+}
+
+// Err converts a recorded error into an error, or nil if no error is recorded
+func (e *Error) Err() error {
+	if e == nil {
+		return nil
+	}
+	if errs := e.Errors(nil); len(errs) > 0 {
+		return errs[0]
+	}
+
+	return fmt.Errorf("%s: %w", e.Message, ErrRecordedError)
+}
@@ -0,0 +1,227 @@
+package record_test
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/gousb"
+	"github.com/ntchjb/gohid/usb"
+	"github.com/ntchjb/gohid/usb/record"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var deviceDesc = &gousb.DeviceDesc{
+	Bus:                  1,
+	Address:              21,
+	Speed:                gousb.SpeedFull,
+	Spec:                 0x0200,
+	Device:               0x0100,
+	Vendor:               0xFF01,
+	Product:              0x0001,
+	MaxControlPacketSize: 64,
+	Configs: map[int]gousb.ConfigDesc{
+		1: {
+			Number:   1,
+			MaxPower: 100,
+			Interfaces: []gousb.InterfaceDesc{
+				{
+					Number: 0,
+					AltSettings: []gousb.InterfaceSetting{
+						{
+							Alternate: 0,
+							Class:     gousb.ClassHID,
+							Endpoints: map[gousb.EndpointAddress]gousb.EndpointDesc{
+								0x81: {
+									Address:       0x81,
+									Number:        1,
+									Direction:     gousb.EndpointDirectionIn,
+									MaxPacketSize: 64,
+									TransferType:  gousb.TransferTypeInterrupt,
+									PollInterval:  10 * time.Millisecond,
+								},
+								0x01: {
+									Address:       0x01,
+									Number:        1,
+									Direction:     gousb.EndpointDirectionOut,
+									MaxPacketSize: 64,
+									TransferType:  gousb.TransferTypeInterrupt,
+									PollInterval:  10 * time.Millisecond,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+// recordSession records a session of a mocked device, which is replayed by runSession
+func recordSession(t *testing.T) []byte {
+	ctrl := gomock.NewController(t)
+	usbCtx := usb.NewMockContext(ctrl)
+	device := usb.NewMockDevice(ctrl)
+	config := usb.NewMockConfig(ctrl)
+	intf := usb.NewMockInterface(ctrl)
+	epIn := usb.NewMockInEndpoint(ctrl)
+	epOut := usb.NewMockOutEndpoint(ctrl)
+	reader := usb.NewMockStreamReader(ctrl)
+	writer := usb.NewMockStreamWriter(ctrl)
+
+	usbCtx.EXPECT().IterateDevices(gomock.Any()).DoAndReturn(func(reader func(desc *gousb.DeviceDesc)) error {
+		reader(deviceDesc)
+		return nil
+	})
+	usbCtx.EXPECT().OpenDevice(gousb.ID(0xFF01), gousb.ID(0x0001)).Return(device, nil)
+	device.EXPECT().Descriptor().Return(deviceDesc).AnyTimes()
+	device.EXPECT().Manufacturer().Return("gohid", nil)
+	device.EXPECT().GetStringDescriptor(4).Return("", gousb.ErrorIO)
+	device.EXPECT().Control(uint8(0x81), uint8(0x06), uint16(0x2200), uint16(0), gomock.Len(64)).DoAndReturn(
+		func(bmRequestType, bRequest uint8, wValue, wIndex uint16, data []byte) (int, error) {
+			return copy(data, []byte{0x05, 0x01, 0xC0}), nil
+		},
+	)
+	device.EXPECT().Control(uint8(0x21), uint8(0x09), uint16(0x0301), uint16(0), []byte{0x01, 0xFF}).Return(0, gousb.ErrorPipe)
+	device.EXPECT().Config(1).Return(config, nil)
+	config.EXPECT().Interface(0, 0).Return(intf, nil)
+	intf.EXPECT().InEndpoint(1).Return(epIn, nil)
+	intf.EXPECT().OutEndpoint(1).Return(epOut, nil)
+	epIn.EXPECT().NewStream(16).Return(reader, nil)
+	epIn.EXPECT().Descriptor().Return(deviceDesc.Configs[1].Interfaces[0].AltSettings[0].Endpoints[0x81]).Times(2)
+	epIn.EXPECT().ClearHalt().Return(nil)
+	device.EXPECT().Reset().Return(gousb.ErrorNotFound)
+	epOut.EXPECT().NewStream(16).Return(writer, nil)
+	epOut.EXPECT().Descriptor().Return(deviceDesc.Configs[1].Interfaces[0].AltSettings[0].Endpoints[0x01])
+	reader.EXPECT().ReadContext(gomock.Any(), gomock.Len(64)).DoAndReturn(func(ctx context.Context, data []byte) (int, error) {
+		return copy(data, []byte{0x01, 0x02}), nil
+	})
+	reader.EXPECT().ReadContext(gomock.Any(), gomock.Len(64)).Return(0, gousb.TransferStall)
+	reader.EXPECT().ReadContext(gomock.Any(), gomock.Len(64)).Return(0, context.DeadlineExceeded)
+	writer.EXPECT().WriteContext(gomock.Any(), []byte{0x03, 0x04}).Return(2, nil)
+
+	var buf bytes.Buffer
+	runSession(t, record.NewRecorder(usbCtx, &buf, slog.Default()))
+
+	return buf.Bytes()
+}
+
+// runSession makes requests to a device, and checks their results
+func runSession(t *testing.T, usbCtx usb.Context) {
+	var descs []*gousb.DeviceDesc
+	require.NoError(t, usbCtx.IterateDevices(func(desc *gousb.DeviceDesc) {
+		descs = append(descs, desc)
+	}))
+	assert.Equal(t, []*gousb.DeviceDesc{deviceDesc}, descs)
+
+	device, err := usbCtx.OpenDevice(0xFF01, 0x0001)
+	require.NoError(t, err)
+	assert.Equal(t, deviceDesc, device.Descriptor())
+	str, err := device.Manufacturer()
+	assert.NoError(t, err)
+	assert.Equal(t, "gohid", str)
+	_, err = device.GetStringDescriptor(4)
+	assert.ErrorIs(t, err, gousb.ErrorIO)
+
+	data := make([]byte, 64)
+	n, err := device.Control(0x81, 0x06, 0x2200, 0, data)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x05, 0x01, 0xC0}, data[:n])
+	_, err = device.Control(0x21, 0x09, 0x0301, 0, []byte{0x01, 0xFF})
+	assert.ErrorIs(t, err, gousb.ErrorPipe)
+
+	config, err := device.Config(1)
+	require.NoError(t, err)
+	intf, err := config.Interface(0, 0)
+	require.NoError(t, err)
+	epIn, err := intf.InEndpoint(1)
+	require.NoError(t, err)
+	epOut, err := intf.OutEndpoint(1)
+	require.NoError(t, err)
+	reader, err := epIn.NewStream(16)
+	require.NoError(t, err)
+	writer, err := epOut.NewStream(16)
+	require.NoError(t, err)
+
+	n, err = reader.ReadContext(context.Background(), data)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x01, 0x02}, data[:n])
+	_, err = reader.ReadContext(context.Background(), data)
+	assert.ErrorIs(t, err, gousb.TransferStall)
+	assert.NoError(t, epIn.ClearHalt())
+	assert.ErrorIs(t, device.Reset(), gousb.ErrorNotFound)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = reader.ReadContext(ctx, data)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	n, err = writer.WriteContext(context.Background(), []byte{0x03, 0x04})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+}
+
+func TestRecorder(t *testing.T) {
+	session := recordSession(t)
+
+	lines := strings.Split(strings.TrimSpace(string(session)), "\n")
+	// Cancelled read is not recorded
+	require.Len(t, lines, 11)
+	assert.JSONEq(t, `{"type":"control","device":1,"requestType":129,"request":6,"value":8704,"length":64,"data":"0501C0","n":3}`, lines[4])
+	assert.JSONEq(t, `{"type":"control","device":1,"requestType":33,"request":9,"value":769,"length":2,"data":"01FF","error":{"message":"libusb: pipe error [code -9]","code":-9}}`, lines[5])
+	assert.JSONEq(t, `{"type":"read","device":1,"endpoint":129,"error":{"message":"halt condition detected (endpoint stalled) or control request not supported","transferStatus":4}}`, lines[7])
+	assert.JSONEq(t, `{"type":"clearHalt","device":1,"endpoint":129}`, lines[8])
+	assert.JSONEq(t, `{"type":"reset","device":1,"error":{"message":"libusb: not found [code -5]","code":-5}}`, lines[9])
+}
+
+func TestReplayContext(t *testing.T) {
+	usbCtx, err := record.NewReplayContext(bytes.NewReader(recordSession(t)))
+	require.NoError(t, err)
+
+	runSession(t, usbCtx)
+}
+
+func TestReplayContext_Mismatch(t *testing.T) {
+	usbCtx, err := record.NewReplayContext(bytes.NewReader(recordSession(t)))
+	require.NoError(t, err)
+
+	_, err = usbCtx.OpenDevice(0xFF01, 0x0002)
+	assert.ErrorIs(t, err, record.ErrReplayMismatch)
+	_, err = usbCtx.OpenDevice(0xFF01, 0x0001)
+	assert.ErrorIs(t, err, record.ErrReplayEnded)
+
+	usbCtx, err = record.NewReplayContext(bytes.NewReader(recordSession(t)))
+	require.NoError(t, err)
+	device, err := usbCtx.OpenDevice(0xFF01, 0x0001)
+	require.NoError(t, err)
+	_, err = device.Control(0x81, 0x06, 0x2100, 0, make([]byte, 64))
+	assert.ErrorIs(t, err, record.ErrReplayMismatch)
+	_, err = device.Control(0x21, 0x09, 0x0301, 0, []byte{0x01, 0xFE})
+	assert.ErrorIs(t, err, record.ErrReplayMismatch)
+	_, err = device.Control(0x21, 0x09, 0x0301, 0, []byte{0x01, 0xFF})
+	assert.ErrorIs(t, err, record.ErrReplayEnded)
+	_, err = device.Product()
+	assert.ErrorIs(t, err, record.ErrReplayEnded)
+}
+
+func TestNewReplayContext_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		session string
+	}{
+		{name: "malformed", session: `{"type":`},
+		{name: "unknown type", session: `{"type":"open","device":1,"devices":[{}]}` + "\n" + `{"type":"suspend","device":1}`},
+		{name: "device not opened", session: `{"type":"control","device":2}`},
+		{name: "open without device", session: `{"type":"open","vendorId":1}`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := record.NewReplayContext(strings.NewReader(test.session))
+			assert.ErrorIs(t, err, record.ErrInvalidSession)
+		})
+	}
+}
@@ -0,0 +1,331 @@
+package record
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"sync"
+
+	"github.com/google/gousb"
+	"github.com/ntchjb/gohid/hid"
+	"github.com/ntchjb/gohid/usb"
+)
+
+// journal writes events as JSON lines
+type journal struct {
+	encoder *json.Encoder
+	logger  *slog.Logger
+	// Number of opened devices
+	devices int
+	mu      sync.Mutex
+}
+
+func (j *journal) write(event Event) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if err := j.encoder.Encode(event); err != nil {
+		j.logger.Error("unable to write recorded event", "type", event.Type, "err", err)
+	}
+}
+
+func (j *journal) nextDevice() int {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.devices++
+	return j.devices
+}
+
+type recorderContext struct {
+	ctx     usb.Context
+	journal *journal
+}
+
+// NewRecorder wraps a USB context, so that sessions of devices opened from it are written to w as JSON lines
+func NewRecorder(ctx usb.Context, w io.Writer, logger *slog.Logger) usb.Context {
+	return &recorderContext{
+		ctx: ctx,
+		journal: &journal{
+			encoder: json.NewEncoder(w),
+			logger:  logger,
+		},
+	}
+}
+
+func (r *recorderContext) IterateDevices(reader func(desc *gousb.DeviceDesc)) error {
+	var descs []*gousb.DeviceDesc
+	err := r.ctx.IterateDevices(func(desc *gousb.DeviceDesc) {
+		descs = append(descs, desc)
+		reader(desc)
+	})
+	r.journal.write(Event{
+		Type:    EVENT_DEVICES,
+		Devices: descs,
+		Error:   newError(err),
+	})
+
+	return err
+}
+
+func (r *recorderContext) OpenDevice(vid, pid gousb.ID) (usb.Device, error) {
+	device, err := r.ctx.OpenDevice(vid, pid)
+	event := Event{
+		Type:      EVENT_OPEN,
+		VendorID:  vid,
+		ProductID: pid,
+		Error:     newError(err),
+	}
+	if err != nil {
+		r.journal.write(event)
+		return nil, err
+	}
+	event.Device = r.journal.nextDevice()
+	event.Devices = []*gousb.DeviceDesc{device.Descriptor()}
+	r.journal.write(event)
+
+	return &recorderDevice{
+		Device:  device,
+		handle:  event.Device,
+		journal: r.journal,
+	}, nil
+}
+
+func (r *recorderContext) Close() error {
+	return r.ctx.Close()
+}
+
+type recorderDevice struct {
+	usb.Device
+	// Handle of this device in recorded events
+	handle  int
+	journal *journal
+}
+
+func (d *recorderDevice) Control(bmRequestType, bRequest uint8, wValue, wIndex uint16, data []byte) (int, error) {
+	event := Event{
+		Type:        EVENT_CONTROL,
+		Device:      d.handle,
+		RequestType: bmRequestType,
+		Request:     bRequest,
+		Value:       wValue,
+		Index:       wIndex,
+		Length:      len(data),
+	}
+	// Data of OUT transfers is recorded before the transfer, in case the device modifies the buffer
+	if bmRequestType&0x80 == 0 {
+		event.Data = append(event.Data, data...)
+	}
+	n, err := d.Device.Control(bmRequestType, bRequest, wValue, wIndex, data)
+	if bmRequestType&0x80 != 0 && n > 0 {
+		event.Data = append(event.Data, data[:n]...)
+	}
+	event.N = n
+	event.Error = newError(err)
+	d.journal.write(event)
+
+	return n, err
+}
+
+func (d *recorderDevice) recordString(index int, name string, get func() (string, error)) (string, error) {
+	str, err := get()
+	d.journal.write(Event{
+		Type:        EVENT_STRING,
+		Device:      d.handle,
+		StringIndex: index,
+		StringName:  name,
+		String:      str,
+		Error:       newError(err),
+	})
+
+	return str, err
+}
+
+func (d *recorderDevice) GetStringDescriptor(index int) (string, error) {
+	return d.recordString(index, "", func() (string, error) {
+		return d.Device.GetStringDescriptor(index)
+	})
+}
+
+func (d *recorderDevice) Manufacturer() (string, error) {
+	return d.recordString(0, STRING_MANUFACTURER, d.Device.Manufacturer)
+}
+
+func (d *recorderDevice) Product() (string, error) {
+	return d.recordString(0, STRING_PRODUCT, d.Device.Product)
+}
+
+func (d *recorderDevice) SerialNumber() (string, error) {
+	return d.recordString(0, STRING_SERIAL_NUMBER, d.Device.SerialNumber)
+}
+
+func (d *recorderDevice) Reset() error {
+	err := d.Device.Reset()
+	d.journal.write(Event{
+		Type:   EVENT_RESET,
+		Device: d.handle,
+		Error:  newError(err),
+	})
+
+	return err
+}
+
+// clearHalt clears halt condition of an endpoint, and records it
+func (d *recorderDevice) clearHalt(address gousb.EndpointAddress, clearHalt func() error) error {
+	err := clearHalt()
+	d.journal.write(Event{
+		Type:     EVENT_CLEAR_HALT,
+		Device:   d.handle,
+		Endpoint: uint8(address),
+		Error:    newError(err),
+	})
+
+	return err
+}
+
+func (d *recorderDevice) Config(configNumber int) (usb.Config, error) {
+	config, err := d.Device.Config(configNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	return &recorderConfig{Config: config, device: d}, nil
+}
+
+type recorderConfig struct {
+	usb.Config
+	device *recorderDevice
+}
+
+func (c *recorderConfig) Interface(num, alt int) (usb.Interface, error) {
+	intf, err := c.Config.Interface(num, alt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &recorderInterface{Interface: intf, device: c.device}, nil
+}
+
+type recorderInterface struct {
+	usb.Interface
+	device *recorderDevice
+}
+
+func (i *recorderInterface) InEndpoint(num int) (usb.InEndpoint, error) {
+	ep, err := i.Interface.InEndpoint(num)
+	if err != nil {
+		return nil, err
+	}
+
+	return &recorderInEndpoint{InEndpoint: ep, device: i.device}, nil
+}
+
+func (i *recorderInterface) OutEndpoint(num int) (usb.OutEndpoint, error) {
+	ep, err := i.Interface.OutEndpoint(num)
+	if err != nil {
+		return nil, err
+	}
+
+	return &recorderOutEndpoint{OutEndpoint: ep, device: i.device}, nil
+}
+
+type recorderInEndpoint struct {
+	usb.InEndpoint
+	device *recorderDevice
+}
+
+func (e *recorderInEndpoint) NewStream(count int) (usb.StreamReader, error) {
+	stream, err := e.InEndpoint.NewStream(count)
+	if err != nil {
+		return nil, err
+	}
+
+	return &recorderStreamReader{
+		StreamReader: stream,
+		endpoint:     uint8(e.InEndpoint.Descriptor().Address),
+		device:       e.device,
+	}, nil
+}
+
+func (e *recorderInEndpoint) ClearHalt() error {
+	return e.device.clearHalt(e.InEndpoint.Descriptor().Address, e.InEndpoint.ClearHalt)
+}
+
+type recorderOutEndpoint struct {
+	usb.OutEndpoint
+	device *recorderDevice
+}
+
+func (e *recorderOutEndpoint) NewStream(count int) (usb.StreamWriter, error) {
+	stream, err := e.OutEndpoint.NewStream(count)
+	if err != nil {
+		return nil, err
+	}
+
+	return &recorderStreamWriter{
+		StreamWriter: stream,
+		endpoint:     uint8(e.OutEndpoint.Descriptor().Address),
+		device:       e.device,
+	}, nil
+}
+
+func (e *recorderOutEndpoint) ClearHalt() error {
+	return e.device.clearHalt(e.OutEndpoint.Descriptor().Address, e.OutEndpoint.ClearHalt)
+}
+
+// isCancelled reports whether a transfer is interrupted by its caller, which is not part of device behavior
+func isCancelled(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+type recorderStreamReader struct {
+	usb.StreamReader
+	endpoint uint8
+	device   *recorderDevice
+}
+
+func (s *recorderStreamReader) ReadContext(ctx context.Context, data []byte) (int, error) {
+	n, err := s.StreamReader.ReadContext(ctx, data)
+	if isCancelled(err) {
+		return n, err
+	}
+	event := Event{
+		Type:     EVENT_READ,
+		Device:   s.device.handle,
+		Endpoint: s.endpoint,
+		N:        n,
+		Error:    newError(err),
+	}
+	if n > 0 {
+		event.Data = append(event.Data, data[:n]...)
+	}
+	s.device.journal.write(event)
+
+	return n, err
+}
+
+type recorderStreamWriter struct {
+	usb.StreamWriter
+	endpoint uint8
+	device   *recorderDevice
+}
+
+func (s *recorderStreamWriter) WriteContext(ctx context.Context, data []byte) (int, error) {
+	event := Event{
+		Type:     EVENT_WRITE,
+		Device:   s.device.handle,
+		Endpoint: s.endpoint,
+		Data:     append(hid.HexBytes(nil), data...),
+	}
+	n, err := s.StreamWriter.WriteContext(ctx, data)
+	if isCancelled(err) {
+		return n, err
+	}
+	event.N = n
+	event.Error = newError(err)
+	s.device.journal.write(event)
+
+	return n, err
+}
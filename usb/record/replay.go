@@ -0,0 +1,427 @@
+package record
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/google/gousb"
+	"github.com/ntchjb/gohid/usb"
+)
+
+// replaySession keeps recorded events of an opened device which are not yet replayed
+type replaySession struct {
+	desc     *gousb.DeviceDesc
+	controls []Event
+	strings  []Event
+	reads    map[uint8][]Event
+	writes   map[uint8][]Event
+	// Clearing halt and reset events, in order of recording
+	recoveries []Event
+	// Closed when the device is closed, to stop pending reads
+	closed    chan struct{}
+	closeOnce sync.Once
+	mu        sync.Mutex
+}
+
+// next removes the first event of the queue, or returns false if there is no event left
+func next(queue *[]Event) (Event, bool) {
+	if len(*queue) == 0 {
+		return Event{}, false
+	}
+	event := (*queue)[0]
+	*queue = (*queue)[1:]
+
+	return event, true
+}
+
+type replayContext struct {
+	enumerations []Event
+	opens        []Event
+	sessions     map[int]*replaySession
+	mu           sync.Mutex
+}
+
+// NewReplayContext creates a USB context which serves a session recorded by NewRecorder.
+// Requests are expected to be made in the same order as recorded.
+func NewReplayContext(r io.Reader) (usb.Context, error) {
+	res := &replayContext{
+		sessions: make(map[int]*replaySession),
+	}
+	decoder := json.NewDecoder(r)
+	for line := 1; ; line++ {
+		var event Event
+		if err := decoder.Decode(&event); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("event %d: %w: %w", line, ErrInvalidSession, err)
+		}
+		if err := res.add(event); err != nil {
+			return nil, fmt.Errorf("event %d: %w", line, err)
+		}
+	}
+
+	return res, nil
+}
+
+// LoadReplayFile creates a USB context which serves a session recorded in a file
+func LoadReplayFile(path string) (usb.Context, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open recorded session: %w", err)
+	}
+	defer file.Close()
+
+	return NewReplayContext(file)
+}
+
+func (r *replayContext) add(event Event) error {
+	if event.Type == EVENT_DEVICES {
+		r.enumerations = append(r.enumerations, event)
+		return nil
+	}
+	if event.Type == EVENT_OPEN {
+		r.opens = append(r.opens, event)
+		if event.Error != nil {
+			return nil
+		}
+		if event.Device == 0 || len(event.Devices) != 1 {
+			return fmt.Errorf("open event without device: %w", ErrInvalidSession)
+		}
+		r.sessions[event.Device] = &replaySession{
+			desc:   event.Devices[0],
+			reads:  make(map[uint8][]Event),
+			writes: make(map[uint8][]Event),
+			closed: make(chan struct{}),
+		}
+		return nil
+	}
+
+	session, ok := r.sessions[event.Device]
+	if !ok {
+		return fmt.Errorf("device %d is not opened: %w", event.Device, ErrInvalidSession)
+	}
+	switch event.Type {
+	case EVENT_CONTROL:
+		session.controls = append(session.controls, event)
+	case EVENT_STRING:
+		session.strings = append(session.strings, event)
+	case EVENT_READ:
+		session.reads[event.Endpoint] = append(session.reads[event.Endpoint], event)
+	case EVENT_WRITE:
+		session.writes[event.Endpoint] = append(session.writes[event.Endpoint], event)
+	case EVENT_CLEAR_HALT, EVENT_RESET:
+		session.recoveries = append(session.recoveries, event)
+	default:
+		return fmt.Errorf("unknown event type %q: %w", event.Type, ErrInvalidSession)
+	}
+
+	return nil
+}
+
+func (r *replayContext) IterateDevices(reader func(desc *gousb.DeviceDesc)) error {
+	r.mu.Lock()
+	if len(r.enumerations) == 0 {
+		r.mu.Unlock()
+		return fmt.Errorf("devices: %w", ErrReplayEnded)
+	}
+	event := r.enumerations[0]
+	// The last enumeration is kept, as connected devices stay the same afterwards
+	if len(r.enumerations) > 1 {
+		r.enumerations = r.enumerations[1:]
+	}
+	r.mu.Unlock()
+
+	for _, desc := range event.Devices {
+		reader(desc)
+	}
+
+	return event.Error.Err()
+}
+
+func (r *replayContext) OpenDevice(vid, pid gousb.ID) (usb.Device, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	event, ok := next(&r.opens)
+	if !ok {
+		return nil, fmt.Errorf("open %s:%s: %w", vid, pid, ErrReplayEnded)
+	}
+	if event.VendorID != vid || event.ProductID != pid {
+		return nil, fmt.Errorf("open %s:%s, recorded %s:%s: %w", vid, pid, event.VendorID, event.ProductID, ErrReplayMismatch)
+	}
+	if event.Error != nil {
+		return nil, event.Error.Err()
+	}
+
+	return &replayDevice{session: r.sessions[event.Device]}, nil
+}
+
+func (r *replayContext) Close() error {
+	return nil
+}
+
+type replayDevice struct {
+	session *replaySession
+}
+
+func (d *replayDevice) SetAutoDetach(autoDetach bool) error {
+	return nil
+}
+
+func (d *replayDevice) Config(configNumber int) (usb.Config, error) {
+	desc, ok := d.session.desc.Configs[configNumber]
+	if !ok {
+		return nil, fmt.Errorf("config %d: %w", configNumber, gousb.ErrorNotFound)
+	}
+
+	return &replayConfig{desc: desc, session: d.session}, nil
+}
+
+func (d *replayDevice) Descriptor() *gousb.DeviceDesc {
+	return d.session.desc
+}
+
+func (d *replayDevice) Control(bmRequestType, bRequest uint8, wValue, wIndex uint16, data []byte) (int, error) {
+	d.session.mu.Lock()
+	defer d.session.mu.Unlock()
+
+	event, ok := next(&d.session.controls)
+	if !ok {
+		return 0, fmt.Errorf("control %#02x %#02x: %w", bmRequestType, bRequest, ErrReplayEnded)
+	}
+	if event.RequestType != bmRequestType || event.Request != bRequest || event.Value != wValue || event.Index != wIndex || event.Length != len(data) {
+		return 0, fmt.Errorf(
+			"control %#02x %#02x %#04x %#04x length %d, recorded %#02x %#02x %#04x %#04x length %d: %w",
+			bmRequestType, bRequest, wValue, wIndex, len(data),
+			event.RequestType, event.Request, event.Value, event.Index, event.Length, ErrReplayMismatch,
+		)
+	}
+	if bmRequestType&0x80 == 0 {
+		if !bytes.Equal(event.Data, data) {
+			return 0, fmt.Errorf("control %#02x %#02x data %x, recorded %x: %w", bmRequestType, bRequest, data, []byte(event.Data), ErrReplayMismatch)
+		}
+	} else {
+		copy(data, event.Data)
+	}
+
+	return event.N, event.Error.Err()
+}
+
+// findString gets a recorded string by index or name
+func (d *replayDevice) findString(index int, name string) (string, error) {
+	d.session.mu.Lock()
+	defer d.session.mu.Unlock()
+
+	for _, event := range d.session.strings {
+		if event.StringIndex == index && event.StringName == name {
+			return event.String, event.Error.Err()
+		}
+	}
+	if name == "" {
+		name = fmt.Sprintf("string %d", index)
+	}
+
+	return "", fmt.Errorf("%s: %w", name, ErrReplayEnded)
+}
+
+func (d *replayDevice) GetStringDescriptor(index int) (string, error) {
+	return d.findString(index, "")
+}
+
+func (d *replayDevice) Manufacturer() (string, error) {
+	return d.findString(0, STRING_MANUFACTURER)
+}
+
+func (d *replayDevice) Product() (string, error) {
+	return d.findString(0, STRING_PRODUCT)
+}
+
+func (d *replayDevice) SerialNumber() (string, error) {
+	return d.findString(0, STRING_SERIAL_NUMBER)
+}
+
+func (d *replayDevice) Reset() error {
+	return d.session.recover(EVENT_RESET, 0)
+}
+
+// recover replays the next recorded clearing halt or reset, which must be of the same type and endpoint
+func (s *replaySession) recover(eventType EventType, endpoint uint8) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	event, ok := next(&s.recoveries)
+	if !ok {
+		return fmt.Errorf("%s endpoint %#02x: %w", eventType, endpoint, ErrReplayEnded)
+	}
+	if event.Type != eventType || event.Endpoint != endpoint {
+		return fmt.Errorf("%s endpoint %#02x, recorded %s endpoint %#02x: %w", eventType, endpoint, event.Type, event.Endpoint, ErrReplayMismatch)
+	}
+
+	return event.Error.Err()
+}
+
+func (d *replayDevice) Close() error {
+	d.session.closeOnce.Do(func() {
+		close(d.session.closed)
+	})
+
+	return nil
+}
+
+type replayConfig struct {
+	desc    gousb.ConfigDesc
+	session *replaySession
+}
+
+func (c *replayConfig) Interface(num, alt int) (usb.Interface, error) {
+	for _, intf := range c.desc.Interfaces {
+		if intf.Number != num {
+			continue
+		}
+		for _, setting := range intf.AltSettings {
+			if setting.Alternate == alt {
+				return &replayInterface{setting: setting, session: c.session}, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("interface %d alt %d: %w", num, alt, gousb.ErrorNotFound)
+}
+
+func (c *replayConfig) Close() error {
+	return nil
+}
+
+type replayInterface struct {
+	setting gousb.InterfaceSetting
+	session *replaySession
+}
+
+func (i *replayInterface) endpoint(num int, direction gousb.EndpointDirection) (gousb.EndpointDesc, error) {
+	for _, desc := range i.setting.Endpoints {
+		if desc.Number == num && desc.Direction == direction {
+			return desc, nil
+		}
+	}
+
+	return gousb.EndpointDesc{}, fmt.Errorf("endpoint %d %s: %w", num, direction, gousb.ErrorNotFound)
+}
+
+func (i *replayInterface) InEndpoint(num int) (usb.InEndpoint, error) {
+	desc, err := i.endpoint(num, gousb.EndpointDirectionIn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &replayInEndpoint{desc: desc, session: i.session}, nil
+}
+
+func (i *replayInterface) OutEndpoint(num int) (usb.OutEndpoint, error) {
+	desc, err := i.endpoint(num, gousb.EndpointDirectionOut)
+	if err != nil {
+		return nil, err
+	}
+
+	return &replayOutEndpoint{desc: desc, session: i.session}, nil
+}
+
+func (i *replayInterface) Close() error {
+	return nil
+}
+
+type replayInEndpoint struct {
+	desc    gousb.EndpointDesc
+	session *replaySession
+}
+
+func (e *replayInEndpoint) Descriptor() gousb.EndpointDesc {
+	return e.desc
+}
+
+func (e *replayInEndpoint) NewStream(count int) (usb.StreamReader, error) {
+	return &replayStreamReader{endpoint: uint8(e.desc.Address), session: e.session}, nil
+}
+
+func (e *replayInEndpoint) ClearHalt() error {
+	return e.session.recover(EVENT_CLEAR_HALT, uint8(e.desc.Address))
+}
+
+type replayOutEndpoint struct {
+	desc    gousb.EndpointDesc
+	session *replaySession
+}
+
+func (e *replayOutEndpoint) Descriptor() gousb.EndpointDesc {
+	return e.desc
+}
+
+func (e *replayOutEndpoint) NewStream(count int) (usb.StreamWriter, error) {
+	return &replayStreamWriter{endpoint: uint8(e.desc.Address), session: e.session}, nil
+}
+
+func (e *replayOutEndpoint) ClearHalt() error {
+	return e.session.recover(EVENT_CLEAR_HALT, uint8(e.desc.Address))
+}
+
+type replayStreamReader struct {
+	endpoint uint8
+	session  *replaySession
+}
+
+// ReadContext replays the next recorded read. If all recorded reads are replayed, it waits until ctx is done,
+// as a device that has no more data to send.
+func (s *replayStreamReader) ReadContext(ctx context.Context, data []byte) (int, error) {
+	s.session.mu.Lock()
+	queue := s.session.reads[s.endpoint]
+	event, ok := next(&queue)
+	s.session.reads[s.endpoint] = queue
+	s.session.mu.Unlock()
+
+	if !ok {
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-s.session.closed:
+			return 0, fmt.Errorf("read endpoint %#02x: %w", s.endpoint, ErrReplayEnded)
+		}
+	}
+	copy(data, event.Data)
+
+	return min(event.N, len(data)), event.Error.Err()
+}
+
+func (s *replayStreamReader) Close() error {
+	return nil
+}
+
+type replayStreamWriter struct {
+	endpoint uint8
+	session  *replaySession
+}
+
+func (s *replayStreamWriter) WriteContext(ctx context.Context, data []byte) (int, error) {
+	s.session.mu.Lock()
+	defer s.session.mu.Unlock()
+
+	queue := s.session.writes[s.endpoint]
+	event, ok := next(&queue)
+	s.session.writes[s.endpoint] = queue
+	if !ok {
+		return 0, fmt.Errorf("write endpoint %#02x: %w", s.endpoint, ErrReplayEnded)
+	}
+	if !bytes.Equal(event.Data, data) {
+		return 0, fmt.Errorf("write endpoint %#02x data %x, recorded %x: %w", s.endpoint, data, []byte(event.Data), ErrReplayMismatch)
+	}
+
+	return event.N, event.Error.Err()
+}
+
+func (s *replayStreamWriter) Close() error {
+	return nil
+}
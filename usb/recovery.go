@@ -0,0 +1,19 @@
+package usb
+
+import (
+	"github.com/google/gousb"
+)
+
+// Standard request which clears halt condition of an endpoint
+const (
+	REQUEST_TYPE_ENDPOINT_OUT = REQUEST_DIRECTION_OUT | REQUEST_TYPE_STANDARD | RECIPIENT_ENDPOINT
+	FEATURE_ENDPOINT_HALT     = 0x00
+)
+
+// ClearHalt clears halt condition of an endpoint of a device by sending CLEAR_FEATURE(ENDPOINT_HALT),
+// where address 0 is the control endpoint
+func ClearHalt(device Device, address gousb.EndpointAddress) error {
+	_, err := device.Control(REQUEST_TYPE_ENDPOINT_OUT, REQUEST_CLEAR_FEATURE, FEATURE_ENDPOINT_HALT, uint16(address), nil)
+
+	return err
+}
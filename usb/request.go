@@ -0,0 +1,31 @@
+package usb
+
+// Fields of bmRequestType of setup packets
+const (
+	REQUEST_DIRECTION_OUT = 0x00
+	REQUEST_DIRECTION_IN  = 0x80
+
+	REQUEST_TYPE_MASK     = 0x60
+	REQUEST_TYPE_STANDARD = 0x00 << 5
+	REQUEST_TYPE_CLASS    = 0x01 << 5
+	REQUEST_TYPE_VENDOR   = 0x02 << 5
+	REQUEST_TYPE_RESERVED = 0x03 << 5
+
+	RECIPIENT_MASK      = 0x1F
+	RECIPIENT_DEVICE    = 0x00
+	RECIPIENT_INTERFACE = 0x01
+	RECIPIENT_ENDPOINT  = 0x02
+	RECIPIENT_OTHER     = 0x03
+)
+
+// Standard requests of USB 2.0 specification
+const (
+	REQUEST_GET_STATUS        = 0x00
+	REQUEST_CLEAR_FEATURE     = 0x01
+	REQUEST_SET_FEATURE       = 0x03
+	REQUEST_GET_DESCRIPTOR    = 0x06
+	REQUEST_GET_CONFIGURATION = 0x08
+	REQUEST_SET_CONFIGURATION = 0x09
+	REQUEST_GET_INTERFACE     = 0x0A
+	REQUEST_SET_INTERFACE     = 0x0B
+)
@@ -0,0 +1,46 @@
+package usb
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/gousb"
+)
+
+// Status of URBs as negative Linux errno, used by usbmon captures and USB/IP
+const (
+	URB_STATUS_OK          int32 = 0
+	URB_STATUS_ENOENT      int32 = -2
+	URB_STATUS_ENODEV      int32 = -19
+	URB_STATUS_EPIPE       int32 = -32
+	URB_STATUS_EPROTO      int32 = -71
+	URB_STATUS_EOVERFLOW   int32 = -75
+	URB_STATUS_ECONNRESET  int32 = -104
+	URB_STATUS_ESHUTDOWN   int32 = -108
+	URB_STATUS_ETIMEDOUT   int32 = -110
+	URB_STATUS_EINPROGRESS int32 = -115
+)
+
+// URBStatus converts an error of a transfer into URB status. A cancelled transfer has status of an unlinked URB.
+func URBStatus(err error) int32 {
+	switch {
+	case err == nil:
+		return URB_STATUS_OK
+	case errors.Is(err, context.Canceled), errors.Is(err, gousb.TransferCancelled), errors.Is(err, gousb.ErrorInterrupted):
+		return URB_STATUS_ENOENT
+	case errors.Is(err, context.DeadlineExceeded):
+		return URB_STATUS_ETIMEDOUT
+	case errors.Is(err, gousb.ErrorOverflow), errors.Is(err, gousb.TransferOverflow):
+		return URB_STATUS_EOVERFLOW
+	}
+	switch ErrorKind(err) {
+	case ErrStall:
+		return URB_STATUS_EPIPE
+	case ErrTimeout:
+		return URB_STATUS_ETIMEDOUT
+	case ErrDisconnected:
+		return URB_STATUS_ENODEV
+	}
+
+	return URB_STATUS_EPROTO
+}
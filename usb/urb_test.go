@@ -0,0 +1,36 @@
+package usb_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/gousb"
+	"github.com/ntchjb/gohid/usb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestURBStatus(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int32
+	}{
+		{name: "OK", err: nil, status: usb.URB_STATUS_OK},
+		{name: "Canceled", err: fmt.Errorf("read: %w", context.Canceled), status: usb.URB_STATUS_ENOENT},
+		{name: "TransferCancelled", err: gousb.TransferCancelled, status: usb.URB_STATUS_ENOENT},
+		{name: "DeadlineExceeded", err: context.DeadlineExceeded, status: usb.URB_STATUS_ETIMEDOUT},
+		{name: "Timeout", err: usb.NewError(usb.OPERATION_CONTROL, 0xFF01, 0x0001, 0, gousb.ErrorTimeout), status: usb.URB_STATUS_ETIMEDOUT},
+		{name: "Stall", err: gousb.TransferStall, status: usb.URB_STATUS_EPIPE},
+		{name: "Overflow", err: gousb.TransferOverflow, status: usb.URB_STATUS_EOVERFLOW},
+		{name: "Disconnected", err: gousb.ErrorNoDevice, status: usb.URB_STATUS_ENODEV},
+		{name: "Other", err: errors.New("other error"), status: usb.URB_STATUS_EPROTO},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.status, usb.URBStatus(test.err))
+		})
+	}
+}
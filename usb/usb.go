@@ -8,7 +8,8 @@ import (
 
 // StreamReader provides ability to write data to USB device via endpoint with higher throughput
 type StreamWriter interface {
-	// Write data to USB device via endpoint OUT
+	// Write data to USB device via endpoint OUT, which is split into packets of max packet size of the endpoint.
+	// Empty data is sent as a zero-length packet.
 	WriteContext(ctx context.Context, data []byte) (int, error)
 	// Close stream writer system
 	Close() error
@@ -28,6 +29,8 @@ type OutEndpoint interface {
 	Descriptor() gousb.EndpointDesc
 	// Create stream writer to write data to OUT endpoint
 	NewStream(count int) (StreamWriter, error)
+	// Clear halt condition of the endpoint using CLEAR_FEATURE(ENDPOINT_HALT), after which transfers can be retried
+	ClearHalt() error
 }
 
 // OutEndpoint represents endpoint IN of USB device
@@ -36,6 +39,8 @@ type InEndpoint interface {
 	Descriptor() gousb.EndpointDesc
 	// Create stream reader to read data from IN endpoint
 	NewStream(count int) (StreamReader, error)
+	// Clear halt condition of the endpoint using CLEAR_FEATURE(ENDPOINT_HALT), after which transfers can be retried
+	ClearHalt() error
 }
 
 // Interface represents interface of USB device located under USB device's configuration
@@ -75,6 +80,8 @@ type Device interface {
 	Product() (string, error)
 	// Get device serial number string by getting string descriptor from USB device
 	SerialNumber() (string, error)
+	// Reset USB port of the device. Claimed configuration and interfaces are kept if the device does not re-enumerate.
+	Reset() error
 	// Close USB device connection and release resources
 	Close() error
 }
@@ -0,0 +1,236 @@
+// Package usbip provides a usb.Context of devices exported by a USB/IP server, e.g. usbipd of Linux,
+// so that HID devices attached to another host can be used over network.
+package usbip
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"time"
+
+	"github.com/google/gousb"
+	"github.com/ntchjb/gohid/usb"
+	"github.com/ntchjb/usbip-virtual-device/usbip/protocol/op"
+)
+
+var (
+	ErrOperationFailed  = errors.New("USB/IP operation failed")
+	ErrUnexpectedReply  = errors.New("unexpected USB/IP reply")
+	ErrConnectionClosed = errors.New("USB/IP connection closed")
+)
+
+const (
+	// Default port of USB/IP server
+	DEFAULT_PORT = 3240
+	// Timeout of connecting to USB/IP server
+	DIAL_TIMEOUT = 5 * time.Second
+	// Timeout of control transfers, as control requests of usb.Device have no context
+	CONTROL_TIMEOUT = 5 * time.Second
+	// Time waiting for reply of CMD_UNLINK of a cancelled transfer before giving up
+	UNLINK_TIMEOUT = time.Second
+)
+
+// Device speeds in OP_REP_DEVLIST and OP_REP_IMPORT, which are enum usb_device_speed of Linux
+const (
+	SPEED_UNKNOWN    = 0
+	SPEED_LOW        = 1
+	SPEED_FULL       = 2
+	SPEED_HIGH       = 3
+	SPEED_WIRELESS   = 4
+	SPEED_SUPER      = 5
+	SPEED_SUPER_PLUS = 6
+)
+
+type contextImpl struct {
+	address string
+	logger  *slog.Logger
+}
+
+// NewContext creates a usb.Context of devices exported by USB/IP server at address in format of host:port.
+// Devices are listed by OP_REQ_DEVLIST, and each opened device is imported over its own connection.
+func NewContext(address string, logger *slog.Logger) usb.Context {
+	return &contextImpl{
+		address: address,
+		logger:  logger,
+	}
+}
+
+// fullReader reads whole buffers, as decoders of USB/IP messages fail on partial reads of TCP stream
+type fullReader struct {
+	reader io.Reader
+}
+
+func (r fullReader) Read(buf []byte) (int, error) {
+	return io.ReadFull(r.reader, buf)
+}
+
+type encoder interface {
+	Encode(writer io.Writer) error
+}
+
+// encode encodes a message from its parts, so that it can be sent by a single write
+func encode(parts ...encoder) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, part := range parts {
+		if err := part.Encode(&buf); err != nil {
+			return nil, err
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+// request sends an operation request over a new connection, and reads header of its reply
+func (c *contextImpl) request(code, replyCode op.Operation, req ...encoder) (net.Conn, io.Reader, error) {
+	conn, err := net.DialTimeout("tcp", c.address, DIAL_TIMEOUT)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to connect to USB/IP server %s: %w", c.address, err)
+	}
+	reader := fullReader{reader: conn}
+
+	buf, err := encode(append([]encoder{&op.OpHeader{Version: op.VERSION, CommandOrReplyCode: code}}, req...)...)
+	if err == nil {
+		_, err = conn.Write(buf)
+	}
+	if err != nil {
+		conn.Close()
+		return nil, nil, fmt.Errorf("unable to send operation %04x: %w", code, err)
+	}
+
+	var header op.OpHeader
+	if err := header.Decode(reader); err != nil {
+		conn.Close()
+		return nil, nil, fmt.Errorf("unable to read reply of operation %04x: %w", code, err)
+	}
+	if header.CommandOrReplyCode != replyCode {
+		conn.Close()
+		return nil, nil, fmt.Errorf("reply %04x of operation %04x: %w", header.CommandOrReplyCode, code, ErrUnexpectedReply)
+	}
+	if header.Status != op.OP_STATUS_OK {
+		conn.Close()
+		return nil, nil, fmt.Errorf("operation %04x, status %d: %w", code, header.Status, ErrOperationFailed)
+	}
+
+	return conn, reader, nil
+}
+
+// listDevices gets exported devices by OP_REQ_DEVLIST
+func (c *contextImpl) listDevices() ([]op.DeviceInfo, error) {
+	conn, reader, err := c.request(op.OP_REQ_DEVLIST, op.OP_REP_DEVLIST)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	var reply op.OpRepDevList
+	if err := reply.Decode(reader); err != nil {
+		return nil, fmt.Errorf("unable to decode device list: %w", err)
+	}
+
+	return reply.Devices, nil
+}
+
+func (c *contextImpl) IterateDevices(reader func(desc *gousb.DeviceDesc)) error {
+	devices, err := c.listDevices()
+	if err != nil {
+		return err
+	}
+	for _, info := range devices {
+		reader(deviceDesc(info))
+	}
+
+	return nil
+}
+
+func (c *contextImpl) OpenDevice(vid, pid gousb.ID) (usb.Device, error) {
+	devices, err := c.listDevices()
+	if err != nil {
+		return nil, err
+	}
+	for _, info := range devices {
+		if gousb.ID(info.IDVendor) == vid && gousb.ID(info.IDProduct) == pid {
+			return c.importDevice(info.BusID)
+		}
+	}
+
+	return nil, fmt.Errorf("device %s:%s: %w", vid, pid, gousb.ErrorNotFound)
+}
+
+// importDevice attaches an exported device by OP_REQ_IMPORT, then reads its descriptors
+func (c *contextImpl) importDevice(busID [32]byte) (usb.Device, error) {
+	conn, reader, err := c.request(op.OP_REQ_IMPORT, op.OP_REP_IMPORT, &op.OpReqImport{BusID: busID})
+	if err != nil {
+		return nil, err
+	}
+
+	var reply op.OpRepImport
+	if err := reply.Decode(reader); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("unable to decode import reply: %w", err)
+	}
+
+	logger := c.logger.With("busID", string(bytes.TrimRight(busID[:], "\x00")))
+	device := newDevice(conn, reader, reply.DeviceInfo, logger)
+	if err := device.readDescriptors(); err != nil {
+		device.Close()
+		return nil, err
+	}
+
+	return device, nil
+}
+
+func (c *contextImpl) Close() error {
+	return nil
+}
+
+// deviceSpeed converts device speed of USB/IP into gousb.Speed
+func deviceSpeed(speed uint32) gousb.Speed {
+	switch speed {
+	case SPEED_LOW:
+		return gousb.SpeedLow
+	case SPEED_FULL:
+		return gousb.SpeedFull
+	case SPEED_HIGH, SPEED_WIRELESS:
+		return gousb.SpeedHigh
+	case SPEED_SUPER, SPEED_SUPER_PLUS:
+		return gousb.SpeedSuper
+	}
+
+	return gousb.SpeedUnknown
+}
+
+// deviceDesc creates device descriptor from device information of device list, which has the active configuration
+// with interface classes, but without endpoints
+func deviceDesc(info op.DeviceInfo) *gousb.DeviceDesc {
+	config := gousb.ConfigDesc{
+		Number: int(info.BConfigurationValue),
+	}
+	for number, intf := range info.Interfaces {
+		config.Interfaces = append(config.Interfaces, gousb.InterfaceDesc{
+			Number: number,
+			AltSettings: []gousb.InterfaceSetting{{
+				Number:    number,
+				Class:     gousb.Class(intf.BInterfaceClass),
+				SubClass:  gousb.Class(intf.BInterfaceSubclass),
+				Protocol:  gousb.Protocol(intf.BInterfaceProtocol),
+				Endpoints: make(map[gousb.EndpointAddress]gousb.EndpointDesc),
+			}},
+		})
+	}
+
+	return &gousb.DeviceDesc{
+		Bus:      int(info.BusNum),
+		Address:  int(info.DevNum),
+		Speed:    deviceSpeed(info.Speed),
+		Class:    gousb.Class(info.BDeviceClass),
+		SubClass: gousb.Class(info.BDeviceSubclass),
+		Protocol: gousb.Protocol(info.BDeviceProtocol),
+		Vendor:   gousb.ID(info.IDVendor),
+		Product:  gousb.ID(info.IDProduct),
+		Device:   gousb.BCD(info.BCDDevice),
+		Configs:  map[int]gousb.ConfigDesc{config.Number: config},
+	}
+}
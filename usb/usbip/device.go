@@ -0,0 +1,440 @@
+package usbip
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unicode/utf16"
+
+	"github.com/google/gousb"
+	"github.com/ntchjb/gohid/usb"
+	"github.com/ntchjb/usbip-virtual-device/usbip/protocol/command"
+	"github.com/ntchjb/usbip-virtual-device/usbip/protocol/op"
+)
+
+const (
+	// URB_DIR_IN of transfer flags, which Linux USB/IP server requires for IN transfers
+	TRANSFER_FLAG_DIR_IN = 0x0200
+	// SET_FEATURE(PORT_RESET) request to the hub port, which USB/IP hosts perform by resetting the device
+	// instead of passing it to the device
+	PORT_RESET_REQUEST_TYPE = usb.REQUEST_DIRECTION_OUT | usb.REQUEST_TYPE_CLASS | usb.RECIPIENT_OTHER
+	PORT_RESET_REQUEST      = usb.REQUEST_SET_FEATURE
+	PORT_RESET_FEATURE      = 0x04
+)
+
+// urb is a submitted transfer waiting for its reply
+type urb struct {
+	// Direction is needed to decode RET_SUBMIT, because Linux server sends it as zero
+	direction command.Direction
+	done      chan command.RetSubmit
+	// Closed when the transfer is unlinked
+	unlinked chan struct{}
+}
+
+// deviceImpl is an imported device, where transfers are sent as URBs over connection of the import
+type deviceImpl struct {
+	conn   net.Conn
+	reader io.Reader
+	devID  uint32
+	info   op.DeviceInfoTruncated
+	logger *slog.Logger
+
+	desc *gousb.DeviceDesc
+	// String descriptor indices of manufacturer, product and serial number
+	strings [3]uint8
+
+	seqNum  atomic.Uint32
+	writeMu sync.Mutex
+
+	mu sync.Mutex
+	// Submitted URBs by sequence number
+	pending map[uint32]*urb
+	// Sequence numbers of unlinked URBs by sequence number of CMD_UNLINK
+	unlinks map[uint32]uint32
+	closed  chan struct{}
+	err     error
+}
+
+func newDevice(conn net.Conn, reader io.Reader, info op.DeviceInfoTruncated, logger *slog.Logger) *deviceImpl {
+	d := &deviceImpl{
+		conn:    conn,
+		reader:  reader,
+		devID:   info.BusNum<<16 | info.DevNum,
+		info:    info,
+		logger:  logger,
+		pending: make(map[uint32]*urb),
+		unlinks: make(map[uint32]uint32),
+		closed:  make(chan struct{}),
+	}
+	go d.receive()
+
+	return d
+}
+
+// shutdown closes connection of the device, and fails all pending transfers with given reason
+func (d *deviceImpl) shutdown(err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.err != nil {
+		return
+	}
+	d.err = fmt.Errorf("%w: %w", ErrConnectionClosed, err)
+	close(d.closed)
+	d.conn.Close()
+}
+
+func (d *deviceImpl) closeError() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.err
+}
+
+// receive reads replies of URBs until connection is closed
+func (d *deviceImpl) receive() {
+	for {
+		if err := d.receiveReply(); err != nil {
+			if !errors.Is(err, io.EOF) && !errors.Is(err, net.ErrClosed) {
+				d.logger.Error("unable to receive USB/IP reply", "err", err)
+			}
+			d.shutdown(err)
+			return
+		}
+	}
+}
+
+func (d *deviceImpl) receiveReply() error {
+	var header command.CmdHeader
+	if err := header.Decode(d.reader); err != nil {
+		return err
+	}
+
+	switch header.Command {
+	case command.RET_SUBMIT:
+		d.mu.Lock()
+		u, ok := d.pending[header.SeqNum]
+		delete(d.pending, header.SeqNum)
+		d.mu.Unlock()
+		if !ok {
+			// Length of transfer buffer depends on direction of the URB, so rest of the stream cannot be decoded
+			return fmt.Errorf("RET_SUBMIT of unknown URB %d: %w", header.SeqNum, ErrUnexpectedReply)
+		}
+
+		ret := command.RetSubmit{CmdHeader: header}
+		ret.Direction = u.direction
+		if err := ret.Decode(d.reader); err != nil {
+			return err
+		}
+		u.done <- ret
+	case command.RET_UNLINK:
+		ret := command.RetUnlink{CmdHeader: header}
+		if err := ret.Decode(d.reader); err != nil {
+			return err
+		}
+
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		seqNum := d.unlinks[header.SeqNum]
+		delete(d.unlinks, header.SeqNum)
+		// URB is unlinked before completion, or it is completed and its RET_SUBMIT has been received
+		if u, ok := d.pending[seqNum]; ok {
+			delete(d.pending, seqNum)
+			close(u.unlinked)
+		}
+	default:
+		return fmt.Errorf("command %d: %w", header.Command, ErrUnexpectedReply)
+	}
+
+	return nil
+}
+
+// send sends a command by a single write, so that commands of concurrent transfers are not interleaved
+func (d *deviceImpl) send(parts ...encoder) error {
+	buf, err := encode(parts...)
+	if err != nil {
+		return err
+	}
+
+	d.writeMu.Lock()
+	defer d.writeMu.Unlock()
+	if _, err := d.conn.Write(buf); err != nil {
+		d.shutdown(err)
+		return d.closeError()
+	}
+
+	return nil
+}
+
+// submit sends a transfer as CMD_SUBMIT and waits for its completion. The transfer is unlinked when ctx is done,
+// then a transfer completed before unlinked is still returned, as data of a completed IN transfer would be lost.
+func (d *deviceImpl) submit(ctx context.Context, cmd command.CmdSubmit) (command.RetSubmit, error) {
+	cmd.Command = command.CMD_SUBMIT
+	cmd.SeqNum = d.seqNum.Add(1)
+	cmd.DevID = d.devID
+	if cmd.Direction == command.DIR_IN {
+		cmd.TransferFlags |= TRANSFER_FLAG_DIR_IN
+	}
+	u := &urb{
+		direction: cmd.Direction,
+		done:      make(chan command.RetSubmit, 1),
+		unlinked:  make(chan struct{}),
+	}
+
+	d.mu.Lock()
+	if d.err != nil {
+		d.mu.Unlock()
+		return command.RetSubmit{}, d.err
+	}
+	d.pending[cmd.SeqNum] = u
+	d.mu.Unlock()
+
+	if err := d.send(&cmd.CmdHeader, &cmd); err != nil {
+		return command.RetSubmit{}, err
+	}
+
+	select {
+	case ret := <-u.done:
+		return ret, nil
+	case <-d.closed:
+		return command.RetSubmit{}, d.closeError()
+	case <-ctx.Done():
+	}
+
+	if err := d.unlink(cmd.SeqNum); err != nil {
+		return command.RetSubmit{}, err
+	}
+	timer := time.NewTimer(UNLINK_TIMEOUT)
+	defer timer.Stop()
+	select {
+	case ret := <-u.done:
+		return ret, nil
+	case <-u.unlinked:
+	case <-d.closed:
+	case <-timer.C:
+		d.logger.Warn("no reply of unlinking URB", "seqNum", cmd.SeqNum)
+	}
+
+	return command.RetSubmit{}, ctx.Err()
+}
+
+// unlink sends CMD_UNLINK to cancel a submitted URB
+func (d *deviceImpl) unlink(seqNum uint32) error {
+	cmd := command.CmdUnlink{
+		CmdHeader: command.CmdHeader{
+			Command: command.CMD_UNLINK,
+			SeqNum:  d.seqNum.Add(1),
+			DevID:   d.devID,
+		},
+		UnlinkSeqNum: seqNum,
+	}
+
+	d.mu.Lock()
+	d.unlinks[cmd.SeqNum] = seqNum
+	d.mu.Unlock()
+
+	return d.send(&cmd.CmdHeader, &cmd)
+}
+
+// statusError converts failed URB status into an error of control transfer
+func statusError(status int32) error {
+	switch status {
+	case usb.URB_STATUS_EPIPE:
+		return gousb.ErrorPipe
+	case usb.URB_STATUS_ENODEV, usb.URB_STATUS_ESHUTDOWN:
+		return gousb.ErrorNoDevice
+	case usb.URB_STATUS_ETIMEDOUT:
+		return gousb.ErrorTimeout
+	case usb.URB_STATUS_EOVERFLOW:
+		return gousb.ErrorOverflow
+	case usb.URB_STATUS_ENOENT, usb.URB_STATUS_ECONNRESET:
+		return gousb.ErrorInterrupted
+	}
+
+	return gousb.ErrorIO
+}
+
+// transferStatus converts failed URB status into status of interrupt transfer
+func transferStatus(status int32) gousb.TransferStatus {
+	switch status {
+	case usb.URB_STATUS_EPIPE:
+		return gousb.TransferStall
+	case usb.URB_STATUS_ENODEV, usb.URB_STATUS_ESHUTDOWN:
+		return gousb.TransferNoDevice
+	case usb.URB_STATUS_ETIMEDOUT:
+		return gousb.TransferTimedOut
+	case usb.URB_STATUS_EOVERFLOW:
+		return gousb.TransferOverflow
+	case usb.URB_STATUS_ENOENT, usb.URB_STATUS_ECONNRESET:
+		return gousb.TransferCancelled
+	}
+
+	return gousb.TransferError
+}
+
+func (d *deviceImpl) SetAutoDetach(autoDetach bool) error {
+	return nil
+}
+
+// Reset resets the device on the host, by SET_FEATURE(PORT_RESET) request
+func (d *deviceImpl) Reset() error {
+	_, err := d.Control(PORT_RESET_REQUEST_TYPE, PORT_RESET_REQUEST, PORT_RESET_FEATURE, 0, nil)
+
+	return err
+}
+
+func (d *deviceImpl) Config(configNumber int) (usb.Config, error) {
+	desc, ok := d.desc.Configs[configNumber]
+	if !ok {
+		return nil, fmt.Errorf("config %d: %w", configNumber, gousb.ErrorNotFound)
+	}
+
+	return &usbConfig{desc: desc, device: d}, nil
+}
+
+func (d *deviceImpl) Descriptor() *gousb.DeviceDesc {
+	return d.desc
+}
+
+// Control sends a control transfer to endpoint 0, which fails with gousb.ErrorTimeout after CONTROL_TIMEOUT
+func (d *deviceImpl) Control(bmRequestType, bRequest uint8, wValue, wIndex uint16, data []byte) (int, error) {
+	cmd := command.CmdSubmit{
+		TransferBufferLength: uint32(len(data)),
+	}
+	cmd.Setup[0] = bmRequestType
+	cmd.Setup[1] = bRequest
+	binary.LittleEndian.PutUint16(cmd.Setup[2:4], wValue)
+	binary.LittleEndian.PutUint16(cmd.Setup[4:6], wIndex)
+	binary.LittleEndian.PutUint16(cmd.Setup[6:8], uint16(len(data)))
+	if bmRequestType&usb.REQUEST_DIRECTION_IN != 0 {
+		cmd.Direction = command.DIR_IN
+	} else {
+		cmd.Direction = command.DIR_OUT
+		cmd.TransferBuffer = data
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), CONTROL_TIMEOUT)
+	defer cancel()
+	ret, err := d.submit(ctx, cmd)
+	if errors.Is(err, context.DeadlineExceeded) {
+		return 0, gousb.ErrorTimeout
+	}
+	if errors.Is(err, ErrConnectionClosed) {
+		return 0, fmt.Errorf("%w: %w", gousb.ErrorNoDevice, err)
+	}
+	if err != nil {
+		return 0, err
+	}
+	if ret.Status != 0 {
+		return 0, statusError(int32(ret.Status))
+	}
+	if cmd.Direction == command.DIR_IN {
+		return copy(data, ret.TransferBuffer), nil
+	}
+
+	return int(ret.ActualLength), nil
+}
+
+// getDescriptor gets a standard descriptor of the device by GET_DESCRIPTOR request
+func (d *deviceImpl) getDescriptor(descType, index uint8, langID uint16, length int) ([]byte, error) {
+	buf := make([]byte, length)
+	requestType := uint8(usb.REQUEST_DIRECTION_IN | usb.REQUEST_TYPE_STANDARD | usb.RECIPIENT_DEVICE)
+	n, err := d.Control(requestType, usb.REQUEST_GET_DESCRIPTOR, uint16(descType)<<8|uint16(index), langID, buf)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf[:n], nil
+}
+
+// readDescriptors gets device and configuration descriptors, as USB/IP server only provides summary of them
+func (d *deviceImpl) readDescriptors() error {
+	buf, err := d.getDescriptor(usb.DESCRIPTOR_TYPE_DEVICE, 0, 0, usb.DEVICE_DESCRIPTOR_LENGTH)
+	if err != nil {
+		return fmt.Errorf("unable to get device descriptor: %w", err)
+	}
+	desc, strings, err := usb.DecodeDeviceDescriptor(buf)
+	if err != nil {
+		return err
+	}
+	desc.Bus = int(d.info.BusNum)
+	desc.Address = int(d.info.DevNum)
+	desc.Speed = deviceSpeed(d.info.Speed)
+
+	for index := 0; index < int(d.info.BNumConfigurations); index++ {
+		header, err := d.getDescriptor(usb.DESCRIPTOR_TYPE_CONFIG, uint8(index), 0, usb.CONFIG_DESCRIPTOR_LENGTH)
+		if err != nil {
+			return fmt.Errorf("unable to get config descriptor %d: %w", index, err)
+		}
+		if len(header) < usb.CONFIG_DESCRIPTOR_LENGTH {
+			return fmt.Errorf("config descriptor %d of %d bytes: %w", index, len(header), usb.ErrInvalidDescriptor)
+		}
+		buf, err := d.getDescriptor(usb.DESCRIPTOR_TYPE_CONFIG, uint8(index), 0, int(binary.LittleEndian.Uint16(header[2:4])))
+		if err != nil {
+			return fmt.Errorf("unable to get config descriptor %d: %w", index, err)
+		}
+		config, err := usb.DecodeConfigDescriptor(buf, desc.Speed)
+		if err != nil {
+			return fmt.Errorf("unable to decode config descriptor %d: %w", index, err)
+		}
+		desc.Configs[config.Number] = config
+	}
+
+	d.desc = desc
+	d.strings = strings
+
+	return nil
+}
+
+func (d *deviceImpl) GetStringDescriptor(index int) (string, error) {
+	buf, err := d.getDescriptor(usb.DESCRIPTOR_TYPE_STRING, uint8(index), usb.STRING_LANGUAGE_ID, 0xFF)
+	if err != nil {
+		return "", err
+	}
+	if len(buf) < 2 || buf[1] != usb.DESCRIPTOR_TYPE_STRING {
+		return "", fmt.Errorf("string descriptor %d: %w", index, usb.ErrInvalidDescriptor)
+	}
+	buf = buf[2:min(len(buf), int(buf[0]))]
+
+	codes := make([]uint16, len(buf)/2)
+	for i := range codes {
+		codes[i] = binary.LittleEndian.Uint16(buf[i*2:])
+	}
+
+	return string(utf16.Decode(codes)), nil
+}
+
+// deviceString gets manufacturer, product or serial number string, which is empty if the device does not have it
+func (d *deviceImpl) deviceString(index uint8) (string, error) {
+	if index == 0 {
+		return "", nil
+	}
+
+	return d.GetStringDescriptor(int(index))
+}
+
+func (d *deviceImpl) Manufacturer() (string, error) {
+	return d.deviceString(d.strings[0])
+}
+
+func (d *deviceImpl) Product() (string, error) {
+	return d.deviceString(d.strings[1])
+}
+
+func (d *deviceImpl) SerialNumber() (string, error) {
+	return d.deviceString(d.strings[2])
+}
+
+// Close closes connection of the device, which detaches the device from this host
+func (d *deviceImpl) Close() error {
+	d.shutdown(net.ErrClosed)
+
+	return nil
+}
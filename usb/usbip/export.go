@@ -0,0 +1,424 @@
+package usbip
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/google/gousb"
+	"github.com/ntchjb/gohid/hid"
+	"github.com/ntchjb/gohid/usb"
+	virtualusb "github.com/ntchjb/usbip-virtual-device/usb"
+	usbprotocol "github.com/ntchjb/usbip-virtual-device/usb/protocol"
+	"github.com/ntchjb/usbip-virtual-device/usbip/protocol/command"
+	"github.com/ntchjb/usbip-virtual-device/usbip/protocol/op"
+)
+
+// exportedDevice serves target interface of a HID device to USB/IP server, by translating URBs into calls of hid.Device.
+// Standard requests are answered from descriptors read at export, and HID idle rate and protocol are kept locally,
+// as they cannot be sent via hid.Device.
+type exportedDevice struct {
+	device hid.Device
+	logger *slog.Logger
+	info   op.DeviceInfo
+
+	deviceDescriptor []byte
+	configDescriptor []byte
+	hidDescriptor    []byte
+	reportDescriptor []byte
+	strings          map[uint8]string
+	usesReportIDs    bool
+	inEndpoint       int
+	outEndpoint      int
+
+	// Cancels reads waiting for Input reports when server is closed
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu       sync.Mutex
+	idle     uint8
+	protocol uint8
+}
+
+func newExportedDevice(device hid.Device, logger *slog.Logger) (*exportedDevice, error) {
+	deviceInfo := device.GetDeviceInfo()
+	if deviceInfo.DeviceDesc == nil {
+		return nil, fmt.Errorf("device target is not set: %w", hid.ErrUninitializedDevice)
+	}
+	desc := deviceInfo.DeviceDesc
+
+	reportDescriptor, err := device.GetReportDescriptor()
+	if err != nil {
+		return nil, err
+	}
+	hidDescriptor, err := device.GetHIDDescriptor()
+	if err != nil {
+		return nil, err
+	}
+	// Length of report descriptor is changed if it is patched by quirks
+	hidDescriptor.WDescriptorLength = uint16(len(reportDescriptor))
+	var hidBuf bytes.Buffer
+	if err := hidDescriptor.Encode(&hidBuf); err != nil {
+		return nil, fmt.Errorf("unable to encode HID descriptor: %w", err)
+	}
+
+	e := &exportedDevice{
+		device:           device,
+		logger:           logger.With("device", fmt.Sprintf("%s:%s", desc.Vendor, desc.Product)),
+		hidDescriptor:    hidBuf.Bytes(),
+		reportDescriptor: reportDescriptor,
+		strings:          make(map[uint8]string),
+		protocol:         hid.PROTOCOL_REPORT,
+	}
+	e.ctx, e.cancel = context.WithCancel(context.Background())
+
+	if schema, err := hid.ParseReportDescriptor(reportDescriptor); err != nil {
+		e.logger.Warn("unable to parse report descriptor, Output reports are assumed to have no report ID", "err", err)
+	} else {
+		e.usesReportIDs = schema.UsesReportIDs()
+	}
+
+	e.deviceDescriptor = usb.EncodeDeviceDescriptor(desc)
+	// Only the configuration of the target is exported
+	e.deviceDescriptor[usb.DEVICE_DESCRIPTOR_NUM_CONFIGURATIONS_OFFSET] = 1
+	for _, str := range []struct {
+		index  uint8
+		offset int
+		get    func() (string, error)
+	}{
+		{usb.STRING_INDEX_MANUFACTURER, usb.DEVICE_DESCRIPTOR_MANUFACTURER_OFFSET, device.GetManufacturer},
+		{usb.STRING_INDEX_PRODUCT, usb.DEVICE_DESCRIPTOR_PRODUCT_OFFSET, device.GetProduct},
+		{usb.STRING_INDEX_SERIAL_NUMBER, usb.DEVICE_DESCRIPTOR_SERIAL_NUMBER_OFFSET, device.GetSerialNumber},
+	} {
+		if value, err := str.get(); err == nil && value != "" {
+			e.strings[str.index] = value
+			e.deviceDescriptor[str.offset] = str.index
+		}
+	}
+
+	config, setting, err := targetSetting(deviceInfo)
+	if err != nil {
+		return nil, err
+	}
+	for _, endpoint := range setting.Endpoints {
+		if endpoint.TransferType != gousb.TransferTypeInterrupt {
+			continue
+		}
+		if endpoint.Direction == gousb.EndpointDirectionIn {
+			e.inEndpoint = endpoint.Number
+		} else {
+			e.outEndpoint = endpoint.Number
+		}
+	}
+	e.configDescriptor = usb.EncodeConfigDescriptor(desc, config, map[int][]byte{0: e.hidDescriptor})
+
+	e.info = op.DeviceInfo{
+		DeviceInfoTruncated: op.DeviceInfoTruncated{
+			Speed:               usbipSpeed(desc.Speed),
+			IDVendor:            uint16(desc.Vendor),
+			IDProduct:           uint16(desc.Product),
+			BCDDevice:           uint16(desc.Device),
+			BDeviceClass:        uint8(desc.Class),
+			BDeviceSubclass:     uint8(desc.SubClass),
+			BDeviceProtocol:     uint8(desc.Protocol),
+			BConfigurationValue: uint8(config.Number),
+			BNumConfigurations:  1,
+			BNumInterfaces:      1,
+		},
+		Interfaces: []op.DeviceInterface{{
+			BInterfaceClass:    uint8(setting.Class),
+			BInterfaceSubclass: uint8(setting.SubClass),
+			BInterfaceProtocol: uint8(setting.Protocol),
+		}},
+	}
+
+	return e, nil
+}
+
+// targetSetting gets configuration of device target, having only the target interface setting as interface 0
+func targetSetting(deviceInfo hid.DeviceInfo) (gousb.ConfigDesc, gousb.InterfaceSetting, error) {
+	config := deviceInfo.DeviceDesc.Configs[deviceInfo.GetConfigNumber()]
+	for _, intf := range config.Interfaces {
+		if intf.Number != deviceInfo.GetInterfaceNumber() {
+			continue
+		}
+		for _, setting := range intf.AltSettings {
+			if setting.Alternate != deviceInfo.GetAltSettingNumber() {
+				continue
+			}
+			setting.Number = 0
+			setting.Alternate = 0
+			config.Interfaces = []gousb.InterfaceDesc{{Number: 0, AltSettings: []gousb.InterfaceSetting{setting}}}
+			return config, setting, nil
+		}
+	}
+
+	return gousb.ConfigDesc{}, gousb.InterfaceSetting{}, fmt.Errorf("target interface of device: %w", hid.ErrDeviceProfileNotFound)
+}
+
+// usbipSpeed converts gousb.Speed into device speed of USB/IP
+func usbipSpeed(speed gousb.Speed) uint32 {
+	switch speed {
+	case gousb.SpeedLow:
+		return SPEED_LOW
+	case gousb.SpeedHigh:
+		return SPEED_HIGH
+	case gousb.SpeedSuper:
+		return SPEED_SUPER
+	}
+
+	return SPEED_FULL
+}
+
+// stop cancels reads waiting for Input reports
+func (e *exportedDevice) stop() {
+	e.cancel()
+}
+
+func (e *exportedDevice) SetBusID(busNum, devNum uint) {
+	e.info.BusNum = uint32(busNum)
+	e.info.DevNum = uint32(devNum)
+	busID := fmt.Sprintf("%d-%d", busNum, devNum)
+	copy(e.info.BusID[:], busID)
+	copy(e.info.Path[:], "/gohid/"+busID)
+}
+
+func (e *exportedDevice) GetBusID() usbprotocol.BusID {
+	return e.info.BusID
+}
+
+func (e *exportedDevice) GetDeviceInfo() op.DeviceInfo {
+	return e.info
+}
+
+func (e *exportedDevice) GetWorkerPoolProfile() virtualusb.WorkerPoolProfile {
+	// A read waiting for Input report occupies a worker, so control requests need others.
+	// Single reply worker keeps replies from being interleaved on the connection.
+	return virtualusb.WorkerPoolProfile{
+		MaximumProcWorkers:        4,
+		MaximumReplyWorkers:       1,
+		MaximumUnlinkReplyWorkers: 1,
+	}
+}
+
+func (e *exportedDevice) Process(cmd command.CmdSubmit) command.RetSubmit {
+	ret := command.RetSubmit{
+		CmdHeader: command.CmdHeader{
+			Command: command.RET_SUBMIT,
+			SeqNum:  cmd.SeqNum,
+		},
+	}
+
+	var data []byte
+	var n int
+	var err error
+	switch {
+	case cmd.EndpointNumber == 0:
+		data, err = e.control(cmd.Setup, cmd.TransferBuffer)
+		n = len(data)
+		if cmd.Direction == command.DIR_OUT {
+			n, data = len(cmd.TransferBuffer), nil
+		}
+	case cmd.Direction == command.DIR_IN && int(cmd.EndpointNumber) == e.inEndpoint:
+		data = make([]byte, cmd.TransferBufferLength)
+		n, err = e.device.ReadInput(e.ctx, data)
+		data = data[:n]
+	case cmd.Direction == command.DIR_OUT && int(cmd.EndpointNumber) == e.outEndpoint:
+		err = e.writeOutput(cmd.TransferBuffer)
+		n = len(cmd.TransferBuffer)
+	default:
+		err = gousb.TransferStall
+	}
+
+	if err != nil {
+		e.logger.Debug("URB failed", "seqNum", cmd.SeqNum, "endpoint", cmd.EndpointNumber, "err", err)
+		status := usb.URBStatus(err)
+		ret.Status = uint32(status)
+
+		return ret
+	}
+	ret.ActualLength = uint32(n)
+	ret.TransferBuffer = data
+
+	return ret
+}
+
+// writeOutput writes an Output report from interrupt OUT transfer, which has report ID byte only if the device uses report IDs
+func (e *exportedDevice) writeOutput(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+	if !e.usesReportIDs {
+		data = append([]byte{0}, data...)
+	}
+	_, err := e.device.WriteOutput(e.ctx, data)
+
+	return err
+}
+
+// control handles a control transfer, and returns data of IN transfer
+func (e *exportedDevice) control(setup [8]byte, data []byte) ([]byte, error) {
+	bmRequestType := setup[0]
+	request := setup[1]
+	wValue := binary.LittleEndian.Uint16(setup[2:4])
+	wLength := int(binary.LittleEndian.Uint16(setup[6:8]))
+	isIn := bmRequestType&usb.REQUEST_DIRECTION_IN != 0
+	requestType := bmRequestType & usb.REQUEST_TYPE_MASK
+	recipient := bmRequestType & usb.RECIPIENT_MASK
+
+	var res []byte
+	var err error
+	switch {
+	case requestType == usb.REQUEST_TYPE_CLASS && recipient == usb.RECIPIENT_INTERFACE:
+		res, err = e.hidRequest(isIn, hid.SetupRequest(request), wValue, wLength, data)
+	case requestType == usb.REQUEST_TYPE_STANDARD:
+		res, err = e.standardRequest(isIn, recipient, request, wValue)
+	case bmRequestType == PORT_RESET_REQUEST_TYPE && request == PORT_RESET_REQUEST && wValue == PORT_RESET_FEATURE:
+		// Exported devices keep their state on port reset, as the target is kept by hid.Device
+	default:
+		err = gousb.ErrorPipe
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return res[:min(len(res), wLength)], nil
+}
+
+func (e *exportedDevice) standardRequest(isIn bool, recipient, request uint8, wValue uint16) ([]byte, error) {
+	switch {
+	case isIn && request == usb.REQUEST_GET_DESCRIPTOR && recipient == usb.RECIPIENT_DEVICE:
+		return e.getDescriptor(wValue)
+	case isIn && request == usb.REQUEST_GET_DESCRIPTOR && recipient == usb.RECIPIENT_INTERFACE:
+		switch hid.ClassDescriptorType(wValue >> 8) {
+		case hid.DESCRIPTOR_TYPE_HID:
+			return e.hidDescriptor, nil
+		case hid.DESCRIPTOR_TYPE_REPORT:
+			return e.reportDescriptor, nil
+		}
+	case isIn && request == usb.REQUEST_GET_STATUS:
+		return []byte{0, 0}, nil
+	case isIn && request == usb.REQUEST_GET_CONFIGURATION:
+		return []byte{e.info.BConfigurationValue}, nil
+	case isIn && request == usb.REQUEST_GET_INTERFACE:
+		return []byte{0}, nil
+	case !isIn && (request == usb.REQUEST_SET_CONFIGURATION || request == usb.REQUEST_SET_INTERFACE ||
+		request == usb.REQUEST_CLEAR_FEATURE || request == usb.REQUEST_SET_FEATURE):
+		// Configuration and interface are selected by the target, and halt conditions are cleared by the device itself
+		return nil, nil
+	}
+
+	return nil, gousb.ErrorPipe
+}
+
+func (e *exportedDevice) getDescriptor(wValue uint16) ([]byte, error) {
+	index := uint8(wValue)
+	switch uint8(wValue >> 8) {
+	case usb.DESCRIPTOR_TYPE_DEVICE:
+		return e.deviceDescriptor, nil
+	case usb.DESCRIPTOR_TYPE_CONFIG:
+		if index == 0 {
+			return e.configDescriptor, nil
+		}
+	case usb.DESCRIPTOR_TYPE_STRING:
+		if index == 0 {
+			return binary.LittleEndian.AppendUint16([]byte{4, usb.DESCRIPTOR_TYPE_STRING}, usb.STRING_LANGUAGE_ID), nil
+		}
+		if str, ok := e.strings[index]; ok {
+			return usb.EncodeStringDescriptor(str), nil
+		}
+		str, err := e.device.GetStringDescriptor(int(index))
+		if err != nil {
+			return nil, err
+		}
+		return usb.EncodeStringDescriptor(str), nil
+	}
+
+	return nil, gousb.ErrorPipe
+}
+
+// hidRequest handles HID class requests, where reports are passed to hid.Device with report ID as the first byte
+func (e *exportedDevice) hidRequest(isIn bool, request hid.SetupRequest, wValue uint16, wLength int, data []byte) ([]byte, error) {
+	reportType := hid.ReportType(wValue >> 8)
+	reportID := uint8(wValue)
+
+	switch request {
+	case hid.SETUP_REQUEST_HID_GET_REPORT:
+		if !isIn || wLength == 0 {
+			break
+		}
+		var get func([]byte) (int, error)
+		switch reportType {
+		case hid.REPORT_TYPE_INPUT:
+			get = e.device.GetInputReport
+		case hid.REPORT_TYPE_FEATURE:
+			get = e.device.GetFeatureReport
+		default:
+			return nil, gousb.ErrorPipe
+		}
+		if reportID == 0 {
+			// hid.Device skips report ID zero, which is not sent by the device
+			buf := make([]byte, wLength+1)
+			n, err := get(buf)
+			if err != nil {
+				return nil, err
+			}
+			return buf[1:max(n, 1)], nil
+		}
+		buf := make([]byte, wLength)
+		buf[0] = reportID
+		n, err := get(buf)
+		if err != nil {
+			return nil, err
+		}
+		return buf[:n], nil
+	case hid.SETUP_REQUEST_HID_SET_REPORT:
+		if isIn {
+			break
+		}
+		var set func([]byte) (int, error)
+		switch reportType {
+		case hid.REPORT_TYPE_OUTPUT:
+			set = e.device.SendOutputReport
+		case hid.REPORT_TYPE_FEATURE:
+			set = e.device.SendFeatureReport
+		default:
+			return nil, gousb.ErrorPipe
+		}
+		if len(data) == 0 {
+			return nil, nil
+		}
+		if reportID == 0 {
+			data = append([]byte{0}, data...)
+		}
+		_, err := set(data)
+		return nil, err
+	case hid.SETUP_REQUEST_HID_GET_IDLE, hid.SETUP_REQUEST_HID_GET_PROTOCOL:
+		if !isIn {
+			break
+		}
+		e.mu.Lock()
+		defer e.mu.Unlock()
+		if request == hid.SETUP_REQUEST_HID_GET_IDLE {
+			return []byte{e.idle}, nil
+		}
+		return []byte{e.protocol}, nil
+	case hid.SETUP_REQUEST_HID_SET_IDLE, hid.SETUP_REQUEST_HID_SET_PROTOCOL:
+		if isIn {
+			break
+		}
+		e.mu.Lock()
+		defer e.mu.Unlock()
+		if request == hid.SETUP_REQUEST_HID_SET_IDLE {
+			e.idle = uint8(wValue >> 8)
+		} else {
+			e.protocol = uint8(wValue)
+		}
+		return nil, nil
+	}
+
+	return nil, gousb.ErrorPipe
+}
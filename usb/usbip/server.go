@@ -0,0 +1,141 @@
+package usbip
+
+import (
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/ntchjb/gohid/hid"
+	virtualusb "github.com/ntchjb/usbip-virtual-device/usb"
+	usbprotocol "github.com/ntchjb/usbip-virtual-device/usb/protocol"
+	virtualusbip "github.com/ntchjb/usbip-virtual-device/usbip"
+)
+
+const (
+	// Default bus number of exported devices
+	DEFAULT_BUS_NUM = 1
+	// Default maximum number of exported devices
+	DEFAULT_MAX_DEVICE_COUNT = 16
+	// Default maximum number of concurrent connections, including imported devices and device list requests
+	DEFAULT_MAX_CONNECTIONS = 16
+)
+
+// ServerConfig configures USB/IP server of exported devices
+type ServerConfig struct {
+	// Address to listen, in format of host:port, e.g. ":3240"
+	ListenAddress string
+	// Bus number of exported devices, or DEFAULT_BUS_NUM if it is zero
+	BusNum uint
+	// Maximum number of exported devices, or DEFAULT_MAX_DEVICE_COUNT if it is zero
+	MaxDeviceCount int
+	// Maximum number of concurrent connections, or DEFAULT_MAX_CONNECTIONS if it is zero
+	MaxConnections uint
+}
+
+// Server exports HID devices opened by this library over USB/IP, so that other hosts can attach them
+// by `usbip attach`, or by usb.Context of this package
+type Server interface {
+	// Export an opened HID device whose target has been set. Only the target interface is exported,
+	// as interface 0 of a single-interface device. The device is still owned and closed by the caller.
+	Export(device hid.Device) error
+	// Start listening for connections
+	Open() error
+	// Stop pending Input report reads of exported devices, and close the server
+	Close() error
+}
+
+// registrar is a device registrar which can be used while the server is serving
+type registrar struct {
+	registrar virtualusb.DeviceRegistrar
+	mu        sync.RWMutex
+}
+
+func (r *registrar) Register(device virtualusb.Device) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.registrar.Register(device)
+}
+
+func (r *registrar) GetDevice(busID usbprotocol.BusID) (virtualusb.Device, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.registrar.GetDevice(busID)
+}
+
+func (r *registrar) GetAvailableDevices() []virtualusb.Device {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.registrar.GetAvailableDevices()
+}
+
+type serverImpl struct {
+	server    virtualusbip.USBIPServer
+	registrar *registrar
+	logger    *slog.Logger
+
+	mu      sync.Mutex
+	devices []*exportedDevice
+}
+
+func NewServer(config ServerConfig, logger *slog.Logger) Server {
+	if config.BusNum == 0 {
+		config.BusNum = DEFAULT_BUS_NUM
+	}
+	if config.MaxDeviceCount == 0 {
+		config.MaxDeviceCount = DEFAULT_MAX_DEVICE_COUNT
+	}
+	if config.MaxConnections == 0 {
+		config.MaxConnections = DEFAULT_MAX_CONNECTIONS
+	}
+
+	reg := &registrar{
+		registrar: virtualusb.NewDeviceRegistrar(virtualusb.DeviceRegistrarConfig{
+			BusNum:         config.BusNum,
+			MaxDeviceCount: config.MaxDeviceCount,
+		}),
+	}
+
+	return &serverImpl{
+		server: virtualusbip.NewUSBIPServer(virtualusbip.USBIPServerConfig{
+			ListenAddress:    config.ListenAddress,
+			MaxTCPConnection: config.MaxConnections,
+		}, reg, logger),
+		registrar: reg,
+		logger:    logger,
+	}
+}
+
+func (s *serverImpl) Export(device hid.Device) error {
+	exported, err := newExportedDevice(device, s.logger)
+	if err != nil {
+		return err
+	}
+	if err := s.registrar.Register(exported); err != nil {
+		exported.stop()
+		return fmt.Errorf("unable to register device: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.devices = append(s.devices, exported)
+
+	return nil
+}
+
+func (s *serverImpl) Open() error {
+	return s.server.Open()
+}
+
+func (s *serverImpl) Close() error {
+	// Server waits for URBs being processed, including reads waiting for Input reports
+	s.mu.Lock()
+	for _, device := range s.devices {
+		device.stop()
+	}
+	s.mu.Unlock()
+
+	return s.server.Close()
+}
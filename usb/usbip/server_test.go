@@ -0,0 +1,251 @@
+package usbip_test
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/gousb"
+	"github.com/ntchjb/gohid/hid"
+	"github.com/ntchjb/gohid/manager"
+	"github.com/ntchjb/gohid/usb/emulator"
+	"github.com/ntchjb/gohid/usb/usbip"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// Vendor-defined device with Input report 1, Output report 2 and Feature report 3
+var reportIDDescriptor = []byte{
+	0x06, 0x00, 0xFF, 0x09, 0x01, 0xA1, 0x01,
+	0x15, 0x00, 0x26, 0xFF, 0x00, 0x75, 0x08, 0x95, 0x02,
+	0x85, 0x01, 0x09, 0x01, 0x81, 0x02,
+	0x85, 0x02, 0x09, 0x02, 0x91, 0x02,
+	0x85, 0x03, 0x09, 0x03, 0xB1, 0x02,
+	0xC0,
+}
+
+// Composite device where HID interface is the second interface
+var compositeDeviceDesc = &gousb.DeviceDesc{
+	Bus:                  1,
+	Address:              2,
+	Speed:                gousb.SpeedFull,
+	Spec:                 0x0200,
+	Device:               0x0100,
+	Vendor:               0xFF01,
+	Product:              0x0002,
+	MaxControlPacketSize: 64,
+	Configs: map[int]gousb.ConfigDesc{
+		1: {
+			Number:   1,
+			MaxPower: 100,
+			Interfaces: []gousb.InterfaceDesc{
+				{
+					Number: 0,
+					AltSettings: []gousb.InterfaceSetting{
+						{Alternate: 0, Class: gousb.ClassVendorSpec},
+					},
+				},
+				{
+					Number: 1,
+					AltSettings: []gousb.InterfaceSetting{
+						{
+							Number:    1,
+							Alternate: 0,
+							Class:     gousb.ClassHID,
+							Endpoints: map[gousb.EndpointAddress]gousb.EndpointDesc{
+								0x82: {
+									Address:       0x82,
+									Number:        2,
+									Direction:     gousb.EndpointDirectionIn,
+									MaxPacketSize: 64,
+									TransferType:  gousb.TransferTypeInterrupt,
+									UsageType:     gousb.InterruptUsageTypePeriodic,
+									PollInterval:  10 * time.Millisecond,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+// freeAddress gets an address of localhost which is not listened
+func freeAddress(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	return address
+}
+
+// exportEmulated plugs an emulated device, then exports its HID interface on USB/IP server of localhost,
+// and returns address of the server
+func exportEmulated(t *testing.T, config emulator.DeviceConfig, intf int) (emulator.Device, string) {
+	emulatorCtx := emulator.NewContext()
+	device, err := emulatorCtx.Connect(config)
+	require.NoError(t, err)
+	local, err := manager.NewDeviceManager(emulatorCtx, slog.Default()).Open(config.Desc.Vendor, config.Desc.Product, hid.DeviceConfig{
+		StreamLaneCount: hid.DEFAULT_ENDPOINT_STREAM_COUNT,
+	})
+	require.NoError(t, err)
+	require.NoError(t, local.SetTarget(1, intf, 0))
+
+	address := freeAddress(t)
+	server := usbip.NewServer(usbip.ServerConfig{ListenAddress: address}, slog.Default())
+	require.NoError(t, server.Export(local))
+	require.NoError(t, server.Open())
+	t.Cleanup(func() {
+		server.Close()
+		local.Close()
+	})
+
+	return device, address
+}
+
+func TestServer_Control(t *testing.T) {
+	_, address := exportEmulated(t, emulator.DeviceConfig{
+		Desc:         deviceDesc,
+		Manufacturer: "gohid",
+		Interfaces: map[int]emulator.InterfaceConfig{
+			0: {ReportDescriptor: reportDescriptor},
+		},
+	}, 0)
+	usbDevice, err := usbip.NewContext(address, slog.Default()).OpenDevice(0xFF01, 0x0001)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		usbDevice.Close()
+	})
+
+	// Cases are run in order, as some requests change state of the exported device
+	tests := []struct {
+		name          string
+		bmRequestType uint8
+		bRequest      uint8
+		wValue        uint16
+		length        int
+		expected      []byte
+		err           error
+	}{
+		{name: "language ID", bmRequestType: 0x80, bRequest: 0x06, wValue: 0x0300, length: 255, expected: []byte{0x04, 0x03, 0x09, 0x04}},
+		{name: "truncated device descriptor", bmRequestType: 0x80, bRequest: 0x06, wValue: 0x0100, length: 8, expected: []byte{0x12, 0x01, 0x00, 0x02, 0x00, 0x00, 0x00, 0x40}},
+		{name: "unknown config descriptor", bmRequestType: 0x80, bRequest: 0x06, wValue: 0x0201, length: 9, err: gousb.ErrorPipe},
+		{name: "get status", bmRequestType: 0x80, bRequest: 0x00, length: 2, expected: []byte{0x00, 0x00}},
+		{name: "get configuration", bmRequestType: 0x80, bRequest: 0x08, length: 1, expected: []byte{0x01}},
+		{name: "set configuration", bmRequestType: 0x00, bRequest: 0x09, wValue: 1, expected: []byte{}},
+		{name: "get protocol", bmRequestType: 0xA1, bRequest: 0x03, length: 1, expected: []byte{0x01}},
+		{name: "set idle", bmRequestType: 0x21, bRequest: 0x0A, wValue: 0x0400, expected: []byte{}},
+		{name: "get idle", bmRequestType: 0xA1, bRequest: 0x02, length: 1, expected: []byte{0x04}},
+		{name: "unsupported report", bmRequestType: 0xA1, bRequest: 0x01, wValue: 0x0300, length: 2, err: gousb.ErrorPipe},
+		{name: "vendor request", bmRequestType: 0xC0, bRequest: 0x01, length: 1, err: gousb.ErrorPipe},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := make([]byte, test.length)
+			n, err := usbDevice.Control(test.bmRequestType, test.bRequest, test.wValue, 0, data)
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, data[:n])
+		})
+	}
+}
+
+func TestServer_ReportIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	handler := emulator.NewMockHandler(ctrl)
+	device, address := exportEmulated(t, emulator.DeviceConfig{
+		Desc: deviceDesc,
+		Interfaces: map[int]emulator.InterfaceConfig{
+			0: {ReportDescriptor: reportIDDescriptor, Handler: handler},
+		},
+	}, 0)
+	hidDevice, err := manager.NewDeviceManager(usbip.NewContext(address, slog.Default()), slog.Default()).Open(0xFF01, 0x0001, hid.DeviceConfig{
+		StreamLaneCount: hid.DEFAULT_ENDPOINT_STREAM_COUNT,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		hidDevice.Close()
+	})
+	require.NoError(t, hidDevice.SetTarget(1, 0, 0))
+
+	// Report ID is kept as the first byte of reports in both sides
+	handler.EXPECT().GetReport(hid.REPORT_TYPE_FEATURE, uint8(3), gomock.Any()).DoAndReturn(
+		func(reportType hid.ReportType, reportID uint8, data []byte) (int, error) {
+			return copy(data, []byte{0x03, 0x12, 0x34}), nil
+		},
+	)
+	handler.EXPECT().SetReport(hid.REPORT_TYPE_FEATURE, uint8(3), []byte{0x03, 0x56, 0x78}).Return(nil)
+	handler.EXPECT().Output([]byte{0x02, 0x9A, 0xBC}).Return(nil)
+
+	data := []byte{0x03, 0x00, 0x00}
+	n, err := hidDevice.GetFeatureReport(data)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x03, 0x12, 0x34}, data[:n])
+	_, err = hidDevice.SendFeatureReport([]byte{0x03, 0x56, 0x78})
+	assert.NoError(t, err)
+	_, err = hidDevice.WriteOutput(context.Background(), []byte{0x02, 0x9A, 0xBC})
+	assert.NoError(t, err)
+
+	require.NoError(t, device.SendInput(context.Background(), 0, []byte{0x01, 0xDE, 0xF0}))
+	data = make([]byte, 64)
+	n, err = hidDevice.ReadInput(context.Background(), data)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x01, 0xDE, 0xF0}, data[:n])
+}
+
+func TestServer_Export(t *testing.T) {
+	device, address := exportEmulated(t, emulator.DeviceConfig{
+		Desc: compositeDeviceDesc,
+		Interfaces: map[int]emulator.InterfaceConfig{
+			1: {ReportDescriptor: reportDescriptor},
+		},
+	}, 1)
+	hidDevice, err := manager.NewDeviceManager(usbip.NewContext(address, slog.Default()), slog.Default()).Open(0xFF01, 0x0002, hid.DeviceConfig{
+		StreamLaneCount: hid.DEFAULT_ENDPOINT_STREAM_COUNT,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		hidDevice.Close()
+	})
+
+	require.NoError(t, hidDevice.SetTarget(1, 0, 0))
+
+	// Only the target interface is exported, as interface 0
+	config := hidDevice.GetDeviceInfo().DeviceDesc.Configs[1]
+	require.Len(t, config.Interfaces, 1)
+	assert.Equal(t, 0, config.Interfaces[0].Number)
+	assert.Equal(t, gousb.ClassHID, config.Interfaces[0].AltSettings[0].Class)
+	assert.Contains(t, config.Interfaces[0].AltSettings[0].Endpoints, gousb.EndpointAddress(0x82))
+
+	require.NoError(t, device.SendInput(context.Background(), 1, []byte{0x01, 0x02}))
+	data := make([]byte, 64)
+	n, err := hidDevice.ReadInput(context.Background(), data)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x01, 0x02}, data[:n])
+}
+
+func TestServer_ExportUninitializedDevice(t *testing.T) {
+	emulatorCtx := emulator.NewContext()
+	_, err := emulatorCtx.Connect(emulator.DeviceConfig{
+		Desc: deviceDesc,
+		Interfaces: map[int]emulator.InterfaceConfig{
+			0: {ReportDescriptor: reportDescriptor},
+		},
+	})
+	require.NoError(t, err)
+	local, err := manager.NewDeviceManager(emulatorCtx, slog.Default()).Open(0xFF01, 0x0001, hid.DeviceConfig{})
+	require.NoError(t, err)
+	defer local.Close()
+
+	server := usbip.NewServer(usbip.ServerConfig{ListenAddress: freeAddress(t)}, slog.Default())
+	assert.ErrorIs(t, server.Export(local), hid.ErrUninitializedDevice)
+}
@@ -0,0 +1,188 @@
+package usbip
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/gousb"
+	"github.com/ntchjb/gohid/usb"
+	"github.com/ntchjb/usbip-virtual-device/usbip/protocol/command"
+)
+
+type usbConfig struct {
+	desc   gousb.ConfigDesc
+	device *deviceImpl
+}
+
+func (c *usbConfig) Interface(num, alt int) (usb.Interface, error) {
+	for _, intf := range c.desc.Interfaces {
+		if intf.Number != num {
+			continue
+		}
+		for _, setting := range intf.AltSettings {
+			if setting.Alternate == alt {
+				return &usbInterface{setting: setting, device: c.device}, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("interface %d alt %d: %w", num, alt, gousb.ErrorNotFound)
+}
+
+func (c *usbConfig) Close() error {
+	return nil
+}
+
+type usbInterface struct {
+	setting gousb.InterfaceSetting
+	device  *deviceImpl
+}
+
+func (i *usbInterface) endpoint(num int, direction gousb.EndpointDirection) (gousb.EndpointDesc, error) {
+	for _, desc := range i.setting.Endpoints {
+		if desc.Number == num && desc.Direction == direction {
+			return desc, nil
+		}
+	}
+
+	return gousb.EndpointDesc{}, fmt.Errorf("endpoint %d %s: %w", num, direction, gousb.ErrorNotFound)
+}
+
+func (i *usbInterface) InEndpoint(num int) (usb.InEndpoint, error) {
+	desc, err := i.endpoint(num, gousb.EndpointDirectionIn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &inEndpoint{desc: desc, device: i.device}, nil
+}
+
+func (i *usbInterface) OutEndpoint(num int) (usb.OutEndpoint, error) {
+	desc, err := i.endpoint(num, gousb.EndpointDirectionOut)
+	if err != nil {
+		return nil, err
+	}
+
+	return &outEndpoint{desc: desc, device: i.device}, nil
+}
+
+func (i *usbInterface) Close() error {
+	return nil
+}
+
+type inEndpoint struct {
+	desc   gousb.EndpointDesc
+	device *deviceImpl
+}
+
+func (e *inEndpoint) Descriptor() gousb.EndpointDesc {
+	return e.desc
+}
+
+// NewStream creates stream of the endpoint, where each read is submitted as an URB when it is called
+func (e *inEndpoint) NewStream(count int) (usb.StreamReader, error) {
+	return &streamReader{desc: e.desc, device: e.device}, nil
+}
+
+func (e *inEndpoint) ClearHalt() error {
+	return usb.ClearHalt(e.device, e.desc.Address)
+}
+
+type outEndpoint struct {
+	desc   gousb.EndpointDesc
+	device *deviceImpl
+}
+
+func (e *outEndpoint) Descriptor() gousb.EndpointDesc {
+	return e.desc
+}
+
+// NewStream creates stream of the endpoint, where each write is submitted as an URB when it is called
+func (e *outEndpoint) NewStream(count int) (usb.StreamWriter, error) {
+	return &streamWriter{desc: e.desc, device: e.device}, nil
+}
+
+func (e *outEndpoint) ClearHalt() error {
+	return usb.ClearHalt(e.device, e.desc.Address)
+}
+
+// urbInterval converts poll interval of an endpoint into interval of URB, which is in frames,
+// or in microframes for high speed devices
+func urbInterval(interval time.Duration, speed gousb.Speed) uint32 {
+	if speed >= gousb.SpeedHigh {
+		return uint32(interval / (125 * time.Microsecond))
+	}
+
+	return uint32(interval / time.Millisecond)
+}
+
+// transfer submits an interrupt or bulk transfer to an endpoint
+func transfer(ctx context.Context, device *deviceImpl, desc gousb.EndpointDesc, cmd command.CmdSubmit) (command.RetSubmit, error) {
+	cmd.EndpointNumber = uint32(desc.Number)
+	cmd.Interval = urbInterval(desc.PollInterval, device.desc.Speed)
+
+	ret, err := device.submit(ctx, cmd)
+	if errors.Is(err, ErrConnectionClosed) {
+		return ret, fmt.Errorf("%w: %w", gousb.TransferNoDevice, err)
+	}
+	if err != nil {
+		return ret, err
+	}
+	if ret.Status != 0 {
+		return ret, transferStatus(int32(ret.Status))
+	}
+
+	return ret, nil
+}
+
+type streamReader struct {
+	desc   gousb.EndpointDesc
+	device *deviceImpl
+}
+
+// ReadContext reads from the endpoint. The URB is unlinked when ctx is done, which fails with context error.
+func (s *streamReader) ReadContext(ctx context.Context, data []byte) (int, error) {
+	ret, err := transfer(ctx, s.device, s.desc, command.CmdSubmit{
+		CmdHeader:            command.CmdHeader{Direction: command.DIR_IN},
+		TransferBufferLength: uint32(len(data)),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	n := copy(data, ret.TransferBuffer)
+	if n < len(ret.TransferBuffer) {
+		return n, gousb.TransferOverflow
+	}
+
+	return n, nil
+}
+
+func (s *streamReader) Close() error {
+	return nil
+}
+
+type streamWriter struct {
+	desc   gousb.EndpointDesc
+	device *deviceImpl
+}
+
+// WriteContext writes to the endpoint. The URB is unlinked when ctx is done, which fails with context error.
+func (s *streamWriter) WriteContext(ctx context.Context, data []byte) (int, error) {
+	ret, err := transfer(ctx, s.device, s.desc, command.CmdSubmit{
+		CmdHeader:            command.CmdHeader{Direction: command.DIR_OUT},
+		TransferBufferLength: uint32(len(data)),
+		TransferBuffer:       data,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(ret.ActualLength), nil
+}
+
+func (s *streamWriter) Close() error {
+	return nil
+}
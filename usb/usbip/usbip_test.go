@@ -0,0 +1,362 @@
+package usbip_test
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/gousb"
+	"github.com/ntchjb/gohid/hid"
+	"github.com/ntchjb/gohid/manager"
+	"github.com/ntchjb/gohid/usb"
+	"github.com/ntchjb/gohid/usb/emulator"
+	"github.com/ntchjb/gohid/usb/usbip"
+	virtualusb "github.com/ntchjb/usbip-virtual-device/usb"
+	usbprotocol "github.com/ntchjb/usbip-virtual-device/usb/protocol"
+	virtualusbip "github.com/ntchjb/usbip-virtual-device/usbip"
+	"github.com/ntchjb/usbip-virtual-device/usbip/protocol/command"
+	"github.com/ntchjb/usbip-virtual-device/usbip/protocol/op"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var deviceDesc = &gousb.DeviceDesc{
+	Bus:                  1,
+	Address:              1,
+	Speed:                gousb.SpeedFull,
+	Spec:                 0x0200,
+	Device:               0x0100,
+	Vendor:               0xFF01,
+	Product:              0x0001,
+	MaxControlPacketSize: 64,
+	Configs: map[int]gousb.ConfigDesc{
+		1: {
+			Number:   1,
+			MaxPower: 100,
+			Interfaces: []gousb.InterfaceDesc{
+				{
+					Number: 0,
+					AltSettings: []gousb.InterfaceSetting{
+						{
+							Alternate: 0,
+							Class:     gousb.ClassHID,
+							Endpoints: map[gousb.EndpointAddress]gousb.EndpointDesc{
+								0x81: {
+									Address:       0x81,
+									Number:        1,
+									Direction:     gousb.EndpointDirectionIn,
+									MaxPacketSize: 64,
+									TransferType:  gousb.TransferTypeInterrupt,
+									UsageType:     gousb.InterruptUsageTypePeriodic,
+									PollInterval:  10 * time.Millisecond,
+								},
+								0x01: {
+									Address:       0x01,
+									Number:        1,
+									Direction:     gousb.EndpointDirectionOut,
+									MaxPacketSize: 64,
+									TransferType:  gousb.TransferTypeInterrupt,
+									UsageType:     gousb.InterruptUsageTypePeriodic,
+									PollInterval:  10 * time.Millisecond,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+// Vendor-defined device with 2-byte Input, Output and Feature reports
+var reportDescriptor = []byte{
+	0x06, 0x00, 0xFF, 0x09, 0x01, 0xA1, 0x01,
+	0x15, 0x00, 0x26, 0xFF, 0x00, 0x75, 0x08, 0x95, 0x02,
+	0x09, 0x01, 0x81, 0x02,
+	0x09, 0x02, 0x91, 0x02,
+	0x09, 0x03, 0xB1, 0x02,
+	0xC0,
+}
+
+// Time an exported device waits for an Input report, before the URB is completed with timeout
+const exportedReadTimeout = 200 * time.Millisecond
+
+// exportedDevice serves an emulated device on USB/IP server of usbip-virtual-device, by forwarding URBs to it
+type exportedDevice struct {
+	device usb.Device
+	reader usb.StreamReader
+	writer usb.StreamWriter
+	info   op.DeviceInfo
+}
+
+func newExportedDevice(t *testing.T, device usb.Device) *exportedDevice {
+	config, err := device.Config(1)
+	require.NoError(t, err)
+	intf, err := config.Interface(0, 0)
+	require.NoError(t, err)
+	in, err := intf.InEndpoint(1)
+	require.NoError(t, err)
+	reader, err := in.NewStream(1)
+	require.NoError(t, err)
+	out, err := intf.OutEndpoint(1)
+	require.NoError(t, err)
+	writer, err := out.NewStream(1)
+	require.NoError(t, err)
+
+	desc := device.Descriptor()
+	return &exportedDevice{
+		device: device,
+		reader: reader,
+		writer: writer,
+		info: op.DeviceInfo{
+			DeviceInfoTruncated: op.DeviceInfoTruncated{
+				Speed:               usbip.SPEED_FULL,
+				IDVendor:            uint16(desc.Vendor),
+				IDProduct:           uint16(desc.Product),
+				BCDDevice:           uint16(desc.Device),
+				BConfigurationValue: 1,
+				BNumConfigurations:  1,
+				BNumInterfaces:      1,
+			},
+			Interfaces: []op.DeviceInterface{{BInterfaceClass: uint8(gousb.ClassHID)}},
+		},
+	}
+}
+
+func (e *exportedDevice) SetBusID(busNum, devNum uint) {
+	e.info.BusNum = uint32(busNum)
+	e.info.DevNum = uint32(devNum)
+	copy(e.info.BusID[:], fmt.Sprintf("%d-%d", busNum, devNum))
+}
+
+func (e *exportedDevice) GetBusID() usbprotocol.BusID {
+	return e.info.BusID
+}
+
+func (e *exportedDevice) GetDeviceInfo() op.DeviceInfo {
+	return e.info
+}
+
+func (e *exportedDevice) GetWorkerPoolProfile() virtualusb.WorkerPoolProfile {
+	return virtualusb.WorkerPoolProfile{MaximumProcWorkers: 4, MaximumReplyWorkers: 1, MaximumUnlinkReplyWorkers: 1}
+}
+
+func (e *exportedDevice) Process(cmd command.CmdSubmit) command.RetSubmit {
+	// Direction of reply is zero, as Linux server does
+	ret := command.RetSubmit{CmdHeader: command.CmdHeader{Command: command.RET_SUBMIT, SeqNum: cmd.SeqNum}}
+	data := cmd.TransferBuffer
+	if cmd.Direction == command.DIR_IN {
+		data = make([]byte, cmd.TransferBufferLength)
+	}
+
+	var n int
+	var err error
+	switch {
+	case cmd.EndpointNumber == 0:
+		n, err = e.device.Control(cmd.Setup[0], cmd.Setup[1],
+			binary.LittleEndian.Uint16(cmd.Setup[2:4]), binary.LittleEndian.Uint16(cmd.Setup[4:6]), data)
+	case cmd.Direction == command.DIR_IN:
+		ctx, cancel := context.WithTimeout(context.Background(), exportedReadTimeout)
+		n, err = e.reader.ReadContext(ctx, data)
+		cancel()
+	default:
+		n, err = e.writer.WriteContext(context.Background(), data)
+	}
+
+	var status int32
+	switch {
+	case err == nil:
+	case errors.Is(err, gousb.ErrorPipe), errors.Is(err, gousb.TransferStall):
+		status = usb.URB_STATUS_EPIPE
+	case errors.Is(err, gousb.ErrorTimeout), errors.Is(err, context.DeadlineExceeded):
+		status = usb.URB_STATUS_ETIMEDOUT
+	default:
+		status = usb.URB_STATUS_ENODEV
+	}
+	ret.Status = uint32(status)
+	if err == nil {
+		ret.ActualLength = uint32(n)
+		if cmd.Direction == command.DIR_IN {
+			ret.TransferBuffer = data[:n]
+		}
+	}
+
+	return ret
+}
+
+// startServer exports a device on USB/IP server listening on a free port of localhost, and returns address of the server
+func startServer(t *testing.T, device virtualusb.Device) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	registrar := virtualusb.NewDeviceRegistrar(virtualusb.DeviceRegistrarConfig{BusNum: 1, MaxDeviceCount: 1})
+	require.NoError(t, registrar.Register(device))
+	server := virtualusbip.NewUSBIPServer(virtualusbip.USBIPServerConfig{
+		ListenAddress:    address,
+		MaxTCPConnection: 8,
+	}, registrar, slog.Default())
+	require.NoError(t, server.Open())
+	t.Cleanup(func() {
+		server.Close()
+	})
+
+	return address
+}
+
+// connect exports an emulated device, and opens its HID interface via device manager of USB/IP context
+func connect(t *testing.T, handler emulator.Handler) (emulator.Device, manager.DeviceManager, hid.Device) {
+	emulatorCtx := emulator.NewContext()
+	device, err := emulatorCtx.Connect(emulator.DeviceConfig{
+		Desc:         deviceDesc,
+		Manufacturer: "gohid",
+		Product:      "Emulated device",
+		Interfaces: map[int]emulator.InterfaceConfig{
+			0: {ReportDescriptor: reportDescriptor, Handler: handler},
+		},
+	})
+	require.NoError(t, err)
+	emulated, err := emulatorCtx.OpenDevice(0xFF01, 0x0001)
+	require.NoError(t, err)
+	address := startServer(t, newExportedDevice(t, emulated))
+
+	man := manager.NewDeviceManager(usbip.NewContext(address, slog.Default()), slog.Default())
+	hidDevice, err := man.Open(0xFF01, 0x0001, hid.DeviceConfig{StreamLaneCount: hid.DEFAULT_ENDPOINT_STREAM_COUNT})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		hidDevice.Close()
+	})
+	require.NoError(t, hidDevice.SetAutoDetach(true))
+	require.NoError(t, hidDevice.SetTarget(1, 0, 0))
+
+	return device, man, hidDevice
+}
+
+func TestContext_Enumerate(t *testing.T) {
+	_, man, _ := connect(t, nil)
+
+	deviceInfos, err := man.Enumerate(0, 0)
+	require.NoError(t, err)
+	require.Len(t, deviceInfos, 1)
+	desc := deviceInfos[0].DeviceDesc
+	assert.Equal(t, 1, desc.Bus)
+	assert.Equal(t, 1, desc.Address)
+	assert.Equal(t, gousb.SpeedFull, desc.Speed)
+	assert.Equal(t, gousb.ID(0xFF01), desc.Vendor)
+	assert.Equal(t, gousb.ID(0x0001), desc.Product)
+	assert.Equal(t, gousb.BCD(0x0100), desc.Device)
+	require.Contains(t, desc.Configs, 1)
+	assert.Equal(t, gousb.ClassHID, desc.Configs[1].Interfaces[0].AltSettings[0].Class)
+
+	deviceInfos, err = man.Enumerate(0xFF01, 0x0002)
+	require.NoError(t, err)
+	assert.Empty(t, deviceInfos)
+}
+
+func TestContext_Reports(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	handler := emulator.NewMockHandler(ctrl)
+	device, _, hidDevice := connect(t, handler)
+
+	// Full descriptors are read from the imported device
+	assert.Equal(t, deviceDesc, hidDevice.GetDeviceInfo().DeviceDesc)
+	desc, err := hidDevice.GetReportDescriptor()
+	require.NoError(t, err)
+	assert.Equal(t, reportDescriptor, []byte(desc))
+	str, err := hidDevice.GetManufacturer()
+	assert.NoError(t, err)
+	assert.Equal(t, "gohid", str)
+	str, err = hidDevice.GetProduct()
+	assert.NoError(t, err)
+	assert.Equal(t, "Emulated device", str)
+	str, err = hidDevice.GetSerialNumber()
+	assert.NoError(t, err)
+	assert.Empty(t, str)
+
+	handler.EXPECT().GetReport(hid.REPORT_TYPE_FEATURE, uint8(0), gomock.Len(2)).DoAndReturn(
+		func(reportType hid.ReportType, reportID uint8, data []byte) (int, error) {
+			return copy(data, []byte{0x12, 0x34}), nil
+		},
+	)
+	handler.EXPECT().SetReport(hid.REPORT_TYPE_FEATURE, uint8(0), []byte{0x56, 0x78}).Return(nil)
+	handler.EXPECT().Output([]byte{0x9A, 0xBC}).Return(nil)
+
+	data := []byte{0x00, 0x00, 0x00}
+	n, err := hidDevice.GetFeatureReport(data)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x00, 0x12, 0x34}, data[:n])
+	n, err = hidDevice.SendFeatureReport([]byte{0x00, 0x56, 0x78})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+	n, err = hidDevice.WriteOutput(context.Background(), []byte{0x00, 0x9A, 0xBC})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	require.NoError(t, device.SendInput(context.Background(), 0, []byte{0x01, 0x02}))
+	data = make([]byte, 64)
+	n, err = hidDevice.ReadInput(context.Background(), data)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x01, 0x02}, data[:n])
+
+	// Pending read is unlinked when no Input report is sent, then the connection is still usable
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = hidDevice.ReadInput(ctx, data)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	str, err = hidDevice.GetManufacturer()
+	assert.NoError(t, err)
+	assert.Equal(t, "gohid", str)
+}
+
+func TestContext_Errors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	handler := emulator.NewMockHandler(ctrl)
+	device, man, hidDevice := connect(t, handler)
+
+	handler.EXPECT().GetReport(hid.REPORT_TYPE_FEATURE, uint8(0), gomock.Any()).Return(0, errors.New("unsupported"))
+	handler.EXPECT().GetReport(hid.REPORT_TYPE_INPUT, uint8(0), gomock.Any()).Return(0, gousb.ErrorTimeout)
+	handler.EXPECT().Output(gomock.Any()).Return(errors.New("busy"))
+
+	_, err := hidDevice.GetFeatureReport([]byte{0x00, 0x00, 0x00})
+	assert.ErrorIs(t, err, gousb.ErrorPipe)
+	_, err = hidDevice.GetInputReport([]byte{0x00, 0x00, 0x00})
+	assert.ErrorIs(t, err, gousb.ErrorTimeout)
+	_, err = hidDevice.WriteOutput(context.Background(), []byte{0x00, 0x01, 0x02})
+	assert.ErrorIs(t, err, gousb.TransferStall)
+
+	device.SetHalt(0x81, true)
+	_, err = hidDevice.ReadInput(context.Background(), make([]byte, 64))
+	assert.ErrorIs(t, err, gousb.TransferStall)
+	device.SetHalt(0x81, false)
+
+	_, err = man.Open(0xFF01, 0x0002, hid.DeviceConfig{})
+	assert.ErrorIs(t, err, gousb.ErrorNotFound)
+
+	// Transfers fail with no device error after the connection is closed
+	require.NoError(t, hidDevice.Close())
+	_, err = hidDevice.GetFeatureReport([]byte{0x00, 0x00, 0x00})
+	assert.ErrorIs(t, err, gousb.ErrorNoDevice)
+	assert.ErrorIs(t, err, usbip.ErrConnectionClosed)
+	_, err = hidDevice.ReadInput(context.Background(), make([]byte, 64))
+	assert.ErrorIs(t, err, gousb.TransferNoDevice)
+}
+
+func TestContext_ServerUnavailable(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	usbCtx := usbip.NewContext(address, slog.Default())
+	err = usbCtx.IterateDevices(func(desc *gousb.DeviceDesc) {})
+	assert.Error(t, err)
+	_, err = usbCtx.OpenDevice(0xFF01, 0x0001)
+	assert.Error(t, err)
+}
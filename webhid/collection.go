@@ -0,0 +1,154 @@
+package webhid
+
+import (
+	"github.com/ntchjb/gohid/hid"
+)
+
+// Unit systems of the lowest nibble of Unit item, named as HIDUnitSystem of WebHID
+var unitSystems = map[uint32]string{
+	0x0: "none",
+	0x1: "si-linear",
+	0x2: "si-rotation",
+	0x3: "english-linear",
+	0x4: "english-rotation",
+	0xF: "vendor-defined",
+}
+
+const UNIT_SYSTEM_RESERVED = "reserved"
+
+// unitExponent returns signed exponent of n-th nibble of Unit item
+func unitExponent(unit uint32, n int) int8 {
+	nibble := int8((unit >> (4 * n)) & 0x0F)
+	if nibble >= 8 {
+		nibble -= 16
+	}
+
+	return nibble
+}
+
+func newReportItem(field *hid.ReportField) ReportItem {
+	item := ReportItem{
+		IsAbsolute:        !field.IsRelative(),
+		IsArray:           field.IsArray(),
+		IsBufferedBytes:   field.Flags&hid.REPORT_FLAG_BUFFERED_BYTES != 0,
+		IsConstant:        field.IsConstant(),
+		IsLinear:          field.Flags&hid.REPORT_FLAG_NON_LINEAR == 0,
+		IsRange:           field.HasUsageRange,
+		IsVolatile:        field.Flags&hid.REPORT_FLAG_VOLATILE != 0,
+		HasNull:           field.IsNullState(),
+		HasPreferredState: field.Flags&hid.REPORT_FLAG_NO_PREFERRED == 0,
+		Wrap:              field.Flags&hid.REPORT_FLAG_WRAP != 0,
+
+		Usages:       []uint32{},
+		UsageMinimum: uint32(field.UsageMinimum),
+		UsageMaximum: uint32(field.UsageMaximum),
+		ReportSize:   field.ReportSize,
+		ReportCount:  field.ReportCount,
+
+		UnitExponent:                        field.UnitExponent,
+		UnitFactorLengthExponent:            unitExponent(field.Unit, 1),
+		UnitFactorMassExponent:              unitExponent(field.Unit, 2),
+		UnitFactorTimeExponent:              unitExponent(field.Unit, 3),
+		UnitFactorTemperatureExponent:       unitExponent(field.Unit, 4),
+		UnitFactorCurrentExponent:           unitExponent(field.Unit, 5),
+		UnitFactorLuminousIntensityExponent: unitExponent(field.Unit, 6),
+
+		LogicalMinimum:  field.LogicalMinimum,
+		LogicalMaximum:  field.LogicalMaximum,
+		PhysicalMinimum: field.PhysicalMinimum,
+		PhysicalMaximum: field.PhysicalMaximum,
+
+		Strings: []string{},
+	}
+	for _, usage := range field.Usages {
+		item.Usages = append(item.Usages, uint32(usage))
+	}
+	var ok bool
+	if item.UnitSystem, ok = unitSystems[field.Unit&0x0F]; !ok {
+		item.UnitSystem = UNIT_SYSTEM_RESERVED
+	}
+
+	return item
+}
+
+// appendReportItem appends a field to the report of its report ID, keeping reports in order of their first field
+func appendReportItem(reports []ReportInfo, field *hid.ReportField) []ReportInfo {
+	for i := range reports {
+		if reports[i].ReportID == field.ReportID {
+			reports[i].Items = append(reports[i].Items, newReportItem(field))
+			return reports
+		}
+	}
+
+	return append(reports, ReportInfo{
+		ReportID: field.ReportID,
+		Items:    []ReportItem{newReportItem(field)},
+	})
+}
+
+// newCollectionInfo converts a collection and its nested collections, where reports of a collection
+// contain fields declared directly in it
+func newCollectionInfo(collection *hid.ReportCollection) CollectionInfo {
+	info := CollectionInfo{
+		UsagePage:      collection.Usage.Page(),
+		Usage:          collection.Usage.ID(),
+		Type:           uint8(collection.Type),
+		Children:       []CollectionInfo{},
+		InputReports:   []ReportInfo{},
+		OutputReports:  []ReportInfo{},
+		FeatureReports: []ReportInfo{},
+	}
+	for _, child := range collection.Children {
+		info.Children = append(info.Children, newCollectionInfo(child))
+	}
+	for _, field := range collection.Fields {
+		switch field.ReportType {
+		case hid.REPORT_TYPE_INPUT:
+			info.InputReports = appendReportItem(info.InputReports, field)
+		case hid.REPORT_TYPE_OUTPUT:
+			info.OutputReports = appendReportItem(info.OutputReports, field)
+		case hid.REPORT_TYPE_FEATURE:
+			info.FeatureReports = appendReportItem(info.FeatureReports, field)
+		}
+	}
+
+	return info
+}
+
+// NewCollections converts top-level collections of a parsed report descriptor into collections of WebHID
+func NewCollections(schema *hid.ReportSchema) []CollectionInfo {
+	res := []CollectionInfo{}
+	for _, collection := range schema.Collections {
+		res = append(res, newCollectionInfo(collection))
+	}
+
+	return res
+}
+
+// Match reports whether a device matches the filter
+func (f Filter) Match(device Device) bool {
+	if (f.VendorID != 0 && f.VendorID != device.VendorID) || (f.ProductID != 0 && f.ProductID != device.ProductID) {
+		return false
+	}
+	if f.UsagePage == 0 && f.Usage == 0 {
+		return true
+	}
+	for _, collection := range device.Collections {
+		if (f.UsagePage == 0 || f.UsagePage == collection.UsagePage) && (f.Usage == 0 || f.Usage == collection.Usage) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchAny reports whether a device matches any of filters
+func matchAny(filters []Filter, device Device) bool {
+	for _, filter := range filters {
+		if filter.Match(device) {
+			return true
+		}
+	}
+
+	return false
+}
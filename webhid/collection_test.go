@@ -0,0 +1,143 @@
+package webhid_test
+
+import (
+	"testing"
+
+	"github.com/ntchjb/gohid/hid"
+	"github.com/ntchjb/gohid/webhid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Mouse with 3 buttons, X and Y
+var mouseDescriptor = []byte{
+	0x05, 0x01, 0x09, 0x02, 0xA1, 0x01,
+	0x09, 0x01, 0xA1, 0x00,
+	0x05, 0x09, 0x19, 0x01, 0x29, 0x03, 0x15, 0x00, 0x25, 0x01, 0x95, 0x03, 0x75, 0x01, 0x81, 0x02,
+	0x95, 0x01, 0x75, 0x05, 0x81, 0x03,
+	0x05, 0x01, 0x09, 0x30, 0x09, 0x31, 0x15, 0x81, 0x25, 0x7F, 0x75, 0x08, 0x95, 0x02, 0x81, 0x06,
+	0xC0, 0xC0,
+}
+
+// Sensor with volatile Feature report 2 of distance in centimeters, in SI linear unit system
+var sensorDescriptor = []byte{
+	0x05, 0x20, 0x09, 0x01, 0xA1, 0x01,
+	0x85, 0x02, 0x05, 0x20, 0x0A, 0xB0, 0x04, 0x15, 0x00, 0x27, 0xFF, 0xFF, 0x00, 0x00,
+	0x65, 0x11, 0x55, 0x0E, 0x75, 0x10, 0x95, 0x01, 0xB1, 0xA2,
+	0xC0,
+}
+
+func TestNewCollections(t *testing.T) {
+	tests := []struct {
+		name     string
+		desc     []byte
+		expected []webhid.CollectionInfo
+	}{
+		{
+			name: "Mouse",
+			desc: mouseDescriptor,
+			expected: []webhid.CollectionInfo{
+				{
+					UsagePage: 0x01, Usage: 0x02, Type: 1,
+					Children: []webhid.CollectionInfo{
+						{
+							UsagePage: 0x01, Usage: 0x01, Type: 0,
+							Children: []webhid.CollectionInfo{},
+							InputReports: []webhid.ReportInfo{
+								{
+									ReportID: 0,
+									Items: []webhid.ReportItem{
+										{
+											IsAbsolute: true, IsLinear: true, IsRange: true, HasPreferredState: true,
+											Usages: []uint32{}, UsageMinimum: 0x00090001, UsageMaximum: 0x00090003,
+											ReportSize: 1, ReportCount: 3, UnitSystem: "none", LogicalMaximum: 1, Strings: []string{},
+										},
+										{
+											IsAbsolute: true, IsConstant: true, IsLinear: true, HasPreferredState: true,
+											Usages: []uint32{}, ReportSize: 5, ReportCount: 1, UnitSystem: "none",
+											LogicalMaximum: 1, Strings: []string{},
+										},
+										{
+											IsLinear: true, HasPreferredState: true,
+											Usages: []uint32{0x00010030, 0x00010031}, ReportSize: 8, ReportCount: 2, UnitSystem: "none",
+											LogicalMinimum: -127, LogicalMaximum: 127, Strings: []string{},
+										},
+									},
+								},
+							},
+							OutputReports:  []webhid.ReportInfo{},
+							FeatureReports: []webhid.ReportInfo{},
+						},
+					},
+					InputReports:   []webhid.ReportInfo{},
+					OutputReports:  []webhid.ReportInfo{},
+					FeatureReports: []webhid.ReportInfo{},
+				},
+			},
+		},
+		{
+			name: "Sensor",
+			desc: sensorDescriptor,
+			expected: []webhid.CollectionInfo{
+				{
+					UsagePage: 0x20, Usage: 0x01, Type: 1,
+					Children:      []webhid.CollectionInfo{},
+					InputReports:  []webhid.ReportInfo{},
+					OutputReports: []webhid.ReportInfo{},
+					FeatureReports: []webhid.ReportInfo{
+						{
+							ReportID: 2,
+							Items: []webhid.ReportItem{
+								{
+									IsAbsolute: true, IsLinear: true, IsVolatile: true,
+									Usages: []uint32{0x002004B0}, ReportSize: 16, ReportCount: 1,
+									UnitExponent: -2, UnitSystem: "si-linear", UnitFactorLengthExponent: 1,
+									LogicalMaximum: 0xFFFF, Strings: []string{},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			schema, err := hid.ParseReportDescriptor(test.desc)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, webhid.NewCollections(schema))
+		})
+	}
+}
+
+func TestFilter_Match(t *testing.T) {
+	schema, err := hid.ParseReportDescriptor(mouseDescriptor)
+	require.NoError(t, err)
+	device := webhid.Device{
+		VendorID:    0x046D,
+		ProductID:   0xC077,
+		Collections: webhid.NewCollections(schema),
+	}
+
+	tests := []struct {
+		name     string
+		filter   webhid.Filter
+		expected bool
+	}{
+		{name: "Any", filter: webhid.Filter{}, expected: true},
+		{name: "Vendor", filter: webhid.Filter{VendorID: 0x046D}, expected: true},
+		{name: "Product", filter: webhid.Filter{VendorID: 0x046D, ProductID: 0xC077}, expected: true},
+		{name: "Other product", filter: webhid.Filter{VendorID: 0x046D, ProductID: 0xC078}, expected: false},
+		{name: "Usage page", filter: webhid.Filter{UsagePage: 0x01}, expected: true},
+		{name: "Usage", filter: webhid.Filter{UsagePage: 0x01, Usage: 0x02}, expected: true},
+		{name: "Nested collection usage", filter: webhid.Filter{UsagePage: 0x01, Usage: 0x01}, expected: false},
+		{name: "Other usage page", filter: webhid.Filter{VendorID: 0x046D, UsagePage: 0x0C}, expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.filter.Match(device))
+		})
+	}
+}
@@ -0,0 +1,616 @@
+package webhid
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/ntchjb/gohid/hid"
+	"github.com/ntchjb/gohid/manager"
+	"github.com/ntchjb/gohid/usb"
+)
+
+const (
+	// Timeout of sending reports to devices, and of receiving Feature reports
+	TRANSFER_TIMEOUT = 5 * time.Second
+	// Timeout of writing a message to a WebSocket session, after which the session is closed
+	WRITE_TIMEOUT = 5 * time.Second
+	// Interval between retries of reading Input reports after a read fails
+	READ_RETRY_INTERVAL = 100 * time.Millisecond
+	// Number of consecutive failed reads of Input reports, after which a device is closed as if it was unplugged
+	READ_FAILURE_LIMIT = 5
+)
+
+// Config configures which devices and web pages can use the gateway
+type Config struct {
+	// Devices which web pages may access. No device is accessible if it is empty.
+	Allowlist []Filter
+	// Origins of web pages allowed to open sessions, e.g. "https://dashboard.example.com".
+	// If it is empty, only pages served by the same host as the gateway are allowed.
+	Origins []string
+	// Config of devices opened by the gateway
+	DeviceConfig hid.DeviceConfig
+}
+
+// Gateway serves devices of the allowlist to web pages. It is an http.Handler, where GET requests return
+// devices in the allowlist, and WebSocket connections are sessions of WebHID-like requests.
+type Gateway interface {
+	http.Handler
+	// Close all sessions, and close devices opened by them
+	Close() error
+}
+
+// description is a device with its parsed report descriptor
+type description struct {
+	device Device
+	schema *hid.ReportSchema
+	// Target of the device
+	info hid.DeviceInfo
+}
+
+// sharedDevice is a device opened by one or more sessions, whose Input reports are sent to all of them
+type sharedDevice struct {
+	desc   description
+	device hid.Device
+	// Sessions which opened this device, guarded by mutex of gateway
+	sessions map[*session]struct{}
+	cancel   context.CancelFunc
+	// Closed when reading Input reports is stopped
+	done chan struct{}
+}
+
+type gatewayImpl struct {
+	man      manager.DeviceManager
+	config   Config
+	upgrader websocket.Upgrader
+	logger   *slog.Logger
+
+	mu           sync.Mutex
+	descriptions map[string]description
+	opened       map[string]*sharedDevice
+	sessions     map[*session]struct{}
+	closed       bool
+	wg           sync.WaitGroup
+}
+
+// NewGateway creates a gateway of devices of man. The device manager is not closed by the gateway.
+func NewGateway(man manager.DeviceManager, config Config, logger *slog.Logger) Gateway {
+	g := &gatewayImpl{
+		man:          man,
+		config:       config,
+		logger:       logger,
+		descriptions: make(map[string]description),
+		opened:       make(map[string]*sharedDevice),
+		sessions:     make(map[*session]struct{}),
+	}
+	if len(config.Origins) > 0 {
+		g.upgrader.CheckOrigin = func(r *http.Request) bool {
+			return slices.Contains(config.Origins, r.Header.Get("Origin"))
+		}
+	}
+
+	return g
+}
+
+// DeviceID formats ID of a HID interface of a device
+func DeviceID(info hid.DeviceInfo) string {
+	return fmt.Sprintf("%04x:%04x:%x", uint16(info.DeviceDesc.Vendor), uint16(info.DeviceDesc.Product), info.GetInterfaceNumber())
+}
+
+// allowedVendor reports whether any filter of the allowlist may match a device by its vendor ID and product ID,
+// so that other devices are never opened by the gateway
+func (g *gatewayImpl) allowedVendor(info hid.DeviceInfo) bool {
+	for _, filter := range g.config.Allowlist {
+		if (filter.VendorID == 0 || filter.VendorID == uint16(info.DeviceDesc.Vendor)) &&
+			(filter.ProductID == 0 || filter.ProductID == uint16(info.DeviceDesc.Product)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// devices returns connected devices in the allowlist
+func (g *gatewayImpl) devices() ([]description, error) {
+	deviceInfos, err := g.man.Enumerate(0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []description
+	for _, info := range deviceInfos {
+		if !g.allowedVendor(info) || slices.ContainsFunc(res, func(desc description) bool { return desc.device.ID == DeviceID(info) }) {
+			continue
+		}
+		desc, err := g.describe(info)
+		if err != nil {
+			g.logger.Warn("unable to read report descriptor of device", "device", DeviceID(info), "err", err)
+			continue
+		}
+		if matchAny(g.config.Allowlist, desc.device) {
+			res = append(res, desc)
+		}
+	}
+
+	return res, nil
+}
+
+// describe reads report descriptor and product name of a device, which are cached by device ID
+func (g *gatewayImpl) describe(info hid.DeviceInfo) (description, error) {
+	id := DeviceID(info)
+	g.mu.Lock()
+	desc, ok := g.descriptions[id]
+	g.mu.Unlock()
+	if ok {
+		return desc, nil
+	}
+
+	device, err := g.openTarget(info)
+	if err != nil {
+		return desc, err
+	}
+	defer device.Close()
+	reportDesc, err := device.GetReportDescriptor()
+	if err != nil {
+		return desc, err
+	}
+	schema, err := hid.ParseReportDescriptor(reportDesc)
+	if err != nil {
+		return desc, err
+	}
+	product, err := device.GetProduct()
+	if err != nil {
+		g.logger.Debug("unable to get product name of device", "device", id, "err", err)
+	}
+
+	desc = description{
+		device: Device{
+			ID:          id,
+			VendorID:    uint16(info.DeviceDesc.Vendor),
+			ProductID:   uint16(info.DeviceDesc.Product),
+			ProductName: product,
+			Collections: NewCollections(schema),
+		},
+		schema: schema,
+		info:   info,
+	}
+	g.mu.Lock()
+	g.descriptions[id] = desc
+	g.mu.Unlock()
+
+	return desc, nil
+}
+
+// openTarget opens a device, and sets its target to the HID interface of info
+func (g *gatewayImpl) openTarget(info hid.DeviceInfo) (hid.Device, error) {
+	device, err := g.man.Open(info.DeviceDesc.Vendor, info.DeviceDesc.Product, g.config.DeviceConfig)
+	if err != nil {
+		return nil, err
+	}
+	if err := device.SetAutoDetach(true); err != nil {
+		device.Close()
+		return nil, err
+	}
+	if err := device.SetTarget(info.GetConfigNumber(), info.GetInterfaceNumber(), info.GetAltSettingNumber()); err != nil {
+		device.Close()
+		return nil, err
+	}
+
+	return device, nil
+}
+
+// open opens a device for a session, or shares the device if it has been opened by other sessions
+func (g *gatewayImpl) open(sess *session, desc description) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if _, ok := sess.opened[desc.device.ID]; ok {
+		return fmt.Errorf("device %s: %w", desc.device.ID, ErrDeviceAlreadyOpened)
+	}
+	shared, ok := g.opened[desc.device.ID]
+	if !ok {
+		device, err := g.openTarget(desc.info)
+		if err != nil {
+			return err
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		shared = &sharedDevice{
+			desc:     desc,
+			device:   device,
+			sessions: make(map[*session]struct{}),
+			cancel:   cancel,
+			done:     make(chan struct{}),
+		}
+		g.opened[desc.device.ID] = shared
+		go func() {
+			defer close(shared.done)
+			if g.read(ctx, shared) {
+				g.disconnect(shared)
+			}
+		}()
+	}
+	shared.sessions[sess] = struct{}{}
+	sess.opened[desc.device.ID] = shared
+
+	return nil
+}
+
+// release closes a device for a session, and closes the device if no other session has opened it
+func (g *gatewayImpl) release(sess *session, shared *sharedDevice) {
+	g.mu.Lock()
+	delete(sess.opened, shared.desc.device.ID)
+	delete(shared.sessions, sess)
+	owned := len(shared.sessions) == 0 && g.opened[shared.desc.device.ID] == shared
+	if owned {
+		delete(g.opened, shared.desc.device.ID)
+	}
+	g.mu.Unlock()
+	if !owned {
+		return
+	}
+
+	shared.cancel()
+	<-shared.done
+	if err := shared.device.Close(); err != nil {
+		g.logger.Warn("unable to close device", "device", shared.desc.device.ID, "err", err)
+	}
+}
+
+// disconnect removes an unplugged device from sessions which opened it
+func (g *gatewayImpl) disconnect(shared *sharedDevice) {
+	id := shared.desc.device.ID
+	g.mu.Lock()
+	if g.opened[id] != shared {
+		g.mu.Unlock()
+		return
+	}
+	delete(g.opened, id)
+	delete(g.descriptions, id)
+	sessions := make([]*session, 0, len(shared.sessions))
+	for sess := range shared.sessions {
+		delete(sess.opened, id)
+		sessions = append(sessions, sess)
+	}
+	g.mu.Unlock()
+
+	for _, sess := range sessions {
+		sess.send(Event{Type: EVENT_DISCONNECT, DeviceID: id})
+	}
+	if err := shared.device.Close(); err != nil {
+		g.logger.Debug("unable to close disconnected device", "device", id, "err", err)
+	}
+}
+
+// read sends Input reports of a device to sessions until ctx is done, or returns true if the device is unplugged.
+// A failed read is retried, where the device reopens its stream, until reads fail READ_FAILURE_LIMIT times in a row.
+func (g *gatewayImpl) read(ctx context.Context, shared *sharedDevice) bool {
+	id := shared.desc.device.ID
+	usesReportIDs := shared.desc.schema.UsesReportIDs()
+	buf := make([]byte, hid.HID_MAX_REPORT_SIZE)
+	failures := 0
+	for {
+		n, err := shared.device.ReadInput(ctx, buf)
+		if ctx.Err() != nil {
+			return false
+		}
+		if errors.Is(err, usb.ErrDisconnected) {
+			return true
+		}
+		if err != nil {
+			failures++
+			if failures >= READ_FAILURE_LIMIT {
+				g.logger.Error("close device after repeated failed reads", "device", id, "failures", failures, "err", err)
+				return true
+			}
+			g.logger.Warn("unable to read Input report", "device", id, "err", err)
+			select {
+			case <-ctx.Done():
+				return false
+			case <-time.After(READ_RETRY_INTERVAL):
+			}
+			continue
+		}
+		failures = 0
+
+		event := Event{Type: EVENT_INPUT_REPORT, DeviceID: id}
+		data := buf[:n]
+		if usesReportIDs && n > 0 {
+			event.ReportID = data[0]
+			data = data[1:]
+		}
+		event.Data = append(hid.HexBytes{}, data...)
+
+		g.mu.Lock()
+		sessions := make([]*session, 0, len(shared.sessions))
+		for sess := range shared.sessions {
+			sessions = append(sessions, sess)
+		}
+		g.mu.Unlock()
+		for _, sess := range sessions {
+			sess.send(event)
+		}
+	}
+}
+
+func (g *gatewayImpl) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !websocket.IsWebSocketUpgrade(r) {
+		g.serveDevices(w, r)
+		return
+	}
+
+	conn, err := g.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// Upgrader has replied with an error
+		g.logger.Debug("unable to upgrade WebSocket connection", "addr", r.RemoteAddr, "err", err)
+		return
+	}
+	sess := &session{
+		gateway: g,
+		conn:    conn,
+		granted: make(map[string]struct{}),
+		opened:  make(map[string]*sharedDevice),
+	}
+	g.mu.Lock()
+	if g.closed {
+		g.mu.Unlock()
+		conn.Close()
+		return
+	}
+	g.sessions[sess] = struct{}{}
+	g.wg.Add(1)
+	g.mu.Unlock()
+	defer g.wg.Done()
+
+	sess.serve()
+}
+
+// serveDevices replies devices in the allowlist as JSON
+func (g *gatewayImpl) serveDevices(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	descs, err := g.devices()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	devices := []Device{}
+	g.mu.Lock()
+	for _, desc := range descs {
+		_, desc.device.Opened = g.opened[desc.device.ID]
+		devices = append(devices, desc.device)
+	}
+	g.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(devices); err != nil {
+		g.logger.Debug("unable to write devices", "addr", r.RemoteAddr, "err", err)
+	}
+}
+
+func (g *gatewayImpl) Close() error {
+	g.mu.Lock()
+	g.closed = true
+	for sess := range g.sessions {
+		sess.conn.Close()
+	}
+	g.mu.Unlock()
+	g.wg.Wait()
+
+	return nil
+}
+
+// session is a WebSocket connection of a web page, which has its own granted and opened devices
+type session struct {
+	gateway *gatewayImpl
+	conn    *websocket.Conn
+	writeMu sync.Mutex
+
+	// Devices granted by requestDevice, which is only used by goroutine of requests
+	granted map[string]struct{}
+	// Devices opened by this session, guarded by mutex of gateway
+	opened map[string]*sharedDevice
+}
+
+// send writes a message to the web page, where the connection is closed if it cannot be written in time
+func (s *session) send(msg any) {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	s.conn.SetWriteDeadline(time.Now().Add(WRITE_TIMEOUT))
+	if err := s.conn.WriteJSON(msg); err != nil {
+		s.gateway.logger.Debug("unable to write message, closing session", "addr", s.conn.RemoteAddr(), "err", err)
+		s.conn.Close()
+	}
+}
+
+func (s *session) serve() {
+	for {
+		_, data, err := s.conn.ReadMessage()
+		if err != nil {
+			break
+		}
+		var req Request
+		if err := json.Unmarshal(data, &req); err != nil {
+			s.send(Response{Error: newError(fmt.Errorf("%w: %w", ErrInvalidRequest, err))})
+			continue
+		}
+		res := s.handle(req)
+		res.ID = req.ID
+		s.send(res)
+	}
+
+	s.conn.Close()
+	s.gateway.mu.Lock()
+	opened := make([]*sharedDevice, 0, len(s.opened))
+	for _, shared := range s.opened {
+		opened = append(opened, shared)
+	}
+	delete(s.gateway.sessions, s)
+	s.gateway.mu.Unlock()
+	for _, shared := range opened {
+		s.gateway.release(s, shared)
+	}
+}
+
+// openedDevice returns a device opened by this session
+func (s *session) openedDevice(id string) (*sharedDevice, error) {
+	s.gateway.mu.Lock()
+	defer s.gateway.mu.Unlock()
+
+	shared, ok := s.opened[id]
+	if !ok {
+		return nil, fmt.Errorf("device %s: %w", id, ErrDeviceNotOpened)
+	}
+
+	return shared, nil
+}
+
+// devices returns connected devices in the allowlist, with opened state of this session
+func (s *session) devices() ([]description, error) {
+	descs, err := s.gateway.devices()
+	if err != nil {
+		return nil, err
+	}
+
+	s.gateway.mu.Lock()
+	defer s.gateway.mu.Unlock()
+	for i := range descs {
+		_, descs[i].device.Opened = s.opened[descs[i].device.ID]
+	}
+
+	return descs, nil
+}
+
+// grantedDevice returns a device granted to this session
+func (s *session) grantedDevice(id string) (description, error) {
+	if _, ok := s.granted[id]; !ok {
+		return description{}, fmt.Errorf("device %s: %w", id, ErrDeviceNotAllowed)
+	}
+	descs, err := s.devices()
+	if err != nil {
+		return description{}, err
+	}
+	for _, desc := range descs {
+		if desc.device.ID == id {
+			return desc, nil
+		}
+	}
+
+	return description{}, fmt.Errorf("device %s: %w", id, ErrDeviceNotFound)
+}
+
+func (s *session) handle(req Request) Response {
+	var res Response
+	var err error
+	switch req.Method {
+	case METHOD_REQUEST_DEVICE, METHOD_GET_DEVICES:
+		res.Devices, err = s.listDevices(req)
+	case METHOD_OPEN:
+		var desc description
+		if desc, err = s.grantedDevice(req.DeviceID); err == nil {
+			err = s.gateway.open(s, desc)
+		}
+	case METHOD_CLOSE, METHOD_FORGET:
+		// Closing a device which is not opened succeeds, like WebHID
+		if shared, openErr := s.openedDevice(req.DeviceID); openErr == nil {
+			s.gateway.release(s, shared)
+		}
+		if req.Method == METHOD_FORGET {
+			delete(s.granted, req.DeviceID)
+		}
+	case METHOD_SEND_REPORT, METHOD_SEND_FEATURE_REPORT, METHOD_RECEIVE_FEATURE_REPORT:
+		var shared *sharedDevice
+		if shared, err = s.openedDevice(req.DeviceID); err == nil {
+			res.Data, err = s.transfer(shared, req)
+		}
+	default:
+		err = fmt.Errorf("%w: %q", ErrUnknownMethod, req.Method)
+	}
+	res.Error = newError(err)
+
+	return res
+}
+
+// listDevices returns devices of requestDevice, which are then granted, or devices of getDevices
+func (s *session) listDevices(req Request) ([]Device, error) {
+	descs, err := s.devices()
+	if err != nil {
+		return nil, err
+	}
+
+	res := []Device{}
+	for _, desc := range descs {
+		if req.Method == METHOD_GET_DEVICES {
+			if _, ok := s.granted[desc.device.ID]; !ok {
+				continue
+			}
+		} else if len(req.Filters) > 0 && !matchAny(req.Filters, desc.device) {
+			continue
+		}
+		s.granted[desc.device.ID] = struct{}{}
+		res = append(res, desc.device)
+	}
+
+	return res, nil
+}
+
+// transfer sends a report to an opened device, or receives a Feature report from it
+func (s *session) transfer(shared *sharedDevice, req Request) (hid.HexBytes, error) {
+	report := append([]byte{req.ReportID}, req.Data...)
+	switch req.Method {
+	case METHOD_SEND_REPORT:
+		ctx, cancel := context.WithTimeout(context.Background(), TRANSFER_TIMEOUT)
+		defer cancel()
+		_, err := shared.device.WriteOutput(ctx, report)
+		return nil, err
+	case METHOD_SEND_FEATURE_REPORT:
+		_, err := shared.device.SendFeatureReport(report)
+		return nil, err
+	}
+
+	layout, err := shared.desc.schema.Layout(hid.REPORT_TYPE_FEATURE, req.ReportID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrReportNotFound, err)
+	}
+	buf := layout.NewBuffer()
+	n, err := shared.device.GetFeatureReport(buf)
+	if err != nil {
+		return nil, err
+	}
+	if !shared.desc.schema.UsesReportIDs() {
+		return buf[1:max(n, 1)], nil
+	}
+
+	return buf[:n], nil
+}
+
+// newError converts an error into a DOMException-like error, or returns nil if there is no error
+func newError(err error) *Error {
+	if err == nil {
+		return nil
+	}
+	res := &Error{Name: ERROR_NETWORK, Message: err.Error()}
+	switch {
+	case errors.Is(err, ErrDeviceNotAllowed):
+		res.Name = ERROR_NOT_ALLOWED
+	case errors.Is(err, ErrDeviceNotFound), errors.Is(err, ErrReportNotFound):
+		res.Name = ERROR_NOT_FOUND
+	case errors.Is(err, ErrDeviceNotOpened), errors.Is(err, ErrDeviceAlreadyOpened):
+		res.Name = ERROR_INVALID_STATE
+	case errors.Is(err, ErrUnknownMethod):
+		res.Name = ERROR_NOT_SUPPORTED
+	case errors.Is(err, ErrInvalidRequest):
+		res.Name = ERROR_TYPE
+	}
+
+	return res
+}
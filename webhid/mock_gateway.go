@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./webhid/gateway.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./webhid/gateway.go -destination=./webhid/mock_gateway.go -package=webhid
+//
+
+// Package webhid is a generated GoMock package.
+package webhid
+
+import (
+	http "net/http"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGateway is a mock of Gateway interface.
+type MockGateway struct {
+	ctrl     *gomock.Controller
+	recorder *MockGatewayMockRecorder
+}
+
+// MockGatewayMockRecorder is the mock recorder for MockGateway.
+type MockGatewayMockRecorder struct {
+	mock *MockGateway
+}
+
+// NewMockGateway creates a new mock instance.
+func NewMockGateway(ctrl *gomock.Controller) *MockGateway {
+	mock := &MockGateway{ctrl: ctrl}
+	mock.recorder = &MockGatewayMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGateway) EXPECT() *MockGatewayMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockGateway) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockGatewayMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockGateway)(nil).Close))
+}
+
+// ServeHTTP mocks base method.
+func (m *MockGateway) ServeHTTP(arg0 http.ResponseWriter, arg1 *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ServeHTTP", arg0, arg1)
+}
+
+// ServeHTTP indicates an expected call of ServeHTTP.
+func (mr *MockGatewayMockRecorder) ServeHTTP(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServeHTTP", reflect.TypeOf((*MockGateway)(nil).ServeHTTP), arg0, arg1)
+}
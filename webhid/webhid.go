@@ -0,0 +1,172 @@
+// Package webhid is an HTTP and WebSocket gateway which lets web pages use devices of a device manager,
+// with messages shaped after the WebHID API, e.g.
+//
+//	gateway := webhid.NewGateway(man, webhid.Config{
+//		Allowlist: []webhid.Filter{{VendorID: 0x046D}},
+//	}, logger)
+//	http.Handle("/webhid", gateway)
+//
+// A plain GET request returns devices in the allowlist as JSON. A WebSocket connection is a session where the page
+// sends requests like {"id": 1, "method": "requestDevice", "filters": [{"usagePage": 12}]}, which are replied with
+// the same ID, and receives events like {"type": "inputreport", "deviceId": "046d:c52b:2", "reportId": 1, "data": "0100"}.
+// Report data is written as hex strings.
+package webhid
+
+import (
+	"errors"
+
+	"github.com/ntchjb/gohid/hid"
+)
+
+var (
+	ErrDeviceNotAllowed    = errors.New("device is not allowed")
+	ErrDeviceNotFound      = errors.New("device not found")
+	ErrDeviceNotOpened     = errors.New("device is not opened")
+	ErrDeviceAlreadyOpened = errors.New("device is already opened")
+	ErrReportNotFound      = errors.New("report not found")
+	ErrUnknownMethod       = errors.New("unknown method")
+	ErrInvalidRequest      = errors.New("invalid request")
+)
+
+type Method string
+
+const (
+	// Grant devices matching filters and the allowlist to the session, and return them
+	METHOD_REQUEST_DEVICE Method = "requestDevice"
+	// Return devices granted to the session which are connected
+	METHOD_GET_DEVICES            Method = "getDevices"
+	METHOD_OPEN                   Method = "open"
+	METHOD_CLOSE                  Method = "close"
+	METHOD_FORGET                 Method = "forget"
+	METHOD_SEND_REPORT            Method = "sendReport"
+	METHOD_SEND_FEATURE_REPORT    Method = "sendFeatureReport"
+	METHOD_RECEIVE_FEATURE_REPORT Method = "receiveFeatureReport"
+)
+
+type EventType string
+
+const (
+	// An Input report received from an opened device, where data excludes report ID
+	EVENT_INPUT_REPORT EventType = "inputreport"
+	// An opened device is unplugged, after which it is no longer opened
+	EVENT_DISCONNECT EventType = "disconnect"
+)
+
+// Names of DOMException used by WebHID, which are names of errors replied to requests
+const (
+	ERROR_NOT_ALLOWED   = "NotAllowedError"
+	ERROR_NOT_FOUND     = "NotFoundError"
+	ERROR_INVALID_STATE = "InvalidStateError"
+	ERROR_NOT_SUPPORTED = "NotSupportedError"
+	ERROR_NETWORK       = "NetworkError"
+	ERROR_TYPE          = "TypeError"
+)
+
+// Filter matches devices like HIDDeviceFilter of WebHID, where zero values match any device.
+// Usage page and usage are matched against top-level collections.
+type Filter struct {
+	VendorID  uint16 `json:"vendorId,omitempty"`
+	ProductID uint16 `json:"productId,omitempty"`
+	UsagePage uint16 `json:"usagePage,omitempty"`
+	Usage     uint16 `json:"usage,omitempty"`
+}
+
+// Request is a message sent by web page
+type Request struct {
+	ID     uint32 `json:"id"`
+	Method Method `json:"method"`
+	// Filters of requestDevice
+	Filters  []Filter `json:"filters,omitempty"`
+	DeviceID string   `json:"deviceId,omitempty"`
+	ReportID uint8    `json:"reportId,omitempty"`
+	// Report data, excluding report ID
+	Data hid.HexBytes `json:"data,omitempty"`
+}
+
+// Response is a reply of request with the same ID
+type Response struct {
+	ID      uint32   `json:"id"`
+	Devices []Device `json:"devices,omitempty"`
+	// Feature report received by receiveFeatureReport, which starts with report ID if the device uses report IDs
+	Data  hid.HexBytes `json:"data,omitempty"`
+	Error *Error       `json:"error,omitempty"`
+}
+
+// Event is a message sent to web page without request
+type Event struct {
+	Type     EventType    `json:"type"`
+	DeviceID string       `json:"deviceId"`
+	ReportID uint8        `json:"reportId,omitempty"`
+	Data     hid.HexBytes `json:"data,omitempty"`
+}
+
+// Error is a rejected request, where name is name of DOMException, e.g. "NotAllowedError"
+type Error struct {
+	Name    string `json:"name"`
+	Message string `json:"message"`
+}
+
+// Device is a HID interface of a device, like HIDDevice of WebHID
+type Device struct {
+	// ID of the device in the gateway, formatted as vendorID:productID:interfaceNumber in hex, e.g. "046d:c52b:2"
+	ID          string           `json:"id"`
+	Opened      bool             `json:"opened"`
+	VendorID    uint16           `json:"vendorId"`
+	ProductID   uint16           `json:"productId"`
+	ProductName string           `json:"productName"`
+	Collections []CollectionInfo `json:"collections"`
+}
+
+// CollectionInfo is a collection of report descriptor, like HIDCollectionInfo of WebHID
+type CollectionInfo struct {
+	UsagePage      uint16           `json:"usagePage"`
+	Usage          uint16           `json:"usage"`
+	Type           uint8            `json:"type"`
+	Children       []CollectionInfo `json:"children"`
+	InputReports   []ReportInfo     `json:"inputReports"`
+	OutputReports  []ReportInfo     `json:"outputReports"`
+	FeatureReports []ReportInfo     `json:"featureReports"`
+}
+
+// ReportInfo is a report of a collection, like HIDReportInfo of WebHID
+type ReportInfo struct {
+	ReportID uint8        `json:"reportId"`
+	Items    []ReportItem `json:"items"`
+}
+
+// ReportItem is a field of a report, like HIDReportItem of WebHID
+type ReportItem struct {
+	IsAbsolute        bool `json:"isAbsolute"`
+	IsArray           bool `json:"isArray"`
+	IsBufferedBytes   bool `json:"isBufferedBytes"`
+	IsConstant        bool `json:"isConstant"`
+	IsLinear          bool `json:"isLinear"`
+	IsRange           bool `json:"isRange"`
+	IsVolatile        bool `json:"isVolatile"`
+	HasNull           bool `json:"hasNull"`
+	HasPreferredState bool `json:"hasPreferredState"`
+	Wrap              bool `json:"wrap"`
+
+	// Extended usages, where the upper 16 bits are usage page
+	Usages       []uint32 `json:"usages"`
+	UsageMinimum uint32   `json:"usageMinimum"`
+	UsageMaximum uint32   `json:"usageMaximum"`
+	ReportSize   uint32   `json:"reportSize"`
+	ReportCount  uint32   `json:"reportCount"`
+
+	UnitExponent                        int8   `json:"unitExponent"`
+	UnitSystem                          string `json:"unitSystem"`
+	UnitFactorLengthExponent            int8   `json:"unitFactorLengthExponent"`
+	UnitFactorMassExponent              int8   `json:"unitFactorMassExponent"`
+	UnitFactorTimeExponent              int8   `json:"unitFactorTimeExponent"`
+	UnitFactorTemperatureExponent       int8   `json:"unitFactorTemperatureExponent"`
+	UnitFactorCurrentExponent           int8   `json:"unitFactorCurrentExponent"`
+	UnitFactorLuminousIntensityExponent int8   `json:"unitFactorLuminousIntensityExponent"`
+
+	LogicalMinimum  int32 `json:"logicalMinimum"`
+	LogicalMaximum  int32 `json:"logicalMaximum"`
+	PhysicalMinimum int32 `json:"physicalMinimum"`
+	PhysicalMaximum int32 `json:"physicalMaximum"`
+
+	Strings []string `json:"strings"`
+}
@@ -0,0 +1,362 @@
+package webhid_test
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/gousb"
+	"github.com/gorilla/websocket"
+	"github.com/ntchjb/gohid/hid"
+	"github.com/ntchjb/gohid/manager"
+	"github.com/ntchjb/gohid/usb/emulator"
+	"github.com/ntchjb/gohid/webhid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newDeviceDesc(address int, vid, pid gousb.ID) *gousb.DeviceDesc {
+	return &gousb.DeviceDesc{
+		Bus:                  1,
+		Address:              address,
+		Speed:                gousb.SpeedFull,
+		Spec:                 0x0200,
+		Device:               0x0100,
+		Vendor:               vid,
+		Product:              pid,
+		MaxControlPacketSize: 64,
+		Configs: map[int]gousb.ConfigDesc{
+			1: {
+				Number:   1,
+				MaxPower: 100,
+				Interfaces: []gousb.InterfaceDesc{
+					{
+						Number: 0,
+						AltSettings: []gousb.InterfaceSetting{
+							{
+								Alternate: 0,
+								Class:     gousb.ClassHID,
+								Endpoints: map[gousb.EndpointAddress]gousb.EndpointDesc{
+									0x81: {
+										Address:       0x81,
+										Number:        1,
+										Direction:     gousb.EndpointDirectionIn,
+										MaxPacketSize: 64,
+										TransferType:  gousb.TransferTypeInterrupt,
+										PollInterval:  10 * time.Millisecond,
+									},
+									0x01: {
+										Address:       0x01,
+										Number:        1,
+										Direction:     gousb.EndpointDirectionOut,
+										MaxPacketSize: 64,
+										TransferType:  gousb.TransferTypeInterrupt,
+										PollInterval:  10 * time.Millisecond,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Vendor-defined device with 2-byte Input, Output and Feature reports
+var reportDescriptor = []byte{
+	0x06, 0x00, 0xFF, 0x09, 0x01, 0xA1, 0x01,
+	0x15, 0x00, 0x26, 0xFF, 0x00, 0x75, 0x08, 0x95, 0x02,
+	0x09, 0x01, 0x81, 0x02,
+	0x09, 0x02, 0x91, 0x02,
+	0x09, 0x03, 0xB1, 0x02,
+	0xC0,
+}
+
+// serve plugs an allowed device and a device which is not allowed, then serves a gateway of them
+func serve(t *testing.T, handler emulator.Handler, origins []string) (emulator.Device, *httptest.Server) {
+	emulatorCtx := emulator.NewContext()
+	device, err := emulatorCtx.Connect(emulator.DeviceConfig{
+		Desc:         newDeviceDesc(3, 0xFF01, 0x0001),
+		Manufacturer: "gohid",
+		Product:      "Allowed device",
+		Interfaces: map[int]emulator.InterfaceConfig{
+			0: {ReportDescriptor: reportDescriptor, Handler: handler},
+		},
+	})
+	require.NoError(t, err)
+	_, err = emulatorCtx.Connect(emulator.DeviceConfig{
+		Desc:         newDeviceDesc(4, 0xFF02, 0x0001),
+		Manufacturer: "gohid",
+		Product:      "Other device",
+		Interfaces: map[int]emulator.InterfaceConfig{
+			0: {ReportDescriptor: mouseDescriptor},
+		},
+	})
+	require.NoError(t, err)
+
+	gateway := webhid.NewGateway(manager.NewDeviceManager(emulatorCtx, slog.Default()), webhid.Config{
+		Allowlist:    []webhid.Filter{{VendorID: 0xFF01}},
+		Origins:      origins,
+		DeviceConfig: hid.DeviceConfig{StreamLaneCount: hid.DEFAULT_ENDPOINT_STREAM_COUNT},
+	}, slog.Default())
+	server := httptest.NewServer(gateway)
+	t.Cleanup(func() {
+		gateway.Close()
+		server.Close()
+	})
+
+	return device, server
+}
+
+// client is a WebSocket session, which separates responses from events
+type client struct {
+	t         *testing.T
+	conn      *websocket.Conn
+	nextID    uint32
+	responses chan webhid.Response
+	events    chan webhid.Event
+}
+
+func dial(t *testing.T, server *httptest.Server) *client {
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		conn.Close()
+	})
+
+	c := &client{
+		t:         t,
+		conn:      conn,
+		responses: make(chan webhid.Response, 16),
+		events:    make(chan webhid.Event, 16),
+	}
+	go func() {
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			var msg struct {
+				Type webhid.EventType `json:"type"`
+			}
+			require.NoError(t, json.Unmarshal(data, &msg))
+			if msg.Type != "" {
+				var event webhid.Event
+				require.NoError(t, json.Unmarshal(data, &event))
+				c.events <- event
+			} else {
+				var res webhid.Response
+				require.NoError(t, json.Unmarshal(data, &res))
+				c.responses <- res
+			}
+		}
+	}()
+
+	return c
+}
+
+func (c *client) call(req webhid.Request) webhid.Response {
+	c.nextID++
+	req.ID = c.nextID
+	require.NoError(c.t, c.conn.WriteJSON(req))
+	select {
+	case res := <-c.responses:
+		assert.Equal(c.t, req.ID, res.ID)
+		return res
+	case <-time.After(time.Second):
+		require.FailNow(c.t, "response timeout")
+		return webhid.Response{}
+	}
+}
+
+func (c *client) event() webhid.Event {
+	select {
+	case event := <-c.events:
+		return event
+	case <-time.After(time.Second):
+		require.FailNow(c.t, "event timeout")
+		return webhid.Event{}
+	}
+}
+
+func errorName(res webhid.Response) string {
+	if res.Error == nil {
+		return ""
+	}
+
+	return res.Error.Name
+}
+
+func TestGateway_Devices(t *testing.T) {
+	_, server := serve(t, nil, nil)
+
+	res, err := http.Get(server.URL)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	var devices []webhid.Device
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&devices))
+	require.Len(t, devices, 1)
+	assert.Equal(t, "ff01:0001:0", devices[0].ID)
+	assert.Equal(t, "Allowed device", devices[0].ProductName)
+	assert.False(t, devices[0].Opened)
+
+	res, err = http.Post(server.URL, "application/json", nil)
+	require.NoError(t, err)
+	res.Body.Close()
+	assert.Equal(t, http.StatusMethodNotAllowed, res.StatusCode)
+}
+
+func TestGateway_Origin(t *testing.T) {
+	_, server := serve(t, nil, []string{"https://example.com"})
+	url := "ws" + strings.TrimPrefix(server.URL, "http")
+
+	_, res, err := websocket.DefaultDialer.Dial(url, http.Header{"Origin": {"https://attacker.example"}})
+	assert.Error(t, err)
+	require.NotNil(t, res)
+	assert.Equal(t, http.StatusForbidden, res.StatusCode)
+
+	conn, _, err := websocket.DefaultDialer.Dial(url, http.Header{"Origin": {"https://example.com"}})
+	require.NoError(t, err)
+	conn.Close()
+}
+
+func TestGateway_Session(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	handler := emulator.NewMockHandler(ctrl)
+	device, server := serve(t, handler, nil)
+	c := dial(t, server)
+	const id = "ff01:0001:0"
+
+	// Devices must be requested before they are opened
+	res := c.call(webhid.Request{Method: webhid.METHOD_OPEN, DeviceID: id})
+	assert.Equal(t, webhid.ERROR_NOT_ALLOWED, errorName(res))
+	res = c.call(webhid.Request{Method: webhid.METHOD_GET_DEVICES})
+	assert.Nil(t, res.Error)
+	assert.Empty(t, res.Devices)
+	res = c.call(webhid.Request{Method: webhid.METHOD_REQUEST_DEVICE, Filters: []webhid.Filter{{UsagePage: 0x0C}}})
+	assert.Nil(t, res.Error)
+	assert.Empty(t, res.Devices)
+
+	res = c.call(webhid.Request{Method: webhid.METHOD_REQUEST_DEVICE, Filters: []webhid.Filter{{UsagePage: 0xFF00, Usage: 0x01}}})
+	assert.Nil(t, res.Error)
+	require.Len(t, res.Devices, 1)
+	assert.Equal(t, id, res.Devices[0].ID)
+	assert.Equal(t, uint16(0xFF01), res.Devices[0].VendorID)
+	require.Len(t, res.Devices[0].Collections, 1)
+	collection := res.Devices[0].Collections[0]
+	assert.Equal(t, uint16(0xFF00), collection.UsagePage)
+	require.Len(t, collection.InputReports, 1)
+	require.Len(t, collection.InputReports[0].Items, 1)
+	assert.Equal(t, uint32(2), collection.InputReports[0].Items[0].ReportCount)
+	assert.Equal(t, int32(255), collection.InputReports[0].Items[0].LogicalMaximum)
+
+	res = c.call(webhid.Request{Method: webhid.METHOD_SEND_REPORT, DeviceID: id, Data: hid.HexBytes{0x01, 0x02}})
+	assert.Equal(t, webhid.ERROR_INVALID_STATE, errorName(res))
+	res = c.call(webhid.Request{Method: webhid.METHOD_OPEN, DeviceID: id})
+	assert.Nil(t, res.Error)
+	res = c.call(webhid.Request{Method: webhid.METHOD_OPEN, DeviceID: id})
+	assert.Equal(t, webhid.ERROR_INVALID_STATE, errorName(res))
+	res = c.call(webhid.Request{Method: webhid.METHOD_GET_DEVICES})
+	require.Len(t, res.Devices, 1)
+	assert.True(t, res.Devices[0].Opened)
+
+	handler.EXPECT().Output([]byte{0x01, 0x02}).Return(nil)
+	handler.EXPECT().SetReport(hid.REPORT_TYPE_FEATURE, uint8(0), []byte{0x03, 0x04}).Return(nil)
+	handler.EXPECT().GetReport(hid.REPORT_TYPE_FEATURE, uint8(0), gomock.Len(2)).DoAndReturn(
+		func(reportType hid.ReportType, reportID uint8, data []byte) (int, error) {
+			return copy(data, []byte{0x05, 0x06}), nil
+		},
+	)
+	res = c.call(webhid.Request{Method: webhid.METHOD_SEND_REPORT, DeviceID: id, Data: hid.HexBytes{0x01, 0x02}})
+	assert.Nil(t, res.Error)
+	res = c.call(webhid.Request{Method: webhid.METHOD_SEND_FEATURE_REPORT, DeviceID: id, Data: hid.HexBytes{0x03, 0x04}})
+	assert.Nil(t, res.Error)
+	res = c.call(webhid.Request{Method: webhid.METHOD_RECEIVE_FEATURE_REPORT, DeviceID: id})
+	assert.Nil(t, res.Error)
+	assert.Equal(t, hid.HexBytes{0x05, 0x06}, res.Data)
+	res = c.call(webhid.Request{Method: webhid.METHOD_RECEIVE_FEATURE_REPORT, DeviceID: id, ReportID: 1})
+	assert.Equal(t, webhid.ERROR_NOT_FOUND, errorName(res))
+
+	require.NoError(t, device.SendInput(context.Background(), 0, []byte{0x07, 0x08}))
+	assert.Equal(t, webhid.Event{Type: webhid.EVENT_INPUT_REPORT, DeviceID: id, Data: hid.HexBytes{0x07, 0x08}}, c.event())
+
+	res = c.call(webhid.Request{Method: "unknown"})
+	assert.Equal(t, webhid.ERROR_NOT_SUPPORTED, errorName(res))
+
+	// Closing twice succeeds, and forgetting revokes the grant
+	res = c.call(webhid.Request{Method: webhid.METHOD_CLOSE, DeviceID: id})
+	assert.Nil(t, res.Error)
+	res = c.call(webhid.Request{Method: webhid.METHOD_CLOSE, DeviceID: id})
+	assert.Nil(t, res.Error)
+	res = c.call(webhid.Request{Method: webhid.METHOD_FORGET, DeviceID: id})
+	assert.Nil(t, res.Error)
+	res = c.call(webhid.Request{Method: webhid.METHOD_OPEN, DeviceID: id})
+	assert.Equal(t, webhid.ERROR_NOT_ALLOWED, errorName(res))
+}
+
+func TestGateway_SharedDevice(t *testing.T) {
+	device, server := serve(t, nil, nil)
+	const id = "ff01:0001:0"
+	clients := []*client{dial(t, server), dial(t, server)}
+	for _, c := range clients {
+		res := c.call(webhid.Request{Method: webhid.METHOD_REQUEST_DEVICE})
+		require.Len(t, res.Devices, 1)
+		res = c.call(webhid.Request{Method: webhid.METHOD_OPEN, DeviceID: id})
+		require.Nil(t, res.Error)
+	}
+
+	// Input reports are sent to every session which opened the device
+	require.NoError(t, device.SendInput(context.Background(), 0, []byte{0x01, 0x02}))
+	for _, c := range clients {
+		assert.Equal(t, webhid.Event{Type: webhid.EVENT_INPUT_REPORT, DeviceID: id, Data: hid.HexBytes{0x01, 0x02}}, c.event())
+	}
+
+	// The device is still read by the other session after one of them closes it
+	res := clients[0].call(webhid.Request{Method: webhid.METHOD_CLOSE, DeviceID: id})
+	require.Nil(t, res.Error)
+	require.NoError(t, device.SendInput(context.Background(), 0, []byte{0x03, 0x04}))
+	assert.Equal(t, hid.HexBytes{0x03, 0x04}, clients[1].event().Data)
+
+	device.Disconnect()
+	assert.Equal(t, webhid.Event{Type: webhid.EVENT_DISCONNECT, DeviceID: id}, clients[1].event())
+	res = clients[1].call(webhid.Request{Method: webhid.METHOD_SEND_REPORT, DeviceID: id, Data: hid.HexBytes{0x01, 0x02}})
+	assert.Equal(t, webhid.ERROR_INVALID_STATE, errorName(res))
+	res = clients[1].call(webhid.Request{Method: webhid.METHOD_GET_DEVICES})
+	assert.Empty(t, res.Devices)
+	assert.Empty(t, clients[0].events)
+}
+
+func TestGateway_FailedReads(t *testing.T) {
+	device, server := serve(t, nil, nil)
+	const id = "ff01:0001:0"
+	c := dial(t, server)
+	res := c.call(webhid.Request{Method: webhid.METHOD_REQUEST_DEVICE})
+	require.Len(t, res.Devices, 1)
+	res = c.call(webhid.Request{Method: webhid.METHOD_OPEN, DeviceID: id})
+	require.Nil(t, res.Error)
+
+	// Pending read receives the report, then the next read is stalled once
+	device.SetHalt(0x81, true)
+	require.NoError(t, device.SendInput(context.Background(), 0, []byte{0x01, 0x02}))
+	assert.Equal(t, hid.HexBytes{0x01, 0x02}, c.event().Data)
+	time.Sleep(10 * time.Millisecond)
+	device.SetHalt(0x81, false)
+	require.NoError(t, device.SendInput(context.Background(), 0, []byte{0x03, 0x04}))
+	assert.Equal(t, webhid.Event{Type: webhid.EVENT_INPUT_REPORT, DeviceID: id, Data: hid.HexBytes{0x03, 0x04}}, c.event())
+
+	// Device which cannot be read anymore is closed as if it was unplugged
+	device.SetHalt(0x81, true)
+	require.NoError(t, device.SendInput(context.Background(), 0, []byte{0x05, 0x06}))
+	assert.Equal(t, hid.HexBytes{0x05, 0x06}, c.event().Data)
+	assert.Equal(t, webhid.Event{Type: webhid.EVENT_DISCONNECT, DeviceID: id}, c.event())
+	res = c.call(webhid.Request{Method: webhid.METHOD_SEND_REPORT, DeviceID: id, Data: hid.HexBytes{0x01, 0x02}})
+	assert.Equal(t, webhid.ERROR_INVALID_STATE, errorName(res))
+}